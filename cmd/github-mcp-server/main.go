@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"time"
+	_ "time/tzdata" // embed the timezone database so --timezone works in minimal containers
 
 	"github.com/github/github-mcp-server/internal/ghmcp"
 	"github.com/github/github-mcp-server/pkg/github"
@@ -32,8 +33,15 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			if configFile := viper.GetString("config"); configFile != "" {
+				viper.SetConfigFile(configFile)
+				if err := viper.ReadInConfig(); err != nil {
+					return fmt.Errorf("failed to read config file: %w", err)
+				}
+			}
+
 			token := viper.GetString("personal_access_token")
-			if token == "" {
+			if token == "" && viper.GetString("replay") == "" && !viper.GetBool("sandbox") {
 				return errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
 			}
 
@@ -57,21 +65,69 @@ var (
 				enabledToolsets = []string{github.ToolsetMetadataDefault.ID}
 			}
 
+			var projectTransitions github.ProjectTransitions
+			if err := viper.UnmarshalKey("project_transitions", &projectTransitions); err != nil {
+				return fmt.Errorf("failed to unmarshal project transitions: %w", err)
+			}
+
+			var pullRequestTemplatePolicies github.PullRequestTemplatePolicies
+			if err := viper.UnmarshalKey("pull_request_templates", &pullRequestTemplatePolicies); err != nil {
+				return fmt.Errorf("failed to unmarshal pull request template policies: %w", err)
+			}
+
+			var iterationCapacity map[string]float64
+			if err := viper.UnmarshalKey("iteration_capacity", &iterationCapacity); err != nil {
+				return fmt.Errorf("failed to unmarshal iteration capacity: %w", err)
+			}
+
+			var licenseAllowList []string
+			if err := viper.UnmarshalKey("license_allow_list", &licenseAllowList); err != nil {
+				return fmt.Errorf("failed to unmarshal license allow-list: %w", err)
+			}
+
+			var toolsetConcurrency map[string]int
+			if err := viper.UnmarshalKey("toolset_concurrency", &toolsetConcurrency); err != nil {
+				return fmt.Errorf("failed to unmarshal toolset concurrency: %w", err)
+			}
+
+			var allowList []string
+			if err := viper.UnmarshalKey("allow-list", &allowList); err != nil {
+				return fmt.Errorf("failed to unmarshal allow-list: %w", err)
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			stdioServerConfig := ghmcp.StdioServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Token:                token,
-				EnabledToolsets:      enabledToolsets,
-				EnabledTools:         enabledTools,
-				DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
-				ReadOnly:             viper.GetBool("read-only"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
-				LockdownMode:         viper.GetBool("lockdown-mode"),
-				RepoAccessCacheTTL:   &ttl,
+				Version:                     version,
+				Host:                        viper.GetString("host"),
+				Token:                       token,
+				EnabledToolsets:             enabledToolsets,
+				EnabledTools:                enabledTools,
+				DynamicToolsets:             viper.GetBool("dynamic_toolsets"),
+				ReadOnly:                    viper.GetBool("read-only"),
+				ExportTranslations:          viper.GetBool("export-translations"),
+				Locale:                      viper.GetString("locale"),
+				EnableCommandLogging:        viper.GetBool("enable-command-logging"),
+				LogFilePath:                 viper.GetString("log-file"),
+				ContentWindowSize:           viper.GetInt("content-window-size"),
+				LockdownMode:                viper.GetBool("lockdown-mode"),
+				RepoAccessCacheTTL:          &ttl,
+				MetricsAddr:                 viper.GetString("metrics-addr"),
+				Timezone:                    viper.GetString("timezone"),
+				ProjectTransitions:          projectTransitions,
+				PullRequestTemplatePolicies: pullRequestTemplatePolicies,
+				IterationCapacity:           iterationCapacity,
+				LicenseAllowList:            licenseAllowList,
+				EnableModels:                viper.GetBool("enable-models"),
+				MaxConcurrentRequests:       viper.GetInt("max-concurrent-requests"),
+				ToolsetConcurrency:          toolsetConcurrency,
+				GraphQLBudget:               viper.GetInt("graphql-budget"),
+				RecordDir:                   viper.GetString("record"),
+				ReplayDir:                   viper.GetString("replay"),
+				Sandbox:                     viper.GetBool("sandbox"),
+				AllowList:                   allowList,
+				SigningKey:                  viper.GetString("signing-key"),
+				DefaultOwner:                viper.GetString("default-owner"),
+				DefaultRepo:                 viper.GetString("default-repo"),
 			}
 			return ghmcp.RunStdioServer(stdioServerConfig)
 		},
@@ -92,10 +148,24 @@ func init() {
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
+	rootCmd.PersistentFlags().String("locale", "", "Bundled locale for tool descriptions and result messages, e.g. de (default English)")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
+	rootCmd.PersistentFlags().String("metrics-addr", "", "Address to serve Prometheus metrics (/metrics) and health checks (/healthz, /readyz) on (e.g. :9090). Disabled if empty")
+	rootCmd.PersistentFlags().String("timezone", "", "IANA timezone used to resolve relative dates in search queries, e.g. Europe/Berlin (default UTC)")
+	rootCmd.PersistentFlags().Bool("enable-models", false, "Make the models toolset available, which calls the GitHub Models inference API with the server's token")
+	rootCmd.PersistentFlags().Int("max-concurrent-requests", 0, "Maximum number of GitHub API requests in flight at once; further requests are queued (0 for no limit)")
+	rootCmd.PersistentFlags().Int("graphql-budget", 0, "Estimated GraphQL rate limit points each session may spend per hour; queries over budget are rejected (0 for no budget)")
+	rootCmd.PersistentFlags().String("record", "", "Record all GitHub API responses as fixtures in this directory")
+	rootCmd.PersistentFlags().String("replay", "", "Serve GitHub API responses from the fixtures in this directory instead of calling GitHub; no token is needed")
+	rootCmd.PersistentFlags().Bool("sandbox", false, "Serve a synthetic organization with repositories, issues, pull requests and a project from memory instead of calling GitHub; writes are kept in memory and no token is needed")
+	rootCmd.PersistentFlags().StringSlice("allow-list", nil, "Restrict all tools to these owners, repositories (owner/repo) and projects (owner/projects/N); calls naming anything else, or naming nothing, are denied")
+	rootCmd.PersistentFlags().String("signing-key", "", "Secret used to attach an HMAC-SHA256 signature of the request and content to every tool result, so consumers can verify results came from this server (prefer the GITHUB_SIGNING_KEY environment variable)")
+	rootCmd.PersistentFlags().String("default-owner", "", "Owner used by tools called without one, making the owner argument optional")
+	rootCmd.PersistentFlags().String("default-repo", "", "Repository of the default owner used by tools called without one, making the repo argument optional")
+	rootCmd.PersistentFlags().String("config", "", "Path to a YAML, JSON or TOML config file setting any of these flags, the project_transitions mapping, the pull_request_templates policies, the iteration_capacity of people, the license_allow_list of dependencies and the toolset_concurrency limits")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
@@ -105,10 +175,24 @@ func init() {
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
+	_ = viper.BindPFlag("locale", rootCmd.PersistentFlags().Lookup("locale"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
+	_ = viper.BindPFlag("metrics-addr", rootCmd.PersistentFlags().Lookup("metrics-addr"))
+	_ = viper.BindPFlag("timezone", rootCmd.PersistentFlags().Lookup("timezone"))
+	_ = viper.BindPFlag("enable-models", rootCmd.PersistentFlags().Lookup("enable-models"))
+	_ = viper.BindPFlag("max-concurrent-requests", rootCmd.PersistentFlags().Lookup("max-concurrent-requests"))
+	_ = viper.BindPFlag("graphql-budget", rootCmd.PersistentFlags().Lookup("graphql-budget"))
+	_ = viper.BindPFlag("record", rootCmd.PersistentFlags().Lookup("record"))
+	_ = viper.BindPFlag("replay", rootCmd.PersistentFlags().Lookup("replay"))
+	_ = viper.BindPFlag("sandbox", rootCmd.PersistentFlags().Lookup("sandbox"))
+	_ = viper.BindPFlag("allow-list", rootCmd.PersistentFlags().Lookup("allow-list"))
+	_ = viper.BindPFlag("signing-key", rootCmd.PersistentFlags().Lookup("signing-key"))
+	_ = viper.BindPFlag("default-owner", rootCmd.PersistentFlags().Lookup("default-owner"))
+	_ = viper.BindPFlag("default-repo", rootCmd.PersistentFlags().Lookup("default-repo"))
+	_ = viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
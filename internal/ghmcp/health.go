@@ -0,0 +1,70 @@
+package ghmcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	gogithub "github.com/google/go-github/v79/github"
+)
+
+// readinessTimeout bounds the time spent verifying GitHub connectivity in a readiness probe.
+const readinessTimeout = 5 * time.Second
+
+type healthStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+func writeHealthStatus(w http.ResponseWriter, code int, status healthStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// livenessHandler reports that the process is up and able to serve requests.
+func livenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeHealthStatus(w, http.StatusOK, healthStatus{Status: "ok"})
+	})
+}
+
+// readinessHandler reports whether the server can serve tool calls, as determined by check.
+func readinessHandler(check func(ctx context.Context) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+		if err := check(ctx); err != nil {
+			writeHealthStatus(w, http.StatusServiceUnavailable, healthStatus{Status: "unavailable", Error: err.Error()})
+			return
+		}
+		writeHealthStatus(w, http.StatusOK, healthStatus{Status: "ok"})
+	})
+}
+
+// checkGitHubAccess verifies that GitHub is reachable and the token is valid. It calls the rate limit endpoint,
+// which requires authentication but does not count against the rate limit.
+func checkGitHubAccess(client *gogithub.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		_, resp, err := client.RateLimit.Get(ctx)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+				return fmt.Errorf("GitHub token is invalid or expired")
+			}
+			return fmt.Errorf("failed to reach GitHub: %w", err)
+		}
+		_ = resp.Body.Close()
+		return nil
+	}
+}
+
+// newReadinessCheck returns the readiness check for a server running with cfg. In sandbox and replay modes no request
+// reaches GitHub and no token is needed, so the server is always ready.
+func newReadinessCheck(cfg StdioServerConfig, apiHost apiHost) func(ctx context.Context) error {
+	if cfg.Sandbox || cfg.ReplayDir != "" {
+		return func(context.Context) error { return nil }
+	}
+	return checkGitHubAccess(newRESTClient(apiHost, cfg.Token, cfg.Version, nil))
+}
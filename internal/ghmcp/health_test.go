@@ -0,0 +1,89 @@
+package ghmcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	livenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestReadinessHandler(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		readinessHandler(func(context.Context) error { return nil }).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		readinessHandler(func(context.Context) error { return errors.New("GitHub token is invalid or expired") }).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		var status healthStatus
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		assert.Equal(t, "unavailable", status.Status)
+		assert.Equal(t, "GitHub token is invalid or expired", status.Error)
+	})
+}
+
+func TestCheckGitHubAccess(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		expectedErr string
+	}{
+		{name: "valid token", status: http.StatusOK},
+		{name: "expired token", status: http.StatusUnauthorized, expectedErr: "GitHub token is invalid or expired"},
+		{name: "GitHub unavailable", status: http.StatusBadGateway, expectedErr: "failed to reach GitHub"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/rate_limit", r.URL.Path)
+				assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{"resources":{}}`))
+			}))
+			defer ts.Close()
+
+			baseURL, err := url.Parse(ts.URL + "/")
+			require.NoError(t, err)
+			client := newRESTClient(apiHost{baseRESTURL: baseURL, uploadURL: baseURL}, "test-token", "test", nil)
+
+			err = checkGitHubAccess(client)(context.Background())
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestNewReadinessCheck(t *testing.T) {
+	// No GitHub server is listening here, so only checks that skip GitHub succeed.
+	baseURL, err := url.Parse("http://127.0.0.1:1/")
+	require.NoError(t, err)
+	host := apiHost{baseRESTURL: baseURL, uploadURL: baseURL}
+
+	assert.NoError(t, newReadinessCheck(StdioServerConfig{Sandbox: true}, host)(context.Background()))
+	assert.NoError(t, newReadinessCheck(StdioServerConfig{ReplayDir: t.TempDir()}, host)(context.Background()))
+	assert.ErrorContains(t, newReadinessCheck(StdioServerConfig{Token: "test-token"}, host)(context.Background()), "failed to reach GitHub")
+}
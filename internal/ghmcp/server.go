@@ -14,11 +14,19 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/github/github-mcp-server/pkg/allowlist"
+	"github.com/github/github-mcp-server/pkg/defaults"
 	"github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/gqlbudget"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	mcplog "github.com/github/github-mcp-server/pkg/log"
+	"github.com/github/github-mcp-server/pkg/metrics"
 	"github.com/github/github-mcp-server/pkg/raw"
+	"github.com/github/github-mcp-server/pkg/replay"
+	"github.com/github/github-mcp-server/pkg/sandbox"
+	"github.com/github/github-mcp-server/pkg/signing"
+	"github.com/github/github-mcp-server/pkg/throttle"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gogithub "github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -62,6 +70,57 @@ type MCPServerConfig struct {
 
 	// RepoAccessTTL overrides the default TTL for repository access cache entries.
 	RepoAccessTTL *time.Duration
+
+	// Metrics records tool invocations and GitHub API requests if set
+	Metrics *metrics.Registry
+
+	// Timezone is the IANA name of the timezone used to resolve relative dates in queries. Defaults to UTC.
+	Timezone string
+
+	// ProjectTransitions maps symbolic transitions of project items to the status options of projects.
+	ProjectTransitions github.ProjectTransitions
+
+	// PullRequestTemplatePolicies configures how create_pull_request fills the pull request templates of owners.
+	PullRequestTemplatePolicies github.PullRequestTemplatePolicies
+
+	// IterationCapacity is the number of points people can take on per iteration, keyed by login.
+	IterationCapacity map[string]float64
+
+	// LicenseAllowList are the SPDX identifiers of the licenses check_dependency_licenses allows dependencies to use.
+	LicenseAllowList []string
+
+	// EnableModels makes the models toolset available, which calls the GitHub Models inference API.
+	EnableModels bool
+	// MaxConcurrentRequests bounds the number of GitHub API requests in flight at once. Zero means no limit.
+	MaxConcurrentRequests int
+
+	// ToolsetConcurrency bounds the number of GitHub API requests in flight at once per toolset, keyed by toolset ID.
+	ToolsetConcurrency map[string]int
+
+	// GraphQLBudget is the number of estimated GraphQL rate limit points each session may spend per hour. Zero means
+	// no budget.
+	GraphQLBudget int
+
+	// RecordDir is a directory to record all GitHub API responses to as fixtures, if set.
+	RecordDir string
+
+	// ReplayDir is a directory of fixtures to serve GitHub API responses from instead of calling GitHub, if set.
+	ReplayDir string
+
+	// Sandbox serves a synthetic organization from memory instead of calling GitHub.
+	Sandbox bool
+
+	// AllowList restricts all tools to these owners, repositories ("owner/repo") and projects ("owner/projects/N"),
+	// denying everything else. No restriction applies if empty.
+	AllowList []string
+
+	// SigningKey is the secret used to attach an HMAC signature to every tool result, if set.
+	SigningKey string
+
+	// DefaultOwner and DefaultRepo are used by tools called without an owner or repo, which makes those arguments
+	// optional. DefaultRepo needs DefaultOwner.
+	DefaultOwner string
+	DefaultRepo  string
 }
 
 const stdioServerLogPrefix = "stdioserver"
@@ -71,19 +130,91 @@ func NewMCPServer(cfg MCPServerConfig, logger *slog.Logger) (*server.MCPServer,
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API host: %w", err)
 	}
+	// The GitHub Models inference API is only served by github.com, so enterprise tokens must not be sent to it.
+	if cfg.EnableModels && apiHost.baseRESTURL.Host != dotcomAPIHostname {
+		return nil, fmt.Errorf("GitHub Models is only available on github.com and cannot be enabled for host %s", cfg.Host)
+	}
+
+	timezone, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timezone: %w", err)
+	}
+
+	for toolset := range cfg.ToolsetConcurrency {
+		if !github.GetValidToolsetIDs()[toolset] {
+			return nil, fmt.Errorf("unknown toolset %q in toolset concurrency limits", toolset)
+		}
+	}
+
+	var allowList *allowlist.List
+	if len(cfg.AllowList) > 0 {
+		if allowList, err = allowlist.Parse(cfg.AllowList); err != nil {
+			return nil, err
+		}
+	}
+
+	var toolDefaults *defaults.Defaults
+	if cfg.DefaultOwner != "" || cfg.DefaultRepo != "" {
+		if toolDefaults, err = defaults.New(cfg.DefaultOwner, cfg.DefaultRepo); err != nil {
+			return nil, err
+		}
+	}
+
+	var signer *signing.Signer
+	if cfg.SigningKey != "" {
+		if signer, err = signing.NewSigner([]byte(cfg.SigningKey)); err != nil {
+			return nil, err
+		}
+	}
+
+	var baseTransport http.RoundTripper = http.DefaultTransport
+	if cfg.Sandbox {
+		baseTransport = sandbox.New()
+	}
+	switch {
+	case cfg.RecordDir != "" && cfg.ReplayDir != "":
+		return nil, fmt.Errorf("recording and replaying fixtures cannot be combined")
+	case cfg.Sandbox && cfg.ReplayDir != "":
+		return nil, fmt.Errorf("the sandbox and replaying fixtures cannot be combined")
+	case cfg.RecordDir != "":
+		if baseTransport, err = replay.NewRecorder(cfg.RecordDir, baseTransport); err != nil {
+			return nil, err
+		}
+	case cfg.ReplayDir != "":
+		if baseTransport, err = replay.NewReplayer(cfg.ReplayDir); err != nil {
+			return nil, err
+		}
+	}
+
+	gqlTransport, restTransport := baseTransport, baseTransport
+	if cfg.Metrics != nil {
+		gqlTransport = cfg.Metrics.Transport("graphql", gqlTransport)
+		restTransport = cfg.Metrics.Transport("rest", restTransport)
+	}
+	var limiter *throttle.Limiter
+	if cfg.MaxConcurrentRequests > 0 || len(cfg.ToolsetConcurrency) > 0 {
+		limiter = throttle.NewLimiter(cfg.MaxConcurrentRequests, cfg.ToolsetConcurrency)
+		// Requests are throttled before metrics are recorded, so that time spent queuing does not count as latency.
+		gqlTransport = limiter.Transport(gqlTransport)
+		restTransport = limiter.Transport(restTransport)
+	}
+	if cfg.GraphQLBudget > 0 {
+		gqlTransport = gqlbudget.NewBudget(cfg.GraphQLBudget, gqlbudget.DefaultWindow).Transport(gqlTransport)
+	}
+	var restHTTPClient *http.Client
+	if restTransport != http.DefaultTransport {
+		restHTTPClient = &http.Client{Transport: restTransport}
+	}
 
 	// Construct our REST client
-	restClient := gogithub.NewClient(nil).WithAuthToken(cfg.Token)
-	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", cfg.Version)
-	restClient.BaseURL = apiHost.baseRESTURL
-	restClient.UploadURL = apiHost.uploadURL
+	restClient := newRESTClient(apiHost, cfg.Token, cfg.Version, restHTTPClient)
 
 	// Construct our GraphQL client
 	// We're using NewEnterpriseClient here unconditionally as opposed to NewClient because we already
 	// did the necessary API host parsing so that github.com will return the correct URL anyway.
 	gqlHTTPClient := &http.Client{
 		Transport: &bearerAuthTransport{
-			transport: http.DefaultTransport,
+			transport: gqlTransport,
 			token:     cfg.Token,
 		},
 	} // We're going to wrap the Transport later in beforeInit
@@ -154,11 +285,6 @@ func NewMCPServer(cfg MCPServerConfig, logger *slog.Logger) (*server.MCPServer,
 	// Generate instructions based on enabled toolsets
 	instructions := github.GenerateInstructions(enabledToolsets)
 
-	ghServer := github.NewServer(cfg.Version,
-		server.WithInstructions(instructions),
-		server.WithHooks(hooks),
-	)
-
 	getClient := func(_ context.Context) (*gogithub.Client, error) {
 		return restClient, nil // closing over client
 	}
@@ -183,10 +309,48 @@ func NewMCPServer(cfg MCPServerConfig, logger *slog.Logger) (*server.MCPServer,
 		getRawClient,
 		cfg.Translator,
 		cfg.ContentWindowSize,
-		github.FeatureFlags{LockdownMode: cfg.LockdownMode},
+		github.FeatureFlags{LockdownMode: cfg.LockdownMode, Timezone: timezone, ProjectTransitions: cfg.ProjectTransitions, PullRequestTemplatePolicies: cfg.PullRequestTemplatePolicies, IterationCapacity: cfg.IterationCapacity, LicenseAllowList: cfg.LicenseAllowList, Models: cfg.EnableModels},
 		repoAccessCache,
 	)
 
+	serverOpts := []server.ServerOption{
+		server.WithInstructions(instructions),
+		server.WithHooks(hooks),
+	}
+	// The signer wraps all other middleware, so that the results they return, such as denials, are signed too.
+	if signer != nil {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(signer.ToolHandlerMiddleware()))
+	}
+	if cfg.Metrics != nil {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(cfg.Metrics.ToolHandlerMiddleware()))
+	}
+	// Defaults are filled in before the allow-list checks the owner and repository of a call.
+	if toolDefaults != nil {
+		tsg.WrapTools(toolDefaults.Tool)
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(toolDefaults.ToolHandlerMiddleware(func(name string) (mcp.Tool, bool) {
+			tool, _, err := tsg.FindToolByName(name)
+			if err != nil {
+				return mcp.Tool{}, false
+			}
+			return tool.Tool, true
+		})))
+	}
+	if allowList != nil {
+		tsg.WrapResourceTemplateHandlers(allowList.ResourceTemplateHandlerMiddleware())
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(allowList.ToolHandlerMiddleware(func(tool string) bool {
+			_, _, err := tsg.FindToolByName(tool)
+			return err == nil
+		})))
+	}
+	if limiter != nil {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(limiter.ToolHandlerMiddleware(func(tool string) string {
+			_, toolset, _ := tsg.FindToolByName(tool)
+			return toolset
+		})))
+	}
+
+	ghServer := github.NewServer(cfg.Version, serverOpts...)
+
 	// Enable and register toolsets if configured
 	// This always happens if toolsets are specified, regardless of whether tools are also specified
 	if len(enabledToolsets) > 0 {
@@ -220,6 +384,15 @@ func NewMCPServer(cfg MCPServerConfig, logger *slog.Logger) (*server.MCPServer,
 	return ghServer, nil
 }
 
+// newRESTClient creates a REST client for the given API host, authenticated with token.
+func newRESTClient(apiHost apiHost, token, version string, httpClient *http.Client) *gogithub.Client {
+	restClient := gogithub.NewClient(httpClient).WithAuthToken(token)
+	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", version)
+	restClient.BaseURL = apiHost.baseRESTURL
+	restClient.UploadURL = apiHost.uploadURL
+	return restClient
+}
+
 type StdioServerConfig struct {
 	// Version of the server
 	Version string
@@ -249,6 +422,9 @@ type StdioServerConfig struct {
 	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#i18n--overriding-descriptions
 	ExportTranslations bool
 
+	// Locale selects a bundled locale for tool descriptions and result messages. Defaults to English.
+	Locale string
+
 	// EnableCommandLogging indicates if we should log commands
 	EnableCommandLogging bool
 
@@ -263,6 +439,58 @@ type StdioServerConfig struct {
 
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
+
+	// MetricsAddr is the address to serve Prometheus metrics and health checks on, e.g. ":9090".
+	// Metrics and health checks are disabled if empty.
+	MetricsAddr string
+
+	// Timezone is the IANA name of the timezone used to resolve relative dates in queries. Defaults to UTC.
+	Timezone string
+
+	// ProjectTransitions maps symbolic transitions of project items to the status options of projects.
+	ProjectTransitions github.ProjectTransitions
+
+	// PullRequestTemplatePolicies configures how create_pull_request fills the pull request templates of owners.
+	PullRequestTemplatePolicies github.PullRequestTemplatePolicies
+
+	// IterationCapacity is the number of points people can take on per iteration, keyed by login.
+	IterationCapacity map[string]float64
+
+	// LicenseAllowList are the SPDX identifiers of the licenses check_dependency_licenses allows dependencies to use.
+	LicenseAllowList []string
+
+	// EnableModels makes the models toolset available, which calls the GitHub Models inference API.
+	EnableModels bool
+	// MaxConcurrentRequests bounds the number of GitHub API requests in flight at once. Zero means no limit.
+	MaxConcurrentRequests int
+
+	// ToolsetConcurrency bounds the number of GitHub API requests in flight at once per toolset, keyed by toolset ID.
+	ToolsetConcurrency map[string]int
+
+	// GraphQLBudget is the number of estimated GraphQL rate limit points each session may spend per hour. Zero means
+	// no budget.
+	GraphQLBudget int
+
+	// RecordDir is a directory to record all GitHub API responses to as fixtures, if set.
+	RecordDir string
+
+	// ReplayDir is a directory of fixtures to serve GitHub API responses from instead of calling GitHub, if set.
+	ReplayDir string
+
+	// Sandbox serves a synthetic organization from memory instead of calling GitHub.
+	Sandbox bool
+
+	// AllowList restricts all tools to these owners, repositories ("owner/repo") and projects ("owner/projects/N"),
+	// denying everything else. No restriction applies if empty.
+	AllowList []string
+
+	// SigningKey is the secret used to attach an HMAC signature to every tool result, if set.
+	SigningKey string
+
+	// DefaultOwner and DefaultRepo are used by tools called without an owner or repo, which makes those arguments
+	// optional. DefaultRepo needs DefaultOwner.
+	DefaultOwner string
+	DefaultRepo  string
 }
 
 // RunStdioServer is not concurrent safe.
@@ -271,7 +499,10 @@ func RunStdioServer(cfg StdioServerConfig) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	t, dumpTranslations := translations.TranslationHelper()
+	t, dumpTranslations, err := translations.TranslationHelperForLocale(cfg.Locale)
+	if err != nil {
+		return err
+	}
 
 	var slogHandler slog.Handler
 	var logOutput io.Writer
@@ -287,26 +518,72 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
 	}
 	logger := slog.New(slogHandler)
-	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "lockdownEnabled", cfg.LockdownMode)
+	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "lockdownEnabled", cfg.LockdownMode, "sandbox", cfg.Sandbox)
 	stdLogger := log.New(logOutput, stdioServerLogPrefix, 0)
 
+	var metricsRegistry *metrics.Registry
+	if cfg.MetricsAddr != "" {
+		metricsRegistry = metrics.NewRegistry()
+	}
+
 	ghServer, err := NewMCPServer(MCPServerConfig{
-		Version:           cfg.Version,
-		Host:              cfg.Host,
-		Token:             cfg.Token,
-		EnabledToolsets:   cfg.EnabledToolsets,
-		EnabledTools:      cfg.EnabledTools,
-		DynamicToolsets:   cfg.DynamicToolsets,
-		ReadOnly:          cfg.ReadOnly,
-		Translator:        t,
-		ContentWindowSize: cfg.ContentWindowSize,
-		LockdownMode:      cfg.LockdownMode,
-		RepoAccessTTL:     cfg.RepoAccessCacheTTL,
+		Version:                     cfg.Version,
+		Host:                        cfg.Host,
+		Token:                       cfg.Token,
+		EnabledToolsets:             cfg.EnabledToolsets,
+		EnabledTools:                cfg.EnabledTools,
+		DynamicToolsets:             cfg.DynamicToolsets,
+		ReadOnly:                    cfg.ReadOnly,
+		Translator:                  t,
+		ContentWindowSize:           cfg.ContentWindowSize,
+		LockdownMode:                cfg.LockdownMode,
+		RepoAccessTTL:               cfg.RepoAccessCacheTTL,
+		Metrics:                     metricsRegistry,
+		Timezone:                    cfg.Timezone,
+		ProjectTransitions:          cfg.ProjectTransitions,
+		PullRequestTemplatePolicies: cfg.PullRequestTemplatePolicies,
+		IterationCapacity:           cfg.IterationCapacity,
+		LicenseAllowList:            cfg.LicenseAllowList,
+		EnableModels:                cfg.EnableModels,
+		MaxConcurrentRequests:       cfg.MaxConcurrentRequests,
+		ToolsetConcurrency:          cfg.ToolsetConcurrency,
+		GraphQLBudget:               cfg.GraphQLBudget,
+		RecordDir:                   cfg.RecordDir,
+		ReplayDir:                   cfg.ReplayDir,
+		Sandbox:                     cfg.Sandbox,
+		AllowList:                   cfg.AllowList,
+		SigningKey:                  cfg.SigningKey,
+		DefaultOwner:                cfg.DefaultOwner,
+		DefaultRepo:                 cfg.DefaultRepo,
 	}, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
 	}
 
+	if metricsRegistry != nil {
+		apiHost, err := parseAPIHost(cfg.Host)
+		if err != nil {
+			return fmt.Errorf("failed to parse API host: %w", err)
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metricsRegistry)
+		mux.Handle("/healthz", livenessHandler())
+		mux.Handle("/readyz", readinessHandler(newReadinessCheck(cfg, apiHost)))
+		metricsServer := &http.Server{
+			Addr:              cfg.MetricsAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("error serving metrics", "error", err)
+			}
+		}()
+		defer func() { _ = metricsServer.Close() }()
+		logger.Info("serving metrics and health checks", "addr", cfg.MetricsAddr)
+	}
+
 	stdioServer := server.NewStdioServer(ghServer)
 	stdioServer.SetErrorLogger(stdLogger)
 
@@ -353,8 +630,11 @@ type apiHost struct {
 	rawURL      *url.URL
 }
 
+// dotcomAPIHostname is the hostname of the github.com REST API.
+const dotcomAPIHostname = "api.github.com"
+
 func newDotcomHost() (apiHost, error) {
-	baseRestURL, err := url.Parse("https://api.github.com/")
+	baseRestURL, err := url.Parse("https://" + dotcomAPIHostname + "/")
 	if err != nil {
 		return apiHost{}, fmt.Errorf("failed to parse dotcom REST URL: %w", err)
 	}
@@ -0,0 +1,21 @@
+package ghmcp
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMCPServer_ModelsOnlyOnDotcom(t *testing.T) {
+	for _, host := range []string{"https://github.example.com", "https://octocorp.ghe.com"} {
+		_, err := NewMCPServer(MCPServerConfig{
+			Host:         host,
+			Token:        "token",
+			EnableModels: true,
+			Translator:   translations.NullTranslationHelper,
+		}, slog.Default())
+		assert.ErrorContains(t, err, "only available on github.com", host)
+	}
+}
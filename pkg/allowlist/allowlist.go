@@ -0,0 +1,274 @@
+// Package allowlist restricts tool calls to an allow-list of owners, repositories and projects, so that a server
+// running with a broadly scoped token can be confined to the assets of one team. Anything not on the list is denied.
+package allowlist
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// issueRefRe matches an issue or pull request reference such as owner/repo#123.
+var issueRefRe = regexp.MustCompile(`^([^/\s]+)/([^/#\s]+)#\d+$`)
+
+// List is a parsed allow-list. Owner logins and repository names are matched case-insensitively, as GitHub does.
+type List struct {
+	owners   map[string]bool
+	repos    map[string]bool
+	projects map[string]bool
+}
+
+// Parse builds a list from entries of the form "owner" (all repositories and projects of the owner), "owner/repo"
+// or "owner/projects/N".
+func Parse(entries []string) (*List, error) {
+	l := &List{owners: map[string]bool{}, repos: map[string]bool{}, projects: map[string]bool{}}
+	for _, entry := range entries {
+		parts := strings.Split(strings.ToLower(strings.TrimSpace(entry)), "/")
+		for _, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("invalid allow-list entry %q", entry)
+			}
+		}
+		switch {
+		case len(parts) == 1:
+			l.owners[parts[0]] = true
+		case len(parts) == 2:
+			l.repos[parts[0]+"/"+parts[1]] = true
+		case len(parts) == 3 && parts[1] == "projects":
+			if _, err := strconv.Atoi(parts[2]); err != nil {
+				return nil, fmt.Errorf("invalid project number in allow-list entry %q", entry)
+			}
+			l.projects[parts[0]+"/"+parts[2]] = true
+		default:
+			return nil, fmt.Errorf("invalid allow-list entry %q: expected owner, owner/repo or owner/projects/N", entry)
+		}
+	}
+	return l, nil
+}
+
+// AllowsOwner reports whether everything belonging to owner is allowed.
+func (l *List) AllowsOwner(owner string) bool {
+	return l.owners[strings.ToLower(owner)]
+}
+
+// AllowsRepo reports whether the repository owner/repo is allowed.
+func (l *List) AllowsRepo(owner, repo string) bool {
+	return l.AllowsOwner(owner) || l.repos[strings.ToLower(owner+"/"+repo)]
+}
+
+// AllowsProject reports whether project number of owner is allowed.
+func (l *List) AllowsProject(owner string, number int) bool {
+	return l.AllowsOwner(owner) || l.projects[strings.ToLower(owner)+"/"+strconv.Itoa(number)]
+}
+
+// projectArgs are the owner and project number arguments naming projects, as used by tools.
+var projectArgs = [][2]string{
+	{"owner", "project_number"},
+	{"source_owner", "source_project_number"},
+	{"target_owner", "target_project_number"},
+	{"project_owner", "project_number"},
+}
+
+// opaqueArgs are arguments naming a target by an ID whose owner cannot be determined from the call, keyed by tool;
+// those under "" apply to every tool. Calls passing any of them are denied.
+var opaqueArgs = map[string][]string{
+	"":                 {"sub_issue_id", "notificationID", "threadID"},
+	"add_project_item": {"item_id"},
+}
+
+// queryQualifiers are the search qualifiers scoping a query to a repository ("repo") or an owner.
+var queryQualifiers = map[string]bool{"repo": true, "org": true, "user": true, "owner": true}
+
+// QueryTargets returns the values of the repo:, org:, user: and owner: qualifiers of a search query, keyed by
+// qualifier. Negated qualifiers, such as -repo:octocat/hello-world, narrow a query rather than widen it and are
+// skipped.
+func QueryTargets(query string) map[string][]string {
+	targets := map[string][]string{}
+	for _, token := range tokenize(query) {
+		name, value, found := strings.Cut(token, ":")
+		name = strings.ToLower(name)
+		if !found || !queryQualifiers[name] {
+			continue
+		}
+		value = strings.Trim(value, `"`)
+		if value != "" {
+			targets[name] = append(targets[name], value)
+		}
+	}
+	return targets
+}
+
+// tokenize splits a query on whitespace outside of double quotes.
+func tokenize(query string) []string {
+	var tokens []string
+	var current strings.Builder
+	quoted := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case !quoted && (r == ' ' || r == '\t' || r == '\n'):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// Check returns an error if the arguments of a call of tool name an owner, repository or project that is not
+// allowed, name a target by an ID whose owner cannot be determined, or name no target at all. A call naming a
+// repository or project of an owner is checked against that repository or project; a call naming only an owner,
+// e.g. to list its repositories, needs the whole owner to be allowed. The repo:, org:, user: and owner: qualifiers of a
+// query argument are checked as well, so that a search cannot reach beyond the list.
+func (l *List) Check(tool string, args map[string]any) error {
+	checked := false
+	deny := func(target string) error {
+		return fmt.Errorf("access to %s is not allowed by the server's allow-list", target)
+	}
+
+	for _, names := range [][]string{opaqueArgs[""], opaqueArgs[tool]} {
+		for _, name := range names {
+			if value, ok := args[name]; ok && value != nil && value != "" {
+				return fmt.Errorf("the server's allow-list cannot determine the owner of the target named by %s, so the call is denied", name)
+			}
+		}
+	}
+
+	owner, _ := args["owner"].(string)
+	if repo, _ := args["repo"].(string); owner != "" && repo != "" {
+		checked = true
+		if !l.AllowsRepo(owner, repo) {
+			return deny(owner + "/" + repo)
+		}
+	}
+	for _, names := range projectArgs {
+		projectOwner, _ := args[names[0]].(string)
+		number, ok := args[names[1]].(float64)
+		if projectOwner == "" || !ok {
+			continue
+		}
+		checked = true
+		if !l.AllowsProject(projectOwner, int(number)) {
+			return deny(fmt.Sprintf("project %d of %s", int(number), projectOwner))
+		}
+	}
+	if owner != "" && !checked {
+		checked = true
+		if !l.AllowsOwner(owner) {
+			return deny(owner)
+		}
+	}
+	for _, name := range []string{"org", "organization"} {
+		if org, _ := args[name].(string); org != "" {
+			checked = true
+			if !l.AllowsOwner(org) {
+				return deny(org)
+			}
+		}
+	}
+	if repos, ok := args["repositories"].([]any); ok {
+		for _, r := range repos {
+			fullName, _ := r.(string)
+			owner, repo, found := strings.Cut(fullName, "/")
+			if !found || !l.AllowsRepo(owner, repo) {
+				return deny(fullName)
+			}
+			checked = true
+		}
+	}
+	// Issue references such as owner/repo#123 may point outside the repository named by owner and repo.
+	if refs, ok := args["issues"].([]any); ok {
+		for _, r := range refs {
+			ref, _ := r.(string)
+			fullName, _, found := strings.Cut(ref, "#")
+			if !found || fullName == "" {
+				continue
+			}
+			owner, repo, found := strings.Cut(fullName, "/")
+			if !found || !l.AllowsRepo(owner, repo) {
+				return deny(fullName)
+			}
+		}
+	}
+	// A reference such as owner/repo#123 names the repository of the issue or pull request a tool acts on. Other tools
+	// take a git ref such as main or refs/heads/feature under the same name, within the repository named by owner and
+	// repo.
+	ref, _ := args["ref"].(string)
+	if m := issueRefRe.FindStringSubmatch(strings.TrimSpace(ref)); m != nil {
+		checked = true
+		if !l.AllowsRepo(m[1], m[2]) {
+			return deny(m[1] + "/" + m[2])
+		}
+	}
+	if query, _ := args["query"].(string); query != "" {
+		targets := QueryTargets(query)
+		for _, fullName := range targets["repo"] {
+			checked = true
+			owner, repo, found := strings.Cut(fullName, "/")
+			if !found || !l.AllowsRepo(owner, repo) {
+				return deny(fullName)
+			}
+		}
+		for _, name := range []string{"org", "user", "owner"} {
+			for _, owner := range targets[name] {
+				checked = true
+				if !l.AllowsOwner(owner) {
+					return deny(owner)
+				}
+			}
+		}
+	}
+
+	if !checked {
+		return fmt.Errorf("the server's allow-list only permits tools that name an allowed owner, repository or project")
+	}
+	return nil
+}
+
+// ToolHandlerMiddleware returns a middleware that denies the tool calls failing Check before any GitHub API request is
+// made. Tools for which isGitHubTool returns false, such as the dynamic toolset discovery tools, are not checked.
+func (l *List) ToolHandlerMiddleware(isGitHubTool func(tool string) bool) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if isGitHubTool(request.Params.Name) {
+				if err := l.Check(request.Params.Name, request.GetArguments()); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// ResourceTemplateHandlerMiddleware returns a middleware that denies reads of resources, such as repository contents,
+// whose template arguments fail Check before any GitHub API request is made.
+func (l *List) ResourceTemplateHandlerMiddleware() func(server.ResourceTemplateHandlerFunc) server.ResourceTemplateHandlerFunc {
+	return func(next server.ResourceTemplateHandlerFunc) server.ResourceTemplateHandlerFunc {
+		return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			// The template matcher gives the value of each variable as a []string.
+			args := map[string]any{}
+			for name, value := range request.Params.Arguments {
+				if values, ok := value.([]string); ok && len(values) > 0 {
+					args[name] = values[0]
+				}
+			}
+			if err := l.Check("", args); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
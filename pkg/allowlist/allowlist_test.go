@@ -0,0 +1,118 @@
+package allowlist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]string{"octo-org", "octocat/hello-world", "octo-org/projects/3"})
+	require.NoError(t, err)
+
+	for _, entry := range []string{"", "octo-org/", "a/b/c", "octo-org/projects/next"} {
+		_, err := Parse([]string{entry})
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	l, err := Parse([]string{"Team-Org", "octocat/Hello-World", "octocat/projects/3"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		tool    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "repository of allowed owner", args: map[string]any{"owner": "team-org", "repo": "api"}},
+		{name: "allowed repository", args: map[string]any{"owner": "OctoCat", "repo": "hello-world", "issue_number": float64(1)}},
+		{name: "other repository", args: map[string]any{"owner": "octocat", "repo": "spoon-knife"}, wantErr: "access to octocat/spoon-knife is not allowed"},
+		{name: "allowed project", args: map[string]any{"owner_type": "user", "owner": "octocat", "project_number": float64(3)}},
+		{name: "other project", args: map[string]any{"owner_type": "user", "owner": "octocat", "project_number": float64(4)}, wantErr: "access to project 4 of octocat"},
+		{name: "allowed repository to other project", args: map[string]any{"owner": "octocat", "repo": "hello-world", "project_number": float64(4)}, wantErr: "project 4 of octocat"},
+		{name: "allowed owner", args: map[string]any{"owner": "team-org"}},
+		{name: "owner of allowed repository only", args: map[string]any{"owner": "octocat"}, wantErr: "access to octocat is not allowed"},
+		{name: "organization", args: map[string]any{"org": "other-org"}, wantErr: "access to other-org"},
+		{name: "mirrored projects", args: map[string]any{"source_owner": "octocat", "source_project_number": float64(3), "target_owner": "other-org", "target_project_number": float64(1)}, wantErr: "project 1 of other-org"},
+		{name: "repositories", args: map[string]any{"repositories": []any{"team-org/api", "octocat/hello-world"}}},
+		{name: "repositories with other", args: map[string]any{"repositories": []any{"team-org/api", "octocat/spoon-knife"}}, wantErr: "octocat/spoon-knife"},
+		{name: "no target", args: map[string]any{"query": "is:issue"}, wantErr: "only permits tools that name"},
+		{name: "query of allowed repository", args: map[string]any{"query": "is:issue repo:octocat/hello-world"}},
+		{name: "query of allowed owner", args: map[string]any{"query": `org:team-org "fix the build"`}},
+		{name: "query reaching other repository", args: map[string]any{"owner": "octocat", "repo": "hello-world", "query": "repo:other/x is:open"}, wantErr: "access to other/x"},
+		{name: "query reaching other owner", args: map[string]any{"owner": "octocat", "repo": "hello-world", "query": "user:OTHER"}, wantErr: "access to OTHER"},
+		{name: "query excluding other repository", args: map[string]any{"owner": "octocat", "repo": "hello-world", "query": "-repo:other/x"}},
+		{name: "quoted qualifier", args: map[string]any{"query": `repo:"other/x"`}, wantErr: "access to other/x"},
+		{name: "cross-repository issue reference", args: map[string]any{"owner": "octocat", "repo": "hello-world", "issues": []any{"#1", "other/x#2"}}, wantErr: "access to other/x"},
+		{name: "opaque issue ID", args: map[string]any{"owner": "octocat", "repo": "hello-world", "sub_issue_id": float64(123)}, wantErr: "cannot determine the owner"},
+		{name: "opaque project content ID", tool: "add_project_item", args: map[string]any{"owner": "octocat", "project_number": float64(3), "item_id": float64(9)}, wantErr: "cannot determine the owner"},
+		{name: "reference to allowed repository", tool: "add_project_item", args: map[string]any{"owner": "octocat", "project_number": float64(3), "ref": "octocat/hello-world#7"}},
+		{name: "reference to other repository", tool: "add_project_item", args: map[string]any{"owner": "octocat", "project_number": float64(3), "ref": "other/x#7"}, wantErr: "access to other/x"},
+		{name: "reference without repository", args: map[string]any{"ref": "#7"}, wantErr: "only permits tools that name"},
+		{name: "git ref of allowed repository", tool: "get_file_contents", args: map[string]any{"owner": "octocat", "repo": "hello-world", "ref": "main"}},
+		{name: "qualified git ref of allowed repository", tool: "get_file_contents", args: map[string]any{"owner": "octocat", "repo": "hello-world", "ref": "refs/heads/feature/x"}},
+		{name: "git ref of other repository", tool: "get_file_contents", args: map[string]any{"owner": "other", "repo": "x", "ref": "main"}, wantErr: "access to other/x"},
+		{name: "issue reference beside allowed repository", tool: "issue_read", args: map[string]any{"owner": "octocat", "repo": "hello-world", "ref": "other/x#7"}, wantErr: "access to other/x"},
+		{name: "project item ID", tool: "get_project_item", args: map[string]any{"owner": "octocat", "project_number": float64(3), "item_id": float64(9)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := l.Check(tc.tool, tc.args)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestToolHandlerMiddleware(t *testing.T) {
+	l, err := Parse([]string{"octocat/hello-world"})
+	require.NoError(t, err)
+
+	called := 0
+	handler := l.ToolHandlerMiddleware(func(tool string) bool { return tool != "enable_toolset" })(
+		func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			called++
+			return mcp.NewToolResultText("ok"), nil
+		})
+	call := func(tool string, args map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = tool
+		request.Params.Arguments = args
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.False(t, call("get_issue", map[string]any{"owner": "octocat", "repo": "hello-world"}).IsError)
+	assert.False(t, call("enable_toolset", map[string]any{"toolset": "issues"}).IsError)
+	result := call("get_me", nil)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "allow-list")
+	assert.Equal(t, 2, called)
+}
+
+func TestResourceTemplateHandlerMiddleware(t *testing.T) {
+	l, err := Parse([]string{"octocat/hello-world"})
+	require.NoError(t, err)
+
+	handler := l.ResourceTemplateHandlerMiddleware()(func(_ context.Context, _ mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		return []mcp.ResourceContents{mcp.TextResourceContents{Text: "ok"}}, nil
+	})
+	read := func(owner, repo string) error {
+		request := mcp.ReadResourceRequest{}
+		request.Params.Arguments = map[string]any{"owner": []string{owner}, "repo": []string{repo}, "path": []string{"README.md"}}
+		_, err := handler(context.Background(), request)
+		return err
+	}
+
+	assert.NoError(t, read("octocat", "hello-world"))
+	assert.ErrorContains(t, read("octocat", "spoon-knife"), "access to octocat/spoon-knife is not allowed")
+}
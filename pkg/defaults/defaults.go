@@ -0,0 +1,110 @@
+// Package defaults fills in the owner and repository of tool calls from server configuration, so that deployments
+// scoped to a single owner or repository, such as a repository bot, don't need to name it in every call.
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Defaults are the owner and repository used by tool calls that don't pass their own.
+type Defaults struct {
+	Owner string
+	Repo  string
+}
+
+// New returns the defaults for owner and repo. A default repository needs a default owner, as a repository name is
+// only unique within its owner.
+func New(owner, repo string) (*Defaults, error) {
+	if repo != "" && owner == "" {
+		return nil, fmt.Errorf("a default repository needs a default owner")
+	}
+	if strings.Contains(owner, "/") || strings.Contains(repo, "/") {
+		return nil, fmt.Errorf("the default owner and repository must be given separately, e.g. --default-owner octo-org --default-repo web-app")
+	}
+	return &Defaults{Owner: owner, Repo: repo}, nil
+}
+
+// defaultedArgs returns the names and values of the arguments of tool that have defaults.
+func (d *Defaults) defaultedArgs(tool mcp.Tool) map[string]string {
+	args := map[string]string{}
+	if _, ok := tool.InputSchema.Properties["owner"]; ok {
+		args["owner"] = d.Owner
+		if _, ok := tool.InputSchema.Properties["repo"]; ok && d.Repo != "" {
+			args["repo"] = d.Repo
+		}
+	}
+	return args
+}
+
+// Tool returns tool with the owner and repo arguments made optional, and their defaults noted in their descriptions.
+func (d *Defaults) Tool(tool server.ServerTool) server.ServerTool {
+	args := d.defaultedArgs(tool.Tool)
+	if len(args) == 0 {
+		return tool
+	}
+	properties := maps.Clone(tool.Tool.InputSchema.Properties)
+	for name, value := range args {
+		property, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		property = maps.Clone(property)
+		description, _ := property["description"].(string)
+		if description = strings.TrimSpace(description); description != "" && !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+		property["description"] = strings.TrimSpace(fmt.Sprintf("%s Defaults to %s.", description, value))
+		properties[name] = property
+	}
+	tool.Tool.InputSchema.Properties = properties
+	tool.Tool.InputSchema.Required = slices.DeleteFunc(slices.Clone(tool.Tool.InputSchema.Required), func(name string) bool {
+		_, ok := args[name]
+		return ok
+	})
+	return tool
+}
+
+// Apply fills in the defaults of the arguments of tool missing from args. The default repository is only filled in
+// for calls on the default owner, so that a call naming another owner is not pointed at a repository of the same name
+// there.
+func (d *Defaults) Apply(tool mcp.Tool, args map[string]any) map[string]any {
+	defaulted := d.defaultedArgs(tool)
+	if len(defaulted) == 0 {
+		return args
+	}
+	args = maps.Clone(args)
+	if args == nil {
+		args = map[string]any{}
+	}
+	owner, _ := args["owner"].(string)
+	if owner == "" {
+		owner = d.Owner
+		args["owner"] = owner
+	}
+	if repo, ok := defaulted["repo"]; ok && strings.EqualFold(owner, d.Owner) {
+		if value, _ := args["repo"].(string); value == "" {
+			args["repo"] = repo
+		}
+	}
+	return args
+}
+
+// ToolHandlerMiddleware returns a middleware that fills in the defaults of every call. findTool returns the tool
+// called, and false for tools to leave alone, such as the dynamic toolset discovery tools.
+func (d *Defaults) ToolHandlerMiddleware(findTool func(name string) (mcp.Tool, bool)) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if tool, ok := findTool(request.Params.Name); ok {
+				request.Params.Arguments = d.Apply(tool, request.GetArguments())
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package defaults
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	repoTool = mcp.NewTool("get_issue",
+		mcp.WithString("owner", mcp.Required(), mcp.Description("Repository owner")),
+		mcp.WithString("repo", mcp.Required(), mcp.Description("Repository name")),
+		mcp.WithNumber("issue_number", mcp.Required()),
+	)
+	ownerTool = mcp.NewTool("list_repositories",
+		mcp.WithString("owner", mcp.Required(), mcp.Description("Organization or user")),
+	)
+	otherTool = mcp.NewTool("get_me")
+)
+
+func TestNew(t *testing.T) {
+	_, err := New("octo-org", "web-app")
+	require.NoError(t, err)
+	_, err = New("octo-org", "")
+	require.NoError(t, err)
+
+	_, err = New("", "web-app")
+	assert.ErrorContains(t, err, "a default repository needs a default owner")
+	_, err = New("octo-org", "octo-org/web-app")
+	assert.ErrorContains(t, err, "must be given separately")
+}
+
+func TestTool(t *testing.T) {
+	d, err := New("octo-org", "web-app")
+	require.NoError(t, err)
+
+	tool := d.Tool(server.ServerTool{Tool: repoTool}).Tool
+	assert.Equal(t, []string{"issue_number"}, tool.InputSchema.Required)
+	assert.Equal(t, "Repository owner. Defaults to octo-org.", tool.InputSchema.Properties["owner"].(map[string]any)["description"])
+	assert.Equal(t, "Repository name. Defaults to web-app.", tool.InputSchema.Properties["repo"].(map[string]any)["description"])
+	// The original tool is left alone.
+	assert.Equal(t, []string{"owner", "repo", "issue_number"}, repoTool.InputSchema.Required)
+	assert.Equal(t, "Repository owner", repoTool.InputSchema.Properties["owner"].(map[string]any)["description"])
+
+	assert.Empty(t, d.Tool(server.ServerTool{Tool: ownerTool}).Tool.InputSchema.Required)
+	assert.Equal(t, otherTool, d.Tool(server.ServerTool{Tool: otherTool}).Tool)
+
+	ownerOnly, err := New("octo-org", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "issue_number"}, ownerOnly.Tool(server.ServerTool{Tool: repoTool}).Tool.InputSchema.Required)
+}
+
+func TestApply(t *testing.T) {
+	d, err := New("octo-org", "web-app")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		tool     mcp.Tool
+		args     map[string]any
+		expected map[string]any
+	}{
+		{name: "fills in owner and repo", tool: repoTool, args: map[string]any{"issue_number": float64(1)}, expected: map[string]any{"owner": "octo-org", "repo": "web-app", "issue_number": float64(1)}},
+		{name: "fills in repo of default owner", tool: repoTool, args: map[string]any{"owner": "Octo-Org"}, expected: map[string]any{"owner": "Octo-Org", "repo": "web-app"}},
+		{name: "keeps given repo", tool: repoTool, args: map[string]any{"repo": "api"}, expected: map[string]any{"owner": "octo-org", "repo": "api"}},
+		{name: "no default repo for other owner", tool: repoTool, args: map[string]any{"owner": "octocat"}, expected: map[string]any{"owner": "octocat"}},
+		{name: "fills in owner only", tool: ownerTool, args: nil, expected: map[string]any{"owner": "octo-org"}},
+		{name: "tool without owner", tool: otherTool, args: map[string]any{}, expected: map[string]any{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, d.Apply(tc.tool, tc.args))
+		})
+	}
+}
+
+func TestToolHandlerMiddleware(t *testing.T) {
+	d, err := New("octo-org", "web-app")
+	require.NoError(t, err)
+
+	var received map[string]any
+	handler := d.ToolHandlerMiddleware(func(name string) (mcp.Tool, bool) {
+		return repoTool, name == repoTool.Name
+	})(func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		received = request.GetArguments()
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_issue"
+	request.Params.Arguments = map[string]any{"issue_number": float64(1)}
+	_, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"owner": "octo-org", "repo": "web-app", "issue_number": float64(1)}, received)
+
+	request.Params.Name = "enable_toolset"
+	request.Params.Arguments = map[string]any{"toolset": "issues"}
+	_, err = handler(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"toolset": "issues"}, received)
+}
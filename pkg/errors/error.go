@@ -90,6 +90,16 @@ func NewGitHubAPIErrorToCtx(ctx context.Context, message string, resp *github.Re
 	return ctx, nil
 }
 
+// NewGitHubGraphQLErrorToCtx retains a GraphQL error in the context for access via middleware, for failures a tool
+// handles gracefully rather than returning as an error result.
+func NewGitHubGraphQLErrorToCtx(ctx context.Context, message string, err error) (context.Context, error) {
+	graphQLErr := newGitHubGraphQLError(message, err)
+	if ctx != nil {
+		_, _ = addGitHubGraphQLErrorToContext(ctx, graphQLErr) // Explicitly ignore error for graceful handling
+	}
+	return ctx, nil
+}
+
 func addGitHubAPIErrorToContext(ctx context.Context, err *GitHubAPIError) (context.Context, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
 		val.api = append(val.api, err) // append the error to the existing slice in the context
@@ -41,6 +41,24 @@ func TestGitHubErrorContext(t *testing.T) {
 		assert.Equal(t, "failed to fetch resource: resource not found", apiError.Error())
 	})
 
+	t.Run("GraphQL errors of gracefully handled failures can be added to context", func(t *testing.T) {
+		// Given a context with GitHub error tracking enabled
+		ctx := ContextWithGitHubErrors(context.Background())
+
+		originalErr := fmt.Errorf("GraphQL query failed")
+
+		// When we add a GraphQL error to the context
+		updatedCtx, err := NewGitHubGraphQLErrorToCtx(ctx, "failed to summarize projects", originalErr)
+		require.NoError(t, err)
+
+		// Then we should be able to retrieve the error from the updated context
+		gqlErrors, err := GetGitHubGraphQLErrors(updatedCtx)
+		require.NoError(t, err)
+		require.Len(t, gqlErrors, 1)
+		assert.Equal(t, "failed to summarize projects", gqlErrors[0].Message)
+		assert.Equal(t, originalErr, gqlErrors[0].Err)
+	})
+
 	t.Run("GraphQL errors can be added to context and retrieved", func(t *testing.T) {
 		// Given a context with GitHub error tracking enabled
 		ctx := ContextWithGitHubErrors(context.Background())
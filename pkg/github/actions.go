@@ -22,6 +22,52 @@ const (
 	DescriptionRepositoryName  = "Repository name"
 )
 
+// WorkflowRunLogs points to the logs of a workflow run.
+type WorkflowRunLogs struct {
+	LogsURL         string `json:"logs_url"`
+	Message         string `json:"message"`
+	Note            string `json:"note"`
+	Warning         string `json:"warning"`
+	OptimizationTip string `json:"optimization_tip"`
+}
+
+// WorkflowJobs are the jobs of a workflow run.
+type WorkflowJobs struct {
+	Jobs            *github.Jobs `json:"jobs"`
+	OptimizationTip string       `json:"optimization_tip"`
+}
+
+// JobLog is the log of a workflow job, as a download URL or as its content.
+type JobLog struct {
+	JobID          int64  `json:"job_id,omitempty"`
+	JobName        string `json:"job_name,omitempty"`
+	Message        string `json:"message,omitempty"`
+	LogsURL        string `json:"logs_url,omitempty"`
+	Note           string `json:"note,omitempty"`
+	LogsContent    string `json:"logs_content,omitempty"`
+	OriginalLength int    `json:"original_length,omitempty"`
+	// Error is set when the log of one of the failed jobs of a run could not be retrieved.
+	Error string `json:"error,omitempty"`
+}
+
+// JobLogs is the log of a single job or, with failed_only, the logs of the failed jobs of a workflow run.
+type JobLogs struct {
+	JobLog
+	RunID        int64           `json:"run_id,omitempty"`
+	TotalJobs    *int            `json:"total_jobs,omitempty"`
+	FailedJobs   *int            `json:"failed_jobs,omitempty"`
+	Logs         []JobLog        `json:"logs,omitempty"`
+	ReturnFormat map[string]bool `json:"return_format,omitempty"`
+}
+
+// ArtifactDownload points to the archive of a workflow run artifact.
+type ArtifactDownload struct {
+	DownloadURL string `json:"download_url"`
+	Message     string `json:"message"`
+	Note        string `json:"note"`
+	ArtifactID  int64  `json:"artifact_id"`
+}
+
 // ListWorkflows creates a tool to list workflows in a repository
 func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_workflows",
@@ -30,6 +76,7 @@ func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_LIST_WORKFLOWS_USER_TITLE", "List workflows"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.Workflows](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -73,12 +120,7 @@ func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflows)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(workflows), nil
 		}
 }
 
@@ -90,6 +132,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_LIST_WORKFLOW_RUNS_USER_TITLE", "List workflow runs"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.WorkflowRuns](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -212,12 +255,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflowRuns)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(workflowRuns), nil
 		}
 }
 
@@ -328,6 +366,7 @@ func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_GET_WORKFLOW_RUN_USER_TITLE", "Get workflow run"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.WorkflowRun](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -367,12 +406,7 @@ func GetWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflowRun)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(workflowRun), nil
 		}
 }
 
@@ -384,6 +418,7 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 				Title:        t("TOOL_GET_WORKFLOW_RUN_LOGS_USER_TITLE", "Get workflow run logs"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[WorkflowRunLogs](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -425,20 +460,15 @@ func GetWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelperF
 			defer func() { _ = resp.Body.Close() }()
 
 			// Create response with the logs URL and information
-			result := map[string]any{
-				"logs_url":         url.String(),
-				"message":          "Workflow run logs are available for download",
-				"note":             "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. You can download this archive to extract and examine individual job logs.",
-				"warning":          "This downloads ALL logs as a ZIP file which can be large and expensive. For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id instead.",
-				"optimization_tip": "Use: get_job_logs with parameters {run_id: " + fmt.Sprintf("%d", runID) + ", failed_only: true} for more efficient failed job debugging",
-			}
-
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			result := WorkflowRunLogs{
+				LogsURL:         url.String(),
+				Message:         "Workflow run logs are available for download",
+				Note:            "The logs_url provides a download link for the complete workflow run logs as a ZIP archive. You can download this archive to extract and examine individual job logs.",
+				Warning:         "This downloads ALL logs as a ZIP file which can be large and expensive. For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id instead.",
+				OptimizationTip: "Use: get_job_logs with parameters {run_id: " + fmt.Sprintf("%d", runID) + ", failed_only: true} for more efficient failed job debugging",
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(result), nil
 		}
 }
 
@@ -450,6 +480,7 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_LIST_WORKFLOW_JOBS_USER_TITLE", "List workflow jobs"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[WorkflowJobs](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -516,17 +547,12 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 			defer func() { _ = resp.Body.Close() }()
 
 			// Add optimization tip for failed job debugging
-			response := map[string]any{
-				"jobs":             jobs,
-				"optimization_tip": "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first",
-			}
-
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			response := WorkflowJobs{
+				Jobs:            jobs,
+				OptimizationTip: "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first",
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(response), nil
 		}
 }
 
@@ -538,6 +564,7 @@ func GetJobLogs(getClient GetClientFn, t translations.TranslationHelperFunc, con
 				Title:        t("TOOL_GET_JOB_LOGS_USER_TITLE", "Get job logs"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[JobLogs](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -644,26 +671,25 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 	}
 
 	if len(failedJobs) == 0 {
-		result := map[string]any{
-			"message":     "No failed jobs found in this workflow run",
-			"run_id":      runID,
-			"total_jobs":  len(jobs.Jobs),
-			"failed_jobs": 0,
+		result := JobLogs{
+			JobLog:     JobLog{Message: "No failed jobs found in this workflow run"},
+			RunID:      runID,
+			TotalJobs:  github.Ptr(len(jobs.Jobs)),
+			FailedJobs: github.Ptr(0),
 		}
-		r, _ := json.Marshal(result)
-		return mcp.NewToolResultText(string(r)), nil
+		return MarshalledStructuredResult(result), nil
 	}
 
 	// Collect logs for all failed jobs
-	var logResults []map[string]any
+	var logResults []JobLog
 	for _, job := range failedJobs {
 		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, tailLines, contentWindowSize)
 		if err != nil {
 			// Continue with other jobs even if one fails
-			jobResult = map[string]any{
-				"job_id":   job.GetID(),
-				"job_name": job.GetName(),
-				"error":    err.Error(),
+			jobResult = JobLog{
+				JobID:   job.GetID(),
+				JobName: job.GetName(),
+				Error:   err.Error(),
 			}
 			// Enable reporting of status codes and error causes
 			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get job logs", resp, err) // Explicitly ignore error for graceful handling
@@ -672,21 +698,16 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 		logResults = append(logResults, jobResult)
 	}
 
-	result := map[string]any{
-		"message":       fmt.Sprintf("Retrieved logs for %d failed jobs", len(failedJobs)),
-		"run_id":        runID,
-		"total_jobs":    len(jobs.Jobs),
-		"failed_jobs":   len(failedJobs),
-		"logs":          logResults,
-		"return_format": map[string]bool{"content": returnContent, "urls": !returnContent},
+	result := JobLogs{
+		JobLog:       JobLog{Message: fmt.Sprintf("Retrieved logs for %d failed jobs", len(failedJobs))},
+		RunID:        runID,
+		TotalJobs:    github.Ptr(len(jobs.Jobs)),
+		FailedJobs:   github.Ptr(len(failedJobs)),
+		Logs:         logResults,
+		ReturnFormat: map[string]bool{"content": returnContent, "urls": !returnContent},
 	}
 
-	r, err := json.Marshal(result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(r)), nil
+	return MarshalledStructuredResult(result), nil
 }
 
 // handleSingleJobLogs gets logs for a single job
@@ -696,28 +717,21 @@ func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get job logs", resp, err), nil
 	}
 
-	r, err := json.Marshal(jobResult)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(r)), nil
+	return MarshalledStructuredResult(JobLogs{JobLog: jobResult}), nil
 }
 
 // getJobLogData retrieves log data for a single job, either as URL or content
-func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int, contentWindowSize int) (map[string]any, *github.Response, error) {
+func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int, contentWindowSize int) (JobLog, *github.Response, error) {
 	// Get the download URL for the job logs
 	url, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
 	if err != nil {
-		return nil, resp, fmt.Errorf("failed to get job logs for job %d: %w", jobID, err)
+		return JobLog{}, resp, fmt.Errorf("failed to get job logs for job %d: %w", jobID, err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	result := map[string]any{
-		"job_id": jobID,
-	}
-	if jobName != "" {
-		result["job_name"] = jobName
+	result := JobLog{
+		JobID:   jobID,
+		JobName: jobName,
 	}
 
 	if returnContent {
@@ -728,16 +742,16 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 			ghRes := &github.Response{
 				Response: httpResp,
 			}
-			return nil, ghRes, fmt.Errorf("failed to download log content for job %d: %w", jobID, err)
+			return JobLog{}, ghRes, fmt.Errorf("failed to download log content for job %d: %w", jobID, err)
 		}
-		result["logs_content"] = content
-		result["message"] = "Job logs content retrieved successfully"
-		result["original_length"] = originalLength
+		result.LogsContent = content
+		result.Message = "Job logs content retrieved successfully"
+		result.OriginalLength = originalLength
 	} else {
 		// Return just the URL
-		result["logs_url"] = url.String()
-		result["message"] = "Job logs are available for download"
-		result["note"] = "The logs_url provides a download link for the individual job logs in plain text format. Use return_content=true to get the actual log content."
+		result.LogsURL = url.String()
+		result.Message = "Job logs are available for download"
+		result.Note = "The logs_url provides a download link for the individual job logs in plain text format. Use return_content=true to get the actual log content."
 	}
 
 	return result, resp, nil
@@ -977,6 +991,7 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 				Title:        t("TOOL_LIST_WORKFLOW_RUN_ARTIFACTS_USER_TITLE", "List workflow artifacts"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.ArtifactList](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -1029,12 +1044,7 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(artifacts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(artifacts), nil
 		}
 }
 
@@ -1046,6 +1056,7 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 				Title:        t("TOOL_DOWNLOAD_WORKFLOW_RUN_ARTIFACT_USER_TITLE", "Download workflow artifact"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[ArtifactDownload](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -1087,19 +1098,14 @@ func DownloadWorkflowRunArtifact(getClient GetClientFn, t translations.Translati
 			defer func() { _ = resp.Body.Close() }()
 
 			// Create response with the download URL and information
-			result := map[string]any{
-				"download_url": url.String(),
-				"message":      "Artifact is available for download",
-				"note":         "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
-				"artifact_id":  artifactID,
+			result := ArtifactDownload{
+				DownloadURL: url.String(),
+				Message:     "Artifact is available for download",
+				Note:        "The download_url provides a download link for the artifact as a ZIP archive. The link is temporary and expires after a short time.",
+				ArtifactID:  artifactID,
 			}
 
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(result), nil
 		}
 }
 
@@ -1175,6 +1181,7 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 				Title:        t("TOOL_GET_WORKFLOW_RUN_USAGE_USER_TITLE", "Get workflow usage"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.WorkflowRunUsage](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description(DescriptionRepositoryOwner),
@@ -1214,11 +1221,6 @@ func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelper
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(usage)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(usage), nil
 		}
 }
@@ -0,0 +1,259 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// AgentStateGistDescription identifies the secret gist of the authenticated user that holds agent state.
+	AgentStateGistDescription = "github-mcp-server agent state"
+	// MaxAgentStateSize bounds the size in bytes of the state saved under a single key.
+	MaxAgentStateSize = 256 << 10
+
+	agentStateFileSuffix = ".json"
+)
+
+// AgentState is the state saved under a key or, when no key is given, the keys state is saved under.
+type AgentState struct {
+	GistID    string            `json:"gist_id"`
+	Keys      []string          `json:"keys,omitempty"`
+	Key       string            `json:"key,omitempty"`
+	State     any               `json:"state,omitempty"`
+	UpdatedAt *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+var agentStateKeyPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+
+// agentStateFile returns the name of the gist file holding the state saved under key.
+func agentStateFile(key string) (github.GistFilename, error) {
+	if !agentStateKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid key %q: use up to 100 letters, digits, dots, dashes and underscores", key)
+	}
+	return github.GistFilename(key + agentStateFileSuffix), nil
+}
+
+// findAgentStateGist looks up the agent state gist among the gists of the authenticated user. It returns nil if
+// there is none yet.
+func findAgentStateGist(ctx context.Context, client *github.Client) (*github.Gist, *github.Response, error) {
+	opts := &github.GistListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		gists, resp, err := client.Gists.List(ctx, "", opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, gist := range gists {
+			if gist.GetDescription() == AgentStateGistDescription {
+				return gist, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// SaveAgentState creates a tool to persist a JSON document under a key in a secret gist.
+func SaveAgentState(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("save_agent_state",
+			mcp.WithDescription(t("TOOL_SAVE_AGENT_STATE_DESCRIPTION", "Persist a small JSON document under a key, so that it can be loaded in later sessions with load_agent_state. State is stored as one file per key in a secret gist of the authenticated user, which is created on first use unless gist_id is given. Saving under an existing key replaces its state.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SAVE_AGENT_STATE_USER_TITLE", "Save agent state"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("Key to save the state under, e.g. 'release-triage'. Letters, digits, dots, dashes and underscores only."),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("The state as a JSON document of at most %d KiB", MaxAgentStateSize>>10)),
+			),
+			mcp.WithString("gist_id",
+				mcp.Description("ID of the gist to store state in. Defaults to the secret gist described as '"+AgentStateGistDescription+"'."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := RequiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filename, err := agentStateFile(key)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := RequiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !json.Valid([]byte(state)) {
+				return mcp.NewToolResultError("state must be a valid JSON document"), nil
+			}
+			if len(state) > MaxAgentStateSize {
+				return mcp.NewToolResultError(fmt.Sprintf("state is %d bytes, which exceeds the limit of %d bytes", len(state), MaxAgentStateSize)), nil
+			}
+			gistID, err := OptionalParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if gistID == "" {
+				gist, resp, err := findAgentStateGist(ctx, client)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to find agent state gist",
+						resp,
+						err,
+					), nil
+				}
+				gistID = gist.GetID()
+			}
+
+			files := map[github.GistFilename]github.GistFile{
+				filename: {Filename: github.Ptr(string(filename)), Content: github.Ptr(state)},
+			}
+			var gist *github.Gist
+			var resp *github.Response
+			if gistID == "" {
+				gist, resp, err = client.Gists.Create(ctx, &github.Gist{
+					Description: github.Ptr(AgentStateGistDescription),
+					Public:      github.Ptr(false),
+					Files:       files,
+				})
+			} else {
+				gist, resp, err = client.Gists.Edit(ctx, gistID, &github.Gist{Files: files})
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to save agent state",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]any{
+				"key":        key,
+				"gist_id":    gist.GetID(),
+				"size":       len(state),
+				"updated_at": gist.GetUpdatedAt(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// LoadAgentState creates a tool to load a JSON document saved with save_agent_state.
+func LoadAgentState(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("load_agent_state",
+			mcp.WithDescription(t("TOOL_LOAD_AGENT_STATE_DESCRIPTION", "Load the JSON document saved under a key with save_agent_state, e.g. in an earlier session. Omit the key to list the keys state has been saved under.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LOAD_AGENT_STATE_USER_TITLE", "Load agent state"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[AgentState](),
+			mcp.WithString("key",
+				mcp.Description("Key the state was saved under. Omit to list the saved keys."),
+			),
+			mcp.WithString("gist_id",
+				mcp.Description("ID of the gist state is stored in. Defaults to the secret gist described as '"+AgentStateGistDescription+"'."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := OptionalParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var filename github.GistFilename
+			if key != "" {
+				if filename, err = agentStateFile(key); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			gistID, err := OptionalParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if gistID == "" {
+				gist, resp, err := findAgentStateGist(ctx, client)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to find agent state gist",
+						resp,
+						err,
+					), nil
+				}
+				if gist == nil {
+					return mcp.NewToolResultError("no agent state has been saved yet"), nil
+				}
+				gistID = gist.GetID()
+			}
+
+			gist, resp, err := client.Gists.Get(ctx, gistID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get agent state gist",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var result AgentState
+			if key == "" {
+				keys := []string{}
+				for name := range gist.Files {
+					if strings.HasSuffix(string(name), agentStateFileSuffix) {
+						keys = append(keys, strings.TrimSuffix(string(name), agentStateFileSuffix))
+					}
+				}
+				sort.Strings(keys)
+				result = AgentState{
+					GistID: gist.GetID(),
+					Keys:   keys,
+				}
+			} else {
+				file, ok := gist.Files[filename]
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("no state saved under key %q", key)), nil
+				}
+				if !json.Valid([]byte(file.GetContent())) {
+					return mcp.NewToolResultError(fmt.Sprintf("state saved under key %q is not valid JSON", key)), nil
+				}
+				result = AgentState{
+					Key:       key,
+					GistID:    gist.GetID(),
+					State:     json.RawMessage(file.GetContent()),
+					UpdatedAt: github.Ptr(gist.GetUpdatedAt()),
+				}
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
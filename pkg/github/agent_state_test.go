@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SaveAgentState(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SaveAgentState(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "save_agent_state", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"key", "state"})
+
+	otherGists := []*github.Gist{{ID: github.Ptr("other"), Description: github.Ptr("notes")}}
+	stateGist := &github.Gist{ID: github.Ptr("state"), Description: github.Ptr(AgentStateGistDescription)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedGistID string
+	}{
+		{
+			name: "creates secret gist on first use",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGists, otherGists),
+				mock.WithRequestMatchHandler(
+					mock.PostGists,
+					expectRequestBody(t, map[string]any{
+						"description": AgentStateGistDescription,
+						"public":      false,
+						"files": map[string]any{
+							"triage.json": map[string]any{"filename": "triage.json", "content": `{"last_issue":42}`},
+						},
+					}).andThen(mockResponse(t, http.StatusCreated, &github.Gist{ID: github.Ptr("new")})),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"key":   "triage",
+				"state": `{"last_issue":42}`,
+			},
+			expectedGistID: "new",
+		},
+		{
+			name: "updates existing state gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGists, append(otherGists, stateGist)),
+				mock.WithRequestMatchHandler(
+					mock.PatchGistsByGistId,
+					expectRequestBody(t, map[string]any{
+						"files": map[string]any{
+							"triage.json": map[string]any{"filename": "triage.json", "content": `[1,2]`},
+						},
+					}).andThen(mockResponse(t, http.StatusOK, stateGist)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"key":   "triage",
+				"state": `[1,2]`,
+			},
+			expectedGistID: "state",
+		},
+		{
+			name: "uses given gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.PatchGistsByGistId, &github.Gist{ID: github.Ptr("mine")}),
+			),
+			requestArgs: map[string]interface{}{
+				"key":     "triage",
+				"state":   `{}`,
+				"gist_id": "mine",
+			},
+			expectedGistID: "mine",
+		},
+		{
+			name:         "invalid JSON",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"key":   "triage",
+				"state": `{"last_issue":`,
+			},
+			expectError:    true,
+			expectedErrMsg: "state must be a valid JSON document",
+		},
+		{
+			name:         "invalid key",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"key":   "../triage",
+				"state": `{}`,
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid key "../triage"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SaveAgentState(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "triage", response["key"])
+			assert.Equal(t, tc.expectedGistID, response["gist_id"])
+		})
+	}
+}
+
+func Test_LoadAgentState(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := LoadAgentState(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "load_agent_state", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	stateGist := &github.Gist{
+		ID:          github.Ptr("state"),
+		Description: github.Ptr(AgentStateGistDescription),
+		Files: map[github.GistFilename]github.GistFile{
+			"triage.json":  {Filename: github.Ptr("triage.json"), Content: github.Ptr(`{"last_issue":42}`)},
+			"release.json": {Filename: github.Ptr("release.json"), Content: github.Ptr(`"v1.2.0"`)},
+			"README.md":    {Filename: github.Ptr("README.md"), Content: github.Ptr("notes")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expected       map[string]any
+	}{
+		{
+			name: "loads state",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGists, []*github.Gist{stateGist}),
+				mock.WithRequestMatch(mock.GetGistsByGistId, stateGist),
+			),
+			requestArgs: map[string]interface{}{"key": "triage"},
+			expected: map[string]any{
+				"key":        "triage",
+				"gist_id":    "state",
+				"state":      map[string]any{"last_issue": float64(42)},
+				"updated_at": "0001-01-01T00:00:00Z",
+			},
+		},
+		{
+			name: "lists keys",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGistsByGistId, stateGist),
+			),
+			requestArgs: map[string]interface{}{"gist_id": "state"},
+			expected: map[string]any{
+				"gist_id": "state",
+				"keys":    []any{"release", "triage"},
+			},
+		},
+		{
+			name: "key not saved",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGistsByGistId, stateGist),
+			),
+			requestArgs:    map[string]interface{}{"key": "deploy", "gist_id": "state"},
+			expectError:    true,
+			expectedErrMsg: `no state saved under key "deploy"`,
+		},
+		{
+			name: "nothing saved yet",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetGists, []*github.Gist{}),
+			),
+			requestArgs:    map[string]interface{}{"key": "triage"},
+			expectError:    true,
+			expectedErrMsg: "no agent state has been saved yet",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := LoadAgentState(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
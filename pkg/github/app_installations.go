@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RepositorySelectionAll is the repository selection of an installation that can access all the repositories of its
+// account.
+const RepositorySelectionAll = "all"
+
+// AppInstallation is a GitHub App installed on an account, with the access it was granted.
+type AppInstallation struct {
+	ID      int64  `json:"id"`
+	AppID   int64  `json:"app_id"`
+	AppSlug string `json:"app_slug"`
+	// RepositorySelection is "all" when the app can access all the repositories of the account, or "selected".
+	RepositorySelection string `json:"repository_selection"`
+	// Permissions map the permissions granted to the app to their access level, e.g. {"contents": "write"}.
+	Permissions map[string]string `json:"permissions"`
+	Events      []string          `json:"events"`
+	CreatedAt   string            `json:"created_at,omitempty"`
+	SuspendedAt string            `json:"suspended_at,omitempty"`
+}
+
+// OrgAppInstallations are the GitHub Apps installed on an organization.
+type OrgAppInstallations struct {
+	Org           string            `json:"org"`
+	Installations []AppInstallation `json:"installations"`
+}
+
+// RepoAppInstallations are the GitHub Apps that can access a repository.
+type RepoAppInstallations struct {
+	Repository    string            `json:"repository"`
+	Installations []AppInstallation `json:"installations"`
+	// Unverified are the installations on selected repositories whose repositories are not visible to the token, so
+	// they may or may not access the repository.
+	Unverified []AppInstallation `json:"unverified"`
+}
+
+func convertToAppInstallation(installation *github.Installation) AppInstallation {
+	result := AppInstallation{
+		ID:                  installation.GetID(),
+		AppID:               installation.GetAppID(),
+		AppSlug:             installation.GetAppSlug(),
+		RepositorySelection: installation.GetRepositorySelection(),
+		Permissions:         map[string]string{},
+		Events:              installation.Events,
+	}
+	if result.Events == nil {
+		result.Events = []string{}
+	}
+	// The permissions are a struct of optional access levels, flatten the granted ones to a map.
+	if installation.Permissions != nil {
+		if b, err := json.Marshal(installation.Permissions); err == nil {
+			_ = json.Unmarshal(b, &result.Permissions)
+		}
+	}
+	if installation.CreatedAt != nil {
+		result.CreatedAt = installation.CreatedAt.Format(time.RFC3339)
+	}
+	if installation.SuspendedAt != nil {
+		result.SuspendedAt = installation.SuspendedAt.Format(time.RFC3339)
+	}
+	return result
+}
+
+// listAllOrgInstallations loads all the pages of the GitHub App installations of an organization.
+func listAllOrgInstallations(ctx context.Context, client *github.Client, org string) ([]*github.Installation, *github.Response, error) {
+	var installations []*github.Installation
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Organizations.ListInstallations(ctx, org, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		installations = append(installations, page.Installations...)
+		if resp.NextPage == 0 {
+			return installations, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// installationIncludesRepository returns whether an installation on selected repositories can access a repository.
+func installationIncludesRepository(ctx context.Context, client *github.Client, installationID int64, fullName string) (bool, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		repos, resp, err := client.Apps.ListUserRepos(ctx, installationID, opts)
+		if err != nil {
+			return false, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, repo := range repos.Repositories {
+			if strings.EqualFold(repo.GetFullName(), fullName) {
+				return true, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListOrgAppInstallations creates a tool to list the GitHub Apps installed on an organization with their permissions.
+func ListOrgAppInstallations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_app_installations",
+			mcp.WithDescription(t("TOOL_LIST_ORG_APP_INSTALLATIONS_DESCRIPTION", "List the GitHub Apps installed on an organization with the permissions and events they were granted and whether they access all or selected repositories, e.g. to review third-party access. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_APP_INSTALLATIONS_USER_TITLE", "List organization app installations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[OrgAppInstallations](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			installations, resp, err := listAllOrgInstallations(ctx, client, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list app installations of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+
+			result := OrgAppInstallations{Org: org, Installations: []AppInstallation{}}
+			for _, installation := range installations {
+				result.Installations = append(result.Installations, convertToAppInstallation(installation))
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
+
+// ListRepoInstallations creates a tool to list the GitHub Apps that can access a repository of an organization.
+func ListRepoInstallations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repo_installations",
+			mcp.WithDescription(t("TOOL_LIST_REPO_INSTALLATIONS_DESCRIPTION", "List the GitHub Apps installed on an organization that can access one of its repositories, with the permissions they were granted. Apps installed on selected repositories whose repositories are not visible to the token are returned as unverified. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPO_INSTALLATIONS_USER_TITLE", "List repository app installations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[RepoAppInstallations](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization owning the repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			installations, resp, err := listAllOrgInstallations(ctx, client, owner)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list app installations of organization '%s'", owner),
+					resp,
+					err,
+				), nil
+			}
+
+			fullName := fmt.Sprintf("%s/%s", owner, repo)
+			result := RepoAppInstallations{Repository: fullName, Installations: []AppInstallation{}, Unverified: []AppInstallation{}}
+			for _, installation := range installations {
+				if installation.GetRepositorySelection() == RepositorySelectionAll {
+					result.Installations = append(result.Installations, convertToAppInstallation(installation))
+					continue
+				}
+				included, resp, err := installationIncludesRepository(ctx, client, installation.GetID(), fullName)
+				switch {
+				case resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound):
+					result.Unverified = append(result.Unverified, convertToAppInstallation(installation))
+				case err != nil:
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list repositories of app installation %d", installation.GetID()),
+						resp,
+						err,
+					), nil
+				case included:
+					result.Installations = append(result.Installations, convertToAppInstallation(installation))
+				}
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ciInstallation = &github.Installation{
+		ID:                  github.Ptr(int64(1)),
+		AppID:               github.Ptr(int64(10)),
+		AppSlug:             github.Ptr("ci-bot"),
+		RepositorySelection: github.Ptr("all"),
+		Permissions:         &github.InstallationPermissions{Contents: github.Ptr("read"), Checks: github.Ptr("write")},
+		Events:              []string{"push"},
+		CreatedAt:           &github.Timestamp{Time: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	deployInstallation = &github.Installation{
+		ID:                  github.Ptr(int64(2)),
+		AppID:               github.Ptr(int64(20)),
+		AppSlug:             github.Ptr("deployer"),
+		RepositorySelection: github.Ptr("selected"),
+		Permissions:         &github.InstallationPermissions{Deployments: github.Ptr("write")},
+	}
+	lintInstallation = &github.Installation{
+		ID:                  github.Ptr(int64(3)),
+		AppID:               github.Ptr(int64(30)),
+		AppSlug:             github.Ptr("linter"),
+		RepositorySelection: github.Ptr("selected"),
+	}
+	secretInstallation = &github.Installation{
+		ID:                  github.Ptr(int64(4)),
+		AppID:               github.Ptr(int64(40)),
+		AppSlug:             github.Ptr("secret-scanner"),
+		RepositorySelection: github.Ptr("selected"),
+	}
+)
+
+func orgInstallationsHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/orgs/octo/installations?page=2>; rel="next"`)
+			mockResponse(t, http.StatusOK, &github.OrganizationInstallations{
+				TotalCount:    github.Ptr(4),
+				Installations: []*github.Installation{ciInstallation, deployInstallation},
+			})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, &github.OrganizationInstallations{
+			TotalCount:    github.Ptr(4),
+			Installations: []*github.Installation{lintInstallation, secretInstallation},
+		})(w, r)
+	}
+}
+
+func Test_ListOrgAppInstallations(t *testing.T) {
+	tool, _ := ListOrgAppInstallations(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(mock.GetOrgsInstallationsByOrg, orgInstallationsHandler(t)),
+	))
+	_, handler := ListOrgAppInstallations(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response OrgAppInstallations
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Installations, 4)
+	assert.Equal(t, AppInstallation{
+		ID:                  1,
+		AppID:               10,
+		AppSlug:             "ci-bot",
+		RepositorySelection: "all",
+		Permissions:         map[string]string{"contents": "read", "checks": "write"},
+		Events:              []string{"push"},
+		CreatedAt:           "2024-03-01T12:00:00Z",
+	}, response.Installations[0])
+	assert.Equal(t, map[string]string{}, response.Installations[2].Permissions)
+	assert.Equal(t, []string{}, response.Installations[2].Events)
+}
+
+func Test_ListRepoInstallations(t *testing.T) {
+	tool, _ := ListRepoInstallations(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(mock.GetOrgsInstallationsByOrg, orgInstallationsHandler(t)),
+		mock.WithRequestMatchHandler(
+			mock.GetUserInstallationsRepositoriesByInstallationId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.Contains(r.URL.Path, "/2/"):
+					mockResponse(t, http.StatusOK, &github.ListRepositories{
+						Repositories: []*github.Repository{{FullName: github.Ptr("octo/web")}, {FullName: github.Ptr("Octo/API")}},
+					})(w, r)
+				case strings.Contains(r.URL.Path, "/3/"):
+					mockResponse(t, http.StatusOK, &github.ListRepositories{
+						Repositories: []*github.Repository{{FullName: github.Ptr("octo/web")}},
+					})(w, r)
+				default:
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message": "Resource not accessible by personal access token"}`))
+				}
+			}),
+		),
+	))
+	_, handler := ListRepoInstallations(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "octo", "repo": "api"}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response RepoAppInstallations
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, "octo/api", response.Repository)
+
+	slugs := func(installations []AppInstallation) []string {
+		result := []string{}
+		for _, installation := range installations {
+			result = append(result, installation.AppSlug)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"ci-bot", "deployer"}, slugs(response.Installations))
+	assert.Equal(t, []string{"secret-scanner"}, slugs(response.Unverified))
+}
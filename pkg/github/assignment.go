@@ -0,0 +1,258 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// AssigneeLoad is the current workload of a candidate assignee in a repository.
+type AssigneeLoad struct {
+	Login          string `json:"login"`
+	OpenIssues     int    `json:"open_issues"`
+	PendingReviews int    `json:"pending_reviews"`
+	Load           int    `json:"load"`
+}
+
+// AssigneeSuggestion is the least loaded candidate assignee and the ranking of all candidates.
+type AssigneeSuggestion struct {
+	Suggestion string         `json:"suggestion"`
+	Ranking    []AssigneeLoad `json:"ranking"`
+}
+
+// rankAssignees computes the load of every candidate and orders them from least to most loaded. Ties are broken by
+// login so that the ranking is stable, which makes round-robin assignment deterministic.
+func rankAssignees(candidates []string, openIssues, pendingReviews map[string]int, issueWeight, reviewWeight int) []AssigneeLoad {
+	ranked := make([]AssigneeLoad, 0, len(candidates))
+	for _, login := range candidates {
+		key := strings.ToLower(login)
+		load := AssigneeLoad{Login: login, OpenIssues: openIssues[key], PendingReviews: pendingReviews[key]}
+		load.Load = load.OpenIssues*issueWeight + load.PendingReviews*reviewWeight
+		ranked = append(ranked, load)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Load != ranked[j].Load {
+			return ranked[i].Load < ranked[j].Load
+		}
+		return strings.ToLower(ranked[i].Login) < strings.ToLower(ranked[j].Login)
+	})
+	return ranked
+}
+
+// listCandidateAssignees returns the members of a team of the owner organization, or the users that can be assigned
+// to issues in the repository if no team is given.
+func listCandidateAssignees(ctx context.Context, client *github.Client, owner, repo, team string) ([]string, *github.Response, error) {
+	var logins []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		var users []*github.User
+		var resp *github.Response
+		var err error
+		if team != "" {
+			users, resp, err = client.Teams.ListTeamMembersBySlug(ctx, owner, team, &github.TeamListTeamMembersOptions{ListOptions: *opts})
+		} else {
+			users, resp, err = client.Issues.ListAssignees(ctx, owner, repo, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, user := range users {
+			logins = append(logins, user.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			return logins, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// countOpenIssueAssignments counts the open issues, excluding pull requests, assigned to each user in a repository.
+func countOpenIssueAssignments(ctx context.Context, client *github.Client, owner, repo string) (map[string]int, *github.Response, error) {
+	counts := map[string]int{}
+	opts := &github.IssueListByRepoOptions{State: "open", Assignee: "*", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			for _, assignee := range issue.Assignees {
+				counts[strings.ToLower(assignee.GetLogin())]++
+			}
+		}
+		if resp.NextPage == 0 {
+			return counts, resp, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// countPendingReviewRequests counts the open pull requests in a repository that each user is requested to review.
+func countPendingReviewRequests(ctx context.Context, client *github.Client, owner, repo string) (map[string]int, *github.Response, error) {
+	counts := map[string]int{}
+	opts := &github.PullRequestListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, pr := range prs {
+			for _, reviewer := range pr.RequestedReviewers {
+				counts[strings.ToLower(reviewer.GetLogin())]++
+			}
+		}
+		if resp.NextPage == 0 {
+			return counts, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// SuggestAssignee creates a tool to rank candidate assignees of a repository by their current workload.
+func SuggestAssignee(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_assignee",
+			mcp.WithDescription(t("TOOL_SUGGEST_ASSIGNEE_DESCRIPTION", "Suggest who to assign new work to in a repository. Computes the current load of every candidate, the open issues assigned to them and the open pull requests awaiting their review, and returns the candidates ranked from least to most loaded. Candidates are the members of a team, an explicit list, or everyone who can be assigned issues in the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUGGEST_ASSIGNEE_USER_TITLE", "Suggest assignee"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[AssigneeSuggestion](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("team",
+				mcp.Description("Slug of a team of the owner organization whose members are the candidates"),
+			),
+			mcp.WithArray("candidates",
+				mcp.Description("Logins of the candidates. Takes precedence over team"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("exclude",
+				mcp.Description("Logins to leave out of the suggestions, e.g. people who are out of office"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithNumber("issue_weight",
+				mcp.Description("Weight of an assigned open issue in the load"),
+				mcp.DefaultNumber(1),
+			),
+			mcp.WithNumber("review_weight",
+				mcp.Description("Weight of a pending review request in the load"),
+				mcp.DefaultNumber(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			team, err := OptionalParam[string](request, "team")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			candidates, err := OptionalStringArrayParam(request, "candidates")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			exclude, err := OptionalStringArrayParam(request, "exclude")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// A weight of zero is meaningful, so the weights cannot use OptionalIntParamWithDefault.
+			issueWeight, issueWeightSet, err := OptionalParamOK[float64](request, "issue_weight")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !issueWeightSet {
+				issueWeight = 1
+			}
+			reviewWeight, reviewWeightSet, err := OptionalParamOK[float64](request, "review_weight")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !reviewWeightSet {
+				reviewWeight = 1
+			}
+			if issueWeight < 0 || reviewWeight < 0 {
+				return mcp.NewToolResultError("issue_weight and review_weight must not be negative"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if len(candidates) == 0 {
+				var resp *github.Response
+				candidates, resp, err = listCandidateAssignees(ctx, client, owner, repo, team)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list candidates",
+						resp,
+						err,
+					), nil
+				}
+			}
+			excluded := map[string]bool{}
+			for _, login := range exclude {
+				excluded[strings.ToLower(login)] = true
+			}
+			filtered := make([]string, 0, len(candidates))
+			seen := map[string]bool{}
+			for _, login := range candidates {
+				key := strings.ToLower(login)
+				if excluded[key] || seen[key] {
+					continue
+				}
+				seen[key] = true
+				filtered = append(filtered, login)
+			}
+			if len(filtered) == 0 {
+				return mcp.NewToolResultError("no candidates to suggest"), nil
+			}
+
+			openIssues, resp, err := countOpenIssueAssignments(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list open issues",
+					resp,
+					err,
+				), nil
+			}
+			pendingReviews, resp, err := countPendingReviewRequests(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list open pull requests",
+					resp,
+					err,
+				), nil
+			}
+
+			ranked := rankAssignees(filtered, openIssues, pendingReviews, int(issueWeight), int(reviewWeight))
+			return MarshalledStructuredResult(AssigneeSuggestion{
+				Suggestion: ranked[0].Login,
+				Ranking:    ranked,
+			}), nil
+		}
+}
@@ -0,0 +1,159 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RankAssignees(t *testing.T) {
+	openIssues := map[string]int{"alice": 2, "bob": 1}
+	pendingReviews := map[string]int{"bob": 1, "carol": 3}
+
+	assert.Equal(t, []AssigneeLoad{
+		{Login: "dave", Load: 0},
+		{Login: "Alice", OpenIssues: 2, Load: 2},
+		{Login: "bob", OpenIssues: 1, PendingReviews: 1, Load: 2},
+		{Login: "carol", PendingReviews: 3, Load: 3},
+	}, rankAssignees([]string{"carol", "bob", "Alice", "dave"}, openIssues, pendingReviews, 1, 1))
+
+	ranked := rankAssignees([]string{"alice", "carol"}, openIssues, pendingReviews, 3, 1)
+	assert.Equal(t, "carol", ranked[0].Login)
+	assert.Equal(t, 6, ranked[1].Load)
+}
+
+func Test_SuggestAssignee(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SuggestAssignee(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "suggest_assignee", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	users := func(logins ...string) []*github.User {
+		result := make([]*github.User, 0, len(logins))
+		for _, login := range logins {
+			result = append(result, &github.User{Login: github.Ptr(login)})
+		}
+		return result
+	}
+	openIssues := []*github.Issue{
+		{Number: github.Ptr(1), Assignees: users("alice", "bob")},
+		{Number: github.Ptr(2), Assignees: users("alice")},
+		{Number: github.Ptr(3), Assignees: users("carol"), PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/octo/repo/pulls/3")}},
+	}
+	openPRs := []*github.PullRequest{
+		{Number: github.Ptr(3), RequestedReviewers: users("bob", "carol")},
+		{Number: github.Ptr(4), RequestedReviewers: users("carol")},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedErrMsg     string
+		expectedSuggestion string
+		expectedRanking    []string
+	}{
+		{
+			name: "ranks team members across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(mock.GetOrgsTeamsMembersByOrgByTeamSlug, users("alice", "bob"), users("carol", "dave")),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"state": "open", "assignee": "*", "per_page": "100"}).andThen(
+						mockResponse(t, http.StatusOK, openIssues),
+					),
+				),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, openPRs),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"team":  "maintainers",
+			},
+			expectedSuggestion: "dave",
+			expectedRanking:    []string{"dave", "alice", "bob", "carol"},
+		},
+		{
+			name: "repository assignees with exclusions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposAssigneesByOwnerByRepo, users("alice", "bob", "carol", "dave")),
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepo, openIssues),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, openPRs),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "octo",
+				"repo":          "repo",
+				"exclude":       []interface{}{"dave"},
+				"review_weight": float64(0),
+			},
+			expectedSuggestion: "carol",
+			expectedRanking:    []string{"carol", "bob", "alice"},
+		},
+		{
+			name: "explicit candidates",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepo, openIssues),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, openPRs),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"candidates": []interface{}{"carol", "alice"},
+			},
+			expectedSuggestion: "alice",
+			expectedRanking:    []string{"alice", "carol"},
+		},
+		{
+			name:         "all candidates excluded",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"candidates": []interface{}{"alice"},
+				"exclude":    []interface{}{"Alice"},
+			},
+			expectError:    true,
+			expectedErrMsg: "no candidates to suggest",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := SuggestAssignee(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Suggestion string         `json:"suggestion"`
+				Ranking    []AssigneeLoad `json:"ranking"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedSuggestion, response.Suggestion)
+			logins := []string{}
+			for _, load := range response.Ranking {
+				logins = append(logins, load.Login)
+			}
+			assert.Equal(t, tc.expectedRanking, logins)
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultBlameReviewersMaxFiles is the default number of files blamed by suggest_reviewers_from_blame.
+	DefaultBlameReviewersMaxFiles = 20
+	// MaxBlameReviewersMaxFiles bounds the number of files blamed by suggest_reviewers_from_blame, as each file
+	// requires a GraphQL query.
+	MaxBlameReviewersMaxFiles = 50
+	// DefaultBlameReviewersLimit is the default number of reviewers suggested.
+	DefaultBlameReviewersLimit = 5
+)
+
+// hunkHeaderPattern matches the header of a hunk of a unified diff, capturing the first line of the hunk in the old
+// file.
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+\d+(?:,\d+)? @@`)
+
+// BlameReviewer is a suggested reviewer of a pull request: an author of lines the pull request changes.
+type BlameReviewer struct {
+	Login        string `json:"login"`
+	Lines        int    `json:"lines"`
+	Files        int    `json:"files"`
+	LastCommitAt string `json:"last_commit_at"`
+}
+
+// BlameReviewersResult is the result of suggesting reviewers of a pull request from the blame of the lines it changes.
+type BlameReviewersResult struct {
+	PullRequest  int             `json:"pull_request"`
+	Author       string          `json:"author"`
+	FilesBlamed  int             `json:"files_blamed"`
+	FilesSkipped int             `json:"files_skipped,omitempty"`
+	Reviewers    []BlameReviewer `json:"reviewers"`
+}
+
+// changedBaseLines returns the lines of the base version of a file touched by a patch: the lines it removes or
+// replaces, and for pure insertions the line the new lines are inserted after.
+func changedBaseLines(patch string) []int {
+	touched := map[int]bool{}
+	oldLine, inHunk, inserting := 0, false, false
+	for _, line := range strings.Split(patch, "\n") {
+		if match := hunkHeaderPattern.FindStringSubmatch(line); match != nil {
+			oldLine, _ = strconv.Atoi(match[1])
+			inHunk, inserting = true, false
+			continue
+		}
+		if !inHunk || line == "" {
+			continue
+		}
+		switch line[0] {
+		case '-':
+			touched[oldLine] = true
+			oldLine++
+			inserting = false
+		case '+':
+			if !inserting && !touched[oldLine-1] {
+				touched[max(oldLine-1, 1)] = true
+			}
+			inserting = true
+		case ' ':
+			oldLine++
+			inserting = false
+		}
+	}
+
+	lines := make([]int, 0, len(touched))
+	for line := range touched {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+type blameRange struct {
+	StartingLine githubv4.Int
+	EndingLine   githubv4.Int
+	Commit       struct {
+		CommittedDate githubv4.DateTime
+		Author        struct {
+			User *struct {
+				Login githubv4.String
+			}
+		}
+	}
+}
+
+// blameFileQuery selects the blame of a file at a commit.
+type blameFileQuery struct {
+	Repository struct {
+		Object *struct {
+			Commit struct {
+				Blame struct {
+					Ranges []blameRange
+				} `graphql:"blame(path: $path)"`
+			} `graphql:"... on Commit"`
+		} `graphql:"object(expression: $expression)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// SuggestReviewersFromBlame creates a tool to suggest reviewers of a pull request from the authors of the lines it
+// changes.
+func SuggestReviewersFromBlame(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_reviewers_from_blame",
+			mcp.WithDescription(t("TOOL_SUGGEST_REVIEWERS_FROM_BLAME_DESCRIPTION", "Suggest reviewers for a pull request by blaming the lines it changes on its merge base. Returns the users who last changed the most of these lines recently, excluding the author of the pull request. Use this to route reviews in repositories without a CODEOWNERS file.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUGGEST_REVIEWERS_FROM_BLAME_USER_TITLE", "Suggest reviewers from blame"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[BlameReviewersResult](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only count lines last changed after this date (ISO 8601 timestamp, e.g. 2024-01-01 or 2024-01-01T00:00:00Z). Defaults to one year ago"),
+			),
+			mcp.WithNumber("max_files",
+				mcp.Description(fmt.Sprintf("Maximum number of changed files to blame (max %d)", MaxBlameReviewersMaxFiles)),
+				mcp.Min(1),
+				mcp.Max(MaxBlameReviewersMaxFiles),
+				mcp.DefaultNumber(DefaultBlameReviewersMaxFiles),
+			),
+			mcp.WithNumber("max_reviewers",
+				mcp.Description("Maximum number of reviewers to suggest"),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultBlameReviewersLimit),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceStr, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// Values below 1 are clamped to 1, so max_files cannot use OptionalIntParamWithDefault.
+			maxFilesParam, maxFilesSet, err := OptionalParamOK[float64](request, "max_files")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxFiles := DefaultBlameReviewersMaxFiles
+			if maxFilesSet {
+				maxFiles = int(maxFilesParam)
+			}
+			maxReviewers, err := OptionalIntParamWithDefault(request, "max_reviewers", DefaultBlameReviewersLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxFiles = min(max(maxFiles, 1), MaxBlameReviewersMaxFiles)
+			if maxReviewers < 1 {
+				maxReviewers = DefaultBlameReviewersLimit
+			}
+			since := time.Now().AddDate(-1, 0, 0)
+			if sinceStr != "" {
+				since, err = parseISOTimestamp(sinceStr)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to parse since: %s", err)), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// The pull request changes the lines of its merge base, which the base branch may have changed since.
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, pr.GetBase().GetSHA(), pr.GetHead().GetSHA(), &github.ListOptions{PerPage: 1})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get the merge base of the pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			mergeBase := comparison.GetMergeBaseCommit().GetSHA()
+
+			var files []*github.CommitFile
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list pull request files",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				files = append(files, page...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			author := pr.GetUser().GetLogin()
+			result := BlameReviewersResult{
+				PullRequest: pullNumber,
+				Author:      author,
+				Reviewers:   []BlameReviewer{},
+			}
+			reviewers := map[string]*BlameReviewer{}
+			lastCommits := map[string]time.Time{}
+			for _, file := range files {
+				// Added files have no lines on the merge base, and files without a patch, such as binary files,
+				// have no changed lines to blame.
+				lines := changedBaseLines(file.GetPatch())
+				if file.GetStatus() == "added" || len(lines) == 0 {
+					continue
+				}
+				if result.FilesBlamed == maxFiles {
+					result.FilesSkipped++
+					continue
+				}
+				path := file.GetFilename()
+				if file.GetPreviousFilename() != "" {
+					path = file.GetPreviousFilename()
+				}
+
+				var query blameFileQuery
+				if err := gqlClient.Query(ctx, &query, map[string]any{
+					"owner":      githubv4.String(owner),
+					"repo":       githubv4.String(repo),
+					"expression": githubv4.String(mergeBase),
+					"path":       githubv4.String(path),
+				}); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						fmt.Sprintf("failed to blame %s", path),
+						err,
+					), nil
+				}
+				result.FilesBlamed++
+				if query.Repository.Object == nil {
+					continue
+				}
+
+				inFile := map[string]bool{}
+				for _, r := range query.Repository.Object.Commit.Blame.Ranges {
+					user := r.Commit.Author.User
+					committedAt := r.Commit.CommittedDate.Time
+					if user == nil || committedAt.Before(since) || strings.EqualFold(string(user.Login), author) {
+						continue
+					}
+					count := 0
+					for _, line := range lines {
+						if line >= int(r.StartingLine) && line <= int(r.EndingLine) {
+							count++
+						}
+					}
+					if count == 0 {
+						continue
+					}
+
+					login := string(user.Login)
+					reviewer, ok := reviewers[strings.ToLower(login)]
+					if !ok {
+						reviewer = &BlameReviewer{Login: login}
+						reviewers[strings.ToLower(login)] = reviewer
+					}
+					reviewer.Lines += count
+					if !inFile[strings.ToLower(login)] {
+						inFile[strings.ToLower(login)] = true
+						reviewer.Files++
+					}
+					if committedAt.After(lastCommits[strings.ToLower(login)]) {
+						lastCommits[strings.ToLower(login)] = committedAt
+						reviewer.LastCommitAt = committedAt.UTC().Format(time.RFC3339)
+					}
+				}
+			}
+
+			for _, reviewer := range reviewers {
+				result.Reviewers = append(result.Reviewers, *reviewer)
+			}
+			// The authors of most changed lines come first, and the most recent of them among equals.
+			sort.Slice(result.Reviewers, func(i, j int) bool {
+				a, b := result.Reviewers[i], result.Reviewers[j]
+				if a.Lines != b.Lines {
+					return a.Lines > b.Lines
+				}
+				if a.LastCommitAt != b.LastCommitAt {
+					return a.LastCommitAt > b.LastCommitAt
+				}
+				return a.Login < b.Login
+			})
+			if len(result.Reviewers) > maxReviewers {
+				result.Reviewers = result.Reviewers[:maxReviewers]
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
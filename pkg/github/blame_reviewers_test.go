@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ChangedBaseLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		expected []int
+	}{
+		{
+			name:     "replaced line",
+			patch:    "@@ -10,3 +10,3 @@ func main() {\n context\n-old\n+new\n context",
+			expected: []int{11},
+		},
+		{
+			name:     "insertion",
+			patch:    "@@ -4,2 +4,4 @@\n a\n+b\n+c\n d",
+			expected: []int{4},
+		},
+		{
+			name:     "several hunks",
+			patch:    "@@ -1,2 +1,1 @@\n-x\n-y\n@@ -20,1 +19,1 @@\n-z\n+w\n\\ No newline at end of file",
+			expected: []int{1, 2, 20},
+		},
+		{
+			name:     "no patch",
+			patch:    "",
+			expected: []int{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, changedBaseLines(tc.patch))
+		})
+	}
+}
+
+func Test_SuggestReviewersFromBlame(t *testing.T) {
+	tool, _ := SuggestReviewersFromBlame(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "suggest_reviewers_from_blame", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, map[string]any{
+			"number": 42,
+			"user":   map[string]any{"login": "author"},
+			"base":   map[string]any{"ref": "main", "sha": "base-sha"},
+			"head":   map[string]any{"ref": "feature", "sha": "head-sha"},
+		}),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCompareByOwnerByRepoByBasehead,
+			expectPath(t, "/repos/octo/app/compare/base-sha...head-sha").andThen(
+				mockResponse(t, http.StatusOK, map[string]any{"merge_base_commit": map[string]any{"sha": "merge-base-sha"}}),
+			),
+		),
+		mock.WithRequestMatch(mock.GetReposPullsFilesByOwnerByRepoByPullNumber, []map[string]any{
+			{"filename": "new.go", "status": "added", "patch": "@@ -0,0 +1,1 @@\n+x"},
+			{"filename": "logo.png", "status": "modified"},
+			{"filename": "handler.go", "previous_filename": "old_handler.go", "status": "renamed", "patch": "@@ -1,6 +1,6 @@\n-a\n-b\n-c\n+A\n+B\n+C\n d\n-e\n+E\n f"},
+		}),
+	)
+
+	blameRange := func(start, end int, login, date string) map[string]any {
+		return map[string]any{
+			"startingLine": start,
+			"endingLine":   end,
+			"commit": map[string]any{
+				"committedDate": date,
+				"author":        map[string]any{"user": map[string]any{"login": login}},
+			},
+		}
+	}
+	// Lines 1-3 and 5 of the base version of the renamed file are changed.
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		blameFileQuery{},
+		map[string]any{
+			"owner":      githubv4.String("octo"),
+			"repo":       githubv4.String("app"),
+			"expression": githubv4.String("merge-base-sha"),
+			"path":       githubv4.String("old_handler.go"),
+		},
+		githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"object": map[string]any{
+			"blame": map[string]any{"ranges": []any{
+				blameRange(1, 1, "alice", "2025-03-01T00:00:00Z"),
+				blameRange(2, 2, "author", "2025-03-02T00:00:00Z"),
+				blameRange(3, 3, "bob", "2019-01-01T00:00:00Z"),
+				blameRange(4, 5, "carol", "2025-01-01T00:00:00Z"),
+				blameRange(6, 9, "alice", "2025-02-01T00:00:00Z"),
+			}},
+		}}}),
+	))
+
+	_, handler := SuggestReviewersFromBlame(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "octo",
+		"repo":       "app",
+		"pullNumber": float64(42),
+		"since":      "2024-01-01",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response BlameReviewersResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, BlameReviewersResult{
+		PullRequest: 42,
+		Author:      "author",
+		FilesBlamed: 1,
+		Reviewers: []BlameReviewer{
+			{Login: "alice", Lines: 1, Files: 1, LastCommitAt: "2025-03-01T00:00:00Z"},
+			{Login: "carol", Lines: 1, Files: 1, LastCommitAt: "2025-01-01T00:00:00Z"},
+		},
+	}, response)
+}
+
+func Test_SuggestReviewersFromBlame_MaxFiles(t *testing.T) {
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, map[string]any{
+			"number": 42,
+			"user":   map[string]any{"login": "author"},
+			"base":   map[string]any{"ref": "main", "sha": "base-sha"},
+			"head":   map[string]any{"ref": "feature", "sha": "head-sha"},
+		}),
+		mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, map[string]any{
+			"merge_base_commit": map[string]any{"sha": "merge-base-sha"},
+		}),
+		mock.WithRequestMatch(mock.GetReposPullsFilesByOwnerByRepoByPullNumber, []map[string]any{
+			{"filename": "a.go", "status": "modified", "patch": "@@ -1,1 +1,1 @@\n-a\n+A"},
+			{"filename": "b.go", "status": "modified", "patch": "@@ -1,1 +1,1 @@\n-b\n+B"},
+		}),
+	)
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		blameFileQuery{},
+		map[string]any{
+			"owner":      githubv4.String("octo"),
+			"repo":       githubv4.String("app"),
+			"expression": githubv4.String("merge-base-sha"),
+			"path":       githubv4.String("a.go"),
+		},
+		githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"object": map[string]any{
+			"blame": map[string]any{"ranges": []any{}},
+		}}}),
+	))
+
+	// A max_files below 1 blames a single file rather than the most files allowed.
+	_, handler := SuggestReviewersFromBlame(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "octo",
+		"repo":       "app",
+		"pullNumber": float64(42),
+		"max_files":  float64(0),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response BlameReviewersResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, 1, response.FilesBlamed)
+	assert.Equal(t, 1, response.FilesSkipped)
+}
+
+func Test_SuggestReviewersFromBlame_PullRequestNotFound(t *testing.T) {
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		),
+	)
+	_, handler := SuggestReviewersFromBlame(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "octo",
+		"repo":       "app",
+		"pullNumber": float64(42),
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "failed to get pull request")
+}
@@ -0,0 +1,543 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	BootstrapStepStatusWouldApply = "would_apply"
+	BootstrapStepStatusApplied    = "applied"
+	BootstrapStepStatusUnchanged  = "unchanged"
+	BootstrapStepStatusError      = "error"
+)
+
+// BootstrapStep describes a single change made, or planned, by bootstrap_repository.
+type BootstrapStep struct {
+	Step   string `json:"step"`
+	Target string `json:"target"`
+	Action string `json:"action,omitempty"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// LabelSpec is the desired name, color and description of a label.
+type LabelSpec struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// branchProtectionSpec is the desired branch protection of bootstrap_repository.
+type branchProtectionSpec struct {
+	Branch                   string
+	RequiredChecks           []string
+	RequiredApprovingReviews int
+	EnforceAdmins            bool
+}
+
+// bootstrapSpec holds the standard setup applied by bootstrap_repository.
+type bootstrapSpec struct {
+	labels           []LabelSpec
+	topics           []string
+	codeowners       []string
+	issueTemplates   map[string]string
+	branchProtection *branchProtectionSpec
+	projectOwnerType string
+	projectOwner     string
+	projectNumber    int
+}
+
+// bootstrapRun applies, or plans when dryRun is set, the steps of a bootstrap to a single repository.
+type bootstrapRun struct {
+	client    *github.Client
+	gqlClient *githubv4.Client
+	owner     string
+	repo      string
+	dryRun    bool
+	steps     []BootstrapStep
+}
+
+// record adds a step to the run. Steps that would change the repository are only applied when fn is called,
+// which does not happen in a dry run.
+func (b *bootstrapRun) record(ctx context.Context, step BootstrapStep, fn func() (*github.Response, error)) {
+	if step.Status == "" {
+		step.Status = BootstrapStepStatusApplied
+		if b.dryRun {
+			step.Status = BootstrapStepStatusWouldApply
+		} else if resp, err := fn(); err != nil {
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, fmt.Sprintf("failed to %s %s", step.Action, step.Step), resp, err)
+			step.Status = BootstrapStepStatusError
+			step.Error = err.Error()
+		}
+	}
+	b.steps = append(b.steps, step)
+}
+
+// fail adds a step that could not be planned because reading the current state of the repository failed.
+func (b *bootstrapRun) fail(ctx context.Context, step, target, msg string, resp *github.Response, err error) {
+	_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, msg, resp, err)
+	b.steps = append(b.steps, BootstrapStep{
+		Step:   step,
+		Target: target,
+		Status: BootstrapStepStatusError,
+		Error:  fmt.Sprintf("%s: %s", msg, err),
+	})
+}
+
+// normalizeLabelColor returns a label color without a leading '#', in lower case.
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(color), "#"))
+}
+
+func (b *bootstrapRun) applyLabels(ctx context.Context, specs []LabelSpec) {
+	labels, resp, err := listAllLabels(ctx, b.client, b.owner, b.repo)
+	if err != nil {
+		b.fail(ctx, "label", "", "failed to list labels", resp, err)
+		return
+	}
+	existing := map[string]*github.Label{}
+	for _, label := range labels {
+		existing[strings.ToLower(label.GetName())] = label
+	}
+
+	for _, spec := range specs {
+		step := BootstrapStep{Step: "label", Target: spec.Name}
+		label := &github.Label{Name: github.Ptr(spec.Name), Color: github.Ptr(normalizeLabelColor(spec.Color)), Description: github.Ptr(spec.Description)}
+		current, ok := existing[strings.ToLower(spec.Name)]
+		switch {
+		case !ok:
+			step.Action = "create"
+			b.record(ctx, step, func() (*github.Response, error) {
+				_, resp, err := b.client.Issues.CreateLabel(ctx, b.owner, b.repo, label)
+				return resp, err
+			})
+		case (spec.Color == "" || normalizeLabelColor(current.GetColor()) == label.GetColor()) &&
+			(spec.Description == "" || current.GetDescription() == spec.Description):
+			step.Status = BootstrapStepStatusUnchanged
+			b.record(ctx, step, nil)
+		default:
+			step.Action = "update"
+			if spec.Color == "" {
+				label.Color = nil
+			}
+			if spec.Description == "" {
+				label.Description = nil
+			}
+			b.record(ctx, step, func() (*github.Response, error) {
+				_, resp, err := b.client.Issues.EditLabel(ctx, b.owner, b.repo, current.GetName(), label)
+				return resp, err
+			})
+		}
+	}
+}
+
+func (b *bootstrapRun) applyTopics(ctx context.Context, topics []string) {
+	current, resp, err := b.client.Repositories.ListAllTopics(ctx, b.owner, b.repo)
+	if err != nil {
+		b.fail(ctx, "topics", "", "failed to list topics", resp, err)
+		return
+	}
+	_ = resp.Body.Close()
+
+	missing, _ := diffStringSets(topics, current)
+	step := BootstrapStep{Step: "topics", Target: strings.Join(topics, ", ")}
+	if len(missing) == 0 {
+		step.Status = BootstrapStepStatusUnchanged
+		b.record(ctx, step, nil)
+		return
+	}
+	step.Action = "add"
+	step.Detail = "adds " + strings.Join(missing, ", ")
+	b.record(ctx, step, func() (*github.Response, error) {
+		_, resp, err := b.client.Repositories.ReplaceAllTopics(ctx, b.owner, b.repo, append(current, missing...))
+		return resp, err
+	})
+}
+
+// applyFile creates a file on the default branch. Existing files are left untouched, so customized files are never
+// overwritten by the standard setup.
+func (b *bootstrapRun) applyFile(ctx context.Context, step, filePath, content string) {
+	_, _, resp, err := b.client.Repositories.GetContents(ctx, b.owner, b.repo, filePath, nil)
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		b.record(ctx, BootstrapStep{Step: step, Target: filePath, Status: BootstrapStepStatusUnchanged, Detail: "file already exists"}, nil)
+		return
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		// The file does not exist yet and will be created.
+	default:
+		b.fail(ctx, step, filePath, "failed to get file contents", resp, err)
+		return
+	}
+
+	b.record(ctx, BootstrapStep{Step: step, Target: filePath, Action: "create"}, func() (*github.Response, error) {
+		_, resp, err := b.client.Repositories.CreateFile(ctx, b.owner, b.repo, filePath, &github.RepositoryContentFileOptions{
+			Message: github.Ptr(fmt.Sprintf("Add %s", filePath)),
+			Content: []byte(content),
+		})
+		return resp, err
+	})
+}
+
+func (b *bootstrapRun) applyProjectLink(ctx context.Context, repoNodeID, ownerType, owner string, number int) {
+	target := fmt.Sprintf("%s/%d", owner, number)
+	var project *github.ProjectV2
+	var resp *github.Response
+	var err error
+	if ownerType == "user" {
+		project, resp, err = b.client.Projects.GetUserProject(ctx, owner, number)
+	} else {
+		project, resp, err = b.client.Projects.GetOrganizationProject(ctx, owner, number)
+	}
+	if err != nil {
+		b.fail(ctx, "project", target, "failed to get project", resp, err)
+		return
+	}
+	_ = resp.Body.Close()
+
+	step := BootstrapStep{Step: "project", Target: target, Action: "link", Detail: project.GetTitle()}
+	b.record(ctx, step, func() (*github.Response, error) {
+		var mutation struct {
+			LinkProjectV2ToRepository struct {
+				Repository struct {
+					ID githubv4.ID
+				}
+			} `graphql:"linkProjectV2ToRepository(input: $input)"`
+		}
+		input := githubv4.LinkProjectV2ToRepositoryInput{
+			ProjectID:    githubv4.ID(project.GetNodeID()),
+			RepositoryID: githubv4.ID(repoNodeID),
+		}
+		return nil, b.gqlClient.Mutate(ctx, &mutation, input, nil)
+	})
+}
+
+func (b *bootstrapRun) applyBranchProtection(ctx context.Context, spec branchProtectionSpec) {
+	step := BootstrapStep{Step: "branch_protection", Target: spec.Branch}
+	protection, resp, err := b.client.Repositories.GetBranchProtection(ctx, b.owner, b.repo, spec.Branch)
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		missing, extra := diffStringSets(spec.RequiredChecks, requiredCheckContexts(protection.GetRequiredStatusChecks()))
+		reviews := 0
+		if protection.RequiredPullRequestReviews != nil {
+			reviews = protection.RequiredPullRequestReviews.RequiredApprovingReviewCount
+		}
+		if len(missing) == 0 && len(extra) == 0 && reviews == spec.RequiredApprovingReviews &&
+			protection.GetEnforceAdmins().Enabled == spec.EnforceAdmins {
+			step.Status = BootstrapStepStatusUnchanged
+			b.record(ctx, step, nil)
+			return
+		}
+		step.Action = "update"
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		step.Action = "create"
+	default:
+		b.fail(ctx, step.Step, spec.Branch, "failed to get branch protection", resp, err)
+		return
+	}
+
+	req := &github.ProtectionRequest{EnforceAdmins: spec.EnforceAdmins}
+	if len(spec.RequiredChecks) > 0 {
+		checks := make([]*github.RequiredStatusCheck, 0, len(spec.RequiredChecks))
+		for _, c := range spec.RequiredChecks {
+			checks = append(checks, &github.RequiredStatusCheck{Context: c})
+		}
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{Strict: true, Checks: &checks}
+	}
+	if spec.RequiredApprovingReviews > 0 {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			RequiredApprovingReviewCount: spec.RequiredApprovingReviews,
+		}
+	}
+	b.record(ctx, step, func() (*github.Response, error) {
+		_, resp, err := b.client.Repositories.UpdateBranchProtection(ctx, b.owner, b.repo, spec.Branch, req)
+		return resp, err
+	})
+}
+
+// codeownersStub returns a CODEOWNERS file that makes the given owners the code owners of the whole repository.
+func codeownersStub(owners []string) string {
+	mentions := make([]string, 0, len(owners))
+	for _, owner := range owners {
+		mentions = append(mentions, "@"+strings.TrimPrefix(strings.TrimSpace(owner), "@"))
+	}
+	return "# These owners are requested for review on every pull request.\n" +
+		"# See https://docs.github.com/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners\n" +
+		"* " + strings.Join(mentions, " ") + "\n"
+}
+
+// parseLabelSpecs converts the labels parameter of a request to label specs.
+func parseLabelSpecs(request mcp.CallToolRequest, p string) ([]LabelSpec, error) {
+	raw, ok := request.GetArguments()[p]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of label objects", p)
+	}
+	labels := make([]LabelSpec, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each label in %s must be an object", p)
+		}
+		name, _ := obj["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("each label in %s must have a name", p)
+		}
+		color, _ := obj["color"].(string)
+		description, _ := obj["description"].(string)
+		labels = append(labels, LabelSpec{Name: name, Color: color, Description: description})
+	}
+	return labels, nil
+}
+
+// labelSpecSchema is the JSON schema of a label in tool parameters.
+var labelSpecSchema = map[string]interface{}{
+	"type":                 "object",
+	"additionalProperties": false,
+	"required":             []string{"name"},
+	"properties": map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":        "string",
+			"description": "Label name",
+		},
+		"color": map[string]interface{}{
+			"type":        "string",
+			"description": "Hexadecimal color code without the leading '#', e.g. 'd73a4a'",
+		},
+		"description": map[string]interface{}{
+			"type":        "string",
+			"description": "Label description",
+		},
+	},
+}
+
+// BootstrapRepository creates a tool to apply a standard setup to a new repository in one call.
+func BootstrapRepository(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bootstrap_repository",
+			mcp.WithDescription(t("TOOL_BOOTSTRAP_REPOSITORY_DESCRIPTION", "Apply a standard setup to a repository in one call: labels, topics, a CODEOWNERS file, issue templates, a link to a project and branch protection. Only the parts that are provided are applied, and existing files are never overwritten. Use dry_run to get the plan without making changes. Returns the status of every step.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_BOOTSTRAP_REPOSITORY_USER_TITLE", "Bootstrap repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Labels to create, or update when their color or description differ"),
+				mcp.Items(labelSpecSchema),
+			),
+			mcp.WithArray("topics",
+				mcp.Description("Topics to add to the repository. Existing topics are kept"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("codeowners",
+				mcp.Description("Users or teams ('org/team') to make code owners of the whole repository in a .github/CODEOWNERS stub"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithObject("issue_templates",
+				mcp.Description("Issue templates to add to .github/ISSUE_TEMPLATE, keyed by file name, e.g. {\"bug_report.yml\": \"name: Bug report\\n...\"}"),
+			),
+			mcp.WithObject("branch_protection",
+				mcp.Description("Branch protection to apply"),
+				mcp.Properties(map[string]any{
+					"branch": map[string]any{
+						"type":        "string",
+						"description": "Branch to protect. Defaults to the default branch",
+					},
+					"required_checks": map[string]any{
+						"type":        "array",
+						"description": "Required status check contexts",
+						"items":       map[string]any{"type": "string"},
+					},
+					"required_approving_reviews": map[string]any{
+						"type":        "number",
+						"description": "Number of approving reviews required before merging",
+					},
+					"enforce_admins": map[string]any{
+						"type":        "boolean",
+						"description": "Enforce the protection for administrators",
+					},
+				}),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Description("Number of a project to link the repository to"),
+			),
+			mcp.WithString("project_owner",
+				mcp.Description("Owner of the project. Defaults to the repository owner"),
+			),
+			mcp.WithString("project_owner_type",
+				mcp.Description("Owner type of the project"),
+				mcp.Enum("user", "org"),
+				mcp.DefaultString("org"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, only return the plan without making changes"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			spec := bootstrapSpec{}
+			spec.labels, err = parseLabelSpecs(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			spec.topics, err = OptionalStringArrayParam(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			spec.codeowners, err = OptionalStringArrayParam(request, "codeowners")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			templates, err := OptionalParam[map[string]any](request, "issue_templates")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			spec.issueTemplates = make(map[string]string, len(templates))
+			for name, content := range templates {
+				text, ok := content.(string)
+				if !ok || path.Base(name) != name || name == "." {
+					return mcp.NewToolResultError(fmt.Sprintf("issue_templates: %q must be a file name with string content", name)), nil
+				}
+				spec.issueTemplates[name] = text
+			}
+			protection, protectionSet, err := OptionalParamOK[map[string]any](request, "branch_protection")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if protectionSet {
+				spec.branchProtection = &branchProtectionSpec{}
+				spec.branchProtection.Branch, _ = protection["branch"].(string)
+				reviews, _ := protection["required_approving_reviews"].(float64)
+				spec.branchProtection.RequiredApprovingReviews = int(reviews)
+				spec.branchProtection.EnforceAdmins, _ = protection["enforce_admins"].(bool)
+				checks, _ := protection["required_checks"].([]interface{})
+				for _, c := range checks {
+					check, ok := c.(string)
+					if !ok {
+						return mcp.NewToolResultError("branch_protection: required_checks must be an array of strings"), nil
+					}
+					spec.branchProtection.RequiredChecks = append(spec.branchProtection.RequiredChecks, check)
+				}
+			}
+			spec.projectNumber, err = OptionalIntParam(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			spec.projectOwner, err = OptionalParam[string](request, "project_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if spec.projectOwner == "" {
+				spec.projectOwner = owner
+			}
+			spec.projectOwnerType, err = OptionalParam[string](request, "project_owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			run := &bootstrapRun{client: client, gqlClient: gqlClient, owner: owner, repo: repo, dryRun: dryRun}
+			if len(spec.labels) > 0 {
+				run.applyLabels(ctx, spec.labels)
+			}
+			if len(spec.topics) > 0 {
+				run.applyTopics(ctx, spec.topics)
+			}
+			if len(spec.codeowners) > 0 {
+				run.applyFile(ctx, "codeowners", ".github/CODEOWNERS", codeownersStub(spec.codeowners))
+			}
+			names := make([]string, 0, len(spec.issueTemplates))
+			for name := range spec.issueTemplates {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				run.applyFile(ctx, "issue_template", ".github/ISSUE_TEMPLATE/"+name, spec.issueTemplates[name])
+			}
+			if spec.projectNumber != 0 {
+				run.applyProjectLink(ctx, repository.GetNodeID(), spec.projectOwnerType, spec.projectOwner, spec.projectNumber)
+			}
+			// Branch protection is applied last so that it does not block the commits of the previous steps.
+			if spec.branchProtection != nil {
+				if spec.branchProtection.Branch == "" {
+					spec.branchProtection.Branch = repository.GetDefaultBranch()
+				}
+				run.applyBranchProtection(ctx, *spec.branchProtection)
+			}
+
+			failed := 0
+			for _, step := range run.steps {
+				if step.Status == BootstrapStepStatusError {
+					failed++
+				}
+			}
+
+			response := map[string]any{
+				"repository": repository.GetFullName(),
+				"dry_run":    dryRun,
+				"steps":      run.steps,
+				"failed":     failed,
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
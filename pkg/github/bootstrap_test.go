@@ -0,0 +1,208 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CodeownersStub(t *testing.T) {
+	stub := codeownersStub([]string{"octocat", "@octo-org/maintainers"})
+	assert.Contains(t, stub, "\n* @octocat @octo-org/maintainers\n")
+}
+
+func Test_BootstrapRepository(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := BootstrapRepository(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "bootstrap_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	for _, p := range []string{"labels", "topics", "codeowners", "issue_templates", "branch_protection", "project_number", "dry_run"} {
+		assert.Contains(t, tool.InputSchema.Properties, p)
+	}
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	repository := &github.Repository{
+		FullName:      github.Ptr("octo/repo"),
+		NodeID:        github.Ptr("R_repo"),
+		DefaultBranch: github.Ptr("main"),
+	}
+	existingLabels := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a"), Description: github.Ptr("Something isn't working")},
+		{Name: github.Ptr("Enhancement"), Color: github.Ptr("ffffff")},
+	}
+	requestArgs := map[string]interface{}{
+		"owner": "octo",
+		"repo":  "repo",
+		"labels": []interface{}{
+			map[string]interface{}{"name": "bug", "color": "#D73A4A"},
+			map[string]interface{}{"name": "enhancement", "color": "a2eeef"},
+			map[string]interface{}{"name": "triage", "color": "fbca04", "description": "Needs triage"},
+		},
+		"topics":          []interface{}{"go", "mcp"},
+		"codeowners":      []interface{}{"octo/maintainers"},
+		"issue_templates": map[string]interface{}{"bug_report.yml": "name: Bug report\n"},
+		"branch_protection": map[string]interface{}{
+			"required_checks":            []interface{}{"build"},
+			"required_approving_reviews": float64(1),
+		},
+		"project_number": float64(7),
+	}
+	notFound := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	readMocks := func() []mock.MockBackendOption {
+		return []mock.MockBackendOption{
+			mock.WithRequestMatch(mock.GetReposByOwnerByRepo, repository),
+			mock.WithRequestMatch(mock.GetReposLabelsByOwnerByRepo, existingLabels),
+			mock.WithRequestMatch(mock.GetReposTopicsByOwnerByRepo, map[string]any{"names": []string{"go"}}),
+			mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/repos/octo/repo/contents/.github/CODEOWNERS" {
+					mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("file"), Name: github.Ptr("CODEOWNERS")})(w, r)
+					return
+				}
+				notFound(w, r)
+			})),
+			mock.WithRequestMatch(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+				&github.ProjectV2{NodeID: github.Ptr("PVT_project"), Title: github.Ptr("Roadmap")},
+			),
+			mock.WithRequestMatchHandler(mock.GetReposBranchesProtectionByOwnerByRepoByBranch, notFound),
+		}
+	}
+
+	t.Run("dry run returns the plan", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(readMocks()...))
+		_, handler := BootstrapRepository(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		args := map[string]interface{}{"dry_run": true}
+		for k, v := range requestArgs {
+			args[k] = v
+		}
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+
+		var response struct {
+			DryRun bool            `json:"dry_run"`
+			Steps  []BootstrapStep `json:"steps"`
+			Failed int             `json:"failed"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 0, response.Failed)
+		assert.Equal(t, []BootstrapStep{
+			{Step: "label", Target: "bug", Status: BootstrapStepStatusUnchanged},
+			{Step: "label", Target: "enhancement", Action: "update", Status: BootstrapStepStatusWouldApply},
+			{Step: "label", Target: "triage", Action: "create", Status: BootstrapStepStatusWouldApply},
+			{Step: "topics", Target: "go, mcp", Action: "add", Status: BootstrapStepStatusWouldApply, Detail: "adds mcp"},
+			{Step: "codeowners", Target: ".github/CODEOWNERS", Status: BootstrapStepStatusUnchanged, Detail: "file already exists"},
+			{Step: "issue_template", Target: ".github/ISSUE_TEMPLATE/bug_report.yml", Action: "create", Status: BootstrapStepStatusWouldApply},
+			{Step: "project", Target: "octo/7", Action: "link", Status: BootstrapStepStatusWouldApply, Detail: "Roadmap"},
+			{Step: "branch_protection", Target: "main", Action: "create", Status: BootstrapStepStatusWouldApply},
+		}, response.Steps)
+	})
+
+	t.Run("applies the setup", func(t *testing.T) {
+		mocks := append(readMocks(),
+			mock.WithRequestMatchHandler(
+				mock.PatchReposLabelsByOwnerByRepoByName,
+				expectRequestBody(t, map[string]any{"name": "enhancement", "color": "a2eeef"}).andThen(
+					mockResponse(t, http.StatusOK, &github.Label{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposLabelsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"name": "triage", "color": "fbca04", "description": "Needs triage"}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Label{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposTopicsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"names": []any{"go", "mcp"}}).andThen(
+					mockResponse(t, http.StatusOK, map[string]any{"names": []string{"go", "mcp"}}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposContentsByOwnerByRepoByPath,
+				expectPath(t, "/repos/octo/repo/contents/.github/ISSUE_TEMPLATE/bug_report.yml").andThen(
+					mockResponse(t, http.StatusCreated, &github.RepositoryContentResponse{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+				mockResponse(t, http.StatusOK, &github.Protection{}),
+			),
+		)
+		client := github.NewClient(mock.NewMockedHTTPClient(mocks...))
+		gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewMutationMatcher(
+				struct {
+					LinkProjectV2ToRepository struct {
+						Repository struct {
+							ID githubv4.ID
+						}
+					} `graphql:"linkProjectV2ToRepository(input: $input)"`
+				}{},
+				githubv4.LinkProjectV2ToRepositoryInput{
+					ProjectID:    githubv4.ID("PVT_project"),
+					RepositoryID: githubv4.ID("R_repo"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"linkProjectV2ToRepository": map[string]any{
+						"repository": map[string]any{"id": "R_repo"},
+					},
+				}),
+			),
+		))
+		_, handler := BootstrapRepository(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(requestArgs))
+		require.NoError(t, err)
+
+		var response struct {
+			Steps  []BootstrapStep `json:"steps"`
+			Failed int             `json:"failed"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 0, response.Failed, response.Steps)
+		statuses := []string{}
+		for _, step := range response.Steps {
+			statuses = append(statuses, step.Step+":"+step.Status)
+		}
+		assert.Equal(t, []string{
+			"label:unchanged",
+			"label:applied",
+			"label:applied",
+			"topics:applied",
+			"codeowners:unchanged",
+			"issue_template:applied",
+			"project:applied",
+			"branch_protection:applied",
+		}, statuses)
+	})
+
+	t.Run("repository not found", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(mock.GetReposByOwnerByRepo, notFound),
+		))
+		_, handler := BootstrapRepository(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"owner": "octo", "repo": "missing"}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get repository")
+	})
+}
@@ -0,0 +1,262 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	RequiredChecksStatusInSync       = "in_sync"
+	RequiredChecksStatusWouldUpdate  = "would_update"
+	RequiredChecksStatusUpdated      = "updated"
+	RequiredChecksStatusNotProtected = "not_protected"
+	RequiredChecksStatusError        = "error"
+)
+
+// RequiredChecksDrift describes the difference between the canonical required status checks
+// and the checks currently configured on a single repository branch.
+type RequiredChecksDrift struct {
+	Repository string   `json:"repository"`
+	Branch     string   `json:"branch,omitempty"`
+	Current    []string `json:"current_contexts"`
+	Missing    []string `json:"missing"`
+	Extra      []string `json:"extra"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// SyncRequiredChecks creates a tool to reconcile the required status checks of a branch across many repositories.
+func SyncRequiredChecks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_required_checks",
+			mcp.WithDescription(t("TOOL_SYNC_REQUIRED_CHECKS_DESCRIPTION", "Apply a canonical list of required status check contexts to branch protection across multiple repositories and report drift. Use dry_run to only report drift without making changes.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SYNC_REQUIRED_CHECKS_USER_TITLE", "Sync required status checks"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Repositories to reconcile, in 'owner/repo' format"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("contexts",
+				mcp.Required(),
+				mcp.Description("Canonical list of required status check contexts (check names). An empty list removes all required checks."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Protected branch to reconcile. Defaults to each repository's default branch"),
+			),
+			mcp.WithBoolean("strict",
+				mcp.Description("Require branches to be up to date before merging. When omitted, the current setting of each repository is preserved"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, only report drift without updating branch protection"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("missing required parameter: repositories"), nil
+			}
+			if _, ok := request.GetArguments()["contexts"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: contexts"), nil
+			}
+			contexts, err := OptionalStringArrayParam(request, "contexts")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			strict, strictSet, err := OptionalParamOK[bool](request, "strict")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := make([]RequiredChecksDrift, 0, len(repositories))
+			for _, fullName := range repositories {
+				drift := RequiredChecksDrift{Repository: fullName}
+
+				owner, repo, err := parseRepoFullName(fullName)
+				if err != nil {
+					drift.Status = RequiredChecksStatusError
+					drift.Error = err.Error()
+					results = append(results, drift)
+					continue
+				}
+
+				drift.Branch = branch
+				if drift.Branch == "" {
+					repoInfo, resp, err := client.Repositories.Get(ctx, owner, repo)
+					if err != nil {
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get repository", resp, err)
+						drift.Status = RequiredChecksStatusError
+						drift.Error = fmt.Sprintf("failed to get repository: %s", err)
+						results = append(results, drift)
+						continue
+					}
+					drift.Branch = repoInfo.GetDefaultBranch()
+				}
+
+				checks, resp, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repo, drift.Branch)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						drift.Current = []string{}
+						drift.Missing, drift.Extra = diffStringSets(contexts, nil)
+						drift.Status = RequiredChecksStatusNotProtected
+						drift.Error = "branch protection with required status checks is not enabled for this branch"
+						results = append(results, drift)
+						continue
+					}
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get required status checks", resp, err)
+					drift.Status = RequiredChecksStatusError
+					drift.Error = fmt.Sprintf("failed to get required status checks: %s", err)
+					results = append(results, drift)
+					continue
+				}
+
+				drift.Current = requiredCheckContexts(checks)
+				drift.Missing, drift.Extra = diffStringSets(contexts, drift.Current)
+
+				strictChanged := strictSet && strict != checks.Strict
+				if len(drift.Missing) == 0 && len(drift.Extra) == 0 && !strictChanged {
+					drift.Status = RequiredChecksStatusInSync
+					results = append(results, drift)
+					continue
+				}
+
+				if dryRun {
+					drift.Status = RequiredChecksStatusWouldUpdate
+					results = append(results, drift)
+					continue
+				}
+
+				req := &github.RequiredStatusChecksRequest{
+					Checks: syncRequiredCheckList(checks, contexts),
+				}
+				if strictSet {
+					req.Strict = github.Ptr(strict)
+				}
+
+				_, resp, err = client.Repositories.UpdateRequiredStatusChecks(ctx, owner, repo, drift.Branch, req)
+				if err != nil {
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to update required status checks", resp, err)
+					drift.Status = RequiredChecksStatusError
+					drift.Error = fmt.Sprintf("failed to update required status checks: %s", err)
+					results = append(results, drift)
+					continue
+				}
+				drift.Status = RequiredChecksStatusUpdated
+				results = append(results, drift)
+			}
+
+			response := map[string]any{
+				"dry_run": dryRun,
+				"results": results,
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// requiredCheckContexts returns the contexts of the required status checks, preferring the
+// checks list over the deprecated contexts list.
+func requiredCheckContexts(checks *github.RequiredStatusChecks) []string {
+	contexts := []string{}
+	if checks == nil {
+		return contexts
+	}
+	if checks.Checks != nil {
+		for _, c := range *checks.Checks {
+			contexts = append(contexts, c.Context)
+		}
+		return contexts
+	}
+	if checks.Contexts != nil {
+		contexts = append(contexts, *checks.Contexts...)
+	}
+	return contexts
+}
+
+// syncRequiredCheckList returns the required status checks to set so that exactly contexts are required. Existing
+// checks for those contexts are kept as they are, including the app they are bound to, and the missing contexts are
+// added without an app binding. Repeated contexts are only listed once, where they first appear.
+func syncRequiredCheckList(checks *github.RequiredStatusChecks, contexts []string) []*github.RequiredStatusCheck {
+	existing := map[string]*github.RequiredStatusCheck{}
+	if checks != nil && checks.Checks != nil {
+		for _, c := range *checks.Checks {
+			existing[c.Context] = c
+		}
+	}
+	result := make([]*github.RequiredStatusCheck, 0, len(contexts))
+	seen := make(map[string]bool, len(contexts))
+	for _, c := range contexts {
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		if check, ok := existing[c]; ok {
+			result = append(result, check)
+			continue
+		}
+		result = append(result, &github.RequiredStatusCheck{Context: c})
+	}
+	return result
+}
+
+// diffStringSets returns the values of want missing from have, and the values of have not present in want.
+// Both results are sorted and never nil.
+func diffStringSets(want, have []string) (missing, extra []string) {
+	wantSet := make(map[string]bool, len(want))
+	for _, w := range want {
+		wantSet[w] = true
+	}
+	haveSet := make(map[string]bool, len(have))
+	for _, h := range have {
+		haveSet[h] = true
+	}
+
+	missing = []string{}
+	for w := range wantSet {
+		if !haveSet[w] {
+			missing = append(missing, w)
+		}
+	}
+	extra = []string{}
+	for h := range haveSet {
+		if !wantSet[h] {
+			extra = append(extra, h)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
@@ -0,0 +1,208 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SyncRequiredChecks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SyncRequiredChecks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "sync_required_checks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repositories")
+	assert.Contains(t, tool.InputSchema.Properties, "contexts")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "strict")
+	assert.Contains(t, tool.InputSchema.Properties, "dry_run")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"repositories", "contexts"})
+
+	currentChecks := &github.RequiredStatusChecks{
+		Strict: true,
+		Checks: &[]*github.RequiredStatusCheck{
+			{Context: "build", AppID: github.Ptr(int64(15368))},
+			{Context: "legacy-ci"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedResults []RequiredChecksDrift
+	}{
+		{
+			name: "dry run reports drift without updating",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{DefaultBranch: github.Ptr("main")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", Method: http.MethodGet},
+					expectPath(t, "/repos/octo-org/api/branches/main/protection/required_status_checks").andThen(
+						mockResponse(t, http.StatusOK, currentChecks),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+				"contexts":     []interface{}{"build", "test"},
+				"dry_run":      true,
+			},
+			expectedResults: []RequiredChecksDrift{
+				{
+					Repository: "octo-org/api",
+					Branch:     "main",
+					Current:    []string{"build", "legacy-ci"},
+					Missing:    []string{"test"},
+					Extra:      []string{"legacy-ci"},
+					Status:     RequiredChecksStatusWouldUpdate,
+				},
+			},
+		},
+		{
+			name: "applies canonical checks to explicit branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, currentChecks),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						// The app binding of the existing build check is kept, and the repeated build context is
+						// only set once.
+						"checks": []any{
+							map[string]any{"context": "build", "app_id": float64(15368)},
+							map[string]any{"context": "test"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, currentChecks),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+				"contexts":     []interface{}{"build", "test", "build"},
+				"branch":       "release",
+			},
+			expectedResults: []RequiredChecksDrift{
+				{
+					Repository: "octo-org/api",
+					Branch:     "release",
+					Current:    []string{"build", "legacy-ci"},
+					Missing:    []string{"test"},
+					Extra:      []string{"legacy-ci"},
+					Status:     RequiredChecksStatusUpdated,
+				},
+			},
+		},
+		{
+			name: "reports in sync, unprotected, and invalid repositories",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks", Method: http.MethodGet},
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/octo-org/web/branches/main/protection/required_status_checks" {
+							w.WriteHeader(http.StatusNotFound)
+							_, _ = w.Write([]byte(`{"message": "Branch not protected"}`))
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(currentChecks))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api", "octo-org/web", "not-a-repo"},
+				"contexts":     []interface{}{"legacy-ci", "build"},
+				"branch":       "main",
+			},
+			expectedResults: []RequiredChecksDrift{
+				{
+					Repository: "octo-org/api",
+					Branch:     "main",
+					Current:    []string{"build", "legacy-ci"},
+					Missing:    []string{},
+					Extra:      []string{},
+					Status:     RequiredChecksStatusInSync,
+				},
+				{
+					Repository: "octo-org/web",
+					Branch:     "main",
+					Current:    []string{},
+					Missing:    []string{"build", "legacy-ci"},
+					Extra:      []string{},
+					Status:     RequiredChecksStatusNotProtected,
+					Error:      "branch protection with required status checks is not enabled for this branch",
+				},
+				{
+					Repository: "not-a-repo",
+					Status:     RequiredChecksStatusError,
+					Error:      `invalid repository "not-a-repo": expected format 'owner/repo'`,
+				},
+			},
+		},
+		{
+			name:         "missing repositories",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"contexts": []interface{}{"build"},
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: repositories",
+		},
+		{
+			name:         "missing contexts",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: contexts",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SyncRequiredChecks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				DryRun  bool                  `json:"dry_run"`
+				Results []RequiredChecksDrift `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedResults, response.Results)
+		})
+	}
+}
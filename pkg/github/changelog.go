@@ -0,0 +1,421 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultChangelogPath is the default path of the changelog in a repository.
+const DefaultChangelogPath = "CHANGELOG.md"
+
+// changelogCategories are the Keep a Changelog change types, in the order they appear in a release section.
+var changelogCategories = []string{"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security"}
+
+// changelogHeader starts a new changelog.
+const changelogHeader = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+
+## [Unreleased]
+`
+
+var (
+	// changelogReleaseRe matches release headings such as '## [1.2.0] - 2024-05-06' or '## [Unreleased]'.
+	changelogReleaseRe = regexp.MustCompile(`^##\s+\[?([^\]\s]+)\]?`)
+	// changelogCategoryRe matches change type headings such as '### Added'.
+	changelogCategoryRe = regexp.MustCompile(`^###\s+(\S+)`)
+	// changelogLinkRe matches link reference definitions such as '[1.2.0]: https://github.com/owner/repo/compare/v1.1.0...v1.2.0'.
+	changelogLinkRe = regexp.MustCompile(`^\[([^\]]+)\]:\s*(\S+)`)
+	// changelogUnreleasedLinkRe matches the comparison link of the unreleased changes.
+	changelogUnreleasedLinkRe = regexp.MustCompile(`(?i)^\[unreleased\]:\s*(\S+/compare/)(\S+?)\.\.\.HEAD\s*$`)
+)
+
+// normalizeChangelogCategory returns the Keep a Changelog change type matching name, ignoring case.
+func normalizeChangelogCategory(name string) (string, bool) {
+	for _, category := range changelogCategories {
+		if strings.EqualFold(category, name) {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// parseChangelogChanges converts the changes parameter of a request to entries per change type.
+func parseChangelogChanges(request mcp.CallToolRequest) (map[string][]string, error) {
+	changes := map[string][]string{}
+	raw, ok := request.GetArguments()["changes"]
+	if !ok || raw == nil {
+		return changes, nil
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("changes must be an object of change types to lists of entries")
+	}
+	for name, value := range obj {
+		category, ok := normalizeChangelogCategory(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown change type %q: expected one of %s", name, strings.Join(changelogCategories, ", "))
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("changes.%s must be a list of strings", name)
+		}
+		for _, item := range items {
+			entry, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("changes.%s must be a list of strings", name)
+			}
+			if entry = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(entry), "- ")); entry != "" {
+				changes[category] = append(changes[category], entry)
+			}
+		}
+	}
+	return changes, nil
+}
+
+// parseChangelogEntries collects the entries per change type of the lines of a section. Continuation lines of an
+// entry are kept with it.
+func parseChangelogEntries(lines []string) map[string][]string {
+	entries := map[string][]string{}
+	category := ""
+	for _, line := range lines {
+		if m := changelogCategoryRe.FindStringSubmatch(line); m != nil {
+			category, _ = normalizeChangelogCategory(m[1])
+			if category == "" {
+				category = m[1]
+			}
+			continue
+		}
+		if category == "" || strings.TrimSpace(line) == "" {
+			continue
+		}
+		if entry, ok := strings.CutPrefix(line, "- "); ok {
+			entries[category] = append(entries[category], entry)
+		} else if n := len(entries[category]); n > 0 {
+			entries[category][n-1] += "\n" + line
+		}
+	}
+	return entries
+}
+
+// renderChangelogRelease renders a release section. Change types outside of Keep a Changelog are kept after the
+// standard ones.
+func renderChangelogRelease(version, date string, changes map[string][]string) []string {
+	lines := []string{fmt.Sprintf("## [%s] - %s", version, date), ""}
+	var extra []string
+	for category := range changes {
+		if _, ok := normalizeChangelogCategory(category); !ok {
+			extra = append(extra, category)
+		}
+	}
+	sort.Strings(extra)
+	for _, category := range append(append([]string{}, changelogCategories...), extra...) {
+		if len(changes[category]) == 0 {
+			continue
+		}
+		lines = append(lines, "### "+category, "")
+		for _, entry := range changes[category] {
+			lines = append(lines, "- "+entry)
+		}
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// insertChangelogRelease adds a release section to a Keep a Changelog file above the previous release, leaving the
+// rest of the file untouched. With releaseUnreleased, the entries under [Unreleased] are moved into the release. The
+// comparison links at the end of the file are updated if the file has them.
+func insertChangelogRelease(content, version, date string, changes map[string][]string, releaseUnreleased bool) (string, error) {
+	if strings.TrimSpace(content) == "" {
+		content = changelogHeader
+	}
+	lines := strings.Split(strings.TrimRight(content, "\n"), "\n")
+
+	unreleased, firstRelease := -1, -1
+	for i, line := range lines {
+		m := changelogReleaseRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if strings.EqualFold(m[1], "unreleased") {
+			if unreleased < 0 {
+				unreleased = i
+			}
+			continue
+		}
+		if strings.TrimPrefix(m[1], "v") == strings.TrimPrefix(version, "v") {
+			return "", fmt.Errorf("the changelog already has a section for %s", version)
+		}
+		if firstRelease < 0 {
+			firstRelease = i
+		}
+	}
+	linksStart := len(lines)
+	for linksStart > 0 && (changelogLinkRe.MatchString(lines[linksStart-1]) || strings.TrimSpace(lines[linksStart-1]) == "") {
+		linksStart--
+	}
+	insertAt := linksStart
+	if firstRelease >= 0 {
+		insertAt = firstRelease
+	}
+
+	head := lines[:insertAt]
+	release := map[string][]string{}
+	if releaseUnreleased && unreleased >= 0 && unreleased < insertAt {
+		end := insertAt
+		for i := unreleased + 1; i < insertAt; i++ {
+			if strings.HasPrefix(lines[i], "## ") {
+				end = i
+				break
+			}
+		}
+		release = parseChangelogEntries(lines[unreleased+1 : end])
+		head = append(append(append([]string{}, lines[:unreleased+1]...), ""), lines[end:insertAt]...)
+	}
+	total := 0
+	for category, entries := range changes {
+		release[category] = append(release[category], entries...)
+	}
+	for _, entries := range release {
+		total += len(entries)
+	}
+	if total == 0 {
+		return "", fmt.Errorf("no changes to release: pass changes, or set release_unreleased with entries under [Unreleased]")
+	}
+
+	var out []string
+	out = append(out, head...)
+	for len(out) > 0 && strings.TrimSpace(out[len(out)-1]) == "" {
+		out = out[:len(out)-1]
+	}
+	out = append(out, "")
+	out = append(out, renderChangelogRelease(version, date, release)...)
+
+	rest := lines[insertAt:]
+	for len(rest) > 0 && strings.TrimSpace(rest[0]) == "" {
+		rest = rest[1:]
+	}
+	for _, line := range rest {
+		m := changelogUnreleasedLinkRe.FindStringSubmatch(line)
+		if m == nil {
+			out = append(out, line)
+			continue
+		}
+		compareURL, previous := m[1], m[2]
+		tag := version
+		if strings.HasPrefix(previous, "v") && !strings.HasPrefix(version, "v") {
+			tag = "v" + version
+		}
+		out = append(out,
+			fmt.Sprintf("[Unreleased]: %s%s...HEAD", compareURL, tag),
+			fmt.Sprintf("[%s]: %s%s...%s", version, compareURL, previous, tag),
+		)
+	}
+	for len(out) > 0 && strings.TrimSpace(out[len(out)-1]) == "" {
+		out = out[:len(out)-1]
+	}
+	return strings.Join(out, "\n") + "\n", nil
+}
+
+// UpdateChangelog creates a tool to add a release section to a Keep a Changelog file through a pull request.
+func UpdateChangelog(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	entriesSchema := map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string"},
+	}
+	changesProperties := map[string]interface{}{}
+	for _, category := range changelogCategories {
+		changesProperties[category] = entriesSchema
+	}
+
+	return mcp.NewTool("update_changelog",
+			mcp.WithDescription(t("TOOL_UPDATE_CHANGELOG_DESCRIPTION", "Add a release section to a changelog in the Keep a Changelog format (https://keepachangelog.com). The section is inserted above the previous release, the entries under [Unreleased] can be moved into it, and the comparison links at the end of the file are updated. Existing content is preserved. The change is committed to a new branch and a pull request is opened. Use this instead of editing the changelog as a plain string.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_CHANGELOG_USER_TITLE", "Update changelog"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("version",
+				mcp.Required(),
+				mcp.Description("Version of the release, e.g. '1.2.0'"),
+			),
+			mcp.WithString("date",
+				mcp.Description("Release date as YYYY-MM-DD. Defaults to today"),
+			),
+			mcp.WithObject("changes",
+				mcp.Description("Entries of the release per change type, e.g. {\"Added\": [\"Support for X (#12)\"], \"Fixed\": [\"Crash on Y (#15)\"]}"),
+				mcp.Properties(changesProperties),
+				mcp.AdditionalProperties(false),
+			),
+			mcp.WithBoolean("release_unreleased",
+				mcp.Description("Move the entries under [Unreleased] into the release, before the entries in changes"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path of the changelog. It is created if it does not exist"),
+				mcp.DefaultString(DefaultChangelogPath),
+			),
+			mcp.WithString("base",
+				mcp.Description("Branch to update the changelog of and to open the pull request against. Defaults to the repository's default branch"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Name of the branch to create. Defaults to 'changelog-<version>'"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, return the updated changelog without creating a branch or pull request"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			version, err := RequiredParam[string](request, "version")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			date, err := OptionalParam[string](request, "date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if date == "" {
+				date = flags.now().Format(queryDateLayout)
+			} else if _, err := time.Parse(queryDateLayout, date); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid date %q: expected YYYY-MM-DD", date)), nil
+			}
+			changes, err := parseChangelogChanges(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			releaseUnreleased, err := OptionalBoolParamWithDefault(request, "release_unreleased", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if path == "" {
+				path = DefaultChangelogPath
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if branch == "" {
+				branch = "changelog-" + version
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if base == "" {
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get repository",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				base = repository.GetDefaultBranch()
+			}
+
+			var current string
+			file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: base})
+			switch {
+			case err == nil:
+				_ = resp.Body.Close()
+				if file == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("path %q is a directory", path)), nil
+				}
+				current, err = file.GetContent()
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode changelog: %w", err)
+				}
+			case resp != nil && resp.StatusCode == http.StatusNotFound:
+				// The changelog does not exist yet and will be created.
+			default:
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get changelog",
+					resp,
+					err,
+				), nil
+			}
+
+			updated, err := insertChangelogRelease(current, version, date, changes, releaseUnreleased)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var response any
+			if dryRun {
+				response = map[string]any{
+					"dry_run": true,
+					"path":    path,
+					"base":    base,
+					"content": updated,
+				}
+			} else {
+				message := fmt.Sprintf("Update changelog for %s", version)
+				result := propagateFileToRepository(ctx, client, owner+"/"+repo, filePropagation{
+					path:       path,
+					content:    updated,
+					message:    message,
+					branch:     branch,
+					baseBranch: base,
+					title:      message,
+					body:       fmt.Sprintf("Adds the release notes for %s to `%s`.", version, path),
+				})
+				if result.Status == FilePropagationStatusError {
+					return mcp.NewToolResultError(result.Error), nil
+				}
+				response = result
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
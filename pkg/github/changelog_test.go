@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChangelog = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Fixed
+
+- Crash when the config is empty
+  on first start (#14)
+
+### Notes
+
+- Docs moved to the wiki
+
+## [1.1.0] - 2024-04-01
+
+### Added
+
+- Initial release
+
+[Unreleased]: https://github.com/octo/repo/compare/v1.1.0...HEAD
+[1.1.0]: https://github.com/octo/repo/releases/tag/v1.1.0
+`
+
+func Test_InsertChangelogRelease(t *testing.T) {
+	t.Run("releases unreleased entries and updates links", func(t *testing.T) {
+		updated, err := insertChangelogRelease(testChangelog, "1.2.0", "2024-05-06", map[string][]string{
+			"Added": {"Dark mode (#12)"},
+			"Fixed": {"Typo in help (#15)"},
+		}, true)
+		require.NoError(t, err)
+		assert.Equal(t, `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+## [1.2.0] - 2024-05-06
+
+### Added
+
+- Dark mode (#12)
+
+### Fixed
+
+- Crash when the config is empty
+  on first start (#14)
+- Typo in help (#15)
+
+### Notes
+
+- Docs moved to the wiki
+
+## [1.1.0] - 2024-04-01
+
+### Added
+
+- Initial release
+
+[Unreleased]: https://github.com/octo/repo/compare/v1.2.0...HEAD
+[1.2.0]: https://github.com/octo/repo/compare/v1.1.0...v1.2.0
+[1.1.0]: https://github.com/octo/repo/releases/tag/v1.1.0
+`, updated)
+	})
+
+	t.Run("keeps unreleased entries", func(t *testing.T) {
+		updated, err := insertChangelogRelease(testChangelog, "1.2.0", "2024-05-06", map[string][]string{
+			"Security": {"Bump crypto library"},
+		}, false)
+		require.NoError(t, err)
+		assert.Contains(t, updated, "- Docs moved to the wiki\n\n## [1.2.0] - 2024-05-06\n\n### Security\n\n- Bump crypto library\n\n## [1.1.0] - 2024-04-01\n")
+	})
+
+	t.Run("creates a new changelog", func(t *testing.T) {
+		updated, err := insertChangelogRelease("", "0.1.0", "2024-05-06", map[string][]string{"Added": {"First version"}}, false)
+		require.NoError(t, err)
+		assert.Equal(t, changelogHeader+"\n## [0.1.0] - 2024-05-06\n\n### Added\n\n- First version\n", updated)
+	})
+
+	t.Run("version already released", func(t *testing.T) {
+		_, err := insertChangelogRelease(testChangelog, "v1.1.0", "2024-05-06", map[string][]string{"Added": {"Again"}}, false)
+		require.EqualError(t, err, "the changelog already has a section for v1.1.0")
+	})
+
+	t.Run("nothing to release", func(t *testing.T) {
+		_, err := insertChangelogRelease(changelogHeader, "0.1.0", "2024-05-06", nil, true)
+		require.ErrorContains(t, err, "no changes to release")
+	})
+}
+
+func Test_UpdateChangelog(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateChangelog(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_changelog", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "version"})
+
+	changelogFile := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(testChangelog))),
+		SHA:      github.Ptr("file-sha"),
+	}
+	getChangelog := mock.WithRequestMatchHandler(
+		mock.GetReposContentsByOwnerByRepoByPath,
+		expectQueryParams(t, map[string]string{"ref": "main"}).andThen(
+			mockResponse(t, http.StatusOK, changelogFile),
+		),
+	)
+	args := func(extra map[string]interface{}) map[string]interface{} {
+		result := map[string]interface{}{
+			"owner":              "octo",
+			"repo":               "repo",
+			"version":            "1.2.0",
+			"date":               "2024-05-06",
+			"release_unreleased": true,
+		}
+		for k, v := range extra {
+			result[k] = v
+		}
+		return result
+	}
+
+	t.Run("dry run returns the updated changelog", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{DefaultBranch: github.Ptr("main")}),
+			getChangelog,
+		))
+		_, handler := UpdateChangelog(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{"dry_run": true})))
+		require.NoError(t, err)
+
+		var response struct {
+			Content string `json:"content"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Contains(t, response.Content, "## [Unreleased]\n\n## [1.2.0] - 2024-05-06\n\n### Fixed\n")
+	})
+
+	t.Run("opens a pull request", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			getChangelog,
+			mock.WithRequestMatch(mock.GetReposGitRefByOwnerByRepoByRef, &github.Reference{Object: &github.GitObject{SHA: github.Ptr("abc123")}}),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitRefsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"ref": "refs/heads/changelog-1.2.0", "sha": "abc123"}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Reference{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposContentsByOwnerByRepoByPath,
+				expectPath(t, "/repos/octo/repo/contents/CHANGELOG.md").andThen(
+					mockResponse(t, http.StatusOK, &github.RepositoryContentResponse{}),
+				),
+			),
+			mock.WithRequestMatch(mock.PostReposPullsByOwnerByRepo, &github.PullRequest{
+				Number:  github.Ptr(7),
+				HTMLURL: github.Ptr("https://github.com/octo/repo/pull/7"),
+			}),
+		))
+		_, handler := UpdateChangelog(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{"base": "main"})))
+		require.NoError(t, err)
+
+		var response FilePropagationResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, FilePropagationStatusPullRequestCreated, response.Status)
+		assert.Equal(t, "changelog-1.2.0", response.Branch)
+		assert.Equal(t, "updated", response.Action)
+		assert.Equal(t, 7, response.PullRequest)
+	})
+
+	t.Run("invalid change type", func(t *testing.T) {
+		_, handler := UpdateChangelog(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper, FeatureFlags{})
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{
+			"changes": map[string]interface{}{"Improved": []interface{}{"Speed"}},
+		})))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `unknown change type "Improved"`)
+	})
+}
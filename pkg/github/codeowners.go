@@ -0,0 +1,331 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	CodeownerStatusApproved         = "approved"
+	CodeownerStatusChangesRequested = "changes_requested"
+	CodeownerStatusCommented        = "commented"
+	CodeownerStatusReviewRequested  = "review_requested"
+	CodeownerStatusNotRequested     = "not_requested"
+	CodeownerStatusUnknown          = "unknown"
+)
+
+// codeownersPaths are the locations GitHub looks for a CODEOWNERS file, in order of precedence.
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeownersRule is a line of a CODEOWNERS file. A rule without owners makes matching paths unowned.
+type CodeownersRule struct {
+	Pattern string
+	Owners  []string
+}
+
+// CodeownerCoverage is the review status of a code owner of files changed by a pull request.
+type CodeownerCoverage struct {
+	Owner      string   `json:"owner"`
+	Paths      []string `json:"paths"`
+	Status     string   `json:"status"`
+	ReviewedBy []string `json:"reviewed_by,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// CodeownersCoverage is the code owner review coverage of a pull request.
+type CodeownersCoverage struct {
+	PullRequest    int                 `json:"pull_request"`
+	CodeownersPath string              `json:"codeowners_path"`
+	ChangedPaths   int                 `json:"changed_paths"`
+	UnownedPaths   []string            `json:"unowned_paths"`
+	Owners         []CodeownerCoverage `json:"owners"`
+}
+
+// parseCodeowners parses the rules of a CODEOWNERS file, skipping comments and blank lines.
+func parseCodeowners(content string) []CodeownersRule {
+	var rules []CodeownersRule
+	for _, line := range strings.Split(content, "\n") {
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		rules = append(rules, CodeownersRule{Pattern: fields[0], Owners: fields[1:]})
+	}
+	return rules
+}
+
+// matchCodeownersPattern reports whether a CODEOWNERS pattern matches a file path. Patterns follow gitignore rules: a
+// pattern containing a slash, other than a trailing one, is relative to the root of the repository, and a pattern
+// matching a directory matches every file under it. As on GitHub, a pattern ending in '/*' only matches the files
+// directly in the directory.
+func matchCodeownersPattern(pattern, filePath string) bool {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return false
+	}
+	segments := strings.Split(trimmed, "/")
+	if !strings.HasPrefix(pattern, "/") && len(segments) == 1 {
+		segments = append([]string{"**"}, segments...)
+	}
+	pathSegments := strings.Split(strings.Trim(filePath, "/"), "/")
+
+	// The pattern may match the file itself or any directory containing it.
+	for i := len(pathSegments); i >= 1; i-- {
+		isFile := i == len(pathSegments)
+		if (isFile && dirOnly) || (!isFile && strings.HasSuffix(trimmed, "/*")) {
+			continue
+		}
+		if matchGlobSegments(segments, pathSegments[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeownersFor returns the owners of a file path. As on GitHub, the last matching rule takes precedence.
+func codeownersFor(rules []CodeownersRule, filePath string) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if matchCodeownersPattern(rules[i].Pattern, filePath) {
+			return rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// getCodeowners returns the content and path of the CODEOWNERS file of a repository at a ref. The path is empty if
+// the repository has no CODEOWNERS file.
+func getCodeowners(ctx context.Context, client *github.Client, owner, repo, ref string) (string, string, *github.Response, error) {
+	for _, p := range codeownersPaths {
+		file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, p, &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return "", "", resp, err
+		}
+		_ = resp.Body.Close()
+		if file == nil {
+			continue
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return "", "", nil, fmt.Errorf("failed to decode %s: %w", p, err)
+		}
+		return content, p, resp, nil
+	}
+	return "", "", nil, nil
+}
+
+// latestReviewStates returns the latest review state of every reviewer of a pull request, keyed by lowercase login.
+// Pending and dismissed reviews do not count as reviews.
+func latestReviewStates(ctx context.Context, client *github.Client, owner, repo string, number int) (map[string]string, *github.Response, error) {
+	states := map[string]string{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, review := range reviews {
+			login := strings.ToLower(review.GetUser().GetLogin())
+			switch review.GetState() {
+			case "APPROVED", "CHANGES_REQUESTED":
+				states[login] = strings.ToLower(review.GetState())
+			case "COMMENTED":
+				// A comment does not replace an earlier approval or request for changes.
+				if _, ok := states[login]; !ok {
+					states[login] = CodeownerStatusCommented
+				}
+			case "DISMISSED":
+				delete(states, login)
+			}
+		}
+		if resp.NextPage == 0 {
+			return states, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// strongestReviewStatus returns the review status that best represents a set of reviews of a team.
+func strongestReviewStatus(statuses []string) string {
+	for _, status := range []string{CodeownerStatusChangesRequested, CodeownerStatusApproved, CodeownerStatusCommented} {
+		for _, s := range statuses {
+			if s == status {
+				return status
+			}
+		}
+	}
+	return ""
+}
+
+// CodeownersCoverageReport creates a tool to report which code owners of the files changed by a pull request have
+// reviewed it, and which files have no code owner.
+func CodeownersCoverageReport(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("codeowners_coverage_report",
+			mcp.WithDescription(t("TOOL_CODEOWNERS_COVERAGE_REPORT_DESCRIPTION", "Compare the files changed by a pull request against the CODEOWNERS file of its base branch. Reports the changed paths without a code owner and, for every code owner, the paths they own and whether they approved, requested changes, commented, have a pending review request or were not requested yet. Use this to decide whom to ask or remind for a review.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CODEOWNERS_COVERAGE_REPORT_USER_TITLE", "CODEOWNERS coverage report"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[CodeownersCoverage](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			content, codeownersPath, resp, err := getCodeowners(ctx, client, owner, repo, pr.GetBase().GetRef())
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get CODEOWNERS",
+					resp,
+					err,
+				), nil
+			}
+			rules := parseCodeowners(content)
+
+			paths, resp, err := pullRequestFilesCache.Paths(ctx, client, owner, repo, pr)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request files",
+					resp,
+					err,
+				), nil
+			}
+
+			unowned := []string{}
+			owned := map[string][]string{}
+			var owners []string
+			for _, p := range paths {
+				pathOwners := codeownersFor(rules, p)
+				if len(pathOwners) == 0 {
+					unowned = append(unowned, p)
+					continue
+				}
+				for _, o := range pathOwners {
+					if _, ok := owned[o]; !ok {
+						owners = append(owners, o)
+					}
+					owned[o] = append(owned[o], p)
+				}
+			}
+
+			states, resp, err := latestReviewStates(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list reviews",
+					resp,
+					err,
+				), nil
+			}
+			requested := map[string]bool{}
+			for _, user := range pr.RequestedReviewers {
+				requested["@"+strings.ToLower(user.GetLogin())] = true
+			}
+			for _, team := range pr.RequestedTeams {
+				requested["@"+strings.ToLower(owner+"/"+team.GetSlug())] = true
+			}
+
+			coverage := make([]CodeownerCoverage, 0, len(owners))
+			for _, o := range owners {
+				c := CodeownerCoverage{Owner: o, Paths: owned[o]}
+				login := strings.ToLower(strings.TrimPrefix(o, "@"))
+				switch {
+				case !strings.HasPrefix(o, "@"):
+					// Owners given by email cannot be matched to reviewers.
+					c.Status = CodeownerStatusUnknown
+				case strings.Contains(login, "/"):
+					org, slug, _ := strings.Cut(login, "/")
+					members, resp, err := listCandidateAssignees(ctx, client, org, "", slug)
+					if err != nil {
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to list team members", resp, err)
+						c.Status = CodeownerStatusUnknown
+						c.Error = fmt.Sprintf("failed to list members of %s: %s", o, err)
+						break
+					}
+					var memberStates []string
+					for _, member := range members {
+						if state, ok := states[strings.ToLower(member)]; ok {
+							memberStates = append(memberStates, state)
+							c.ReviewedBy = append(c.ReviewedBy, member)
+						}
+					}
+					c.Status = strongestReviewStatus(memberStates)
+				default:
+					c.Status = states[login]
+					if c.Status != "" {
+						c.ReviewedBy = []string{strings.TrimPrefix(o, "@")}
+					}
+				}
+				if c.Status == "" {
+					c.Status = CodeownerStatusNotRequested
+					if requested[strings.ToLower(o)] {
+						c.Status = CodeownerStatusReviewRequested
+					}
+				}
+				coverage = append(coverage, c)
+			}
+			sort.SliceStable(coverage, func(i, j int) bool {
+				return coverage[i].Owner < coverage[j].Owner
+			})
+
+			return MarshalledStructuredResult(CodeownersCoverage{
+				PullRequest:    pullNumber,
+				CodeownersPath: codeownersPath,
+				ChangedPaths:   len(paths),
+				UnownedPaths:   unowned,
+				Owners:         coverage,
+			}), nil
+		}
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchCodeownersPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"*", "any/file.txt", true},
+		{"*.js", "src/app/index.js", true},
+		{"*.js", "src/app/index.ts", false},
+		{"/build/logs/", "build/logs/today.log", true},
+		{"/build/logs/", "src/build/logs/today.log", false},
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		{"apps/", "services/apps/main.go", true},
+		{"apps/", "apps", false},
+		{"/scripts", "scripts/deploy/run.sh", true},
+		{"**/logs", "deep/nested/logs/app.log", true},
+		{"services/api/**", "services/api/handlers/users.go", true},
+		{"services/api/**", "services/web/index.ts", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchCodeownersPattern(tc.pattern, tc.path))
+		})
+	}
+}
+
+func Test_CodeownersFor(t *testing.T) {
+	rules := parseCodeowners(`# Default owners
+*       @octo/maintainers
+
+*.go    @gopher # Go code
+/docs/  docs@example.com
+/docs/generated/
+`)
+	assert.Equal(t, []string{"@octo/maintainers"}, codeownersFor(rules, "README.md"))
+	assert.Equal(t, []string{"@gopher"}, codeownersFor(rules, "cmd/main.go"))
+	assert.Equal(t, []string{"docs@example.com"}, codeownersFor(rules, "docs/index.md"))
+	assert.Empty(t, codeownersFor(rules, "docs/generated/api.md"))
+}
+
+func Test_CodeownersCoverageReport(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CodeownersCoverageReport(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "codeowners_coverage_report", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	codeowners := "*.go @gopher\n/api/ @octo/api-team\n/web/ @frontend\n/vendor/\n"
+	pr := &github.PullRequest{
+		Number:             github.Ptr(5),
+		Base:               &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Head:               &github.PullRequestBranch{SHA: github.Ptr("codeowners-report-head")},
+		RequestedReviewers: []*github.User{{Login: github.Ptr("frontend")}},
+	}
+	files := []*github.CommitFile{
+		{Filename: github.Ptr("api/server.go")},
+		{Filename: github.Ptr("web/app.ts")},
+		{Filename: github.Ptr("vendor/lib.c")},
+		{Filename: github.Ptr("Makefile")},
+		{Filename: github.Ptr("cmd/main.go")},
+	}
+	reviews := []*github.PullRequestReview{
+		{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("APPROVED")},
+		{User: &github.User{Login: github.Ptr("gopher")}, State: github.Ptr("COMMENTED")},
+		{User: &github.User{Login: github.Ptr("gopher")}, State: github.Ptr("DISMISSED")},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/repos/octo/repo/contents/CODEOWNERS" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Type:     github.Ptr("file"),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(codeowners))),
+				})(w, r)
+			}),
+		),
+		mock.WithRequestMatch(mock.GetReposPullsFilesByOwnerByRepoByPullNumber, files),
+		mock.WithRequestMatch(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, reviews),
+		mock.WithRequestMatch(mock.GetOrgsTeamsMembersByOrgByTeamSlug, []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}}),
+	))
+	_, handler := CodeownersCoverageReport(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "octo",
+		"repo":       "repo",
+		"pullNumber": float64(5),
+	}))
+	require.NoError(t, err)
+
+	var response struct {
+		CodeownersPath string              `json:"codeowners_path"`
+		UnownedPaths   []string            `json:"unowned_paths"`
+		Owners         []CodeownerCoverage `json:"owners"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, "CODEOWNERS", response.CodeownersPath)
+	assert.Equal(t, []string{"vendor/lib.c", "Makefile"}, response.UnownedPaths)
+	assert.Equal(t, []CodeownerCoverage{
+		{Owner: "@frontend", Paths: []string{"web/app.ts"}, Status: CodeownerStatusReviewRequested},
+		{Owner: "@gopher", Paths: []string{"cmd/main.go"}, Status: CodeownerStatusNotRequested},
+		{Owner: "@octo/api-team", Paths: []string{"api/server.go"}, Status: CodeownerStatusApproved, ReviewedBy: []string{"alice"}},
+	}, response.Owners)
+}
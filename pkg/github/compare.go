@@ -0,0 +1,193 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// CompareModeThreeDot compares the head with the merge base of the refs: the changes of head since it diverged.
+	CompareModeThreeDot = "three-dot"
+	// CompareModeTwoDot compares the head with the base directly.
+	CompareModeTwoDot = "two-dot"
+)
+
+// RefComparisonFile is a file changed between two refs.
+type RefComparisonFile struct {
+	MinimalCommitFile
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Patch            string `json:"patch,omitempty"`
+}
+
+// RefComparison is the difference between two refs of a repository.
+type RefComparison struct {
+	Base string `json:"base"`
+	Head string `json:"head"`
+	Mode string `json:"mode"`
+	// Status is ahead, behind, diverged or identical: the position of head relative to base.
+	Status       string `json:"status"`
+	AheadBy      int    `json:"ahead_by"`
+	BehindBy     int    `json:"behind_by"`
+	TotalCommits int    `json:"total_commits"`
+	MergeBaseSHA string `json:"merge_base_sha,omitempty"`
+	// Commits are the commits of the requested page, oldest first.
+	Commits []MinimalCommit     `json:"commits"`
+	Files   []RefComparisonFile `json:"files,omitempty"`
+}
+
+// compareRefs compares two refs of a repository, directly for two-dot comparisons or from their merge base for
+// three-dot comparisons.
+func compareRefs(ctx context.Context, client *github.Client, owner, repo, base, head, mode string, opts *github.ListOptions) (*github.CommitsComparison, *github.Response, error) {
+	if mode != CompareModeTwoDot {
+		return client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+	}
+
+	// go-github only supports three-dot comparisons.
+	u := fmt.Sprintf("repos/%s/%s/compare/%s..%s?page=%d&per_page=%d", owner, repo, url.QueryEscape(base), url.QueryEscape(head), opts.Page, opts.PerPage)
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	comparison := new(github.CommitsComparison)
+	resp, err := client.Do(ctx, req, comparison)
+	if err != nil {
+		return nil, resp, err
+	}
+	return comparison, resp, nil
+}
+
+// CompareRefs creates a tool to compare two refs of a repository.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two branches, tags or commits of a repository: how many commits head is ahead of and behind base, the commits in head but not in base, and optionally the changed files with their diffs. Answers questions such as \"what is in main but not in release/1.2?\" with base 'release/1.2' and head 'main'.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[RefComparison](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch name, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch name, tag or commit SHA to compare to"),
+			),
+			mcp.WithString("mode",
+				mcp.Description(`How the files are compared
+Options are:
+- 'three-dot' - the changes of head since its merge base with base, as in a pull request.
+- 'two-dot' - the differences between base and head directly.
+`),
+				mcp.Enum(CompareModeThreeDot, CompareModeTwoDot),
+				mcp.DefaultString(CompareModeThreeDot),
+			),
+			mcp.WithBoolean("include_files",
+				mcp.Description("Include the changed files and their diffs"),
+				mcp.DefaultBool(false),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mode, err := OptionalParam[string](request, "mode")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if mode == "" {
+				mode = CompareModeThreeDot
+			}
+			if mode != CompareModeThreeDot && mode != CompareModeTwoDot {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown mode: %s", mode)), nil
+			}
+			includeFiles, err := OptionalBoolParamWithDefault(request, "include_files", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comparison, resp, err := compareRefs(ctx, client, owner, repo, base, head, mode, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s with %s", base, head),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := RefComparison{
+				Base:         base,
+				Head:         head,
+				Mode:         mode,
+				Status:       comparison.GetStatus(),
+				AheadBy:      comparison.GetAheadBy(),
+				BehindBy:     comparison.GetBehindBy(),
+				TotalCommits: comparison.GetTotalCommits(),
+				MergeBaseSHA: comparison.GetMergeBaseCommit().GetSHA(),
+				Commits:      make([]MinimalCommit, 0, len(comparison.Commits)),
+			}
+			for _, commit := range comparison.Commits {
+				result.Commits = append(result.Commits, convertToMinimalCommit(commit, false))
+			}
+			if includeFiles {
+				result.Files = make([]RefComparisonFile, 0, len(comparison.Files))
+				for _, file := range comparison.Files {
+					result.Files = append(result.Files, RefComparisonFile{
+						MinimalCommitFile: MinimalCommitFile{
+							Filename:  file.GetFilename(),
+							Status:    file.GetStatus(),
+							Additions: file.GetAdditions(),
+							Deletions: file.GetDeletions(),
+							Changes:   file.GetChanges(),
+						},
+						PreviousFilename: file.GetPreviousFilename(),
+						Patch:            file.GetPatch(),
+					})
+				}
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
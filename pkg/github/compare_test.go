@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareRefs(t *testing.T) {
+	tool, _ := CompareRefs(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	comparison := &github.CommitsComparison{
+		Status:          github.Ptr("diverged"),
+		AheadBy:         github.Ptr(2),
+		BehindBy:        github.Ptr(1),
+		TotalCommits:    github.Ptr(2),
+		MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("base123")},
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.Ptr("abc123"), Commit: &github.Commit{Message: github.Ptr("Add feature")}},
+			{SHA: github.Ptr("def456"), Commit: &github.Commit{Message: github.Ptr("Fix bug")}},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("main.go"), Status: github.Ptr("modified"), Additions: github.Ptr(3), Deletions: github.Ptr(1), Changes: github.Ptr(4), Patch: github.Ptr("@@ -1 +1 @@")},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		expectedPath string
+		expectFiles  bool
+	}{
+		{
+			name:         "three-dot comparison",
+			args:         map[string]any{},
+			expectedPath: "/repos/octo/api/compare/release-1.2...main",
+		},
+		{
+			name:         "two-dot comparison with files",
+			args:         map[string]any{"mode": "two-dot", "include_files": true},
+			expectedPath: "/repos/octo/api/compare/release-1.2..main",
+			expectFiles:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, tc.expectedPath).andThen(
+						mockResponse(t, http.StatusOK, comparison),
+					),
+				),
+			))
+			_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+			args := map[string]any{"owner": "octo", "repo": "api", "base": "release-1.2", "head": "main"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response RefComparison
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "diverged", response.Status)
+			assert.Equal(t, 2, response.AheadBy)
+			assert.Equal(t, 1, response.BehindBy)
+			assert.Equal(t, "base123", response.MergeBaseSHA)
+			require.Len(t, response.Commits, 2)
+			assert.Equal(t, "abc123", response.Commits[0].SHA)
+			if !tc.expectFiles {
+				assert.Empty(t, response.Files)
+				return
+			}
+			assert.Equal(t, []RefComparisonFile{{
+				MinimalCommitFile: MinimalCommitFile{Filename: "main.go", Status: "modified", Additions: 3, Deletions: 1, Changes: 4},
+				Patch:             "@@ -1 +1 @@",
+			}}, response.Files)
+		})
+	}
+
+	t.Run("unknown ref", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposCompareByOwnerByRepoByBasehead,
+				mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			),
+		))
+		_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "octo", "repo": "api", "base": "nope", "head": "main"}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to compare nope with main")
+	})
+}
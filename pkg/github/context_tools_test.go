@@ -139,6 +139,9 @@ func Test_GetMe(t *testing.T) {
 			assert.Equal(t, *tc.expectedUser.Location, returnedUser.Details.Location)
 			assert.Equal(t, *tc.expectedUser.Hireable, returnedUser.Details.Hireable)
 			assert.Equal(t, *tc.expectedUser.TwitterUsername, returnedUser.Details.TwitterUsername)
+
+			// Verify the structured content matches the text content
+			assert.Equal(t, returnedUser, result.StructuredContent)
 		})
 	}
 }
@@ -350,6 +353,8 @@ func Test_GetTeams(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Len(t, organizations, tc.expectedTeamsCount)
+			require.IsType(t, ListResult[OrganizationTeams]{}, result.StructuredContent)
+			assert.Len(t, result.StructuredContent.(ListResult[OrganizationTeams]).Items, tc.expectedTeamsCount)
 
 			if tc.expectedTeamsCount > 0 {
 				assert.Equal(t, "testorg1", organizations[0].Org)
@@ -486,6 +491,8 @@ func Test_GetTeamMembers(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Len(t, members, tc.expectedMembersCount)
+			require.IsType(t, ListResult[string]{}, result.StructuredContent)
+			assert.Len(t, result.StructuredContent.(ListResult[string]).Items, tc.expectedMembersCount)
 
 			if tc.expectedMembersCount > 0 {
 				assert.Equal(t, "user1", members[0])
@@ -0,0 +1,204 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ContributionCounts are the numbers of contributions of a user by kind.
+type ContributionCounts struct {
+	Commits      int `json:"commits"`
+	PullRequests int `json:"pull_requests"`
+	Reviews      int `json:"reviews"`
+	Issues       int `json:"issues"`
+}
+
+func (c ContributionCounts) total() int {
+	return c.Commits + c.PullRequests + c.Reviews + c.Issues
+}
+
+// RepositoryContributions are the contributions of a user to a repository.
+type RepositoryContributions struct {
+	Repository string `json:"repository"`
+	ContributionCounts
+}
+
+// UserContributionReport is a summary of the contributions of a user over a date range.
+type UserContributionReport struct {
+	User  string             `json:"user"`
+	Since string             `json:"since"`
+	Until string             `json:"until"`
+	Total ContributionCounts `json:"total"`
+	// RestrictedContributions are contributions to private repositories the token cannot see, which are only counted.
+	RestrictedContributions int                       `json:"restricted_contributions,omitempty"`
+	Repositories            []RepositoryContributions `json:"repositories"`
+}
+
+type contributionsByRepository []struct {
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+	Contributions struct {
+		TotalCount githubv4.Int
+	}
+}
+
+// userContributionsQuery selects the contributions collection of a user between $from and $to.
+type userContributionsQuery struct {
+	User *struct {
+		Login                   githubv4.String
+		ContributionsCollection struct {
+			TotalCommitContributions                   githubv4.Int
+			TotalPullRequestContributions              githubv4.Int
+			TotalPullRequestReviewContributions        githubv4.Int
+			TotalIssueContributions                    githubv4.Int
+			RestrictedContributionsCount               githubv4.Int
+			CommitContributionsByRepository            contributionsByRepository `graphql:"commitContributionsByRepository(maxRepositories: 100)"`
+			PullRequestContributionsByRepository       contributionsByRepository `graphql:"pullRequestContributionsByRepository(maxRepositories: 100)"`
+			PullRequestReviewContributionsByRepository contributionsByRepository `graphql:"pullRequestReviewContributionsByRepository(maxRepositories: 100)"`
+			IssueContributionsByRepository             contributionsByRepository `graphql:"issueContributionsByRepository(maxRepositories: 100)"`
+		} `graphql:"contributionsCollection(from: $from, to: $to)"`
+	} `graphql:"user(login: $login)"`
+}
+
+// GetUserContributionReport creates a tool to summarize the contributions of a user over a date range by repository.
+func GetUserContributionReport(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_user_contribution_report",
+			mcp.WithDescription(t("TOOL_GET_USER_CONTRIBUTION_REPORT_DESCRIPTION", "Summarize the contributions of a user over a date range, by default last month: the commits, pull requests, pull request reviews and issues they authored, in total and per repository, most active repository first. Use this for performance reviews or to recognize community contributors. The range must not exceed one year.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_USER_CONTRIBUTION_REPORT_USER_TITLE", "Get user contribution report"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[UserContributionReport](),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("GitHub username of the user"),
+			),
+			mcp.WithString("since",
+				mcp.Description("First day of the report, as YYYY-MM-DD or an expression such as 'last month' or '2024-W05'. Defaults to the first day of last month, or to 29 days before until"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Last day of the report, as YYYY-MM-DD or an expression such as 'yesterday'. Defaults to the last day of since if it is an expression such as 'last month', or today"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceParam, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			untilParam, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			now := flags.now()
+			if sinceParam == "" && untilParam == "" {
+				sinceParam = "last month"
+			}
+			until := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			var since time.Time
+			if sinceParam != "" {
+				var last time.Time
+				since, last, err = parseDigestDate(sinceParam, now)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("since: %s", err)), nil
+				}
+				if !last.Equal(since) {
+					until = last
+				}
+			}
+			if untilParam != "" {
+				_, until, err = parseDigestDate(untilParam, now)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("until: %s", err)), nil
+				}
+			}
+			if sinceParam == "" {
+				since = until.AddDate(0, 0, -29)
+			}
+			if until.Before(since) {
+				return mcp.NewToolResultError("until must not be before since"), nil
+			}
+			// The end of the range is inclusive, so contributions at any time on the last day count.
+			end := until.AddDate(0, 0, 1).Add(-time.Second)
+			if end.Sub(since) > 366*24*time.Hour {
+				return mcp.NewToolResultError("the date range must not exceed one year"), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query userContributionsQuery
+			if err := gqlClient.Query(ctx, &query, map[string]any{
+				"login": githubv4.String(username),
+				"from":  githubv4.DateTime{Time: since},
+				"to":    githubv4.DateTime{Time: end},
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get contributions",
+					err,
+				), nil
+			}
+			if query.User == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("user %s not found", username)), nil
+			}
+
+			collection := query.User.ContributionsCollection
+			byRepository := map[string]*RepositoryContributions{}
+			add := func(contributions contributionsByRepository, count func(*ContributionCounts) *int) {
+				for _, c := range contributions {
+					name := string(c.Repository.NameWithOwner)
+					repository, ok := byRepository[name]
+					if !ok {
+						repository = &RepositoryContributions{Repository: name}
+						byRepository[name] = repository
+					}
+					*count(&repository.ContributionCounts) += int(c.Contributions.TotalCount)
+				}
+			}
+			add(collection.CommitContributionsByRepository, func(c *ContributionCounts) *int { return &c.Commits })
+			add(collection.PullRequestContributionsByRepository, func(c *ContributionCounts) *int { return &c.PullRequests })
+			add(collection.PullRequestReviewContributionsByRepository, func(c *ContributionCounts) *int { return &c.Reviews })
+			add(collection.IssueContributionsByRepository, func(c *ContributionCounts) *int { return &c.Issues })
+
+			report := UserContributionReport{
+				User:  string(query.User.Login),
+				Since: since.Format(queryDateLayout),
+				Until: until.Format(queryDateLayout),
+				Total: ContributionCounts{
+					Commits:      int(collection.TotalCommitContributions),
+					PullRequests: int(collection.TotalPullRequestContributions),
+					Reviews:      int(collection.TotalPullRequestReviewContributions),
+					Issues:       int(collection.TotalIssueContributions),
+				},
+				RestrictedContributions: int(collection.RestrictedContributionsCount),
+				Repositories:            []RepositoryContributions{},
+			}
+			for _, repository := range byRepository {
+				report.Repositories = append(report.Repositories, *repository)
+			}
+			sort.Slice(report.Repositories, func(i, j int) bool {
+				a, b := report.Repositories[i], report.Repositories[j]
+				if a.total() != b.total() {
+					return a.total() > b.total()
+				}
+				return a.Repository < b.Repository
+			})
+
+			return MarshalledStructuredResult(report), nil
+		}
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetUserContributionReport(t *testing.T) {
+	tool, _ := GetUserContributionReport(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_user_contribution_report", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	byRepository := func(counts map[string]int) []any {
+		var nodes []any
+		for name, count := range counts {
+			nodes = append(nodes, map[string]any{
+				"repository":    map[string]any{"nameWithOwner": name},
+				"contributions": map[string]any{"totalCount": count},
+			})
+		}
+		return nodes
+	}
+	matcher := func(login string, user any) githubv4mock.Matcher {
+		m := githubv4mock.NewQueryMatcher(
+			userContributionsQuery{},
+			map[string]any{
+				"login": githubv4.String(login),
+				"from":  githubv4.DateTime{Time: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)},
+				"to":    githubv4.DateTime{Time: time.Date(2024, time.May, 31, 23, 59, 59, 0, time.UTC)},
+			},
+			githubv4mock.DataResponse(map[string]any{"user": user}),
+		)
+		// The dates are sent as strings, and the whole last day is covered.
+		m.Variables = map[string]any{"login": login, "from": "2024-05-01T00:00:00Z", "to": "2024-05-31T23:59:59Z"}
+		return m
+	}
+
+	t.Run("report", func(t *testing.T) {
+		gqlClient := githubv4mock.NewMockedHTTPClient(matcher("octocat", map[string]any{
+			"login": "octocat",
+			"contributionsCollection": map[string]any{
+				"totalCommitContributions":                   12,
+				"totalPullRequestContributions":              3,
+				"totalPullRequestReviewContributions":        5,
+				"totalIssueContributions":                    1,
+				"restrictedContributionsCount":               4,
+				"commitContributionsByRepository":            byRepository(map[string]int{"octo/api": 10, "octo/web": 2}),
+				"pullRequestContributionsByRepository":       byRepository(map[string]int{"octo/web": 3}),
+				"pullRequestReviewContributionsByRepository": byRepository(map[string]int{"octo/docs": 5}),
+				"issueContributionsByRepository":             byRepository(map[string]int{"octo/docs": 1}),
+			},
+		}))
+		_, handler := GetUserContributionReport(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"username": "octocat",
+			"since":    "2024-05-01",
+			"until":    "2024-05-31",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var report UserContributionReport
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &report))
+		assert.Equal(t, UserContributionReport{
+			User:                    "octocat",
+			Since:                   "2024-05-01",
+			Until:                   "2024-05-31",
+			Total:                   ContributionCounts{Commits: 12, PullRequests: 3, Reviews: 5, Issues: 1},
+			RestrictedContributions: 4,
+			Repositories: []RepositoryContributions{
+				{Repository: "octo/api", ContributionCounts: ContributionCounts{Commits: 10}},
+				{Repository: "octo/docs", ContributionCounts: ContributionCounts{Reviews: 5, Issues: 1}},
+				{Repository: "octo/web", ContributionCounts: ContributionCounts{Commits: 2, PullRequests: 3}},
+			},
+		}, report)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		gqlClient := githubv4mock.NewMockedHTTPClient(matcher("ghost", nil))
+		_, handler := GetUserContributionReport(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"username": "ghost",
+			"since":    "2024-05-01",
+			"until":    "2024-05-31",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "user ghost not found")
+	})
+
+	t.Run("range over a year", func(t *testing.T) {
+		_, handler := GetUserContributionReport(stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"username": "octocat",
+			"since":    "2022-01-01",
+			"until":    "2024-01-01",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "must not exceed one year")
+	})
+}
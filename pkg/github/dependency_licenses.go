@@ -0,0 +1,215 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// LicenseViolation is a dependency whose license is not allowed.
+type LicenseViolation struct {
+	Package   string `json:"package"`
+	Ecosystem string `json:"ecosystem,omitempty"`
+	Version   string `json:"version,omitempty"`
+	// License is the SPDX license expression of the package, or NOASSERTION when it is unknown.
+	License string `json:"license"`
+	// Manifests are the manifests of the repository that depend on the package, when the dependency graph knows them.
+	Manifests []string `json:"manifests,omitempty"`
+}
+
+// DependencyLicensesResult is the result of checking the licenses of the dependencies of a repository.
+type DependencyLicensesResult struct {
+	Repository      string             `json:"repository"`
+	AllowedLicenses []string           `json:"allowed_licenses"`
+	Packages        int                `json:"packages"`
+	Violations      []LicenseViolation `json:"violations"`
+}
+
+// dependencyManifestsQuery selects the packages each manifest of a repository depends on.
+type dependencyManifestsQuery struct {
+	Repository struct {
+		DependencyGraphManifests struct {
+			Nodes []struct {
+				Filename     githubv4.String
+				Dependencies struct {
+					Nodes []struct {
+						PackageName githubv4.String
+					}
+				} `graphql:"dependencies(first: 100)"`
+			}
+		} `graphql:"dependencyGraphManifests(first: 100)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// licenseAllowed reports whether an SPDX license expression is satisfied by the allowed licenses: any alternative of an
+// OR expression must have all the licenses it combines with AND allowed. Unknown licenses are not allowed.
+func licenseAllowed(expression string, allowed map[string]bool) bool {
+	expression = strings.NewReplacer("(", " ", ")", " ").Replace(expression)
+	for _, alternative := range strings.Split(expression, " OR ") {
+		ok := true
+		for _, license := range strings.Split(alternative, " AND ") {
+			license = strings.ToLower(strings.TrimSpace(license))
+			if license == "" || license == "noassertion" || !allowed[license] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// splitSBOMPackageName splits the name of a package in a GitHub SBOM, e.g. "npm:lodash", into its ecosystem and name.
+func splitSBOMPackageName(name string) (string, string) {
+	if ecosystem, pkg, ok := strings.Cut(name, ":"); ok {
+		return ecosystem, pkg
+	}
+	return "", name
+}
+
+// listDependencyManifests returns the manifests of a repository depending on each package, keyed by lowercase package
+// name.
+func listDependencyManifests(ctx context.Context, gqlClient *githubv4.Client, owner, repo string) (map[string][]string, error) {
+	var query dependencyManifestsQuery
+	if err := gqlClient.Query(ctx, &query, map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}); err != nil {
+		return nil, err
+	}
+	manifests := map[string][]string{}
+	for _, manifest := range query.Repository.DependencyGraphManifests.Nodes {
+		for _, dependency := range manifest.Dependencies.Nodes {
+			name := strings.ToLower(string(dependency.PackageName))
+			manifests[name] = append(manifests[name], string(manifest.Filename))
+		}
+	}
+	return manifests, nil
+}
+
+// CheckDependencyLicenses creates a tool to flag the dependencies of a repository whose licenses are not allowed.
+func CheckDependencyLicenses(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_dependency_licenses",
+			mcp.WithDescription(t("TOOL_CHECK_DEPENDENCY_LICENSES_DESCRIPTION", "Check the licenses of all the dependencies of a repository, from its dependency graph, against an allow-list of SPDX license identifiers, by default the license_allow_list of the server's config. Returns the packages whose license is not allowed or unknown, with their version, license and the manifests that depend on them.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_DEPENDENCY_LICENSES_USER_TITLE", "Check dependency licenses"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[DependencyLicensesResult](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithArray("allowed_licenses",
+				mcp.Description("SPDX identifiers of the allowed licenses, e.g. ['MIT', 'Apache-2.0']. Defaults to the license_allow_list of the server's config"),
+				mcp.WithStringItems(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			allowedLicenses, err := OptionalStringArrayParam(request, "allowed_licenses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(allowedLicenses) == 0 {
+				allowedLicenses = flags.LicenseAllowList
+			}
+			if len(allowedLicenses) == 0 {
+				return mcp.NewToolResultError("no allowed licenses: pass allowed_licenses or set license_allow_list in the server's config"), nil
+			}
+			allowed := map[string]bool{}
+			for _, license := range allowedLicenses {
+				allowed[strings.ToLower(license)] = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get dependency graph of '%s/%s'", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// The manifests only make the report easier to act on, so the check does not fail without them.
+			manifests, err := listDependencyManifests(ctx, gqlClient, owner, repo)
+			if err != nil {
+				manifests = map[string][]string{}
+			}
+
+			result := DependencyLicensesResult{
+				Repository:      owner + "/" + repo,
+				AllowedLicenses: allowedLicenses,
+				Violations:      []LicenseViolation{},
+			}
+			info := sbom.GetSBOM()
+			if info == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("the dependency graph of '%s/%s' is empty", owner, repo)), nil
+			}
+			for _, pkg := range info.Packages {
+				// The repository itself is a package of its SBOM.
+				if slices.Contains(info.DocumentDescribes, pkg.GetSPDXID()) {
+					continue
+				}
+				result.Packages++
+				license := pkg.GetLicenseConcluded()
+				if license == "" || license == "NOASSERTION" {
+					license = pkg.GetLicenseDeclared()
+				}
+				if license == "" {
+					license = "NOASSERTION"
+				}
+				if licenseAllowed(license, allowed) {
+					continue
+				}
+				ecosystem, name := splitSBOMPackageName(pkg.GetName())
+				result.Violations = append(result.Violations, LicenseViolation{
+					Package:   name,
+					Ecosystem: ecosystem,
+					Version:   pkg.GetVersionInfo(),
+					License:   license,
+					Manifests: manifests[strings.ToLower(name)],
+				})
+			}
+			sort.Slice(result.Violations, func(i, j int) bool {
+				a, b := result.Violations[i], result.Violations[j]
+				if a.Package != b.Package {
+					return a.Package < b.Package
+				}
+				return a.Version < b.Version
+			})
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
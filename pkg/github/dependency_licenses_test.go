@@ -0,0 +1,121 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_licenseAllowed(t *testing.T) {
+	allowed := map[string]bool{"mit": true, "apache-2.0": true}
+	assert.True(t, licenseAllowed("MIT", allowed))
+	assert.True(t, licenseAllowed("GPL-3.0-only OR MIT", allowed))
+	assert.True(t, licenseAllowed("(MIT AND Apache-2.0)", allowed))
+	assert.False(t, licenseAllowed("MIT AND GPL-3.0-only", allowed))
+	assert.False(t, licenseAllowed("NOASSERTION", allowed))
+	assert.False(t, licenseAllowed("", allowed))
+}
+
+func Test_CheckDependencyLicenses(t *testing.T) {
+	tool, _ := CheckDependencyLicenses(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "check_dependency_licenses", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	sbom := &github.SBOM{SBOM: &github.SBOMInfo{
+		DocumentDescribes: []string{"SPDXRef-com.github.owner-repo"},
+		Packages: []*github.RepoDependencies{
+			{SPDXID: github.Ptr("SPDXRef-com.github.owner-repo"), Name: github.Ptr("com.github.owner/repo"), LicenseConcluded: github.Ptr("GPL-3.0-only")},
+			{SPDXID: github.Ptr("SPDXRef-npm-lodash"), Name: github.Ptr("npm:lodash"), VersionInfo: github.Ptr("4.17.21"), LicenseConcluded: github.Ptr("MIT")},
+			{SPDXID: github.Ptr("SPDXRef-npm-left-pad"), Name: github.Ptr("npm:left-pad"), VersionInfo: github.Ptr("1.3.0"), LicenseDeclared: github.Ptr("WTFPL")},
+			{SPDXID: github.Ptr("SPDXRef-pip-mystery"), Name: github.Ptr("pip:mystery"), VersionInfo: github.Ptr("0.1.0"), LicenseConcluded: github.Ptr("NOASSERTION")},
+		},
+	}}
+	sbomEndpoint := mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/dependency-graph/sbom", Method: http.MethodGet}
+	manifests := githubv4mock.NewQueryMatcher(
+		dependencyManifestsQuery{},
+		map[string]any{"owner": githubv4.String("owner"), "repo": githubv4.String("repo")},
+		githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"dependencyGraphManifests": map[string]any{"nodes": []any{
+			map[string]any{
+				"filename":     "package.json",
+				"dependencies": map[string]any{"nodes": []any{map[string]any{"packageName": "left-pad"}, map[string]any{"packageName": "lodash"}}},
+			},
+		}}}}),
+	)
+
+	tests := []struct {
+		name           string
+		flags          FeatureFlags
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       DependencyLicensesResult
+	}{
+		{
+			name:        "allow-list from config",
+			flags:       FeatureFlags{LicenseAllowList: []string{"MIT", "Apache-2.0"}},
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo"},
+			expected: DependencyLicensesResult{
+				Repository:      "owner/repo",
+				AllowedLicenses: []string{"MIT", "Apache-2.0"},
+				Packages:        3,
+				Violations: []LicenseViolation{
+					{Package: "left-pad", Ecosystem: "npm", Version: "1.3.0", License: "WTFPL", Manifests: []string{"package.json"}},
+					{Package: "mystery", Ecosystem: "pip", Version: "0.1.0", License: "NOASSERTION"},
+				},
+			},
+		},
+		{
+			name:        "allow-list from request",
+			flags:       FeatureFlags{LicenseAllowList: []string{"MIT"}},
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "allowed_licenses": []any{"WTFPL"}},
+			expected: DependencyLicensesResult{
+				Repository:      "owner/repo",
+				AllowedLicenses: []string{"WTFPL"},
+				Packages:        3,
+				Violations: []LicenseViolation{
+					{Package: "lodash", Ecosystem: "npm", Version: "4.17.21", License: "MIT", Manifests: []string{"package.json"}},
+					{Package: "mystery", Ecosystem: "pip", Version: "0.1.0", License: "NOASSERTION"},
+				},
+			},
+		},
+		{
+			name:           "no allow-list",
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "no allowed licenses",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(mock.WithRequestMatch(sbomEndpoint, sbom)))
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(manifests))
+			_, handler := CheckDependencyLicenses(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, tc.flags)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response DependencyLicensesResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -0,0 +1,570 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DependencyUpdateResult describes the pull request opened to fix a Dependabot alert.
+type DependencyUpdateResult struct {
+	Alert          int    `json:"alert"`
+	Package        string `json:"package"`
+	Ecosystem      string `json:"ecosystem"`
+	ManifestPath   string `json:"manifest_path"`
+	PatchedVersion string `json:"patched_version"`
+	// Lines are the 1-based lines of the manifest whose version was bumped.
+	Lines []int `json:"lines"`
+	FilePropagationResult
+}
+
+// dependencyVersionPattern matches a version such as 1.2.3, v1.2.3 or 1.2.3-beta.1.
+var dependencyVersionPattern = regexp.MustCompile(`^v?\d+(\.\d+)*([-+][0-9A-Za-z.-]+)?$`)
+
+var (
+	// npmRangeClausePattern matches a clause of an npm version range, such as ^1.2.3 or >= 1.2.3.
+	npmRangeClausePattern = regexp.MustCompile(`(?:[<>]=?|[~^=])?\s*[^\s<>=~^]+`)
+	// pipRequirementPattern matches a requirement of a pip requirements file, capturing the project name and its
+	// version specifiers, before any environment marker or comment.
+	pipRequirementPattern = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?([^;#]*)`)
+	// pipNameSeparatorPattern matches the runs of separators that are equivalent in the names of Python projects.
+	pipNameSeparatorPattern = regexp.MustCompile(`[-_.]+`)
+	// gemDeclarationPattern matches a gem declaration of a Gemfile, capturing the gem name.
+	gemDeclarationPattern = regexp.MustCompile(`^\s*gem\s*\(?\s*['"]([^'"]+)['"]`)
+	// gemRequirementPattern matches a version requirement argument following a gem name or another requirement.
+	gemRequirementPattern = regexp.MustCompile(`^\s*,\s*['"]([^'"]*)['"]`)
+)
+
+var (
+	npmRangeOperators = []string{">=", "<=", ">", "<", "^", "~", "="}
+	pipOperators      = []string{"===", "~=", "==", "!=", ">=", "<=", ">", "<"}
+	gemOperators      = []string{"~>", ">=", "<=", "!=", "=", ">", "<"}
+	// npmDependencySections are the members of package.json declaring dependencies, as opposed to e.g. overrides.
+	npmDependencySections = map[string]bool{"dependencies": true, "devDependencies": true, "optionalDependencies": true, "peerDependencies": true}
+)
+
+// versionClause is a clause of a version constraint, such as >=1.2.3, with the offsets of its operator and version in
+// the constraint.
+type versionClause struct {
+	op      string
+	opStart int
+	version string
+	start   int
+	end     int
+}
+
+// parseVersionClause parses the clause in s[start:end], an optional operator out of ops followed by a version.
+func parseVersionClause(s string, start, end int, ops []string) (versionClause, bool) {
+	for start < end && isVersionSpace(s[start]) {
+		start++
+	}
+	for end > start && isVersionSpace(s[end-1]) {
+		end--
+	}
+	clause := versionClause{opStart: start}
+	for _, op := range ops {
+		if strings.HasPrefix(s[start:end], op) {
+			clause.op = op
+			start += len(op)
+			break
+		}
+	}
+	for start < end && isVersionSpace(s[start]) {
+		start++
+	}
+	clause.version, clause.start, clause.end = s[start:end], start, end
+	return clause, dependencyVersionPattern.MatchString(clause.version)
+}
+
+func isVersionSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// compareDependencyVersions compares two versions by their numeric components, a pre-release of a version being
+// older than the version.
+func compareDependencyVersions(a, b string) int {
+	split := func(v string) ([]string, string) {
+		v = strings.TrimPrefix(v, "v")
+		release, pre, _ := strings.Cut(strings.SplitN(v, "+", 2)[0], "-")
+		return strings.Split(release, "."), pre
+	}
+	aParts, aPre := split(a)
+	bParts, bPre := split(b)
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		var x, y int
+		if i < len(aParts) {
+			x, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			y, _ = strconv.Atoi(bParts[i])
+		}
+		if x != y {
+			return cmp.Compare(x, y)
+		}
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return strings.Compare(aPre, bPre)
+}
+
+// raiseVersionConstraint raises the lower bounds of a version constraint to patched, keeping their operators and a
+// leading "v", so that the constraint only allows patched versions. It returns the updated constraint and whether it
+// changed, and fails when the constraint has no lower bound or its upper bounds exclude patched.
+func raiseVersionConstraint(constraint string, clauses []versionClause, patched string) (string, bool, error) {
+	var b strings.Builder
+	last, raised, changed := 0, false, false
+	for _, clause := range clauses {
+		switch clause.op {
+		case "<":
+			if compareDependencyVersions(patched, clause.version) >= 0 {
+				return "", false, fmt.Errorf("%q excludes the patched version %s", constraint, patched)
+			}
+		case "<=":
+			if compareDependencyVersions(patched, clause.version) > 0 {
+				return "", false, fmt.Errorf("%q excludes the patched version %s", constraint, patched)
+			}
+		case "!=":
+			if compareDependencyVersions(patched, clause.version) == 0 {
+				return "", false, fmt.Errorf("%q excludes the patched version %s", constraint, patched)
+			}
+		default:
+			raised = true
+			if compareDependencyVersions(clause.version, patched) >= 0 {
+				continue
+			}
+			version := strings.TrimPrefix(patched, "v")
+			if strings.HasPrefix(clause.version, "v") {
+				version = "v" + version
+			}
+			b.WriteString(constraint[last:clause.opStart])
+			// A strict lower bound would exclude the patched version itself.
+			if clause.op == ">" {
+				b.WriteString(">=")
+			} else {
+				b.WriteString(clause.op)
+			}
+			b.WriteString(constraint[clause.opStart+len(clause.op) : clause.start])
+			b.WriteString(version)
+			last, changed = clause.end, true
+		}
+	}
+	if !raised {
+		return "", false, fmt.Errorf("%q has no lower bound to raise to %s", constraint, patched)
+	}
+	b.WriteString(constraint[last:])
+	return b.String(), changed, nil
+}
+
+// dependencyEdit is the replacement of the version constraint of a dependency declaration in a manifest.
+type dependencyEdit struct {
+	start, end int
+	constraint string
+}
+
+// bumpDependencyVersion raises the version constraints of the declarations of a package in a manifest to the patched
+// version. Only the dependency declarations of manifests of the npm (package.json), pip (requirements files), Go
+// (go.mod) and RubyGems (Gemfile) ecosystems are edited. It returns the updated content and the lines changed, and
+// fails when no declaration of the package allows an older version, or a declaration cannot be raised safely.
+func bumpDependencyVersion(content, ecosystem, manifestPath, name, patched string) (string, []int, error) {
+	var edits []dependencyEdit
+	var err error
+	switch manifest := path.Base(manifestPath); {
+	case ecosystem == "npm" && manifest == "package.json":
+		edits, err = npmDependencyEdits(content, name, patched)
+	case ecosystem == "pip" && strings.HasSuffix(manifest, ".txt"):
+		edits, err = lineDependencyEdits(content, patched, func(line string) ([]versionClause, error) {
+			return pipRequirementClauses(line, name)
+		})
+	case ecosystem == "go" && manifest == "go.mod":
+		edits, err = goModDependencyEdits(content, name, patched)
+	case ecosystem == "rubygems" && manifest == "Gemfile":
+		edits, err = lineDependencyEdits(content, patched, func(line string) ([]versionClause, error) {
+			return gemRequirementClauses(line, name)
+		})
+	default:
+		return "", nil, fmt.Errorf("bumping %s dependencies in %s is not supported; update it, or regenerate it, manually", ecosystem, manifest)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot bump %s: %w; update the manifest manually", name, err)
+	}
+	if len(edits) == 0 {
+		return "", nil, fmt.Errorf("no dependency declaration of the manifest allows a version of %s older than %s; update it, or regenerate the lockfile, manually", name, patched)
+	}
+
+	var b strings.Builder
+	lines := []int{}
+	last := 0
+	for _, edit := range edits {
+		b.WriteString(content[last:edit.start])
+		b.WriteString(edit.constraint)
+		last = edit.end
+		line := strings.Count(content[:edit.start], "\n") + 1
+		if len(lines) == 0 || lines[len(lines)-1] != line {
+			lines = append(lines, line)
+		}
+	}
+	b.WriteString(content[last:])
+	return b.String(), lines, nil
+}
+
+// lineDependencyEdits raises the constraints of the dependency declarations of a manifest with one declaration per
+// line. clauses returns the clauses of the constraint declared by a line, with offsets in the line, or nil if the line
+// does not declare the dependency.
+func lineDependencyEdits(content, patched string, clauses func(line string) ([]versionClause, error)) ([]dependencyEdit, error) {
+	var edits []dependencyEdit
+	offset := 0
+	for _, line := range strings.SplitAfter(content, "\n") {
+		lineClauses, err := clauses(line)
+		if err != nil {
+			return nil, err
+		}
+		if len(lineClauses) > 0 {
+			start, end := lineClauses[0].opStart, lineClauses[len(lineClauses)-1].end
+			constraint := line[start:end]
+			for i := range lineClauses {
+				lineClauses[i].opStart -= start
+				lineClauses[i].start -= start
+				lineClauses[i].end -= start
+			}
+			raised, changed, err := raiseVersionConstraint(constraint, lineClauses, patched)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				edits = append(edits, dependencyEdit{start: offset + start, end: offset + end, constraint: raised})
+			}
+		}
+		offset += len(line)
+	}
+	return edits, nil
+}
+
+// pipRequirementClauses returns the version specifiers of a requirement of the named project, compared by normalized
+// name. Requirements without specifiers, options and comments have none.
+func pipRequirementClauses(line, name string) ([]versionClause, error) {
+	match := pipRequirementPattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return nil, nil
+	}
+	normalize := func(s string) string { return pipNameSeparatorPattern.ReplaceAllString(strings.ToLower(s), "-") }
+	if normalize(line[match[2]:match[3]]) != normalize(name) {
+		return nil, nil
+	}
+	var clauses []versionClause
+	start := match[4]
+	for start < match[5] && strings.TrimSpace(line[start:match[5]]) != "" {
+		end := strings.IndexByte(line[start:match[5]], ',')
+		if end < 0 {
+			end = match[5]
+		} else {
+			end += start
+		}
+		clause, ok := parseVersionClause(line, start, end, pipOperators)
+		if !ok {
+			return nil, fmt.Errorf("requirement %q is not supported", strings.TrimSpace(line[match[4]:match[5]]))
+		}
+		clauses = append(clauses, clause)
+		start = end + 1
+	}
+	return clauses, nil
+}
+
+// gemRequirementClauses returns the version requirements of a declaration of the named gem, the string arguments
+// following its name.
+func gemRequirementClauses(line, name string) ([]versionClause, error) {
+	match := gemDeclarationPattern.FindStringSubmatchIndex(line)
+	if match == nil || line[match[2]:match[3]] != name {
+		return nil, nil
+	}
+	var clauses []versionClause
+	offset := match[1]
+	for {
+		requirement := gemRequirementPattern.FindStringSubmatchIndex(line[offset:])
+		if requirement == nil {
+			return clauses, nil
+		}
+		clause, ok := parseVersionClause(line, offset+requirement[2], offset+requirement[3], gemOperators)
+		if !ok {
+			return nil, fmt.Errorf("requirement %q is not supported", line[offset+requirement[2]:offset+requirement[3]])
+		}
+		clauses = append(clauses, clause)
+		offset += requirement[1]
+	}
+}
+
+// goModDependencyEdits raises the versions of the requirements of a module in a go.mod file, in require directives
+// and blocks.
+func goModDependencyEdits(content, module, patched string) ([]dependencyEdit, error) {
+	var edits []dependencyEdit
+	offset, inBlock := 0, false
+	for _, line := range strings.SplitAfter(content, "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) >= 2 && fields[0] == "require" && fields[1] == "(":
+			inBlock = true
+		case inBlock && len(fields) > 0 && fields[0] == ")":
+			inBlock = false
+		case len(fields) >= 3 && fields[0] == "require" && fields[1] == module:
+			fields = fields[1:]
+			fallthrough
+		case inBlock && len(fields) >= 2 && fields[0] == module:
+			start := strings.Index(line, module) + len(module)
+			start += strings.Index(line[start:], fields[1])
+			end := start + len(fields[1])
+			clause, ok := parseVersionClause(fields[1], 0, len(fields[1]), nil)
+			if !ok {
+				return nil, fmt.Errorf("%q is not a version", fields[1])
+			}
+			raised, changed, err := raiseVersionConstraint(fields[1], []versionClause{clause}, patched)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				edits = append(edits, dependencyEdit{start: offset + start, end: offset + end, constraint: raised})
+			}
+		}
+		offset += len(line)
+	}
+	return edits, nil
+}
+
+// npmDependencyEdits raises the version ranges of a package in the dependency sections of a package.json file. Other
+// members, such as the name of the package or its overrides, are left alone.
+func npmDependencyEdits(content, name, patched string) ([]dependencyEdit, error) {
+	type frame struct {
+		object    bool
+		expectKey bool
+		key       string
+		// section is the member of the root object holding the object or array, if it is nested in one.
+		section string
+	}
+	decoder := json.NewDecoder(strings.NewReader(content))
+	var stack []frame
+	var edits []dependencyEdit
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return edits, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse package.json: %w", err)
+		}
+		var top *frame
+		if len(stack) > 0 {
+			top = &stack[len(stack)-1]
+		}
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				section := ""
+				if len(stack) == 1 {
+					section = top.key
+				} else if top != nil {
+					section = top.section
+				}
+				stack = append(stack, frame{object: delim == '{', expectKey: delim == '{', section: section})
+			case '}', ']':
+				stack = stack[:len(stack)-1]
+				if len(stack) > 0 {
+					stack[len(stack)-1].expectKey = stack[len(stack)-1].object
+				}
+			}
+			continue
+		}
+		if top == nil || !top.object {
+			continue
+		}
+		if top.expectKey {
+			top.key, _ = token.(string)
+			top.expectKey = false
+			continue
+		}
+		top.expectKey = true
+		value, ok := token.(string)
+		if !ok || len(stack) != 2 || !npmDependencySections[top.section] || top.key != name {
+			continue
+		}
+
+		// The offset is right after the closing quote of the value, which has no escaped quotes as a range.
+		end := int(decoder.InputOffset()) - 1
+		start := strings.LastIndexByte(content[:end], '"') + 1
+		if content[start:end] != value {
+			return nil, fmt.Errorf("unexpected range %s", content[start:end])
+		}
+		var clauses []versionClause
+		for _, loc := range npmRangeClausePattern.FindAllStringIndex(value, -1) {
+			clause, ok := parseVersionClause(value, loc[0], loc[1], npmRangeOperators)
+			if !ok {
+				return nil, fmt.Errorf("range %q is not supported", value)
+			}
+			clauses = append(clauses, clause)
+		}
+		if len(clauses) == 0 || strings.TrimSpace(npmRangeClausePattern.ReplaceAllString(value, "")) != "" {
+			return nil, fmt.Errorf("range %q is not supported", value)
+		}
+		raised, changed, err := raiseVersionConstraint(value, clauses, patched)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			edits = append(edits, dependencyEdit{start: start, end: end, constraint: raised})
+		}
+	}
+}
+
+// CreateDependencyUpdatePR creates a tool to fix a Dependabot alert by bumping the vulnerable dependency to its patched
+// version in a pull request.
+func CreateDependencyUpdatePR(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_dependency_update_pr",
+			mcp.WithDescription(t("TOOL_CREATE_DEPENDENCY_UPDATE_PR_DESCRIPTION", "Fix a Dependabot alert for which Dependabot did not open a pull request: bump the vulnerable dependency to the first patched version in the manifest of the alert, on a new branch, and open a pull request referencing the advisory. Dependency declarations of package.json (npm), requirements files (pip), go.mod (Go) and Gemfile (RubyGems) manifests are bumped, keeping their range operators; lockfiles need to be regenerated on the branch.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DEPENDENCY_UPDATE_PR_USER_TITLE", "Create dependency update pull request"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[DependencyUpdateResult](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("alertNumber",
+				mcp.Required(),
+				mcp.Description("The number of the Dependabot alert."),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Name of the branch to create. Defaults to 'dependabot-alert-<alertNumber>'"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Branch to update and to open the pull request against. Defaults to the repository's default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertNumber, err := RequiredInt(request, "alertNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if branch == "" {
+				branch = fmt.Sprintf("dependabot-alert-%d", alertNumber)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alert, resp, err := client.Dependabot.GetRepoAlert(ctx, owner, repo, alertNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			if alert.GetState() != "open" {
+				return mcp.NewToolResultError(fmt.Sprintf("alert %d is %s, not open", alertNumber, alert.GetState())), nil
+			}
+
+			result := DependencyUpdateResult{
+				Alert:          alertNumber,
+				Package:        alert.GetDependency().GetPackage().GetName(),
+				Ecosystem:      alert.GetDependency().GetPackage().GetEcosystem(),
+				ManifestPath:   alert.GetDependency().GetManifestPath(),
+				PatchedVersion: alert.GetSecurityVulnerability().GetFirstPatchedVersion().GetIdentifier(),
+			}
+			if result.PatchedVersion == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("no patched version of %s is available for alert %d", result.Package, alertNumber)), nil
+			}
+
+			var opts *github.RepositoryContentGetOptions
+			if base != "" {
+				opts = &github.RepositoryContentGetOptions{Ref: base}
+			}
+			manifest, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, result.ManifestPath, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get manifest %s", result.ManifestPath),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			if manifest == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("manifest %s is a directory", result.ManifestPath)), nil
+			}
+			content, err := manifest.GetContent()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode manifest: %w", err)
+			}
+
+			updated, lines, err := bumpDependencyVersion(content, result.Ecosystem, result.ManifestPath, result.Package, result.PatchedVersion)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			result.Lines = lines
+
+			advisory := alert.GetSecurityAdvisory()
+			message := fmt.Sprintf("Bump %s to %s", result.Package, result.PatchedVersion)
+			var body strings.Builder
+			fmt.Fprintf(&body, "Bumps %s to %s in `%s` to fix Dependabot alert [#%d](%s).\n\n", result.Package, result.PatchedVersion, result.ManifestPath, alertNumber, alert.GetHTMLURL())
+			fmt.Fprintf(&body, "**Advisory:** [%s](https://github.com/advisories/%s)", advisory.GetGHSAID(), advisory.GetGHSAID())
+			if advisory.GetCVEID() != "" {
+				fmt.Fprintf(&body, " (%s)", advisory.GetCVEID())
+			}
+			if advisory.GetSummary() != "" {
+				fmt.Fprintf(&body, ": %s", advisory.GetSummary())
+			}
+			body.WriteString("\n")
+
+			result.FilePropagationResult = propagateFileToRepository(ctx, client, owner+"/"+repo, filePropagation{
+				path:       result.ManifestPath,
+				content:    updated,
+				message:    message,
+				branch:     branch,
+				baseBranch: base,
+				title:      message,
+				body:       body.String(),
+			})
+			if result.Status == FilePropagationStatusError {
+				return mcp.NewToolResultError(result.Error), nil
+			}
+			return MarshalledStructuredResult(result), nil
+		}
+}
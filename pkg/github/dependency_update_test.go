@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_bumpDependencyVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		ecosystem     string
+		manifest      string
+		content       string
+		pkg           string
+		patched       string
+		expected      string
+		expectedLines []int
+		expectedErr   string
+	}{
+		{
+			name:          "package.json keeps range operator",
+			ecosystem:     "npm",
+			manifest:      "package.json",
+			content:       "{\n  \"dependencies\": {\n    \"lodash\": \"^4.17.15\",\n    \"lodash.merge\": \"4.6.1\"\n  }\n}",
+			pkg:           "lodash",
+			patched:       "4.17.21",
+			expected:      "{\n  \"dependencies\": {\n    \"lodash\": \"^4.17.21\",\n    \"lodash.merge\": \"4.6.1\"\n  }\n}",
+			expectedLines: []int{3},
+		},
+		{
+			name:          "package.json raises lower bound of range",
+			ecosystem:     "npm",
+			manifest:      "web/package.json",
+			content:       "{\"devDependencies\": {\"minimist\": \">1.2.0 <2\"}}",
+			pkg:           "minimist",
+			patched:       "1.2.6",
+			expected:      "{\"devDependencies\": {\"minimist\": \">=1.2.6 <2\"}}",
+			expectedLines: []int{1},
+		},
+		{
+			name:      "package.json leaves name and overrides alone",
+			ecosystem: "npm",
+			manifest:  "package.json",
+			content: "{\n  \"name\": \"lodash\",\n  \"description\": \"lodash 4.17.15 fork\",\n" +
+				"  \"dependencies\": {\"lodash\": \"~4.17.15\"},\n  \"overrides\": {\"lodash\": \"4.17.15\"}\n}",
+			pkg:     "lodash",
+			patched: "4.17.21",
+			expected: "{\n  \"name\": \"lodash\",\n  \"description\": \"lodash 4.17.15 fork\",\n" +
+				"  \"dependencies\": {\"lodash\": \"~4.17.21\"},\n  \"overrides\": {\"lodash\": \"4.17.15\"}\n}",
+			expectedLines: []int{4},
+		},
+		{
+			name:        "package.json upper bound excludes patched version",
+			ecosystem:   "npm",
+			manifest:    "package.json",
+			content:     "{\"dependencies\": {\"lodash\": \">=3.0.0 <4.17.20\"}}",
+			pkg:         "lodash",
+			patched:     "4.17.21",
+			expectedErr: "excludes the patched version 4.17.21",
+		},
+		{
+			name:        "package.json union range",
+			ecosystem:   "npm",
+			manifest:    "package.json",
+			content:     "{\"dependencies\": {\"lodash\": \"^3.0.0 || ^4.0.0\"}}",
+			pkg:         "lodash",
+			patched:     "4.17.21",
+			expectedErr: "is not supported",
+		},
+		{
+			name:          "requirements.txt",
+			ecosystem:     "pip",
+			manifest:      "requirements.txt",
+			content:       "flask==1.0.0\nrequests==2.19.1 # http\n",
+			pkg:           "requests",
+			patched:       "2.20.0",
+			expected:      "flask==1.0.0\nrequests==2.20.0 # http\n",
+			expectedLines: []int{2},
+		},
+		{
+			name:          "requirements.txt keeps range and skips mentions",
+			ecosystem:     "pip",
+			manifest:      "requirements/base.txt",
+			content:       "# requests==2.19.1 is vulnerable\nrequests-oauthlib==1.0.0\nRequests[socks] >= 2.19.1, < 3 ; python_version > \"3\"\n",
+			pkg:           "requests",
+			patched:       "2.20.0",
+			expected:      "# requests==2.19.1 is vulnerable\nrequests-oauthlib==1.0.0\nRequests[socks] >= 2.20.0, < 3 ; python_version > \"3\"\n",
+			expectedLines: []int{3},
+		},
+		{
+			name:        "requirements.txt upper bound excludes patched version",
+			ecosystem:   "pip",
+			manifest:    "requirements.txt",
+			content:     "django>=2.2,<3\n",
+			pkg:         "django",
+			patched:     "3.2.19",
+			expectedErr: "\">=2.2,<3\" excludes the patched version 3.2.19",
+		},
+		{
+			name:          "go.mod keeps v prefix",
+			ecosystem:     "go",
+			manifest:      "go.mod",
+			content:       "module example.com/app\n\n// golang.org/x/net v0.7.0 is pinned by the proxy\nrequire (\n\tgolang.org/x/net v0.7.0 // indirect\n\tgolang.org/x/net/html v0.1.0\n)\n\nreplace golang.org/x/net v0.7.0 => ../net\n",
+			pkg:           "golang.org/x/net",
+			patched:       "0.17.0",
+			expected:      "module example.com/app\n\n// golang.org/x/net v0.7.0 is pinned by the proxy\nrequire (\n\tgolang.org/x/net v0.17.0 // indirect\n\tgolang.org/x/net/html v0.1.0\n)\n\nreplace golang.org/x/net v0.7.0 => ../net\n",
+			expectedLines: []int{5},
+		},
+		{
+			name:          "go.mod require directive",
+			ecosystem:     "go",
+			manifest:      "go.mod",
+			content:       "module example.com/app\n\nrequire golang.org/x/net v0.7.0\n",
+			pkg:           "golang.org/x/net",
+			patched:       "v0.17.0",
+			expected:      "module example.com/app\n\nrequire golang.org/x/net v0.17.0\n",
+			expectedLines: []int{3},
+		},
+		{
+			name:          "Gemfile keeps pessimistic operator",
+			ecosystem:     "rubygems",
+			manifest:      "Gemfile",
+			content:       "# gem 'rack', '2.0.1'\ngem 'rack-cors', '~> 1.0'\ngem \"rack\", \"~> 2.0\", \">= 2.0.1\", require: false\n",
+			pkg:           "rack",
+			patched:       "2.2.6.4",
+			expected:      "# gem 'rack', '2.0.1'\ngem 'rack-cors', '~> 1.0'\ngem \"rack\", \"~> 2.2.6.4\", \">= 2.2.6.4\", require: false\n",
+			expectedLines: []int{3},
+		},
+		{
+			name:        "lockfile",
+			ecosystem:   "npm",
+			manifest:    "package-lock.json",
+			content:     "\"node_modules/lodash\": {\n  \"version\": \"4.17.15\"\n}",
+			pkg:         "lodash",
+			patched:     "4.17.21",
+			expectedErr: "bumping npm dependencies in package-lock.json is not supported",
+		},
+		{
+			name:        "already patched",
+			ecosystem:   "pip",
+			manifest:    "requirements.txt",
+			content:     "requests>=2.20.0\n",
+			pkg:         "requests",
+			patched:     "2.20.0",
+			expectedErr: "no dependency declaration of the manifest allows a version of requests older than 2.20.0",
+		},
+		{
+			name:        "only mentioned",
+			ecosystem:   "pip",
+			manifest:    "requirements.txt",
+			content:     "# pin requests to 2.19.1\nrequests\n",
+			pkg:         "requests",
+			patched:     "2.20.0",
+			expectedErr: "no dependency declaration of the manifest allows a version of requests older than 2.20.0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, lines, err := bumpDependencyVersion(tc.content, tc.ecosystem, tc.manifest, tc.pkg, tc.patched)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, updated)
+			assert.Equal(t, tc.expectedLines, lines)
+		})
+	}
+}
+
+func Test_CreateDependencyUpdatePR(t *testing.T) {
+	tool, _ := CreateDependencyUpdatePR(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_dependency_update_pr", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "alertNumber"})
+
+	alert := func(state, patched string) *github.DependabotAlert {
+		return &github.DependabotAlert{
+			Number:  github.Ptr(5),
+			State:   github.Ptr(state),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/5"),
+			Dependency: &github.Dependency{
+				Package:      &github.VulnerabilityPackage{Ecosystem: github.Ptr("pip"), Name: github.Ptr("requests")},
+				ManifestPath: github.Ptr("requirements.txt"),
+			},
+			SecurityAdvisory: &github.DependabotSecurityAdvisory{
+				GHSAID:  github.Ptr("GHSA-x84v-xcm2-53pg"),
+				CVEID:   github.Ptr("CVE-2018-18074"),
+				Summary: github.Ptr("Insufficiently Protected Credentials in Requests"),
+			},
+			SecurityVulnerability: &github.AdvisoryVulnerability{
+				FirstPatchedVersion: &github.FirstPatchedVersion{Identifier: github.Ptr(patched)},
+			},
+		}
+	}
+	manifest := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte("flask==1.0.0\nrequests==2.19.1\n"))),
+		SHA:      github.Ptr("manifestsha"),
+	}
+
+	t.Run("opens pull request", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependabotAlertsByOwnerByRepoByAlertNumber, alert("open", "2.20.0")),
+			mock.WithRequestMatch(mock.GetReposContentsByOwnerByRepoByPath, manifest, manifest),
+			mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{DefaultBranch: github.Ptr("main")}),
+			mock.WithRequestMatch(
+				mock.GetReposGitRefByOwnerByRepoByRef,
+				&github.Reference{Ref: github.Ptr("refs/heads/main"), Object: &github.GitObject{SHA: github.Ptr("abc123")}},
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposGitRefsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"ref": "refs/heads/dependabot-alert-5", "sha": "abc123"}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/heads/dependabot-alert-5")}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PutReposContentsByOwnerByRepoByPath,
+				expectRequestBody(t, map[string]any{
+					"message": "Bump requests to 2.20.0",
+					"content": base64.StdEncoding.EncodeToString([]byte("flask==1.0.0\nrequests==2.20.0\n")),
+					"branch":  "dependabot-alert-5",
+					"sha":     "manifestsha",
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.RepositoryContentResponse{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposPullsByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var pr github.NewPullRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&pr))
+					assert.Equal(t, "Bump requests to 2.20.0", pr.GetTitle())
+					assert.Contains(t, pr.GetBody(), "[GHSA-x84v-xcm2-53pg](https://github.com/advisories/GHSA-x84v-xcm2-53pg) (CVE-2018-18074)")
+					assert.Contains(t, pr.GetBody(), "Dependabot alert [#5](https://github.com/owner/repo/security/dependabot/5)")
+					w.WriteHeader(http.StatusCreated)
+					_ = json.NewEncoder(w).Encode(&github.PullRequest{Number: github.Ptr(42), HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42")})
+				}),
+			),
+		)
+		_, handler := CreateDependencyUpdatePR(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"alertNumber": float64(5),
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response DependencyUpdateResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, DependencyUpdateResult{
+			Alert:          5,
+			Package:        "requests",
+			Ecosystem:      "pip",
+			ManifestPath:   "requirements.txt",
+			PatchedVersion: "2.20.0",
+			Lines:          []int{2},
+			FilePropagationResult: FilePropagationResult{
+				Repository:     "owner/repo",
+				Status:         FilePropagationStatusPullRequestCreated,
+				BaseBranch:     "main",
+				Branch:         "dependabot-alert-5",
+				Action:         "updated",
+				PullRequest:    42,
+				PullRequestURL: "https://github.com/owner/repo/pull/42",
+			},
+		}, response)
+	})
+
+	t.Run("no patched version", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependabotAlertsByOwnerByRepoByAlertNumber, alert("open", "")),
+		)
+		_, handler := CreateDependencyUpdatePR(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"alertNumber": float64(5),
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "no patched version of requests")
+	})
+
+	t.Run("alert not open", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposDependabotAlertsByOwnerByRepoByAlertNumber, alert("fixed", "2.20.0")),
+		)
+		_, handler := CreateDependencyUpdatePR(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":       "owner",
+			"repo":        "repo",
+			"alertNumber": float64(5),
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "alert 5 is fixed, not open")
+	})
+}
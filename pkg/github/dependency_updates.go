@@ -0,0 +1,255 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	DependencyUpdateSeveritySecurity = "security"
+	DependencyUpdateSeverityMajor    = "major"
+	DependencyUpdateSeverityMinor    = "minor"
+	DependencyUpdateSeverityPatch    = "patch"
+	DependencyUpdateSeverityUnknown  = "unknown"
+
+	CheckStatusSuccess = "success"
+	CheckStatusPending = "pending"
+	CheckStatusFailure = "failure"
+	CheckStatusNone    = "none"
+	CheckStatusUnknown = "unknown"
+)
+
+// dependencyUpdateSeverityOrder ranks severities so that the most urgent groups are listed first.
+var dependencyUpdateSeverityOrder = map[string]int{
+	DependencyUpdateSeveritySecurity: 0,
+	DependencyUpdateSeverityMajor:    1,
+	DependencyUpdateSeverityMinor:    2,
+	DependencyUpdateSeverityPatch:    3,
+	DependencyUpdateSeverityUnknown:  4,
+}
+
+var defaultDependencyBots = []string{"dependabot[bot]", "renovate[bot]"}
+
+var (
+	// Dependabot: "Bump lodash from 4.17.20 to 4.17.21 in /web", optionally with a conventional commit prefix.
+	dependabotTitleRe = regexp.MustCompile(`(?i)\bbump (\S+) from v?(\S+) to v?(\S+)`)
+	// Dependabot grouped updates: "Bump the npm_and_yarn group across 1 directory with 2 updates".
+	dependabotGroupTitleRe = regexp.MustCompile(`(?i)\bbump the (\S+) group\b`)
+	// Renovate: "Update dependency lodash to v4.17.21", "chore(deps): update module github.com/x/y to v1.2.3".
+	renovateTitleRe = regexp.MustCompile(`(?i)\bupdate (?:dependency |module |docker tag |action )?(\S+) to v?(\S+)`)
+)
+
+// DependencyUpdatePR is a bot-authored dependency update pull request.
+type DependencyUpdatePR struct {
+	Repository  string `json:"repository"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Author      string `json:"author"`
+	From        string `json:"from,omitempty"`
+	To          string `json:"to,omitempty"`
+	CheckStatus string `json:"check_status"`
+}
+
+// DependencyUpdateGroup groups dependency update pull requests for the same package and severity.
+type DependencyUpdateGroup struct {
+	Package      string               `json:"package"`
+	Severity     string               `json:"severity"`
+	PullRequests []DependencyUpdatePR `json:"pull_requests"`
+}
+
+// DependencyUpdates are the open dependency update pull requests of a set of repositories.
+type DependencyUpdates struct {
+	Groups     []DependencyUpdateGroup `json:"groups"`
+	TotalCount int                     `json:"total_count"`
+	// Errors are the repositories whose pull requests could not be listed, with the reason.
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// ListDependencyUpdatePRs creates a tool to find open dependency update pull requests opened by bots across repositories.
+func ListDependencyUpdatePRs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_dependency_update_prs",
+			mcp.WithDescription(t("TOOL_LIST_DEPENDENCY_UPDATE_PRS_DESCRIPTION", "Find open dependency update pull requests opened by bots such as Dependabot or Renovate across multiple repositories. Results are grouped by package and severity (security, major, minor, patch, unknown) and include the combined check status of each pull request (success, failure, pending, none, or unknown when it could not be fetched), to help decide a merge order.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_DEPENDENCY_UPDATE_PRS_USER_TITLE", "List dependency update pull requests"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[DependencyUpdates](),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Repositories to search, in 'owner/repo' format"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("authors",
+				mcp.Description("Bot logins whose pull requests are considered dependency updates. Defaults to 'dependabot[bot]' and 'renovate[bot]'"),
+				mcp.WithStringItems(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("missing required parameter: repositories"), nil
+			}
+			authors, err := OptionalStringArrayParam(request, "authors")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(authors) == 0 {
+				authors = defaultDependencyBots
+			}
+			authorSet := make(map[string]bool, len(authors))
+			for _, a := range authors {
+				authorSet[strings.ToLower(a)] = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			groups := map[string]*DependencyUpdateGroup{}
+			repoErrors := map[string]string{}
+			total := 0
+			for _, fullName := range repositories {
+				owner, repo, err := parseRepoFullName(fullName)
+				if err != nil {
+					repoErrors[fullName] = err.Error()
+					continue
+				}
+
+				prs, resp, err := listOpenPullRequests(ctx, client, owner, repo)
+				if err != nil {
+					_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to list pull requests", resp, err)
+					repoErrors[fullName] = fmt.Sprintf("failed to list pull requests: %s", err)
+					continue
+				}
+
+				for _, pr := range prs {
+					if !authorSet[strings.ToLower(pr.GetUser().GetLogin())] {
+						continue
+					}
+
+					title := sanitize.Sanitize(pr.GetTitle())
+					pkg, from, to := parseDependencyUpdateTitle(title)
+					severity := dependencyUpdateSeverity(pr, title, from, to)
+
+					key := severity + "\x00" + pkg
+					group, ok := groups[key]
+					if !ok {
+						group = &DependencyUpdateGroup{Package: pkg, Severity: severity}
+						groups[key] = group
+					}
+					group.PullRequests = append(group.PullRequests, DependencyUpdatePR{
+						Repository:  fullName,
+						Number:      pr.GetNumber(),
+						Title:       title,
+						URL:         pr.GetHTMLURL(),
+						Author:      pr.GetUser().GetLogin(),
+						From:        from,
+						To:          to,
+						CheckStatus: getRefCheckStatus(ctx, client, owner, repo, pr.GetHead().GetSHA()),
+					})
+					total++
+				}
+			}
+
+			result := make([]DependencyUpdateGroup, 0, len(groups))
+			for _, g := range groups {
+				result = append(result, *g)
+			}
+			sort.Slice(result, func(i, j int) bool {
+				if result[i].Severity != result[j].Severity {
+					return dependencyUpdateSeverityOrder[result[i].Severity] < dependencyUpdateSeverityOrder[result[j].Severity]
+				}
+				return result[i].Package < result[j].Package
+			})
+
+			response := DependencyUpdates{
+				Groups:     result,
+				TotalCount: total,
+				Errors:     repoErrors,
+			}
+
+			return MarshalledStructuredResult(response), nil
+		}
+}
+
+// listOpenPullRequests returns all open pull requests of a repository.
+func listOpenPullRequests(ctx context.Context, client *github.Client, owner, repo string) ([]*github.PullRequest, *github.Response, error) {
+	var all []*github.PullRequest
+	opts := &github.PullRequestListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, prs...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// parseDependencyUpdateTitle extracts the package name and the versions being updated from a
+// Dependabot or Renovate pull request title. Unrecognized titles are returned as the package name.
+func parseDependencyUpdateTitle(title string) (pkg, from, to string) {
+	if m := dependabotTitleRe.FindStringSubmatch(title); m != nil {
+		return m[1], m[2], m[3]
+	}
+	if m := dependabotGroupTitleRe.FindStringSubmatch(title); m != nil {
+		return m[1] + " group", "", ""
+	}
+	if m := renovateTitleRe.FindStringSubmatch(title); m != nil {
+		return m[1], "", m[2]
+	}
+	return title, "", ""
+}
+
+// dependencyUpdateSeverity classifies a dependency update as a security update, based on its
+// labels or title, or by the kind of semantic version bump it performs.
+func dependencyUpdateSeverity(pr *github.PullRequest, title, from, to string) string {
+	for _, l := range pr.Labels {
+		if strings.Contains(strings.ToLower(l.GetName()), "security") {
+			return DependencyUpdateSeveritySecurity
+		}
+	}
+	if strings.Contains(strings.ToLower(title), "[security]") {
+		return DependencyUpdateSeveritySecurity
+	}
+	if from == "" || to == "" {
+		return DependencyUpdateSeverityUnknown
+	}
+
+	fromParts := strings.Split(strings.TrimPrefix(from, "v"), ".")
+	toParts := strings.Split(strings.TrimPrefix(to, "v"), ".")
+	switch {
+	case fromParts[0] != toParts[0]:
+		return DependencyUpdateSeverityMajor
+	case len(fromParts) < 2 || len(toParts) < 2:
+		return DependencyUpdateSeverityUnknown
+	case fromParts[1] != toParts[1]:
+		return DependencyUpdateSeverityMinor
+	default:
+		return DependencyUpdateSeverityPatch
+	}
+}
+
+// getRefCheckStatus summarizes the commit statuses and check runs of a ref as a single status.
+func getRefCheckStatus(ctx context.Context, client *github.Client, owner, repo, ref string) string {
+	return summarizeRefChecks(ctx, client, owner, repo, ref).State
+}
@@ -0,0 +1,301 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDependencyUpdatePRs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDependencyUpdatePRs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_dependency_update_prs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repositories")
+	assert.Contains(t, tool.InputSchema.Properties, "authors")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"repositories"})
+
+	apiPRs := []*github.PullRequest{
+		{
+			Number:  github.Ptr(1),
+			Title:   github.Ptr("Bump lodash from 4.17.20 to 4.17.21"),
+			HTMLURL: github.Ptr("https://github.com/octo-org/api/pull/1"),
+			User:    &github.User{Login: github.Ptr("dependabot[bot]")},
+			Head:    &github.PullRequestBranch{SHA: github.Ptr("sha-api-1")},
+		},
+		{
+			Number:  github.Ptr(2),
+			Title:   github.Ptr("Update dependency react to v18.3.1 [SECURITY]"),
+			HTMLURL: github.Ptr("https://github.com/octo-org/api/pull/2"),
+			User:    &github.User{Login: github.Ptr("renovate[bot]")},
+			Head:    &github.PullRequestBranch{SHA: github.Ptr("sha-api-2")},
+		},
+		{
+			Number: github.Ptr(3),
+			Title:  github.Ptr("Refactor handlers"),
+			User:   &github.User{Login: github.Ptr("octocat")},
+			Head:   &github.PullRequestBranch{SHA: github.Ptr("sha-api-3")},
+		},
+	}
+	webPRs := []*github.PullRequest{
+		{
+			Number:  github.Ptr(7),
+			Title:   github.Ptr("build(deps): bump lodash from 4.17.20 to 4.17.21 in /client"),
+			HTMLURL: github.Ptr("https://github.com/octo-org/web/pull/7"),
+			User:    &github.User{Login: github.Ptr("dependabot[bot]")},
+			Head:    &github.PullRequestBranch{SHA: github.Ptr("sha-web-7")},
+		},
+		{
+			Number:  github.Ptr(8),
+			Title:   github.Ptr("Bump express from 4.21.0 to 5.0.1"),
+			HTMLURL: github.Ptr("https://github.com/octo-org/web/pull/8"),
+			User:    &github.User{Login: github.Ptr("dependabot[bot]")},
+			Head:    &github.PullRequestBranch{SHA: github.Ptr("sha-web-8")},
+		},
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{"state": "open", "per_page": "100"}).andThen(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					if strings.HasPrefix(r.URL.Path, "/repos/octo-org/web/") {
+						_, _ = w.Write(mock.MustMarshal(webPRs))
+						return
+					}
+					_, _ = w.Write(mock.MustMarshal(apiPRs))
+				}),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsStatusByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				if strings.Contains(r.URL.Path, "sha-api-1") {
+					_, _ = w.Write(mock.MustMarshal(&github.CombinedStatus{State: github.Ptr("success"), TotalCount: github.Ptr(1)}))
+					return
+				}
+				_, _ = w.Write(mock.MustMarshal(&github.CombinedStatus{State: github.Ptr("pending"), TotalCount: github.Ptr(0)}))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				runs := &github.ListCheckRunsResults{}
+				switch {
+				case strings.Contains(r.URL.Path, "sha-web-7"):
+					runs.CheckRuns = []*github.CheckRun{{Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")}}
+				case strings.Contains(r.URL.Path, "sha-web-8"):
+					runs.CheckRuns = []*github.CheckRun{{Status: github.Ptr("in_progress")}}
+				case strings.Contains(r.URL.Path, "sha-api-1"):
+					runs.CheckRuns = []*github.CheckRun{{Status: github.Ptr("completed"), Conclusion: github.Ptr("success")}}
+				}
+				_, _ = w.Write(mock.MustMarshal(runs))
+			}),
+		),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedTotal  int
+		expectedGroups []DependencyUpdateGroup
+		expectedErrors map[string]string
+	}{
+		{
+			name:         "groups bot pull requests by package and severity",
+			mockedClient: mockedClient,
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api", "octo-org/web"},
+			},
+			expectedTotal: 4,
+			expectedGroups: []DependencyUpdateGroup{
+				{
+					Package:  "react",
+					Severity: DependencyUpdateSeveritySecurity,
+					PullRequests: []DependencyUpdatePR{
+						{Repository: "octo-org/api", Number: 2, Title: "Update dependency react to v18.3.1 [SECURITY]", URL: "https://github.com/octo-org/api/pull/2", Author: "renovate[bot]", To: "18.3.1", CheckStatus: CheckStatusNone},
+					},
+				},
+				{
+					Package:  "express",
+					Severity: DependencyUpdateSeverityMajor,
+					PullRequests: []DependencyUpdatePR{
+						{Repository: "octo-org/web", Number: 8, Title: "Bump express from 4.21.0 to 5.0.1", URL: "https://github.com/octo-org/web/pull/8", Author: "dependabot[bot]", From: "4.21.0", To: "5.0.1", CheckStatus: CheckStatusPending},
+					},
+				},
+				{
+					Package:  "lodash",
+					Severity: DependencyUpdateSeverityPatch,
+					PullRequests: []DependencyUpdatePR{
+						{Repository: "octo-org/api", Number: 1, Title: "Bump lodash from 4.17.20 to 4.17.21", URL: "https://github.com/octo-org/api/pull/1", Author: "dependabot[bot]", From: "4.17.20", To: "4.17.21", CheckStatus: CheckStatusSuccess},
+						{Repository: "octo-org/web", Number: 7, Title: "build(deps): bump lodash from 4.17.20 to 4.17.21 in /client", URL: "https://github.com/octo-org/web/pull/7", Author: "dependabot[bot]", From: "4.17.20", To: "4.17.21", CheckStatus: CheckStatusFailure},
+					},
+				},
+			},
+		},
+		{
+			name:         "filters by custom authors and reports invalid repositories",
+			mockedClient: mockedClient,
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api", "bad"},
+				"authors":      []interface{}{"renovate[bot]"},
+			},
+			expectedTotal: 1,
+			expectedGroups: []DependencyUpdateGroup{
+				{
+					Package:  "react",
+					Severity: DependencyUpdateSeveritySecurity,
+					PullRequests: []DependencyUpdatePR{
+						{Repository: "octo-org/api", Number: 2, Title: "Update dependency react to v18.3.1 [SECURITY]", URL: "https://github.com/octo-org/api/pull/2", Author: "renovate[bot]", To: "18.3.1", CheckStatus: CheckStatusNone},
+					},
+				},
+			},
+			expectedErrors: map[string]string{
+				"bad": `invalid repository "bad": expected format 'owner/repo'`,
+			},
+		},
+		{
+			name:           "missing repositories",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: repositories",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListDependencyUpdatePRs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Groups     []DependencyUpdateGroup `json:"groups"`
+				TotalCount int                     `json:"total_count"`
+				Errors     map[string]string       `json:"errors"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedTotal, response.TotalCount)
+			assert.Equal(t, tc.expectedGroups, response.Groups)
+			assert.Equal(t, tc.expectedErrors, response.Errors)
+		})
+	}
+}
+
+func Test_ListDependencyUpdatePRs_PaginationAndStatusErrors(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("page") == "2" {
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(mock.MustMarshal([]*github.PullRequest{{
+						Number:  github.Ptr(101),
+						Title:   github.Ptr("Bump lodash from 4.17.20 to 4.17.21"),
+						HTMLURL: github.Ptr("https://github.com/octo-org/api/pull/101"),
+						User:    &github.User{Login: github.Ptr("dependabot[bot]")},
+						Head:    &github.PullRequestBranch{SHA: github.Ptr("sha-api-101")},
+					}}))
+					return
+				}
+				w.Header().Set("Link", `<https://api.github.com/repos/octo-org/api/pulls?state=open&per_page=100&page=2>; rel="next"`)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(mock.MustMarshal([]*github.PullRequest{{
+					Number: github.Ptr(1),
+					Title:  github.Ptr("Refactor handlers"),
+					User:   &github.User{Login: github.Ptr("octocat")},
+				}}))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsStatusByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(mock.MustMarshal(&github.ListCheckRunsResults{
+					CheckRuns: []*github.CheckRun{{Status: github.Ptr("completed"), Conclusion: github.Ptr("success")}},
+				}))
+			}),
+		),
+	)
+
+	_, handler := ListDependencyUpdatePRs(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"repositories": []interface{}{"octo-org/api"},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var response struct {
+		Groups     []DependencyUpdateGroup `json:"groups"`
+		TotalCount int                     `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Equal(t, 1, response.TotalCount)
+	pr := response.Groups[0].PullRequests[0]
+	assert.Equal(t, 101, pr.Number)
+	// A status that could not be fetched must not be reported as passing or pending.
+	assert.Equal(t, CheckStatusUnknown, pr.CheckStatus)
+}
+
+func Test_parseDependencyUpdateTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		pkg   string
+		from  string
+		to    string
+	}{
+		{title: "Bump lodash from 4.17.20 to 4.17.21", pkg: "lodash", from: "4.17.20", to: "4.17.21"},
+		{title: "chore(deps): bump github.com/google/go-github/v79 from v79.0.0 to v79.1.0", pkg: "github.com/google/go-github/v79", from: "79.0.0", to: "79.1.0"},
+		{title: "Bump the npm_and_yarn group across 1 directory with 2 updates", pkg: "npm_and_yarn group"},
+		{title: "Update dependency typescript to v5.6.3", pkg: "typescript", to: "5.6.3"},
+		{title: "chore(deps): update module golang.org/x/net to v0.38.0", pkg: "golang.org/x/net", to: "0.38.0"},
+		{title: "Pin dependencies", pkg: "Pin dependencies"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.title, func(t *testing.T) {
+			pkg, from, to := parseDependencyUpdateTitle(tc.title)
+			assert.Equal(t, tc.pkg, pkg)
+			assert.Equal(t, tc.from, from)
+			assert.Equal(t, tc.to, to)
+		})
+	}
+}
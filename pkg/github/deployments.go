@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// maxDeploymentsScanned bounds the number of recent deployments inspected when looking for the last successful one.
+	maxDeploymentsScanned = 30
+	// maxEnvironmentDriftCommits bounds the number of commits returned by compare_environment_deployments.
+	maxEnvironmentDriftCommits = 100
+)
+
+// EnvironmentDeployment is the latest successful deployment to an environment.
+type EnvironmentDeployment struct {
+	Environment  string            `json:"environment"`
+	DeploymentID int64             `json:"deployment_id"`
+	SHA          string            `json:"sha"`
+	Ref          string            `json:"ref,omitempty"`
+	Creator      string            `json:"creator,omitempty"`
+	DeployedAt   *github.Timestamp `json:"deployed_at,omitempty"`
+}
+
+// DriftCommit is a commit that is deployed to one environment but not another.
+type DriftCommit struct {
+	SHA          string `json:"sha"`
+	Message      string `json:"message"`
+	Author       string `json:"author,omitempty"`
+	HTMLURL      string `json:"html_url,omitempty"`
+	PullRequests []int  `json:"pull_requests,omitempty"`
+}
+
+// EnvironmentDrift is the difference between the commits deployed to two environments.
+type EnvironmentDrift struct {
+	Base     *EnvironmentDeployment `json:"base"`
+	Head     *EnvironmentDeployment `json:"head"`
+	Status   string                 `json:"status"`
+	AheadBy  int                    `json:"ahead_by"`
+	BehindBy int                    `json:"behind_by"`
+	Commits  []DriftCommit          `json:"commits"`
+	// Truncated is set when head is ahead by more commits than returned.
+	Truncated    bool                 `json:"truncated"`
+	PullRequests []MinimalPullRequest `json:"pull_requests,omitempty"`
+}
+
+// getLatestSuccessfulDeployment returns the most recent deployment to environment whose latest status is "success".
+// It returns a nil deployment if none of the recent deployments succeeded.
+func getLatestSuccessfulDeployment(ctx context.Context, client *github.Client, owner, repo, environment string) (*EnvironmentDeployment, *github.Response, error) {
+	deployments, resp, err := client.Repositories.ListDeployments(ctx, owner, repo, &github.DeploymentsListOptions{
+		Environment: environment,
+		ListOptions: github.ListOptions{PerPage: maxDeploymentsScanned},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	for _, deployment := range deployments {
+		// Statuses are returned newest first, so the first one is the current state of the deployment.
+		statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, owner, repo, deployment.GetID(), &github.ListOptions{PerPage: 1})
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		if len(statuses) == 0 || statuses[0].GetState() != "success" {
+			continue
+		}
+		return &EnvironmentDeployment{
+			Environment:  environment,
+			DeploymentID: deployment.GetID(),
+			SHA:          deployment.GetSHA(),
+			Ref:          deployment.GetRef(),
+			Creator:      deployment.GetCreator().GetLogin(),
+			DeployedAt:   statuses[0].CreatedAt,
+		}, resp, nil
+	}
+
+	return nil, resp, nil
+}
+
+// CompareEnvironmentDeployments creates a tool to report the commits and pull requests deployed to one environment but not another.
+func CompareEnvironmentDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_environment_deployments",
+			mcp.WithDescription(t("TOOL_COMPARE_ENVIRONMENT_DEPLOYMENTS_DESCRIPTION", "Compare the latest successful deployments of two environments and list the commits and pull requests deployed to the head environment but not to the base environment, e.g. what is in staging but not yet in production.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_ENVIRONMENT_DEPLOYMENTS_USER_TITLE", "Compare environment deployments"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[EnvironmentDrift](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base_environment",
+				mcp.Required(),
+				mcp.Description("Environment to compare against, e.g. 'production'"),
+			),
+			mcp.WithString("head_environment",
+				mcp.Required(),
+				mcp.Description("Environment expected to be ahead, e.g. 'staging'"),
+			),
+			mcp.WithBoolean("include_pull_requests",
+				mcp.Description("Look up the pull requests associated with each commit. Requires one API call per commit"),
+				mcp.DefaultBool(true),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			baseEnvironment, err := RequiredParam[string](request, "base_environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			headEnvironment, err := RequiredParam[string](request, "head_environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includePullRequests, err := OptionalBoolParamWithDefault(request, "include_pull_requests", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments := make([]*EnvironmentDeployment, 0, 2)
+			for _, environment := range []string{baseEnvironment, headEnvironment} {
+				deployment, resp, err := getLatestSuccessfulDeployment(ctx, client, owner, repo, environment)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get deployments for environment %q", environment),
+						resp,
+						err,
+					), nil
+				}
+				if deployment == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("no successful deployment found for environment %q", environment)), nil
+				}
+				deployments = append(deployments, deployment)
+			}
+			base, head := deployments[0], deployments[1]
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base.SHA, head.SHA, &github.ListOptions{PerPage: maxEnvironmentDriftCommits})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to compare deployed commits",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			commits := make([]DriftCommit, 0, len(comparison.Commits))
+			pullRequests := []MinimalPullRequest{}
+			seenPullRequests := map[int]bool{}
+			for _, commit := range comparison.Commits {
+				message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+				driftCommit := DriftCommit{
+					SHA:     commit.GetSHA(),
+					Message: sanitize.Sanitize(message),
+					Author:  commit.GetAuthor().GetLogin(),
+					HTMLURL: commit.GetHTMLURL(),
+				}
+				if driftCommit.Author == "" {
+					driftCommit.Author = commit.GetCommit().GetAuthor().GetName()
+				}
+
+				if includePullRequests {
+					prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, commit.GetSHA(), &github.ListOptions{PerPage: 10})
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							"failed to list pull requests for commit",
+							resp,
+							err,
+						), nil
+					}
+					_ = resp.Body.Close()
+
+					for _, pr := range prs {
+						driftCommit.PullRequests = append(driftCommit.PullRequests, pr.GetNumber())
+						if seenPullRequests[pr.GetNumber()] {
+							continue
+						}
+						seenPullRequests[pr.GetNumber()] = true
+						if pr.Title != nil {
+							pr.Title = github.Ptr(sanitize.Sanitize(*pr.Title))
+						}
+						pullRequests = append(pullRequests, convertToMinimalPullRequest(pr))
+					}
+				}
+
+				commits = append(commits, driftCommit)
+			}
+
+			response := EnvironmentDrift{
+				Base:      base,
+				Head:      head,
+				Status:    comparison.GetStatus(),
+				AheadBy:   comparison.GetAheadBy(),
+				BehindBy:  comparison.GetBehindBy(),
+				Commits:   commits,
+				Truncated: comparison.GetAheadBy() > len(commits),
+			}
+			if includePullRequests {
+				response.PullRequests = pullRequests
+			}
+
+			return MarshalledStructuredResult(response), nil
+		}
+}
@@ -0,0 +1,229 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareEnvironmentDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareEnvironmentDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "compare_environment_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base_environment")
+	assert.Contains(t, tool.InputSchema.Properties, "head_environment")
+	assert.Contains(t, tool.InputSchema.Properties, "include_pull_requests")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base_environment", "head_environment"})
+
+	deploymentsByEnvironment := map[string][]*github.Deployment{
+		"production": {
+			{ID: github.Ptr(int64(11)), SHA: github.Ptr("prodsha"), Ref: github.Ptr("main")},
+		},
+		"staging": {
+			{ID: github.Ptr(int64(22)), SHA: github.Ptr("failedsha"), Ref: github.Ptr("main")},
+			{ID: github.Ptr(int64(21)), SHA: github.Ptr("stagingsha"), Ref: github.Ptr("main")},
+		},
+	}
+	statusesByDeployment := map[string][]*github.DeploymentStatus{
+		"11": {{State: github.Ptr("success")}},
+		"21": {{State: github.Ptr("success")}},
+		"22": {{State: github.Ptr("failure")}},
+	}
+
+	deploymentsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(mock.MustMarshal(deploymentsByEnvironment[r.URL.Query().Get("environment")]))
+	})
+	statusesHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Split(strings.TrimSuffix(r.URL.Path, "/statuses"), "/")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(mock.MustMarshal(statusesByDeployment[id[len(id)-1]]))
+	})
+
+	mockComparison := &github.CommitsComparison{
+		Status:   github.Ptr("ahead"),
+		AheadBy:  github.Ptr(2),
+		BehindBy: github.Ptr(0),
+		Commits: []*github.RepositoryCommit{
+			{
+				SHA:    github.Ptr("c1"),
+				Commit: &github.Commit{Message: github.Ptr("Add caching (#5)\n\nLonger description")},
+				Author: &github.User{Login: github.Ptr("octocat")},
+			},
+			{
+				SHA:    github.Ptr("c2"),
+				Commit: &github.Commit{Message: github.Ptr("Fix cache key"), Author: &github.CommitAuthor{Name: github.Ptr("Mona")}},
+			},
+		},
+	}
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(5),
+		Title:   github.Ptr("Add caching"),
+		State:   github.Ptr("closed"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/5"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedCommits []DriftCommit
+		expectedPRs     []int
+	}{
+		{
+			name: "lists commits and pull requests deployed to staging but not production",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsByOwnerByRepo, deploymentsHandler),
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId, statusesHandler),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					expectPath(t, "/repos/owner/repo/compare/prodsha...stagingsha").andThen(
+						mockResponse(t, http.StatusOK, mockComparison),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						if strings.Contains(r.URL.Path, "/c1/") {
+							_, _ = w.Write(mock.MustMarshal([]*github.PullRequest{mockPR}))
+							return
+						}
+						_, _ = w.Write([]byte(`[]`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"base_environment": "production",
+				"head_environment": "staging",
+			},
+			expectedCommits: []DriftCommit{
+				{SHA: "c1", Message: "Add caching (#5)", Author: "octocat", PullRequests: []int{5}},
+				{SHA: "c2", Message: "Fix cache key", Author: "Mona"},
+			},
+			expectedPRs: []int{5},
+		},
+		{
+			name: "skips pull request lookups",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsByOwnerByRepo, deploymentsHandler),
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId, statusesHandler),
+				mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, mockComparison),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                 "owner",
+				"repo":                  "repo",
+				"base_environment":      "production",
+				"head_environment":      "staging",
+				"include_pull_requests": false,
+			},
+			expectedCommits: []DriftCommit{
+				{SHA: "c1", Message: "Add caching (#5)", Author: "octocat"},
+				{SHA: "c2", Message: "Fix cache key", Author: "Mona"},
+			},
+		},
+		{
+			name: "no successful deployment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsByOwnerByRepo, deploymentsHandler),
+				mock.WithRequestMatchHandler(mock.GetReposDeploymentsStatusesByOwnerByRepoByDeploymentId, statusesHandler),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"base_environment": "production",
+				"head_environment": "qa",
+			},
+			expectError:    true,
+			expectedErrMsg: `no successful deployment found for environment "qa"`,
+		},
+		{
+			name: "list deployments fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDeploymentsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"base_environment": "production",
+				"head_environment": "staging",
+			},
+			expectError:    true,
+			expectedErrMsg: `failed to get deployments for environment "production"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareEnvironmentDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Base         EnvironmentDeployment `json:"base"`
+				Head         EnvironmentDeployment `json:"head"`
+				Status       string                `json:"status"`
+				AheadBy      int                   `json:"ahead_by"`
+				Commits      []DriftCommit         `json:"commits"`
+				Truncated    bool                  `json:"truncated"`
+				PullRequests []MinimalPullRequest  `json:"pull_requests"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, "prodsha", response.Base.SHA)
+			assert.Equal(t, int64(21), response.Head.DeploymentID)
+			assert.Equal(t, "stagingsha", response.Head.SHA)
+			assert.Equal(t, "ahead", response.Status)
+			assert.Equal(t, 2, response.AheadBy)
+			assert.False(t, response.Truncated)
+			assert.Equal(t, tc.expectedCommits, response.Commits)
+
+			prNumbers := []int{}
+			for _, pr := range response.PullRequests {
+				prNumbers = append(prNumbers, pr.Number)
+			}
+			if tc.expectedPRs == nil {
+				assert.Nil(t, response.PullRequests)
+			} else {
+				assert.Equal(t, tc.expectedPRs, prNumbers)
+			}
+		})
+	}
+}
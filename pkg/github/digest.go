@@ -0,0 +1,368 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// DefaultDigestDiscussions is the default number of notable discussions in a digest.
+const DefaultDigestDiscussions = 5
+
+// DigestDiscussion is a discussion that was active during the period of a digest.
+type DigestDiscussion struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Comments  int       `json:"comments"`
+	Upvotes   int       `json:"upvotes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DigestItem is a pull request or issue of a digest.
+type DigestItem struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Author string `json:"author"`
+}
+
+// WeeklyDigest is the activity of a repository over a date range, along with its markdown rendering.
+type WeeklyDigest struct {
+	Repository         string             `json:"repository"`
+	Since              string             `json:"since"`
+	Until              string             `json:"until"`
+	MergedPullRequests []DigestItem       `json:"merged_pull_requests"`
+	ClosedIssues       []DigestItem       `json:"closed_issues"`
+	NewContributors    []string           `json:"new_contributors"`
+	Discussions        []DigestDiscussion `json:"discussions"`
+	Markdown           string             `json:"markdown"`
+}
+
+// digestItems converts the issues or pull requests of a digest.
+func digestItems(issues []*github.Issue) []DigestItem {
+	items := make([]DigestItem, 0, len(issues))
+	for _, issue := range issues {
+		items = append(items, DigestItem{
+			Number: issue.GetNumber(),
+			Title:  issue.GetTitle(),
+			URL:    issue.GetHTMLURL(),
+			Author: issue.GetUser().GetLogin(),
+		})
+	}
+	return items
+}
+
+// digestDiscussionsQuery lists the discussions of a repository, most recently updated first.
+type digestDiscussionsQuery struct {
+	Repository struct {
+		HasDiscussionsEnabled githubv4.Boolean
+		Discussions           struct {
+			Nodes []struct {
+				Number      githubv4.Int
+				Title       githubv4.String
+				URL         githubv4.String `graphql:"url"`
+				CreatedAt   githubv4.DateTime
+				UpdatedAt   githubv4.DateTime
+				UpvoteCount githubv4.Int
+				Comments    struct {
+					TotalCount githubv4.Int
+				}
+			}
+			PageInfo PageInfoFragment
+		} `graphql:"discussions(first: 50, after: $after, orderBy: {field: UPDATED_AT, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// parseDigestDate parses a date given as YYYY-MM-DD or as a natural expression such as 'last monday'. It returns the
+// first and last day the date covers.
+func parseDigestDate(value string, now time.Time) (time.Time, time.Time, error) {
+	if day, err := time.ParseInLocation(queryDateLayout, value, now.Location()); err == nil {
+		return day, day, nil
+	}
+	from, to, ok := resolveDateExpression(value, now)
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD or an expression such as 'last week'", value)
+	}
+	return from, to, nil
+}
+
+// searchAllIssues returns every issue or pull request matching a search query.
+func searchAllIssues(ctx context.Context, client *github.Client, query string) ([]*github.Issue, *github.Response, error) {
+	var issues []*github.Issue
+	opts := &github.SearchOptions{Sort: "created", Order: "asc", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		result, resp, err := client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		issues = append(issues, result.Issues...)
+		if resp.NextPage == 0 {
+			return issues, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findNewContributors returns the authors of merged pull requests that had no pull request merged in the repository
+// before since. Bots are left out.
+func findNewContributors(ctx context.Context, client *github.Client, owner, repo string, merged []*github.Issue, since time.Time) ([]string, *github.Response, error) {
+	var contributors []string
+	seen := map[string]bool{}
+	for _, pr := range merged {
+		login := pr.GetUser().GetLogin()
+		if login == "" || seen[strings.ToLower(login)] || pr.GetUser().GetType() == "Bot" {
+			continue
+		}
+		seen[strings.ToLower(login)] = true
+
+		query := fmt.Sprintf("repo:%s/%s is:pr is:merged author:%s merged:<%s", owner, repo, login, since.Format(queryDateLayout))
+		result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		if result.GetTotal() == 0 {
+			contributors = append(contributors, login)
+		}
+	}
+	return contributors, nil, nil
+}
+
+// listNotableDiscussions returns the discussions updated between since and until, ordered by the number of comments
+// and upvotes they have.
+func listNotableDiscussions(ctx context.Context, client *githubv4.Client, owner, repo string, since, until time.Time, limit int) ([]DigestDiscussion, error) {
+	var discussions []DigestDiscussion
+	vars := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"after": (*githubv4.String)(nil),
+	}
+	for {
+		var query digestDiscussionsQuery
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return nil, err
+		}
+		if !query.Repository.HasDiscussionsEnabled {
+			return nil, nil
+		}
+		done := !query.Repository.Discussions.PageInfo.HasNextPage
+		for _, node := range query.Repository.Discussions.Nodes {
+			if node.UpdatedAt.Before(since) {
+				done = true
+				break
+			}
+			if node.UpdatedAt.After(until) {
+				continue
+			}
+			discussions = append(discussions, DigestDiscussion{
+				Number:    int(node.Number),
+				Title:     string(node.Title),
+				URL:       string(node.URL),
+				Comments:  int(node.Comments.TotalCount),
+				Upvotes:   int(node.UpvoteCount),
+				CreatedAt: node.CreatedAt.Time,
+			})
+		}
+		if done {
+			break
+		}
+		vars["after"] = githubv4.String(query.Repository.Discussions.PageInfo.EndCursor)
+	}
+
+	sort.SliceStable(discussions, func(i, j int) bool {
+		return discussions[i].Comments+discussions[i].Upvotes > discussions[j].Comments+discussions[j].Upvotes
+	})
+	if len(discussions) > limit {
+		discussions = discussions[:limit]
+	}
+	return discussions, nil
+}
+
+// renderWeeklyDigest renders a digest as markdown.
+func renderWeeklyDigest(owner, repo string, since, until time.Time, merged, closed []*github.Issue, contributors []string, discussions []DigestDiscussion) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s/%s digest: %s to %s\n\n", owner, repo, since.Format(queryDateLayout), until.Format(queryDateLayout))
+	fmt.Fprintf(&b, "%d pull requests merged, %d issues closed, %d new contributors.\n", len(merged), len(closed), len(contributors))
+
+	items := func(title string, issues []*github.Issue) {
+		fmt.Fprintf(&b, "\n## %s\n\n", title)
+		if len(issues) == 0 {
+			b.WriteString("None.\n")
+			return
+		}
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "- [#%d](%s) %s (@%s)\n", issue.GetNumber(), issue.GetHTMLURL(), issue.GetTitle(), issue.GetUser().GetLogin())
+		}
+	}
+	items("Merged pull requests", merged)
+	items("Closed issues", closed)
+
+	b.WriteString("\n## New contributors\n\n")
+	if len(contributors) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, login := range contributors {
+		fmt.Fprintf(&b, "- @%s\n", login)
+	}
+
+	b.WriteString("\n## Notable discussions\n\n")
+	if len(discussions) == 0 {
+		b.WriteString("None.\n")
+	}
+	for _, d := range discussions {
+		fmt.Fprintf(&b, "- [#%d](%s) %s (%d comments, %d upvotes)\n", d.Number, d.URL, d.Title, d.Comments, d.Upvotes)
+	}
+	return b.String()
+}
+
+// GenerateWeeklyDigest creates a tool to summarize the activity of a repository over a date range as markdown.
+func GenerateWeeklyDigest(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("generate_weekly_digest",
+			mcp.WithDescription(t("TOOL_GENERATE_WEEKLY_DIGEST_DESCRIPTION", "Generate a markdown digest of the activity in a repository over a date range, by default the last 7 days: merged pull requests, closed issues, first-time contributors and the most active discussions. Use this instead of assembling a digest from several searches.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GENERATE_WEEKLY_DIGEST_USER_TITLE", "Generate weekly digest"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[WeeklyDigest](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("since",
+				mcp.Description("First day of the digest, as YYYY-MM-DD or an expression such as 'last week' or '2024-W05'. Defaults to 6 days before until"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Last day of the digest, as YYYY-MM-DD or an expression such as 'yesterday'. Defaults to the last day of since if it is an expression such as 'last week', or today"),
+			),
+			mcp.WithNumber("max_discussions",
+				mcp.Description("Maximum number of notable discussions"),
+				mcp.DefaultNumber(DefaultDigestDiscussions),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceParam, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			untilParam, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxDiscussions, err := OptionalIntParamWithDefault(request, "max_discussions", DefaultDigestDiscussions)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			now := flags.now()
+			until := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			var since time.Time
+			if sinceParam != "" {
+				var last time.Time
+				since, last, err = parseDigestDate(sinceParam, now)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("since: %s", err)), nil
+				}
+				if !last.Equal(since) {
+					until = last
+				}
+			}
+			if untilParam != "" {
+				_, until, err = parseDigestDate(untilParam, now)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("until: %s", err)), nil
+				}
+			}
+			if sinceParam == "" {
+				since = until.AddDate(0, 0, -6)
+			}
+			if until.Before(since) {
+				return mcp.NewToolResultError("until must not be before since"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			dateRange := since.Format(queryDateLayout) + ".." + until.Format(queryDateLayout)
+			merged, resp, err := searchAllIssues(ctx, client, fmt.Sprintf("repo:%s/%s is:pr is:merged merged:%s", owner, repo, dateRange))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to search merged pull requests",
+					resp,
+					err,
+				), nil
+			}
+			closed, resp, err := searchAllIssues(ctx, client, fmt.Sprintf("repo:%s/%s is:issue is:closed closed:%s", owner, repo, dateRange))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to search closed issues",
+					resp,
+					err,
+				), nil
+			}
+			contributors, resp, err := findNewContributors(ctx, client, owner, repo, merged, since)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to search earlier pull requests",
+					resp,
+					err,
+				), nil
+			}
+			// The end of the range is inclusive, so discussions updated at any time on the last day count.
+			discussions, err := listNotableDiscussions(ctx, gqlClient, owner, repo, since, until.AddDate(0, 0, 1), maxDiscussions)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list discussions",
+					err,
+				), nil
+			}
+
+			if contributors == nil {
+				contributors = []string{}
+			}
+			if discussions == nil {
+				discussions = []DigestDiscussion{}
+			}
+			digest := WeeklyDigest{
+				Repository:         owner + "/" + repo,
+				Since:              since.Format(queryDateLayout),
+				Until:              until.Format(queryDateLayout),
+				MergedPullRequests: digestItems(merged),
+				ClosedIssues:       digestItems(closed),
+				NewContributors:    contributors,
+				Discussions:        discussions,
+				Markdown:           renderWeeklyDigest(owner, repo, since, until, merged, closed, contributors, discussions),
+			}
+
+			// The text content is the markdown digest, for clients that do not support structured content.
+			return mcp.NewToolResultStructured(digest, digest.Markdown), nil
+		}
+}
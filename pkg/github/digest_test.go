@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseDigestDate(t *testing.T) {
+	now := time.Date(2024, time.May, 15, 10, 0, 0, 0, time.UTC)
+
+	from, to, err := parseDigestDate("2024-05-01", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, from, to)
+
+	from, to, err = parseDigestDate("last week", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, time.May, 12, 0, 0, 0, 0, time.UTC), to)
+
+	_, _, err = parseDigestDate("soon", now)
+	require.ErrorContains(t, err, `invalid date "soon"`)
+}
+
+func Test_GenerateWeeklyDigest(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GenerateWeeklyDigest(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "generate_weekly_digest", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	searchResults := map[string]*github.IssuesSearchResult{
+		"repo:octo/repo is:pr is:merged merged:2024-05-06..2024-05-12": {
+			Total: github.Ptr(3),
+			Issues: []*github.Issue{
+				{Number: github.Ptr(10), Title: github.Ptr("Add caching"), HTMLURL: github.Ptr("https://github.com/octo/repo/pull/10"), User: &github.User{Login: github.Ptr("alice")}},
+				{Number: github.Ptr(11), Title: github.Ptr("Fix typo"), HTMLURL: github.Ptr("https://github.com/octo/repo/pull/11"), User: &github.User{Login: github.Ptr("bob")}},
+				{Number: github.Ptr(12), Title: github.Ptr("Bump deps"), HTMLURL: github.Ptr("https://github.com/octo/repo/pull/12"), User: &github.User{Login: github.Ptr("dependabot[bot]"), Type: github.Ptr("Bot")}},
+			},
+		},
+		"repo:octo/repo is:issue is:closed closed:2024-05-06..2024-05-12": {
+			Total: github.Ptr(1),
+			Issues: []*github.Issue{
+				{Number: github.Ptr(7), Title: github.Ptr("Slow startup"), HTMLURL: github.Ptr("https://github.com/octo/repo/issues/7"), User: &github.User{Login: github.Ptr("carol")}},
+			},
+		},
+		"repo:octo/repo is:pr is:merged author:alice merged:<2024-05-06": {Total: github.Ptr(4)},
+		"repo:octo/repo is:pr is:merged author:bob merged:<2024-05-06":   {Total: github.Ptr(0)},
+	}
+	searchClient := func() *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(mock.GetSearchIssues, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				result, ok := searchResults[r.URL.Query().Get("q")]
+				if !ok {
+					t.Errorf("unexpected search query %q", r.URL.Query().Get("q"))
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					return
+				}
+				mockResponse(t, http.StatusOK, result)(w, r)
+			})),
+		)
+	}
+
+	discussion := func(number int, title string, updatedAt string, comments, upvotes int) map[string]any {
+		return map[string]any{
+			"number":      number,
+			"title":       title,
+			"url":         "https://github.com/octo/repo/discussions/" + title,
+			"createdAt":   "2024-04-01T00:00:00Z",
+			"updatedAt":   updatedAt,
+			"upvoteCount": upvotes,
+			"comments":    map[string]any{"totalCount": comments},
+		}
+	}
+	vars := map[string]interface{}{
+		"owner": githubv4.String("octo"),
+		"repo":  githubv4.String("repo"),
+		"after": (*githubv4.String)(nil),
+	}
+	discussionsResponse := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"hasDiscussionsEnabled": true,
+			"discussions": map[string]any{
+				"nodes": []any{
+					discussion(3, "Future", "2024-05-20T00:00:00Z", 50, 50),
+					discussion(4, "Quiet", "2024-05-12T18:00:00Z", 1, 0),
+					discussion(5, "Roadmap", "2024-05-08T00:00:00Z", 12, 3),
+					discussion(6, "Old", "2024-05-01T00:00:00Z", 40, 0),
+				},
+				"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor"},
+			},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "digest for a date range",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"since": "2024-05-06",
+				"until": "2024-05-12",
+			},
+		},
+		{
+			name: "invalid date",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"since": "a while ago",
+			},
+			expectError:    true,
+			expectedErrMsg: `since: invalid date "a while ago"`,
+		},
+		{
+			name: "until before since",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"since": "2024-05-06",
+				"until": "2024-05-01",
+			},
+			expectError:    true,
+			expectedErrMsg: "until must not be before since",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(digestDiscussionsQuery{}, vars, discussionsResponse),
+			))
+			_, handler := GenerateWeeklyDigest(stubGetClientFn(github.NewClient(searchClient())), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, FeatureFlags{})
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, `# octo/repo digest: 2024-05-06 to 2024-05-12
+
+3 pull requests merged, 1 issues closed, 1 new contributors.
+
+## Merged pull requests
+
+- [#10](https://github.com/octo/repo/pull/10) Add caching (@alice)
+- [#11](https://github.com/octo/repo/pull/11) Fix typo (@bob)
+- [#12](https://github.com/octo/repo/pull/12) Bump deps (@dependabot[bot])
+
+## Closed issues
+
+- [#7](https://github.com/octo/repo/issues/7) Slow startup (@carol)
+
+## New contributors
+
+- @bob
+
+## Notable discussions
+
+- [#5](https://github.com/octo/repo/discussions/Roadmap) Roadmap (12 comments, 3 upvotes)
+- [#4](https://github.com/octo/repo/discussions/Quiet) Quiet (1 comments, 0 upvotes)
+`, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -2,8 +2,8 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/go-viper/mapstructure/v2"
@@ -120,6 +120,46 @@ func getQueryType(useOrdering bool, categoryID *githubv4.ID) any {
 	return &BasicNoOrder{}
 }
 
+// DiscussionsListResult is a page of the discussions of a repository or organisation.
+type DiscussionsListResult struct {
+	Discussions []*github.Discussion `json:"discussions"`
+	PageInfo    CursorPageInfo       `json:"pageInfo"`
+	TotalCount  int                  `json:"totalCount"`
+}
+
+// DiscussionCategory is a discussion category. The ID is omitted when the category is part of a discussion.
+type DiscussionCategory struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// DiscussionResult is a discussion. go-github's Discussion type lacks the isAnswered and answerChosenAt fields.
+type DiscussionResult struct {
+	Number         int                `json:"number"`
+	Title          string             `json:"title"`
+	Body           string             `json:"body"`
+	URL            string             `json:"url"`
+	Closed         bool               `json:"closed"`
+	IsAnswered     bool               `json:"isAnswered"`
+	AnswerChosenAt *time.Time         `json:"answerChosenAt,omitempty"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	Category       DiscussionCategory `json:"category"`
+}
+
+// DiscussionCommentsListResult is a page of the comments of a discussion.
+type DiscussionCommentsListResult struct {
+	Comments   []*github.IssueComment `json:"comments"`
+	PageInfo   CursorPageInfo         `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+// DiscussionCategoriesListResult is a page of the discussion categories of a repository.
+type DiscussionCategoriesListResult struct {
+	Categories []DiscussionCategory `json:"categories"`
+	PageInfo   CursorPageInfo       `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
 func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_discussions",
 			mcp.WithDescription(t("TOOL_LIST_DISCUSSIONS_DESCRIPTION", "List discussions for a repository or organisation.")),
@@ -127,6 +167,7 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 				Title:        t("TOOL_LIST_DISCUSSIONS_USER_TITLE", "List discussions"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[DiscussionsListResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -240,22 +281,16 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"discussions": discussions,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     pageInfo.HasNextPage,
-					"hasPreviousPage": pageInfo.HasPreviousPage,
-					"startCursor":     string(pageInfo.StartCursor),
-					"endCursor":       string(pageInfo.EndCursor),
+			return MarshalledStructuredResult(DiscussionsListResult{
+				Discussions: discussions,
+				PageInfo: CursorPageInfo{
+					HasNextPage:     pageInfo.HasNextPage,
+					HasPreviousPage: pageInfo.HasPreviousPage,
+					StartCursor:     string(pageInfo.StartCursor),
+					EndCursor:       string(pageInfo.EndCursor),
 				},
-				"totalCount": totalCount,
-			}
-
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussions: %w", err)
-			}
-			return mcp.NewToolResultText(string(out)), nil
+				TotalCount: int(totalCount),
+			}), nil
 		}
 }
 
@@ -266,6 +301,7 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 				Title:        t("TOOL_GET_DISCUSSION_USER_TITLE", "Get discussion"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[DiscussionResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -321,34 +357,23 @@ func GetDiscussion(getGQLClient GetGQLClientFn, t translations.TranslationHelper
 			}
 			d := q.Repository.Discussion
 
-			// Build response as map to include fields not present in go-github's Discussion struct.
-			// The go-github library's Discussion type lacks isAnswered and answerChosenAt fields,
-			// so we use map[string]interface{} for the response (consistent with other functions
-			// like ListDiscussions and GetDiscussionComments).
-			response := map[string]interface{}{
-				"number":     int(d.Number),
-				"title":      string(d.Title),
-				"body":       string(d.Body),
-				"url":        string(d.URL),
-				"closed":     bool(d.Closed),
-				"isAnswered": bool(d.IsAnswered),
-				"createdAt":  d.CreatedAt.Time,
-				"category": map[string]interface{}{
-					"name": string(d.Category.Name),
-				},
+			response := DiscussionResult{
+				Number:     int(d.Number),
+				Title:      string(d.Title),
+				Body:       string(d.Body),
+				URL:        string(d.URL),
+				Closed:     bool(d.Closed),
+				IsAnswered: bool(d.IsAnswered),
+				CreatedAt:  d.CreatedAt.Time,
+				Category:   DiscussionCategory{Name: string(d.Category.Name)},
 			}
 
 			// Add optional timestamp fields if present
 			if d.AnswerChosenAt != nil {
-				response["answerChosenAt"] = d.AnswerChosenAt.Time
-			}
-
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussion: %w", err)
+				response.AnswerChosenAt = &d.AnswerChosenAt.Time
 			}
 
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledStructuredResult(response), nil
 		}
 }
 
@@ -359,6 +384,7 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 				Title:        t("TOOL_GET_DISCUSSION_COMMENTS_USER_TITLE", "Get discussion comments"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[DiscussionCommentsListResult](),
 			mcp.WithString("owner", mcp.Required(), mcp.Description("Repository owner")),
 			mcp.WithString("repo", mcp.Required(), mcp.Description("Repository name")),
 			mcp.WithNumber("discussionNumber", mcp.Required(), mcp.Description("Discussion Number")),
@@ -440,23 +466,16 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"comments": comments,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     q.Repository.Discussion.Comments.PageInfo.HasNextPage,
-					"hasPreviousPage": q.Repository.Discussion.Comments.PageInfo.HasPreviousPage,
-					"startCursor":     string(q.Repository.Discussion.Comments.PageInfo.StartCursor),
-					"endCursor":       string(q.Repository.Discussion.Comments.PageInfo.EndCursor),
+			return MarshalledStructuredResult(DiscussionCommentsListResult{
+				Comments: comments,
+				PageInfo: CursorPageInfo{
+					HasNextPage:     bool(q.Repository.Discussion.Comments.PageInfo.HasNextPage),
+					HasPreviousPage: bool(q.Repository.Discussion.Comments.PageInfo.HasPreviousPage),
+					StartCursor:     string(q.Repository.Discussion.Comments.PageInfo.StartCursor),
+					EndCursor:       string(q.Repository.Discussion.Comments.PageInfo.EndCursor),
 				},
-				"totalCount": q.Repository.Discussion.Comments.TotalCount,
-			}
-
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal comments: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(out)), nil
+				TotalCount: q.Repository.Discussion.Comments.TotalCount,
+			}), nil
 		}
 }
 
@@ -467,6 +486,7 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 				Title:        t("TOOL_LIST_DISCUSSION_CATEGORIES_USER_TITLE", "List discussion categories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[DiscussionCategoriesListResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -521,30 +541,24 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			var categories []map[string]string
+			var categories []DiscussionCategory
 			for _, c := range q.Repository.DiscussionCategories.Nodes {
-				categories = append(categories, map[string]string{
-					"id":   fmt.Sprint(c.ID),
-					"name": string(c.Name),
+				categories = append(categories, DiscussionCategory{
+					ID:   fmt.Sprint(c.ID),
+					Name: string(c.Name),
 				})
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"categories": categories,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     q.Repository.DiscussionCategories.PageInfo.HasNextPage,
-					"hasPreviousPage": q.Repository.DiscussionCategories.PageInfo.HasPreviousPage,
-					"startCursor":     string(q.Repository.DiscussionCategories.PageInfo.StartCursor),
-					"endCursor":       string(q.Repository.DiscussionCategories.PageInfo.EndCursor),
+			return MarshalledStructuredResult(DiscussionCategoriesListResult{
+				Categories: categories,
+				PageInfo: CursorPageInfo{
+					HasNextPage:     bool(q.Repository.DiscussionCategories.PageInfo.HasNextPage),
+					HasPreviousPage: bool(q.Repository.DiscussionCategories.PageInfo.HasPreviousPage),
+					StartCursor:     string(q.Repository.DiscussionCategories.PageInfo.StartCursor),
+					EndCursor:       string(q.Repository.DiscussionCategories.PageInfo.EndCursor),
 				},
-				"totalCount": q.Repository.DiscussionCategories.TotalCount,
-			}
-
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal discussion categories: %w", err)
-			}
-			return mcp.NewToolResultText(string(out)), nil
+				TotalCount: q.Repository.DiscussionCategories.TotalCount,
+			}), nil
 		}
 }
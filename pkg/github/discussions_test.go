@@ -469,6 +469,8 @@ func Test_ListDiscussions(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Len(t, response.Discussions, tc.expectedCount, "Expected %d discussions, got %d", tc.expectedCount, len(response.Discussions))
+			require.IsType(t, DiscussionsListResult{}, res.StructuredContent)
+			assert.Len(t, res.StructuredContent.(DiscussionsListResult).Discussions, tc.expectedCount)
 
 			// Verify order if verifyOrder function is provided
 			if tc.verifyOrder != nil {
@@ -20,6 +20,8 @@ func ToolsetEnum(toolsetGroup *toolsets.ToolsetGroup) mcp.PropertyOption {
 }
 
 func EnableToolset(s *server.MCPServer, toolsetGroup *toolsets.ToolsetGroup, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	toolsetAlreadyEnabledMessage := t("RESULT_TOOLSET_ALREADY_ENABLED", "Toolset %s is already enabled")
+	toolsetEnabledMessage := t("RESULT_TOOLSET_ENABLED", "Toolset %s enabled")
 	return mcp.NewTool("enable_toolset",
 			mcp.WithDescription(t("TOOL_ENABLE_TOOLSET_DESCRIPTION", "Enable one of the sets of tools the GitHub MCP server provides, use get_toolset_tools and list_available_toolsets first to see what this will enable")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -44,7 +46,7 @@ func EnableToolset(s *server.MCPServer, toolsetGroup *toolsets.ToolsetGroup, t t
 				return mcp.NewToolResultError(fmt.Sprintf("Toolset %s not found", toolsetName)), nil
 			}
 			if toolset.Enabled {
-				return mcp.NewToolResultText(fmt.Sprintf("Toolset %s is already enabled", toolsetName)), nil
+				return mcp.NewToolResultText(fmt.Sprintf(toolsetAlreadyEnabledMessage, toolsetName)), nil
 			}
 
 			toolset.Enabled = true
@@ -55,7 +57,7 @@ func EnableToolset(s *server.MCPServer, toolsetGroup *toolsets.ToolsetGroup, t t
 			// s.sendNotificationToAllClients("notifications/tools/list_changed", nil)
 			s.AddTools(toolset.GetActiveTools()...)
 
-			return mcp.NewToolResultText(fmt.Sprintf("Toolset %s enabled", toolsetName)), nil
+			return mcp.NewToolResultText(fmt.Sprintf(toolsetEnabledMessage, toolsetName)), nil
 		}
 }
 
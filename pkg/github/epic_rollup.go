@@ -0,0 +1,343 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultTargetDateField is the name of the date field holding the target date of project items.
+	DefaultTargetDateField = "Target date"
+	// MaxEpicIssues is the maximum number of issues of the sub-issue tree of an epic that are rolled up.
+	MaxEpicIssues = 500
+)
+
+// EpicIssue is an issue of the sub-issue tree of an epic.
+type EpicIssue struct {
+	Issue      string   `json:"issue"`
+	Title      string   `json:"title"`
+	State      string   `json:"state"`
+	URL        string   `json:"url"`
+	Parent     string   `json:"parent,omitempty"`
+	Depth      int      `json:"depth"`
+	Assignees  []string `json:"assignees,omitempty"`
+	Points     *float64 `json:"points,omitempty"`
+	TargetDate string   `json:"target_date,omitempty"`
+}
+
+// EpicAssignee is the number of open and closed issues of an epic assigned to a person.
+type EpicAssignee struct {
+	Login  string `json:"login"`
+	Open   int    `json:"open"`
+	Closed int    `json:"closed"`
+}
+
+// EpicRollup aggregates the sub-issue tree of an epic.
+type EpicRollup struct {
+	Epic EpicIssue `json:"epic"`
+	// Total and Closed count the issues of the tree, without the epic itself.
+	Total           int     `json:"total"`
+	Closed          int     `json:"closed"`
+	PercentComplete float64 `json:"percent_complete"`
+	TotalPoints     float64 `json:"total_points"`
+	ClosedPoints    float64 `json:"closed_points"`
+	// PointsPercentComplete is the share of the points that are closed, when issues have points.
+	PointsPercentComplete *float64       `json:"points_percent_complete,omitempty"`
+	UnestimatedIssues     int            `json:"unestimated_issues"`
+	LatestTargetDate      string         `json:"latest_target_date,omitempty"`
+	OverdueIssues         []string       `json:"overdue_issues"`
+	Assignees             []EpicAssignee `json:"assignees"`
+	Issues                []EpicIssue    `json:"issues"`
+	// Truncated is true when issues of the tree were left out, because an issue has more sub-issues than are loaded
+	// or the tree has more than MaxEpicIssues issues.
+	Truncated bool `json:"truncated"`
+}
+
+// epicIssueFields selects an issue of the sub-issue tree of an epic, with the points and target date it has in
+// projects.
+type epicIssueFields struct {
+	ID         githubv4.ID
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String
+	URL        githubv4.String `graphql:"url"`
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+	Assignees struct {
+		Nodes []struct {
+			Login githubv4.String
+		}
+	} `graphql:"assignees(first: 10)"`
+	ProjectItems struct {
+		Nodes []struct {
+			Points struct {
+				Number struct {
+					Number *githubv4.Float
+				} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+			} `graphql:"points: fieldValueByName(name: $pointsField)"`
+			TargetDate struct {
+				Date struct {
+					Date *githubv4.String
+				} `graphql:"... on ProjectV2ItemFieldDateValue"`
+			} `graphql:"targetDate: fieldValueByName(name: $targetDateField)"`
+		}
+	} `graphql:"projectItems(first: 10)"`
+	SubIssues struct {
+		TotalCount githubv4.Int
+		Nodes      []struct {
+			ID githubv4.ID
+		}
+	} `graphql:"subIssues(first: 50)"`
+}
+
+// epicRootQuery selects the epic.
+type epicRootQuery struct {
+	Repository struct {
+		Issue *epicIssueFields `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// epicSubIssuesQuery selects a batch of sub-issues, given by node ID.
+type epicSubIssuesQuery struct {
+	Nodes []struct {
+		Issue epicIssueFields `graphql:"... on Issue"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+func (f epicIssueFields) issue(parent string, depth int) EpicIssue {
+	issue := EpicIssue{
+		Issue:  fmt.Sprintf("%s#%d", f.Repository.NameWithOwner, f.Number),
+		Title:  string(f.Title),
+		State:  string(f.State),
+		URL:    string(f.URL),
+		Parent: parent,
+		Depth:  depth,
+	}
+	for _, assignee := range f.Assignees.Nodes {
+		issue.Assignees = append(issue.Assignees, string(assignee.Login))
+	}
+	// An issue in several projects takes the first values it has.
+	for _, item := range f.ProjectItems.Nodes {
+		if issue.Points == nil && item.Points.Number.Number != nil {
+			points := float64(*item.Points.Number.Number)
+			issue.Points = &points
+		}
+		if issue.TargetDate == "" && item.TargetDate.Date.Date != nil {
+			issue.TargetDate = string(*item.TargetDate.Date.Date)
+		}
+	}
+	return issue
+}
+
+// percent returns part as a percentage of total, rounded to one decimal.
+func percent(part, total float64) float64 {
+	return math.Round(part/total*1000) / 10
+}
+
+// rollUpEpic aggregates the issues of the sub-issue tree of an epic. Target dates before today are overdue for open
+// issues.
+func rollUpEpic(epic EpicIssue, issues []EpicIssue, today string) EpicRollup {
+	rollup := EpicRollup{
+		Epic:          epic,
+		Total:         len(issues),
+		OverdueIssues: []string{},
+		Assignees:     []EpicAssignee{},
+		Issues:        issues,
+	}
+	assignees := map[string]*EpicAssignee{}
+	for _, issue := range issues {
+		closed := strings.EqualFold(issue.State, "CLOSED")
+		if closed {
+			rollup.Closed++
+		}
+		if issue.Points != nil {
+			rollup.TotalPoints += *issue.Points
+			if closed {
+				rollup.ClosedPoints += *issue.Points
+			}
+		} else {
+			rollup.UnestimatedIssues++
+		}
+		if issue.TargetDate != "" {
+			if issue.TargetDate > rollup.LatestTargetDate {
+				rollup.LatestTargetDate = issue.TargetDate
+			}
+			if !closed && issue.TargetDate < today {
+				rollup.OverdueIssues = append(rollup.OverdueIssues, issue.Issue)
+			}
+		}
+		for _, login := range issue.Assignees {
+			a, ok := assignees[strings.ToLower(login)]
+			if !ok {
+				a = &EpicAssignee{Login: login}
+				assignees[strings.ToLower(login)] = a
+			}
+			if closed {
+				a.Closed++
+			} else {
+				a.Open++
+			}
+		}
+	}
+	if rollup.Total > 0 {
+		rollup.PercentComplete = percent(float64(rollup.Closed), float64(rollup.Total))
+	}
+	if rollup.TotalPoints > 0 {
+		p := percent(rollup.ClosedPoints, rollup.TotalPoints)
+		rollup.PointsPercentComplete = &p
+	}
+	for _, a := range assignees {
+		rollup.Assignees = append(rollup.Assignees, *a)
+	}
+	sort.Slice(rollup.Assignees, func(i, j int) bool {
+		return strings.ToLower(rollup.Assignees[i].Login) < strings.ToLower(rollup.Assignees[j].Login)
+	})
+	return rollup
+}
+
+// GetEpicRollup creates a tool to aggregate the sub-issue tree of an issue.
+func GetEpicRollup(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_epic_rollup",
+			mcp.WithDescription(t("TOOL_GET_EPIC_ROLLUP_DESCRIPTION", "Roll up the progress of an epic: walks the whole sub-issue tree of a parent issue and aggregates the states, assignees, points and target dates of the sub-issues, taking points and target dates from project fields. Returns the completion percentage by issues and by points, overdue issues, the workload per assignee and the issues of the tree.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_EPIC_ROLLUP_USER_TITLE", "Get epic rollup"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[EpicRollup](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("The number of the epic issue"),
+			),
+			mcp.WithString("points_field",
+				mcp.Description("Name of the number field of the projects holding the points of issues"),
+				mcp.DefaultString(DefaultPointsField),
+			),
+			mcp.WithString("target_date_field",
+				mcp.Description("Name of the date field of the projects holding the target date of issues"),
+				mcp.DefaultString(DefaultTargetDateField),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pointsField, err := OptionalParam[string](request, "points_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if pointsField == "" {
+				pointsField = DefaultPointsField
+			}
+			targetDateField, err := OptionalParam[string](request, "target_date_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if targetDateField == "" {
+				targetDateField = DefaultTargetDateField
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var root epicRootQuery
+			if err := gqlClient.Query(ctx, &root, map[string]any{
+				"owner":           githubv4.String(owner),
+				"repo":            githubv4.String(repo),
+				"issueNumber":     githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				"pointsField":     githubv4.String(pointsField),
+				"targetDateField": githubv4.String(targetDateField),
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get epic",
+					err,
+				), nil
+			}
+			if root.Repository.Issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue %s/%s#%d not found", owner, repo, issueNumber)), nil
+			}
+			epic := root.Repository.Issue.issue("", 0)
+
+			// Walk the tree level by level, loading the sub-issues of a level in batches.
+			issues := []EpicIssue{}
+			truncated := false
+			type pending struct {
+				id     githubv4.ID
+				parent string
+			}
+			var level []pending
+			expand := func(fields epicIssueFields, issue EpicIssue) {
+				if int(fields.SubIssues.TotalCount) > len(fields.SubIssues.Nodes) {
+					truncated = true
+				}
+				for _, node := range fields.SubIssues.Nodes {
+					level = append(level, pending{id: node.ID, parent: issue.Issue})
+				}
+			}
+			expand(*root.Repository.Issue, epic)
+			for depth := 1; len(level) > 0; depth++ {
+				current := level
+				level = nil
+				if len(issues)+len(current) > MaxEpicIssues {
+					current = current[:MaxEpicIssues-len(issues)]
+					truncated = true
+				}
+				parents := map[githubv4.ID]string{}
+				ids := make([]githubv4.ID, 0, len(current))
+				for _, p := range current {
+					parents[p.id] = p.parent
+					ids = append(ids, p.id)
+				}
+				for start := 0; start < len(ids); start += maxNodesPerQuery {
+					var q epicSubIssuesQuery
+					if err := gqlClient.Query(ctx, &q, map[string]any{
+						"ids":             ids[start:min(start+maxNodesPerQuery, len(ids))],
+						"pointsField":     githubv4.String(pointsField),
+						"targetDateField": githubv4.String(targetDateField),
+					}); err != nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+							"failed to get sub-issues",
+							err,
+						), nil
+					}
+					for _, node := range q.Nodes {
+						issue := node.Issue.issue(parents[node.Issue.ID], depth)
+						issues = append(issues, issue)
+						expand(node.Issue, issue)
+					}
+				}
+			}
+
+			rollup := rollUpEpic(epic, issues, flags.now().Format(queryDateLayout))
+			rollup.Truncated = truncated
+			return MarshalledStructuredResult(rollup), nil
+		}
+}
@@ -0,0 +1,136 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RollUpEpic(t *testing.T) {
+	epic := EpicIssue{Issue: "octo/api#1", State: "OPEN"}
+	issues := []EpicIssue{
+		{Issue: "octo/api#2", State: "CLOSED", Assignees: []string{"alice"}, Points: github.Ptr(3.0), TargetDate: "2024-05-01"},
+		{Issue: "octo/api#3", State: "OPEN", Assignees: []string{"bob", "Alice"}, Points: github.Ptr(5.0), TargetDate: "2024-05-10"},
+		{Issue: "octo/web#4", State: "OPEN", TargetDate: "2024-06-01"},
+	}
+
+	rollup := rollUpEpic(epic, issues, "2024-05-15")
+	assert.Equal(t, 3, rollup.Total)
+	assert.Equal(t, 1, rollup.Closed)
+	assert.Equal(t, 33.3, rollup.PercentComplete)
+	assert.Equal(t, 8.0, rollup.TotalPoints)
+	assert.Equal(t, 3.0, rollup.ClosedPoints)
+	assert.Equal(t, github.Ptr(37.5), rollup.PointsPercentComplete)
+	assert.Equal(t, 1, rollup.UnestimatedIssues)
+	assert.Equal(t, "2024-06-01", rollup.LatestTargetDate)
+	assert.Equal(t, []string{"octo/api#3"}, rollup.OverdueIssues)
+	assert.Equal(t, []EpicAssignee{{Login: "alice", Open: 1, Closed: 1}, {Login: "bob", Open: 1}}, rollup.Assignees)
+
+	empty := rollUpEpic(epic, []EpicIssue{}, "2024-05-15")
+	assert.Zero(t, empty.PercentComplete)
+	assert.Nil(t, empty.PointsPercentComplete)
+}
+
+func Test_GetEpicRollup(t *testing.T) {
+	tool, _ := GetEpicRollup(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	issue := func(id string, number int, state string, assignees []string, points float64, targetDate string, subIssues []string) map[string]any {
+		assigneeNodes := []any{}
+		for _, login := range assignees {
+			assigneeNodes = append(assigneeNodes, map[string]any{"login": login})
+		}
+		subIssueNodes := []any{}
+		for _, subIssue := range subIssues {
+			subIssueNodes = append(subIssueNodes, map[string]any{"id": subIssue})
+		}
+		projectItems := []any{}
+		if points > 0 {
+			projectItems = append(projectItems, map[string]any{
+				"points":     map[string]any{"number": points},
+				"targetDate": map[string]any{"date": targetDate},
+			})
+		}
+		return map[string]any{
+			"id":           id,
+			"number":       number,
+			"title":        "Issue " + id,
+			"state":        state,
+			"url":          "https://github.com/octo/api/issues/" + id,
+			"repository":   map[string]any{"nameWithOwner": "octo/api"},
+			"assignees":    map[string]any{"nodes": assigneeNodes},
+			"projectItems": map[string]any{"nodes": projectItems},
+			"subIssues":    map[string]any{"totalCount": len(subIssues), "nodes": subIssueNodes},
+		}
+	}
+
+	rootMatcher := githubv4mock.NewQueryMatcher(
+		epicRootQuery{},
+		map[string]any{
+			"owner":           githubv4.String("octo"),
+			"repo":            githubv4.String("api"),
+			"issueNumber":     githubv4.Int(1),
+			"pointsField":     githubv4.String("Estimate"),
+			"targetDateField": githubv4.String("Target date"),
+		},
+		githubv4mock.DataResponse(map[string]any{"repository": map[string]any{
+			"issue": issue("I_1", 1, "OPEN", nil, 0, "", []string{"I_2", "I_3"}),
+		}}),
+	)
+	subIssuesMatcher := githubv4mock.NewQueryMatcher(
+		epicSubIssuesQuery{},
+		map[string]any{
+			"ids":             []githubv4.ID{"I_2", "I_3"},
+			"pointsField":     githubv4.String("Estimate"),
+			"targetDateField": githubv4.String("Target date"),
+		},
+		githubv4mock.DataResponse(map[string]any{"nodes": []any{
+			issue("I_2", 2, "CLOSED", []string{"alice"}, 3, "2000-01-01", nil),
+			issue("I_3", 3, "OPEN", []string{"alice", "bob"}, 5, "2000-02-01", nil),
+		}}),
+	)
+	subIssuesMatcher.Variables = map[string]any{"ids": []any{"I_2", "I_3"}, "pointsField": "Estimate", "targetDateField": "Target date"}
+
+	gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(rootMatcher, subIssuesMatcher))
+	_, handler := GetEpicRollup(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, FeatureFlags{})
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":        "octo",
+		"repo":         "api",
+		"issue_number": float64(1),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var rollup EpicRollup
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &rollup))
+	assert.Equal(t, "octo/api#1", rollup.Epic.Issue)
+	assert.Equal(t, 2, rollup.Total)
+	assert.Equal(t, 50.0, rollup.PercentComplete)
+	assert.Equal(t, github.Ptr(37.5), rollup.PointsPercentComplete)
+	assert.Equal(t, "2000-02-01", rollup.LatestTargetDate)
+	assert.Equal(t, []string{"octo/api#3"}, rollup.OverdueIssues)
+	assert.Equal(t, []EpicAssignee{{Login: "alice", Open: 1, Closed: 1}, {Login: "bob", Open: 1}}, rollup.Assignees)
+	require.Len(t, rollup.Issues, 2)
+	assert.Equal(t, EpicIssue{
+		Issue:      "octo/api#2",
+		Title:      "Issue I_2",
+		State:      "CLOSED",
+		URL:        "https://github.com/octo/api/issues/I_2",
+		Parent:     "octo/api#1",
+		Depth:      1,
+		Assignees:  []string{"alice"},
+		Points:     github.Ptr(3.0),
+		TargetDate: "2000-01-01",
+	}, rollup.Issues[0])
+	assert.False(t, rollup.Truncated)
+}
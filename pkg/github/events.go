@@ -0,0 +1,245 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Event is an entry of the public activity feed of a repository, organization or user.
+type Event struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Actor string `json:"actor"`
+	Repo  string `json:"repo"`
+	// Action is the action of the payload, e.g. opened or closed, for the events that have one.
+	Action string `json:"action,omitempty"`
+	// Ref is the branch or tag of push, create and delete events.
+	Ref       string `json:"ref,omitempty"`
+	Public    bool   `json:"public"`
+	CreatedAt string `json:"created_at"`
+}
+
+func convertToEvent(event *github.Event) Event {
+	result := Event{
+		ID:        event.GetID(),
+		Type:      event.GetType(),
+		Actor:     event.GetActor().GetLogin(),
+		Repo:      event.GetRepo().GetName(),
+		Public:    event.GetPublic(),
+		CreatedAt: formatTimestamp(event.CreatedAt),
+	}
+	if event.RawPayload != nil {
+		var payload struct {
+			Action string `json:"action"`
+			Ref    string `json:"ref"`
+		}
+		if err := json.Unmarshal(*event.RawPayload, &payload); err == nil {
+			result.Action = payload.Action
+			result.Ref = payload.Ref
+		}
+	}
+	return result
+}
+
+// filterEvents converts the events, keeping only those of the given types when there are any.
+func filterEvents(events []*github.Event, types []string) []Event {
+	keep := map[string]bool{}
+	for _, eventType := range types {
+		keep[eventType] = true
+	}
+	result := []Event{}
+	for _, event := range events {
+		if len(keep) > 0 && !keep[event.GetType()] {
+			continue
+		}
+		result = append(result, convertToEvent(event))
+	}
+	return result
+}
+
+// withEventTypes adds the parameter to filter the events by type.
+func withEventTypes() mcp.ToolOption {
+	return mcp.WithArray("types",
+		mcp.Description("Only return events of these types, e.g. ['PushEvent', 'PullRequestEvent']. Events are filtered within the requested page"),
+		mcp.WithStringItems(),
+	)
+}
+
+// ListRepoEvents creates a tool to list the recent public events of a repository.
+func ListRepoEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repo_events",
+			mcp.WithDescription(t("TOOL_LIST_REPO_EVENTS_DESCRIPTION", "List the recent events of a repository, newest first, such as pushes, pull requests, issues and releases. A cheap feed of recent activity; only events of the last 90 days are available.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPO_EVENTS_USER_TITLE", "List repository events"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[Event](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			withEventTypes(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			types, err := OptionalStringArrayParam(request, "types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			events, resp, err := client.Activity.ListRepositoryEvents(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list events of repository '%s/%s'", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredListResult(filterEvents(events, types)), nil
+		}
+}
+
+// ListOrgEvents creates a tool to list the recent public events of an organization.
+func ListOrgEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_events",
+			mcp.WithDescription(t("TOOL_LIST_ORG_EVENTS_DESCRIPTION", "List the recent public events of the repositories of an organization, newest first. A cheap feed of recent activity; only events of the last 90 days are available.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_EVENTS_USER_TITLE", "List organization events"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[Event](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			withEventTypes(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			types, err := OptionalStringArrayParam(request, "types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			events, resp, err := client.Activity.ListEventsForOrganization(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list events of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredListResult(filterEvents(events, types)), nil
+		}
+}
+
+// ListUserEvents creates a tool to list the recent events performed by a user.
+func ListUserEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_events",
+			mcp.WithDescription(t("TOOL_LIST_USER_EVENTS_DESCRIPTION", "List the recent events performed by a user, newest first. Only public events are returned, unless the user is the authenticated user. Only events of the last 90 days are available.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_USER_EVENTS_USER_TITLE", "List user events"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[Event](),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user"),
+			),
+			mcp.WithBoolean("public_only",
+				mcp.Description("Only return public events, even for the authenticated user"),
+				mcp.DefaultBool(false),
+			),
+			withEventTypes(),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			publicOnly, err := OptionalBoolParamWithDefault(request, "public_only", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			types, err := OptionalStringArrayParam(request, "types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			events, resp, err := client.Activity.ListEventsPerformedByUser(ctx, username, publicOnly, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list events of user '%s'", username),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredListResult(filterEvents(events, types)), nil
+		}
+}
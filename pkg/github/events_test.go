@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockEvents() []*github.Event {
+	payload := func(s string) *json.RawMessage {
+		raw := json.RawMessage(s)
+		return &raw
+	}
+	createdAt := &github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	return []*github.Event{
+		{
+			ID:         github.Ptr("3"),
+			Type:       github.Ptr("PushEvent"),
+			Actor:      &github.User{Login: github.Ptr("octocat")},
+			Repo:       &github.Repository{Name: github.Ptr("octo/api")},
+			Public:     github.Ptr(true),
+			RawPayload: payload(`{"ref": "refs/heads/main", "size": 2}`),
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         github.Ptr("2"),
+			Type:       github.Ptr("PullRequestEvent"),
+			Actor:      &github.User{Login: github.Ptr("hubot")},
+			Repo:       &github.Repository{Name: github.Ptr("octo/api")},
+			Public:     github.Ptr(true),
+			RawPayload: payload(`{"action": "opened", "number": 7}`),
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:        github.Ptr("1"),
+			Type:      github.Ptr("WatchEvent"),
+			Actor:     &github.User{Login: github.Ptr("hubot")},
+			Repo:      &github.Repository{Name: github.Ptr("octo/api")},
+			Public:    github.Ptr(true),
+			CreatedAt: createdAt,
+		},
+	}
+}
+
+func Test_ListRepoEvents(t *testing.T) {
+	tool, _ := ListRepoEvents(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposEventsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "2",
+				"per_page": "10",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockEvents()),
+			),
+		),
+	))
+	_, handler := ListRepoEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":   "octo",
+		"repo":    "api",
+		"types":   []any{"PushEvent", "PullRequestEvent"},
+		"page":    float64(2),
+		"perPage": float64(10),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response []Event
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []Event{
+		{ID: "3", Type: "PushEvent", Actor: "octocat", Repo: "octo/api", Ref: "refs/heads/main", Public: true, CreatedAt: "2024-05-01T12:00:00Z"},
+		{ID: "2", Type: "PullRequestEvent", Actor: "hubot", Repo: "octo/api", Action: "opened", Public: true, CreatedAt: "2024-05-01T12:00:00Z"},
+	}, response)
+}
+
+func Test_ListOrgEvents(t *testing.T) {
+	tool, _ := ListOrgEvents(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	t.Run("events", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetOrgsEventsByOrg, mockEvents()),
+		))
+		_, handler := ListOrgEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response []Event
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response, 3)
+		assert.Equal(t, "WatchEvent", response[2].Type)
+	})
+
+	t.Run("organization not found", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetOrgsEventsByOrg,
+				mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			),
+		))
+		_, handler := ListOrgEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "nope"}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to list events of organization 'nope'")
+	})
+}
+
+func Test_ListUserEvents(t *testing.T) {
+	tool, _ := ListUserEvents(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	tests := []struct {
+		name       string
+		publicOnly bool
+		endpoint   mock.EndpointPattern
+	}{
+		{name: "all events", endpoint: mock.GetUsersEventsByUsername},
+		{name: "public events", publicOnly: true, endpoint: mock.GetUsersEventsPublicByUsername},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(tc.endpoint, mockEvents()),
+			))
+			_, handler := ListUserEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"username":    "hubot",
+				"public_only": tc.publicOnly,
+				"types":       []any{"WatchEvent"},
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response []Event
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			require.Len(t, response, 1)
+			assert.Equal(t, "1", response[0].ID)
+		})
+	}
+}
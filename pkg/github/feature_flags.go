@@ -1,6 +1,28 @@
 package github
 
+import "time"
+
 // FeatureFlags defines runtime feature toggles that adjust tool behavior.
 type FeatureFlags struct {
 	LockdownMode bool
+	// Timezone is used to resolve relative dates in search queries, e.g. "yesterday". Defaults to UTC.
+	Timezone *time.Location
+	// ProjectTransitions maps the transitions of transition_project_item to the status options of projects.
+	ProjectTransitions ProjectTransitions
+	// PullRequestTemplatePolicies configures how create_pull_request fills the pull request templates of owners.
+	PullRequestTemplatePolicies PullRequestTemplatePolicies
+	// IterationCapacity is the number of points people can take on per iteration, keyed by login.
+	IterationCapacity map[string]float64
+	// LicenseAllowList are the SPDX identifiers of the licenses check_dependency_licenses allows dependencies to use.
+	LicenseAllowList []string
+	// Models enables the models toolset, which calls the GitHub Models inference API with the server's token.
+	Models bool
+}
+
+// now returns the current time in the configured timezone.
+func (f FeatureFlags) now() time.Time {
+	if f.Timezone == nil {
+		return time.Now().UTC()
+	}
+	return time.Now().In(f.Timezone)
 }
@@ -21,6 +21,7 @@ func ListGists(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				Title:        t("TOOL_LIST_GISTS", "List Gists"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.Gist](),
 			mcp.WithString("username",
 				mcp.Description("GitHub username (omit for authenticated user's gists)"),
 			),
@@ -80,12 +81,7 @@ func ListGists(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list gists: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(gists)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(gists), nil
 		}
 }
 
@@ -97,6 +93,7 @@ func GetGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				Title:        t("TOOL_GET_GIST", "Get Gist Content"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.Gist](),
 			mcp.WithString("gist_id",
 				mcp.Required(),
 				mcp.Description("The ID of the gist"),
@@ -127,12 +124,7 @@ func GetGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get gist: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(gist)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(gist), nil
 		}
 }
 
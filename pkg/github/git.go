@@ -2,7 +2,6 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -43,6 +42,7 @@ func GetRepositoryTree(getClient GetClientFn, t translations.TranslationHelperFu
 				Title:        t("TOOL_GET_REPOSITORY_TREE_USER_TITLE", "Get repository tree"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[TreeResponse](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner (username or organization)"),
@@ -150,11 +150,160 @@ func GetRepositoryTree(getClient GetClientFn, t translations.TranslationHelperFu
 				Count:     len(filteredEntries),
 			}
 
-			r, err := json.Marshal(response)
+			return MarshalledStructuredResult(response), nil
+		}
+}
+
+const (
+	// DefaultRepoTreeMaxEntries is the default number of entries returned by get_repo_tree.
+	DefaultRepoTreeMaxEntries = 1000
+	// MaxRepoTreeMaxEntries bounds the number of entries returned by get_repo_tree.
+	MaxRepoTreeMaxEntries = 10000
+)
+
+// RepoTreeEntry is a compact representation of a file or directory in a repository snapshot.
+type RepoTreeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size *int   `json:"size,omitempty"`
+}
+
+// RepoTreeResponse is the response of get_repo_tree.
+type RepoTreeResponse struct {
+	SHA          string          `json:"sha"`
+	Ref          string          `json:"ref"`
+	TotalEntries int             `json:"total_entries"`
+	Truncated    bool            `json:"truncated"`
+	Entries      []RepoTreeEntry `json:"entries"`
+}
+
+// GetRepoTree creates a tool to get a filtered, recursive snapshot of the files in a GitHub repository.
+func GetRepoTree(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repo_tree",
+			mcp.WithDescription(t("TOOL_GET_REPO_TREE_DESCRIPTION", "Get a recursive snapshot of all files and directories of a GitHub repository in a single call, filtered by glob patterns. Use this to build a map of a repository instead of listing it directory by directory.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPO_TREE_USER_TITLE", "Get repository file tree snapshot"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[RepoTreeResponse](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA. Defaults to the repository's default branch"),
+			),
+			mcp.WithArray("include",
+				mcp.Description("Glob patterns of paths to include, e.g. ['src/**', '*.go']. '**' matches any number of directories and patterns without '/' match file names in any directory. Defaults to all paths"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("exclude",
+				mcp.Description("Glob patterns of paths to exclude, e.g. ['vendor/**', '**/testdata/**']"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithNumber("max_entries",
+				mcp.Description(fmt.Sprintf("Maximum number of entries to return (max %d)", MaxRepoTreeMaxEntries)),
+				mcp.Min(1),
+				mcp.Max(MaxRepoTreeMaxEntries),
+				mcp.DefaultNumber(DefaultRepoTreeMaxEntries),
+			),
+			mcp.WithBoolean("include_sizes",
+				mcp.Description("Include the size in bytes of each file"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			include, err := OptionalStringArrayParam(request, "include")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			exclude, err := OptionalStringArrayParam(request, "exclude")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxEntries, err := OptionalIntParamWithDefault(request, "max_entries", DefaultRepoTreeMaxEntries)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeSizes, err := OptionalBoolParamWithDefault(request, "include_sizes", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxEntries < 1 || maxEntries > MaxRepoTreeMaxEntries {
+				maxEntries = MaxRepoTreeMaxEntries
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if ref == "" {
+				repoInfo, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get repository info",
+						resp,
+						err,
+					), nil
+				}
+				ref = repoInfo.GetDefaultBranch()
+			}
+
+			tree, resp, err := client.Git.GetTree(ctx, owner, repo, ref, true)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository tree",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			response := RepoTreeResponse{
+				SHA:       tree.GetSHA(),
+				Ref:       ref,
+				Truncated: tree.GetTruncated(),
+				Entries:   []RepoTreeEntry{},
+			}
+			for _, entry := range tree.Entries {
+				if len(include) > 0 && !matchAnyGlob(include, entry.GetPath()) {
+					continue
+				}
+				if matchAnyGlob(exclude, entry.GetPath()) {
+					continue
+				}
+				response.TotalEntries++
+				if len(response.Entries) >= maxEntries {
+					response.Truncated = true
+					continue
+				}
+				e := RepoTreeEntry{
+					Path: entry.GetPath(),
+					Type: entry.GetType(),
+				}
+				if includeSizes && entry.Size != nil {
+					e.Size = entry.Size
+				}
+				response.Entries = append(response.Entries, e)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(response), nil
 		}
 }
@@ -0,0 +1,52 @@
+package github
+
+import (
+	"path"
+	"strings"
+)
+
+// matchGlob reports whether a slash-separated file path matches a glob pattern.
+// Patterns support the path.Match syntax within a path segment, plus '**' to match any number of segments.
+// Patterns without a '/' are matched against the last segment of the path only, so '*.go' matches Go files in any directory.
+func matchGlob(pattern, filePath string) bool {
+	pattern = strings.Trim(pattern, "/")
+	filePath = strings.Trim(filePath, "/")
+	if !strings.Contains(pattern, "/") && pattern != "**" {
+		ok, _ := path.Match(pattern, path.Base(filePath))
+		return ok
+	}
+	return matchGlobSegments(strings.Split(pattern, "/"), strings.Split(filePath, "/"))
+}
+
+func matchGlobSegments(pattern, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// '**' matches zero or more segments.
+			for i := 0; i <= len(segments); i++ {
+				if matchGlobSegments(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		segments = segments[1:]
+	}
+	return len(segments) == 0
+}
+
+// matchAnyGlob reports whether filePath matches at least one of the patterns.
+func matchAnyGlob(patterns []string, filePath string) bool {
+	for _, p := range patterns {
+		if matchGlob(p, filePath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchGlob(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "*.go", path: "main.go", expected: true},
+		{pattern: "*.go", path: "pkg/github/tools.go", expected: true},
+		{pattern: "*.go", path: "README.md", expected: false},
+		{pattern: "pkg/*.go", path: "pkg/doc.go", expected: true},
+		{pattern: "pkg/*.go", path: "pkg/github/tools.go", expected: false},
+		{pattern: "pkg/**/*.go", path: "pkg/github/tools.go", expected: true},
+		{pattern: "pkg/**/*.go", path: "pkg/doc.go", expected: true},
+		{pattern: "pkg/**", path: "pkg/github/tools.go", expected: true},
+		{pattern: "**/testdata/**", path: "pkg/github/testdata/fixture.json", expected: true},
+		{pattern: "**/testdata/**", path: "pkg/github/tools.go", expected: false},
+		{pattern: "docs/", path: "docs", expected: true},
+		{pattern: "**", path: "anything/at/all", expected: true},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/ci.yml", expected: true},
+		{pattern: "vendor/**", path: "pkg/vendor/x.go", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.expected, matchGlob(tc.pattern, tc.path))
+		})
+	}
+}
+
+func Test_matchAnyGlob(t *testing.T) {
+	assert.True(t, matchAnyGlob([]string{"*.md", "*.go"}, "pkg/server.go"))
+	assert.False(t, matchAnyGlob([]string{"*.md", "*.txt"}, "pkg/server.go"))
+	assert.False(t, matchAnyGlob(nil, "pkg/server.go"))
+}
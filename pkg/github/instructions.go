@@ -71,10 +71,11 @@ Use 'list_discussion_categories' to understand available categories before creat
 	case "projects":
 		return `## Projects
 
-Workflow: 1) list_project_fields (get field IDs), 2) list_project_items (with pagination), 3) optional updates.
+Workflow: 1) list_project_items (with pagination), 2) optional updates. Field names are resolved to IDs automatically.
 
 Field usage:
-	- Call list_project_fields first to understand available fields and get IDs/types before filtering.
+	- Pass field names or IDs in 'fields'; names are resolved from cached field definitions. Set refresh=true if fields were just changed.
+	- Call list_project_fields only when you need the available fields, their types or options.
 	- Use EXACT returned field names (case-insensitive match). Don't invent names or IDs.
 	- Iteration synonyms (sprint/cycle) only if that field exists; map to the actual name (e.g. sprint:@current).
 	- Only include filters for fields that exist and are relevant.
@@ -135,7 +136,7 @@ Common Qualifier Glossary (items):
    updated:>@today-7d | title:*text* | -label:wontfix | label:bug,critical | no:assignee | has:label
 
 Never:
-   - Infer field IDs; fetch via list_project_fields.
+   - Infer field IDs; pass field names instead.
    - Drop 'fields' param on subsequent pages if field values are needed.`
 	default:
 		return ""
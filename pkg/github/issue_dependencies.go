@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// AddBlockedByInput mirrors the GraphQL input of addBlockedBy, which githubv4 does not define.
+type AddBlockedByInput struct {
+	IssueID         githubv4.ID `json:"issueId"`
+	BlockingIssueID githubv4.ID `json:"blockingIssueId"`
+}
+
+// RemoveBlockedByInput mirrors the GraphQL input of removeBlockedBy, which githubv4 does not define.
+type RemoveBlockedByInput struct {
+	IssueID         githubv4.ID `json:"issueId"`
+	BlockingIssueID githubv4.ID `json:"blockingIssueId"`
+}
+
+// IssueDependency is an issue blocking, or blocked by, another issue.
+type IssueDependency struct {
+	Issue string `json:"issue"`
+	Title string `json:"title"`
+	State string `json:"state"`
+	URL   string `json:"url"`
+}
+
+// IssueDependenciesResult lists the issues blocking an issue and the issues it blocks.
+type IssueDependenciesResult struct {
+	Issue     string            `json:"issue"`
+	BlockedBy []IssueDependency `json:"blocked_by"`
+	Blocking  []IssueDependency `json:"blocking"`
+}
+
+// IssueDependencyResult is the result of adding or removing a blocked-by relationship.
+type IssueDependencyResult struct {
+	Issue         string `json:"issue"`
+	BlockingIssue string `json:"blocking_issue"`
+	Blocked       bool   `json:"blocked"`
+}
+
+type issueDependencyNodes struct {
+	Nodes []struct {
+		Number     githubv4.Int
+		Title      githubv4.String
+		State      githubv4.String
+		URL        githubv4.String `graphql:"url"`
+		Repository struct {
+			NameWithOwner githubv4.String
+		}
+	}
+}
+
+func (n issueDependencyNodes) dependencies() []IssueDependency {
+	dependencies := []IssueDependency{}
+	for _, node := range n.Nodes {
+		dependencies = append(dependencies, IssueDependency{
+			Issue: fmt.Sprintf("%s#%d", node.Repository.NameWithOwner, node.Number),
+			Title: string(node.Title),
+			State: string(node.State),
+			URL:   string(node.URL),
+		})
+	}
+	return dependencies
+}
+
+// issueDependenciesListQuery selects the issues blocking an issue and the issues it blocks.
+type issueDependenciesListQuery struct {
+	Repository struct {
+		Issue *struct {
+			BlockedBy issueDependencyNodes `graphql:"blockedBy(first: 100)"`
+			Blocking  issueDependencyNodes `graphql:"blocking(first: 100)"`
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// issueDependencyIDsQuery selects the IDs of a blocked issue and its blocking issue, which may be in another
+// repository.
+type issueDependencyIDsQuery struct {
+	Issue struct {
+		Issue struct {
+			ID githubv4.ID
+		} `graphql:"issue(number: $issueNumber)"`
+	} `graphql:"issue: repository(owner: $owner, name: $repo)"`
+	Blocking struct {
+		Issue struct {
+			ID githubv4.ID
+		} `graphql:"issue(number: $blockingNumber)"`
+	} `graphql:"blocking: repository(owner: $blockingOwner, name: $blockingRepo)"`
+}
+
+// ListIssueDependencies creates a tool to list the blocked-by and blocking relationships of an issue.
+func ListIssueDependencies(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_dependencies",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_DEPENDENCIES_DESCRIPTION", "List the issues blocking an issue and the issues it blocks, across repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ISSUE_DEPENDENCIES_USER_TITLE", "List issue dependencies"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[IssueDependenciesResult](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("The number of the issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query issueDependenciesListQuery
+			if err := gqlClient.Query(ctx, &query, map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list issue dependencies",
+					err,
+				), nil
+			}
+			issue := query.Repository.Issue
+			if issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue %s/%s#%d not found", owner, repo, issueNumber)), nil
+			}
+
+			return MarshalledStructuredResult(IssueDependenciesResult{
+				Issue:     fmt.Sprintf("%s/%s#%d", owner, repo, issueNumber),
+				BlockedBy: issue.BlockedBy.dependencies(),
+				Blocking:  issue.Blocking.dependencies(),
+			}), nil
+		}
+}
+
+// IssueDependencyWrite creates a tool to add or remove a blocked-by relationship between two issues.
+func IssueDependencyWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("issue_dependency_write",
+			mcp.WithDescription(t("TOOL_ISSUE_DEPENDENCY_WRITE_DESCRIPTION", "Mark an issue as blocked by another issue, possibly of another repository, or remove that relationship.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ISSUE_DEPENDENCY_WRITE_USER_TITLE", "Change issue dependency"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[IssueDependencyResult](),
+			mcp.WithString("method",
+				mcp.Required(),
+				mcp.Description(`The action to perform on the relationship
+Options are:
+- 'add' - mark the issue as blocked by the blocking issue.
+- 'remove' - remove the blocked-by relationship between the issues.
+				`),
+				mcp.Enum("add", "remove"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("The number of the blocked issue"),
+			),
+			mcp.WithNumber("blocking_issue_number",
+				mcp.Required(),
+				mcp.Description("The number of the blocking issue"),
+			),
+			mcp.WithString("blocking_owner",
+				mcp.Description("Owner of the repository of the blocking issue. Defaults to owner"),
+			),
+			mcp.WithString("blocking_repo",
+				mcp.Description("Repository of the blocking issue. Defaults to repo"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			method, err := RequiredParam[string](request, "method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			blockingNumber, err := RequiredInt(request, "blocking_issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			blockingOwner, err := OptionalParam[string](request, "blocking_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			blockingRepo, err := OptionalParam[string](request, "blocking_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if blockingOwner == "" {
+				blockingOwner = owner
+			}
+			if blockingRepo == "" {
+				blockingRepo = repo
+			}
+			method = strings.ToLower(method)
+			if method != "add" && method != "remove" {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var ids issueDependencyIDsQuery
+			if err := gqlClient.Query(ctx, &ids, map[string]any{
+				"owner":          githubv4.String(owner),
+				"repo":           githubv4.String(repo),
+				"issueNumber":    githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				"blockingOwner":  githubv4.String(blockingOwner),
+				"blockingRepo":   githubv4.String(blockingRepo),
+				"blockingNumber": githubv4.Int(blockingNumber), // #nosec G115 - issue numbers are always small positive integers
+			}); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get issue IDs",
+					err,
+				), nil
+			}
+			issueID, blockingID := ids.Issue.Issue.ID, ids.Blocking.Issue.ID
+
+			if method == "add" {
+				var mutation struct {
+					AddBlockedBy struct {
+						Issue struct {
+							ID githubv4.ID
+						}
+					} `graphql:"addBlockedBy(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, AddBlockedByInput{IssueID: issueID, BlockingIssueID: blockingID}, nil)
+			} else {
+				var mutation struct {
+					RemoveBlockedBy struct {
+						Issue struct {
+							ID githubv4.ID
+						}
+					} `graphql:"removeBlockedBy(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, RemoveBlockedByInput{IssueID: issueID, BlockingIssueID: blockingID}, nil)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to %s issue dependency", method),
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(IssueDependencyResult{
+				Issue:         fmt.Sprintf("%s/%s#%d", owner, repo, issueNumber),
+				BlockingIssue: fmt.Sprintf("%s/%s#%d", blockingOwner, blockingRepo, blockingNumber),
+				Blocked:       method == "add",
+			}), nil
+		}
+}
@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListIssueDependencies(t *testing.T) {
+	tool, _ := ListIssueDependencies(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	vars := map[string]any{"owner": githubv4.String("octo-org"), "repo": githubv4.String("api"), "issueNumber": githubv4.Int(7)}
+	node := func(repository string, number int, title string) map[string]any {
+		return map[string]any{
+			"number":     number,
+			"title":      title,
+			"state":      "OPEN",
+			"url":        "https://github.com/" + repository + "/issues/" + title,
+			"repository": map[string]any{"nameWithOwner": repository},
+		}
+	}
+
+	t.Run("dependencies", func(t *testing.T) {
+		gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+			issueDependenciesListQuery{},
+			vars,
+			githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"issue": map[string]any{
+				"blockedBy": map[string]any{"nodes": []any{node("octo-org/web", 3, "Design")}},
+				"blocking":  map[string]any{"nodes": []any{}},
+			}}}),
+		))
+		_, handler := ListIssueDependencies(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "octo-org",
+			"repo":         "api",
+			"issue_number": float64(7),
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response IssueDependenciesResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, IssueDependenciesResult{
+			Issue: "octo-org/api#7",
+			BlockedBy: []IssueDependency{
+				{Issue: "octo-org/web#3", Title: "Design", State: "OPEN", URL: "https://github.com/octo-org/web/issues/Design"},
+			},
+			Blocking: []IssueDependency{},
+		}, response)
+	})
+
+	t.Run("issue not found", func(t *testing.T) {
+		gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+			issueDependenciesListQuery{},
+			vars,
+			githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"issue": nil}}),
+		))
+		_, handler := ListIssueDependencies(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":        "octo-org",
+			"repo":         "api",
+			"issue_number": float64(7),
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "issue octo-org/api#7 not found")
+	})
+}
+
+func Test_IssueDependencyWrite(t *testing.T) {
+	tool, _ := IssueDependencyWrite(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "issue_number", "blocking_issue_number"})
+
+	ids := githubv4mock.NewQueryMatcher(
+		issueDependencyIDsQuery{},
+		map[string]any{
+			"owner":          githubv4.String("octo-org"),
+			"repo":           githubv4.String("api"),
+			"issueNumber":    githubv4.Int(7),
+			"blockingOwner":  githubv4.String("octo-org"),
+			"blockingRepo":   githubv4.String("web"),
+			"blockingNumber": githubv4.Int(3),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"issue":    map[string]any{"issue": map[string]any{"id": "I_7"}},
+			"blocking": map[string]any{"issue": map[string]any{"id": "I_3"}},
+		}),
+	)
+
+	tests := []struct {
+		name     string
+		method   string
+		mutation githubv4mock.Matcher
+	}{
+		{
+			name:   "add",
+			method: "add",
+			mutation: githubv4mock.NewMutationMatcher(
+				struct {
+					AddBlockedBy struct {
+						Issue struct {
+							ID githubv4.ID
+						}
+					} `graphql:"addBlockedBy(input: $input)"`
+				}{},
+				AddBlockedByInput{IssueID: githubv4.ID("I_7"), BlockingIssueID: githubv4.ID("I_3")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"addBlockedBy": map[string]any{"issue": map[string]any{"id": "I_7"}}}),
+			),
+		},
+		{
+			name:   "remove",
+			method: "remove",
+			mutation: githubv4mock.NewMutationMatcher(
+				struct {
+					RemoveBlockedBy struct {
+						Issue struct {
+							ID githubv4.ID
+						}
+					} `graphql:"removeBlockedBy(input: $input)"`
+				}{},
+				RemoveBlockedByInput{IssueID: githubv4.ID("I_7"), BlockingIssueID: githubv4.ID("I_3")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"removeBlockedBy": map[string]any{"issue": map[string]any{"id": "I_7"}}}),
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4mock.NewMockedHTTPClient(ids, tc.mutation)
+			_, handler := IssueDependencyWrite(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"method":                tc.method,
+				"owner":                 "octo-org",
+				"repo":                  "api",
+				"issue_number":          float64(7),
+				"blocking_repo":         "web",
+				"blocking_issue_number": float64(3),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response IssueDependencyResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, IssueDependencyResult{Issue: "octo-org/api#7", BlockingIssue: "octo-org/web#3", Blocked: tc.method == "add"}, response)
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplateDir is the directory GitHub reads issue templates and forms from.
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// IssueTemplate describes an issue form or markdown issue template of a repository.
+type IssueTemplate struct {
+	File        string               `json:"file"`
+	Format      string               `json:"format"` // "form" or "markdown"
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Title       string               `json:"title,omitempty"`
+	Labels      []string             `json:"labels,omitempty"`
+	Assignees   []string             `json:"assignees,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Fields      []IssueTemplateField `json:"fields,omitempty"`
+	Body        string               `json:"body,omitempty"`
+}
+
+// IssueTemplateField is an input of an issue form, along with its validations.
+type IssueTemplateField struct {
+	ID              string   `json:"id,omitempty"`
+	Type            string   `json:"type"`
+	Label           string   `json:"label"`
+	Description     string   `json:"description,omitempty"`
+	Placeholder     string   `json:"placeholder,omitempty"`
+	Options         []string `json:"options,omitempty"`
+	Multiple        bool     `json:"multiple,omitempty"`
+	Render          string   `json:"render,omitempty"`
+	Required        bool     `json:"required,omitempty"`
+	RequiredOptions []string `json:"required_options,omitempty"`
+}
+
+// stringList accepts either a YAML list or a comma separated string, as both are valid in issue templates.
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = nil
+		for _, item := range strings.Split(value.Value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+type issueFormOption struct {
+	Label    string `yaml:"label"`
+	Required bool   `yaml:"required"`
+}
+
+// issueFormOptions accepts dropdown options, which are strings, and checkbox options, which are objects.
+type issueFormOptions []issueFormOption
+
+func (o *issueFormOptions) UnmarshalYAML(value *yaml.Node) error {
+	*o = nil
+	for _, node := range value.Content {
+		var option issueFormOption
+		if node.Kind == yaml.ScalarNode {
+			option.Label = node.Value
+		} else if err := node.Decode(&option); err != nil {
+			return err
+		}
+		*o = append(*o, option)
+	}
+	return nil
+}
+
+type issueForm struct {
+	Name        string     `yaml:"name"`
+	Description string     `yaml:"description"`
+	About       string     `yaml:"about"`
+	Title       string     `yaml:"title"`
+	Labels      stringList `yaml:"labels"`
+	Assignees   stringList `yaml:"assignees"`
+	Type        string     `yaml:"type"`
+	Body        []struct {
+		Type       string `yaml:"type"`
+		ID         string `yaml:"id"`
+		Attributes struct {
+			Label       string           `yaml:"label"`
+			Description string           `yaml:"description"`
+			Placeholder string           `yaml:"placeholder"`
+			Options     issueFormOptions `yaml:"options"`
+			Multiple    bool             `yaml:"multiple"`
+			Render      string           `yaml:"render"`
+		} `yaml:"attributes"`
+		Validations struct {
+			Required bool `yaml:"required"`
+		} `yaml:"validations"`
+	} `yaml:"body"`
+}
+
+// parseIssueTemplate parses an issue form (YAML) or a markdown issue template with YAML front matter.
+func parseIssueTemplate(file, content string) (*IssueTemplate, error) {
+	switch strings.ToLower(path.Ext(file)) {
+	case ".yml", ".yaml":
+		var form issueForm
+		if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+			return nil, fmt.Errorf("failed to parse issue form %s: %w", file, err)
+		}
+		template := &IssueTemplate{
+			File:        file,
+			Format:      "form",
+			Name:        form.Name,
+			Description: form.Description,
+			Title:       form.Title,
+			Labels:      form.Labels,
+			Assignees:   form.Assignees,
+			Type:        form.Type,
+		}
+		for _, element := range form.Body {
+			// Markdown elements only display text in the form.
+			if element.Type == "markdown" {
+				continue
+			}
+			field := IssueTemplateField{
+				ID:          element.ID,
+				Type:        element.Type,
+				Label:       element.Attributes.Label,
+				Description: element.Attributes.Description,
+				Placeholder: element.Attributes.Placeholder,
+				Multiple:    element.Attributes.Multiple,
+				Render:      element.Attributes.Render,
+				Required:    element.Validations.Required,
+			}
+			for _, option := range element.Attributes.Options {
+				field.Options = append(field.Options, option.Label)
+				if option.Required {
+					field.RequiredOptions = append(field.RequiredOptions, option.Label)
+				}
+			}
+			template.Fields = append(template.Fields, field)
+		}
+		return template, nil
+	case ".md":
+		template := &IssueTemplate{File: file, Format: "markdown", Body: content}
+		rest, found := strings.CutPrefix(strings.TrimPrefix(content, "\ufeff"), "---")
+		if !found {
+			return template, nil
+		}
+		frontMatter, body, found := strings.Cut(rest, "\n---")
+		if !found {
+			return template, nil
+		}
+		var form issueForm
+		if err := yaml.Unmarshal([]byte(frontMatter), &form); err != nil {
+			return nil, fmt.Errorf("failed to parse front matter of issue template %s: %w", file, err)
+		}
+		template.Name = form.Name
+		template.Description = form.About
+		template.Title = form.Title
+		template.Labels = form.Labels
+		template.Assignees = form.Assignees
+		template.Type = form.Type
+		template.Body = strings.TrimLeft(body, "\r\n")
+		return template, nil
+	default:
+		return nil, nil
+	}
+}
+
+// listIssueTemplates fetches and parses the issue templates of a repository. Repositories without templates
+// return an empty list.
+func listIssueTemplates(ctx context.Context, client *github.Client, owner, repo string) ([]*IssueTemplate, *github.Response, error) {
+	_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return []*IssueTemplate{}, nil, nil
+		}
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	templates := []*IssueTemplate{}
+	for _, entry := range entries {
+		// config.yml configures the template chooser and is not a template.
+		if entry.GetType() != "file" || strings.HasPrefix(strings.ToLower(entry.GetName()), "config.") {
+			continue
+		}
+		switch strings.ToLower(path.Ext(entry.GetName())) {
+		case ".yml", ".yaml", ".md":
+		default:
+			continue
+		}
+
+		file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode issue template %s: %w", entry.GetName(), err)
+		}
+
+		template, err := parseIssueTemplate(entry.GetName(), content)
+		if err != nil {
+			return nil, nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil, nil
+}
+
+func findIssueTemplate(templates []*IssueTemplate, name string) *IssueTemplate {
+	for _, template := range templates {
+		if strings.EqualFold(template.File, name) || strings.EqualFold(template.Name, name) {
+			return template
+		}
+	}
+	return nil
+}
+
+// renderIssueFormBody validates the values of an issue form and renders the issue body the way GitHub does when
+// the form is submitted. Values are keyed by field ID or label; dropdowns with multiple selections and checkboxes
+// take a list of option labels.
+func renderIssueFormBody(template *IssueTemplate, values map[string]any) (string, error) {
+	used := map[string]bool{}
+	var b strings.Builder
+	for _, field := range template.Fields {
+		key := field.ID
+		value, ok := values[key]
+		if !ok || key == "" {
+			key = field.Label
+			value, ok = values[key]
+		}
+		if ok {
+			used[key] = true
+		}
+
+		selected, err := issueFormValues(value)
+		if err != nil {
+			return "", fmt.Errorf("field %q: %w", field.Label, err)
+		}
+		if field.Required && len(selected) == 0 {
+			return "", fmt.Errorf("field %q is required", field.Label)
+		}
+
+		switch field.Type {
+		case "dropdown", "checkboxes":
+			for _, option := range selected {
+				if !slices.Contains(field.Options, option) {
+					return "", fmt.Errorf("field %q: %q is not one of the options %q", field.Label, option, field.Options)
+				}
+			}
+			if field.Type == "dropdown" && !field.Multiple && len(selected) > 1 {
+				return "", fmt.Errorf("field %q accepts a single option", field.Label)
+			}
+			for _, option := range field.RequiredOptions {
+				if !slices.Contains(selected, option) {
+					return "", fmt.Errorf("field %q: option %q must be checked", field.Label, option)
+				}
+			}
+		default:
+			if len(selected) > 1 {
+				return "", fmt.Errorf("field %q accepts a single value", field.Label)
+			}
+		}
+
+		fmt.Fprintf(&b, "### %s\n\n", field.Label)
+		switch {
+		case field.Type == "checkboxes":
+			for _, option := range field.Options {
+				mark := " "
+				if slices.Contains(selected, option) {
+					mark = "X"
+				}
+				fmt.Fprintf(&b, "- [%s] %s\n", mark, option)
+			}
+		case len(selected) == 0:
+			b.WriteString("_No response_\n")
+		case field.Render != "":
+			fmt.Fprintf(&b, "```%s\n%s\n```\n", field.Render, selected[0])
+		default:
+			b.WriteString(strings.Join(selected, ", ") + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	for key := range values {
+		if !used[key] {
+			return "", fmt.Errorf("template %q has no field %q", template.Name, key)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+// issueFormValues converts a field value to a list of non-empty strings.
+func issueFormValues(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		return []string{v}, nil
+	case bool, float64:
+		return []string{fmt.Sprint(v)}, nil
+	case []any:
+		var values []string
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of strings")
+			}
+			if strings.TrimSpace(s) != "" {
+				values = append(values, s)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T", value)
+	}
+}
+
+// ListIssueTemplates creates a tool to list the issue templates and forms of a repository.
+func ListIssueTemplates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_templates",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_TEMPLATES_DESCRIPTION", "List the issue templates of a repository, including the fields and validations of issue forms. Use create_issue_from_template to file an issue that satisfies a form.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ISSUE_TEMPLATES_USER_TITLE", "List issue templates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[IssueTemplate](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			templates, resp, err := listIssueTemplates(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil
+			}
+
+			return MarshalledStructuredListResult(templates), nil
+		}
+}
+
+// CreateIssueFromTemplate creates a tool to create an issue from an issue form, validating the values against the form.
+func CreateIssueFromTemplate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_issue_from_template",
+			mcp.WithDescription(t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_DESCRIPTION", "Create an issue from an issue form of a repository. The values are validated against the form, e.g. required fields and dropdown options, and rendered into the issue body the same way GitHub does. The labels, assignees and type of the form are applied. Use list_issue_templates to get the fields of the form.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_USER_TITLE", "Create issue from template"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("template",
+				mcp.Required(),
+				mcp.Description("File name or name of the issue form, as returned by list_issue_templates"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Issue title. The title prefix of the form is prepended unless already present. Defaults to the title of the form"),
+			),
+			mcp.WithObject("values",
+				mcp.Description("Values of the form fields, keyed by field ID or label. Use a list of option labels for checkboxes and dropdowns that allow multiple selections"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			templateName, err := RequiredParam[string](request, "template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			values, err := OptionalParam[map[string]any](request, "values")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			templates, resp, err := listIssueTemplates(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil
+			}
+			template := findIssueTemplate(templates, templateName)
+			if template == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue template %q not found in %s", templateName, issueTemplateDir)), nil
+			}
+			if template.Format != "form" {
+				return mcp.NewToolResultError(fmt.Sprintf("issue template %q is a markdown template, not an issue form; use issue_write with its body instead", templateName)), nil
+			}
+
+			body, err := renderIssueFormBody(template, values)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if !strings.HasPrefix(title, template.Title) {
+				title = template.Title + title
+			}
+			if strings.TrimSpace(title) == "" {
+				return mcp.NewToolResultError("missing required parameter: title"), nil
+			}
+
+			assignees := template.Assignees
+			if assignees == nil {
+				assignees = []string{}
+			}
+			labels := template.Labels
+			if labels == nil {
+				labels = []string{}
+			}
+			return CreateIssue(ctx, client, owner, repo, title, body, assignees, labels, 0, template.Type)
+		}
+}
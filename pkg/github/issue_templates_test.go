@@ -0,0 +1,345 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugReportForm = `name: Bug Report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+assignees: octocat
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to fill out this bug report!
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+      placeholder: Tell us what you see!
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options:
+        - "1.0"
+        - "2.0"
+    validations:
+      required: true
+  - type: textarea
+    id: logs
+    attributes:
+      label: Relevant log output
+      render: shell
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow this project's Code of Conduct
+          required: true
+`
+
+const featureRequestTemplate = `---
+name: Feature request
+about: Suggest an idea for this project
+title: ''
+labels: enhancement
+---
+
+**Is your feature request related to a problem? Please describe.**
+`
+
+func Test_ParseIssueTemplate(t *testing.T) {
+	form, err := parseIssueTemplate("bug_report.yml", bugReportForm)
+	require.NoError(t, err)
+	assert.Equal(t, "form", form.Format)
+	assert.Equal(t, "Bug Report", form.Name)
+	assert.Equal(t, "[Bug]: ", form.Title)
+	assert.Equal(t, []string{"bug", "triage"}, form.Labels)
+	assert.Equal(t, []string{"octocat"}, form.Assignees)
+	require.Len(t, form.Fields, 4)
+	assert.Equal(t, IssueTemplateField{ID: "what-happened", Type: "textarea", Label: "What happened?", Placeholder: "Tell us what you see!", Required: true}, form.Fields[0])
+	assert.Equal(t, []string{"1.0", "2.0"}, form.Fields[1].Options)
+	assert.Equal(t, "shell", form.Fields[2].Render)
+	assert.Equal(t, []string{"I agree to follow this project's Code of Conduct"}, form.Fields[3].RequiredOptions)
+
+	markdown, err := parseIssueTemplate("feature_request.md", featureRequestTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, "markdown", markdown.Format)
+	assert.Equal(t, "Feature request", markdown.Name)
+	assert.Equal(t, "Suggest an idea for this project", markdown.Description)
+	assert.Equal(t, []string{"enhancement"}, markdown.Labels)
+	assert.Equal(t, "**Is your feature request related to a problem? Please describe.**\n", markdown.Body)
+	assert.Empty(t, markdown.Fields)
+}
+
+func mockIssueTemplateContents(t *testing.T, files map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/contents/.github/ISSUE_TEMPLATE") {
+			entries := []*github.RepositoryContent{}
+			for name := range files {
+				entries = append(entries, &github.RepositoryContent{
+					Type: github.Ptr("file"),
+					Name: github.Ptr(name),
+					Path: github.Ptr(".github/ISSUE_TEMPLATE/" + name),
+				})
+			}
+			mockResponse(t, http.StatusOK, entries)(w, r)
+			return
+		}
+		for name, content := range files {
+			if strings.HasSuffix(r.URL.Path, "/"+name) {
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Type:     github.Ptr("file"),
+					Name:     github.Ptr(name),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+				})(w, r)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}
+}
+
+func Test_ListIssueTemplates(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListIssueTemplates(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_templates", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name          string
+		files         map[string]string
+		expectedFiles []string
+	}{
+		{
+			name: "forms and markdown templates",
+			files: map[string]string{
+				"bug_report.yml":     bugReportForm,
+				"feature_request.md": featureRequestTemplate,
+				"config.yml":         "blank_issues_enabled: false\n",
+			},
+			expectedFiles: []string{"bug_report.yml", "feature_request.md"},
+		},
+		{
+			name:          "repository without templates",
+			files:         nil,
+			expectedFiles: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := mockIssueTemplateContents(t, tc.files)
+			if tc.files == nil {
+				handler = func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}
+			}
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, handler),
+			))
+			_, toolHandler := ListIssueTemplates(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := toolHandler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			var templates []IssueTemplate
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &templates))
+			files := []string{}
+			for _, template := range templates {
+				files = append(files, template.File)
+			}
+			assert.ElementsMatch(t, tc.expectedFiles, files)
+		})
+	}
+}
+
+func Test_CreateIssueFromTemplate(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateIssueFromTemplate(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_issue_from_template", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "template"})
+
+	files := map[string]string{
+		"bug_report.yml":     bugReportForm,
+		"feature_request.md": featureRequestTemplate,
+	}
+	validValues := map[string]interface{}{
+		"what-happened":   "It crashed",
+		"Version":         "2.0",
+		"logs":            "panic: boom",
+		"Code of Conduct": []interface{}{"I agree to follow this project's Code of Conduct"},
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectRequest  map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create issue from form",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "Bug Report",
+				"title":    "App crashes on start",
+				"values":   validValues,
+			},
+			expectRequest: map[string]any{
+				"title": "[Bug]: App crashes on start",
+				"body": "### What happened?\n\nIt crashed\n\n" +
+					"### Version\n\n2.0\n\n" +
+					"### Relevant log output\n\n```shell\npanic: boom\n```\n\n" +
+					"### Code of Conduct\n\n- [X] I agree to follow this project's Code of Conduct",
+				"labels":    []any{"bug", "triage"},
+				"assignees": []any{"octocat"},
+			},
+		},
+		{
+			name: "missing required field",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report.yml",
+				"title":    "App crashes on start",
+				"values":   map[string]interface{}{"Version": "2.0"},
+			},
+			expectError:    true,
+			expectedErrMsg: `field "What happened?" is required`,
+		},
+		{
+			name: "invalid dropdown option",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report.yml",
+				"title":    "App crashes on start",
+				"values": map[string]interface{}{
+					"what-happened": "It crashed",
+					"version":       "3.0",
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: `"3.0" is not one of the options`,
+		},
+		{
+			name: "required checkbox not checked",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report.yml",
+				"title":    "App crashes on start",
+				"values": map[string]interface{}{
+					"what-happened": "It crashed",
+					"version":       "1.0",
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "must be checked",
+		},
+		{
+			name: "unknown field",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report.yml",
+				"title":    "App crashes on start",
+				"values": map[string]interface{}{
+					"what-happened": "It crashed",
+					"version":       "1.0",
+					"terms":         []interface{}{"I agree to follow this project's Code of Conduct"},
+					"severity":      "high",
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: `has no field "severity"`,
+		},
+		{
+			name: "markdown template",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "feature_request.md",
+				"title":    "Dark mode",
+			},
+			expectError:    true,
+			expectedErrMsg: "is a markdown template",
+		},
+		{
+			name: "template not found",
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "security.yml",
+			},
+			expectError:    true,
+			expectedErrMsg: `issue template "security.yml" not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, mockIssueTemplateContents(t, files)),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, tc.expectRequest).andThen(
+						mockResponse(t, http.StatusCreated, &github.Issue{
+							ID:      github.Ptr(int64(1)),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/1"),
+						}),
+					),
+				),
+			))
+			_, handler := CreateIssueFromTemplate(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "https://github.com/owner/repo/issues/1", response.URL)
+		})
+	}
+}
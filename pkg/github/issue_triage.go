@@ -0,0 +1,342 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultLabelColor is the color of labels created for a taxonomy entry without a color.
+const defaultLabelColor = "ededed"
+
+// TaxonomyLabel is a label of a triage taxonomy. Labels in the same group are mutually exclusive.
+type TaxonomyLabel struct {
+	LabelSpec
+	Group string `json:"group,omitempty"`
+}
+
+// LabelPlan is the deterministic set of changes that applies a classification to an issue.
+type LabelPlan struct {
+	Add          []string `json:"add"`
+	Remove       []string `json:"remove"`
+	CreateLabels []string `json:"create_labels"`
+	Comment      string   `json:"comment,omitempty"`
+}
+
+// IssueToClassify is the part of an issue a host needs to classify it.
+type IssueToClassify struct {
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Labels []string `json:"labels"`
+	URL    string   `json:"url"`
+}
+
+// parseTaxonomy converts the taxonomy parameter of a request to taxonomy labels.
+func parseTaxonomy(request mcp.CallToolRequest) ([]TaxonomyLabel, error) {
+	specs, err := parseLabelSpecs(request, "taxonomy")
+	if err != nil {
+		return nil, err
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("missing required parameter: taxonomy")
+	}
+	items, _ := request.GetArguments()["taxonomy"].([]interface{})
+	taxonomy := make([]TaxonomyLabel, 0, len(specs))
+	for i, spec := range specs {
+		group, _ := items[i].(map[string]interface{})["group"].(string)
+		taxonomy = append(taxonomy, TaxonomyLabel{LabelSpec: spec, Group: group})
+	}
+	return taxonomy, nil
+}
+
+// planIssueLabels computes the labels to add to and remove from an issue to apply a classification. Labels that are
+// already on the issue are kept, except for labels of the taxonomy that share a group with a classified label.
+// Classified labels that do not exist in the repository yet are created from the taxonomy.
+func planIssueLabels(taxonomy []TaxonomyLabel, classification, issueLabels, repoLabels []string) (LabelPlan, error) {
+	plan := LabelPlan{Add: []string{}, Remove: []string{}, CreateLabels: []string{}}
+
+	byName := map[string]TaxonomyLabel{}
+	for _, label := range taxonomy {
+		byName[strings.ToLower(label.Name)] = label
+	}
+	onIssue := map[string]bool{}
+	for _, name := range issueLabels {
+		onIssue[strings.ToLower(name)] = true
+	}
+	inRepo := map[string]bool{}
+	for _, name := range repoLabels {
+		inRepo[strings.ToLower(name)] = true
+	}
+
+	chosen := map[string]bool{}
+	groups := map[string]string{}
+	for _, name := range classification {
+		label, ok := byName[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return plan, fmt.Errorf("label %q is not part of the taxonomy", name)
+		}
+		if chosen[strings.ToLower(label.Name)] {
+			continue
+		}
+		if label.Group != "" {
+			if other, ok := groups[label.Group]; ok {
+				return plan, fmt.Errorf("labels %q and %q are both in group %q; choose one", other, label.Name, label.Group)
+			}
+			groups[label.Group] = label.Name
+		}
+		chosen[strings.ToLower(label.Name)] = true
+		if !onIssue[strings.ToLower(label.Name)] {
+			plan.Add = append(plan.Add, label.Name)
+			if !inRepo[strings.ToLower(label.Name)] {
+				plan.CreateLabels = append(plan.CreateLabels, label.Name)
+			}
+		}
+	}
+
+	for _, name := range issueLabels {
+		label, ok := byName[strings.ToLower(name)]
+		if !ok || label.Group == "" || chosen[strings.ToLower(name)] {
+			continue
+		}
+		if _, replaced := groups[label.Group]; replaced {
+			plan.Remove = append(plan.Remove, name)
+		}
+	}
+
+	sort.Strings(plan.Add)
+	sort.Strings(plan.Remove)
+	sort.Strings(plan.CreateLabels)
+	return plan, nil
+}
+
+// ClassifyAndLabelIssue creates a tool to apply a classification from a label taxonomy to an issue.
+func ClassifyAndLabelIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("classify_and_label_issue",
+			mcp.WithDescription(t("TOOL_CLASSIFY_AND_LABEL_ISSUE_DESCRIPTION", `Label an issue according to a label taxonomy. Call it without classification to get the issue and the taxonomy, pick the labels that apply based on their descriptions, then call it again with classification to get the label plan: labels to add, labels of the same group to remove and labels to create in the repository. Set apply to true to apply the plan and post the optional comment.`)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CLASSIFY_AND_LABEL_ISSUE_USER_TITLE", "Classify and label issue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithArray("taxonomy",
+				mcp.Required(),
+				mcp.Description("Labels to classify the issue with. Labels with the same group, e.g. 'priority', are mutually exclusive"),
+				mcp.Items(map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"name"},
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Label name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "When the label applies",
+						},
+						"color": map[string]interface{}{
+							"type":        "string",
+							"description": "Color used when the label has to be created, e.g. 'd73a4a'",
+						},
+						"group": map[string]interface{}{
+							"type":        "string",
+							"description": "Group of mutually exclusive labels",
+						},
+					},
+				}),
+			),
+			mcp.WithArray("classification",
+				mcp.Description("Names of the taxonomy labels that apply to the issue"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Comment to post on the issue when the plan is applied, e.g. the reasoning behind the classification"),
+			),
+			mcp.WithBoolean("apply",
+				mcp.Description("Apply the label plan. When false, only the plan is returned"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taxonomy, err := parseTaxonomy(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			_, classified := request.GetArguments()["classification"]
+			classification, err := OptionalStringArrayParam(request, "classification")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comment, err := OptionalParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			apply, err := OptionalBoolParamWithDefault(request, "apply", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if apply && !classified {
+				return mcp.NewToolResultError("classification is required to apply a label plan"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get issue",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			issueLabels := make([]string, 0, len(issue.Labels))
+			for _, label := range issue.Labels {
+				issueLabels = append(issueLabels, label.GetName())
+			}
+
+			if !classified {
+				response := map[string]any{
+					"issue": IssueToClassify{
+						Number: issue.GetNumber(),
+						Title:  sanitize.Sanitize(issue.GetTitle()),
+						Body:   sanitize.Sanitize(issue.GetBody()),
+						Labels: issueLabels,
+						URL:    issue.GetHTMLURL(),
+					},
+					"taxonomy":     taxonomy,
+					"instructions": "Choose the taxonomy labels that apply to the issue, at most one per group, and call this tool again with them as classification.",
+				}
+				r, err := json.Marshal(response)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			repoLabels, resp, err := listAllLabels(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list labels",
+					resp,
+					err,
+				), nil
+			}
+			repoLabelNames := make([]string, 0, len(repoLabels))
+			for _, label := range repoLabels {
+				repoLabelNames = append(repoLabelNames, label.GetName())
+			}
+
+			plan, err := planIssueLabels(taxonomy, classification, issueLabels, repoLabelNames)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			plan.Comment = comment
+
+			if apply {
+				if err := applyLabelPlan(ctx, client, owner, repo, issueNumber, taxonomy, plan); err != nil {
+					return err, nil
+				}
+			}
+
+			response := map[string]any{
+				"issue":   issue.GetHTMLURL(),
+				"plan":    plan,
+				"applied": apply,
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// applyLabelPlan creates the missing labels, updates the labels of the issue and posts the comment of a plan. It
+// returns a tool error result if any step fails.
+func applyLabelPlan(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, taxonomy []TaxonomyLabel, plan LabelPlan) *mcp.CallToolResult {
+	byName := map[string]TaxonomyLabel{}
+	for _, label := range taxonomy {
+		byName[strings.ToLower(label.Name)] = label
+	}
+
+	for _, name := range plan.CreateLabels {
+		spec := byName[strings.ToLower(name)]
+		label := &github.Label{Name: github.Ptr(spec.Name), Color: github.Ptr(defaultLabelColor)}
+		if spec.Color != "" {
+			label.Color = github.Ptr(normalizeLabelColor(spec.Color))
+		}
+		if spec.Description != "" {
+			label.Description = github.Ptr(spec.Description)
+		}
+		_, resp, err := client.Issues.CreateLabel(ctx, owner, repo, label)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create label %q", name), resp, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	for _, name := range plan.Remove {
+		resp, err := client.Issues.RemoveLabelForIssue(ctx, owner, repo, issueNumber, name)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to remove label %q", name), resp, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if len(plan.Add) > 0 {
+		_, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, issueNumber, plan.Add)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add labels", resp, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if plan.Comment != "" {
+		_, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{Body: github.Ptr(plan.Comment)})
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create comment", resp, err)
+		}
+		_ = resp.Body.Close()
+	}
+	return nil
+}
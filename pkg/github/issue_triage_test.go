@@ -0,0 +1,218 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var triageTaxonomy = []TaxonomyLabel{
+	{LabelSpec: LabelSpec{Name: "bug", Description: "Something isn't working"}},
+	{LabelSpec: LabelSpec{Name: "enhancement", Description: "New feature or request"}},
+	{LabelSpec: LabelSpec{Name: "priority: high", Color: "b60205"}, Group: "priority"},
+	{LabelSpec: LabelSpec{Name: "priority: low"}, Group: "priority"},
+}
+
+func Test_PlanIssueLabels(t *testing.T) {
+	tests := []struct {
+		name           string
+		classification []string
+		issueLabels    []string
+		expected       LabelPlan
+		expectedErrMsg string
+	}{
+		{
+			name:           "add and create labels",
+			classification: []string{"Bug", "priority: high"},
+			issueLabels:    []string{"needs-triage"},
+			expected:       LabelPlan{Add: []string{"bug", "priority: high"}, Remove: []string{}, CreateLabels: []string{"priority: high"}},
+		},
+		{
+			name:           "replace label of the same group",
+			classification: []string{"priority: high"},
+			issueLabels:    []string{"bug", "priority: low"},
+			expected:       LabelPlan{Add: []string{"priority: high"}, Remove: []string{"priority: low"}, CreateLabels: []string{"priority: high"}},
+		},
+		{
+			name:           "already labeled",
+			classification: []string{"bug"},
+			issueLabels:    []string{"bug", "priority: low"},
+			expected:       LabelPlan{Add: []string{}, Remove: []string{}, CreateLabels: []string{}},
+		},
+		{
+			name:           "label outside taxonomy",
+			classification: []string{"question"},
+			expectedErrMsg: `label "question" is not part of the taxonomy`,
+		},
+		{
+			name:           "two labels of the same group",
+			classification: []string{"priority: high", "priority: low"},
+			expectedErrMsg: `are both in group "priority"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := planIssueLabels(triageTaxonomy, tc.classification, tc.issueLabels, []string{"bug", "enhancement", "priority: low", "needs-triage"})
+			if tc.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, plan)
+		})
+	}
+}
+
+func Test_ClassifyAndLabelIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ClassifyAndLabelIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "classify_and_label_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "taxonomy"})
+
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(7),
+		Title:   github.Ptr("Crash on start"),
+		Body:    github.Ptr("The app crashes when started"),
+		Labels:  []*github.Label{{Name: github.Ptr("priority: low")}},
+		HTMLURL: github.Ptr("https://github.com/octo/repo/issues/7"),
+	}
+	taxonomy := []interface{}{
+		map[string]interface{}{"name": "bug", "description": "Something isn't working"},
+		map[string]interface{}{"name": "priority: high", "color": "#B60205", "group": "priority"},
+		map[string]interface{}{"name": "priority: low", "group": "priority"},
+	}
+	args := func(extra map[string]interface{}) map[string]interface{} {
+		a := map[string]interface{}{"owner": "octo", "repo": "repo", "issue_number": float64(7), "taxonomy": taxonomy}
+		for k, v := range extra {
+			a[k] = v
+		}
+		return a
+	}
+
+	t.Run("returns issue and taxonomy without classification", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, mockIssue),
+		))
+		_, handler := ClassifyAndLabelIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(nil)))
+		require.NoError(t, err)
+
+		var response struct {
+			Issue    IssueToClassify `json:"issue"`
+			Taxonomy []TaxonomyLabel `json:"taxonomy"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "Crash on start", response.Issue.Title)
+		assert.Equal(t, []string{"priority: low"}, response.Issue.Labels)
+		require.Len(t, response.Taxonomy, 3)
+		assert.Equal(t, "priority", response.Taxonomy[1].Group)
+	})
+
+	t.Run("returns plan without applying it", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, mockIssue),
+			mock.WithRequestMatch(mock.GetReposLabelsByOwnerByRepo, []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("priority: low")}}),
+		))
+		_, handler := ClassifyAndLabelIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{
+			"classification": []interface{}{"bug", "priority: high"},
+		})))
+		require.NoError(t, err)
+
+		var response struct {
+			Plan    LabelPlan `json:"plan"`
+			Applied bool      `json:"applied"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.False(t, response.Applied)
+		assert.Equal(t, LabelPlan{
+			Add:          []string{"bug", "priority: high"},
+			Remove:       []string{"priority: low"},
+			CreateLabels: []string{"priority: high"},
+		}, response.Plan)
+	})
+
+	t.Run("applies plan", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, mockIssue),
+			mock.WithRequestMatch(mock.GetReposLabelsByOwnerByRepo, []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("priority: low")}}),
+			mock.WithRequestMatchHandler(
+				mock.PostReposLabelsByOwnerByRepo,
+				expectRequestBody(t, map[string]any{"name": "priority: high", "color": "b60205"}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Label{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+				expectPath(t, "/repos/octo/repo/issues/7/labels/priority: low").andThen(
+					mockResponse(t, http.StatusOK, nil),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+				expectRequestBody(t, []any{"bug", "priority: high"}).andThen(
+					mockResponse(t, http.StatusOK, []*github.Label{}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+				expectRequestBody(t, map[string]any{"body": "Classified as a high priority bug."}).andThen(
+					mockResponse(t, http.StatusCreated, &github.IssueComment{}),
+				),
+			),
+		))
+		_, handler := ClassifyAndLabelIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{
+			"classification": []interface{}{"bug", "priority: high"},
+			"comment":        "Classified as a high priority bug.",
+			"apply":          true,
+		})))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response struct {
+			Applied bool `json:"applied"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.Applied)
+	})
+
+	t.Run("rejects labels outside the taxonomy", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, mockIssue),
+			mock.WithRequestMatch(mock.GetReposLabelsByOwnerByRepo, []*github.Label{}),
+		))
+		_, handler := ClassifyAndLabelIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{
+			"classification": []interface{}{"question"},
+		})))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `label "question" is not part of the taxonomy`)
+	})
+
+	t.Run("apply requires classification", func(t *testing.T) {
+		_, handler := ClassifyAndLabelIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(args(map[string]interface{}{"apply": true})))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "classification is required to apply a label plan")
+	})
+}
@@ -170,6 +170,14 @@ type ListIssuesQueryTypeWithLabelsWithSince struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
+// ListIssuesQueryWithFilters is the query structure for fetching issues filtered by assignee or milestone, in which
+// labels and since are set in $filterBy too.
+type ListIssuesQueryWithFilters struct {
+	Repository struct {
+		Issues IssueQueryFragment `graphql:"issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: $filterBy)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
 // Implement the interface for all query types
 func (q *ListIssuesQueryTypeWithLabels) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
@@ -183,6 +191,10 @@ func (q *ListIssuesQueryWithSince) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
 }
 
+func (q *ListIssuesQueryWithFilters) GetIssueFragment() IssueQueryFragment {
+	return q.Repository.Issues
+}
+
 func (q *ListIssuesQueryTypeWithLabelsWithSince) GetIssueFragment() IssueQueryFragment {
 	return q.Repository.Issues
 }
@@ -200,6 +212,14 @@ func getIssueQueryType(hasLabels bool, hasSince bool) any {
 	}
 }
 
+// issueFilterValue converts an optional assignee or milestone filter of list_issues to IssueFilters.
+func issueFilterValue(value string) *githubv4.String {
+	if value == "" {
+		return nil
+	}
+	return githubv4.NewString(githubv4.String(value))
+}
+
 func fragmentToIssue(fragment IssueFragment) *github.Issue {
 	// Convert GraphQL labels to GitHub API labels format
 	var foundLabels []*github.Label
@@ -248,17 +268,15 @@ Options are:
 				mcp.Enum("get", "get_comments", "get_sub_issues", "get_labels"),
 			),
 			mcp.WithString("owner",
-				mcp.Required(),
-				mcp.Description("The owner of the repository"),
+				mcp.Description("The owner of the repository. Required unless ref is given"),
 			),
 			mcp.WithString("repo",
-				mcp.Required(),
-				mcp.Description("The name of the repository"),
+				mcp.Description("The name of the repository. Required unless ref is given"),
 			),
 			mcp.WithNumber("issue_number",
-				mcp.Required(),
-				mcp.Description("The number of the issue"),
+				mcp.Description("The number of the issue. Required unless ref is given"),
 			),
+			WithIssueRef(),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -267,18 +285,11 @@ Options are:
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			owner, err := RequiredParam[string](request, "owner")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			repo, err := RequiredParam[string](request, "repo")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			issueNumber, err := RequiredInt(request, "issue_number")
+			target, err := RequiredIssueTarget(request, "issue_number")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			owner, repo, issueNumber := target.Owner, target.Repo, target.Number
 
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
@@ -535,6 +546,7 @@ func ListIssueTypes(getClient GetClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_LIST_ISSUE_TYPES_USER_TITLE", "List available issue types"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.IssueType](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("The organization owner of the repository"),
@@ -564,12 +576,7 @@ func ListIssueTypes(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issue types: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issueTypes)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issue types: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(issueTypes), nil
 		}
 }
 
@@ -582,35 +589,26 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
-				mcp.Required(),
-				mcp.Description("Repository owner"),
+				mcp.Description("Repository owner. Required unless ref is given"),
 			),
 			mcp.WithString("repo",
-				mcp.Required(),
-				mcp.Description("Repository name"),
+				mcp.Description("Repository name. Required unless ref is given"),
 			),
 			mcp.WithNumber("issue_number",
-				mcp.Required(),
-				mcp.Description("Issue number to comment on"),
+				mcp.Description("Issue number to comment on. Required unless ref is given"),
 			),
+			WithIssueRef(),
 			mcp.WithString("body",
 				mcp.Required(),
 				mcp.Description("Comment content"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			owner, err := RequiredParam[string](request, "owner")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			repo, err := RequiredParam[string](request, "repo")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			issueNumber, err := RequiredInt(request, "issue_number")
+			target, err := RequiredIssueTarget(request, "issue_number")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			owner, repo, issueNumber := target.Owner, target.Repo, target.Number
 			body, err := RequiredParam[string](request, "body")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -647,6 +645,125 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// UpdateIssueComment creates a tool to edit a comment on an issue.
+func UpdateIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_issue_comment",
+			mcp.WithDescription(t("TOOL_UPDATE_ISSUE_COMMENT_DESCRIPTION", "Edit a comment on an issue or pull request in a GitHub repository, replacing its body. Get the ID of a comment with issue_read's get_comments method.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_ISSUE_COMMENT_USER_TITLE", "Update issue comment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[github.IssueComment](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the comment to edit"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("New comment content"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredBigInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comment, resp, err := client.Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{
+				Body: github.Ptr(body),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update comment %d", commentID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(comment), nil
+		}
+}
+
+// DeleteIssueComment creates a tool to delete a comment on an issue.
+func DeleteIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	issueCommentDeletedMessage := t("RESULT_ISSUE_COMMENT_DELETED", "issue comment successfully deleted")
+	return mcp.NewTool("delete_issue_comment",
+			mcp.WithDescription(t("TOOL_DELETE_ISSUE_COMMENT_DESCRIPTION", "Delete a comment on an issue or pull request in a GitHub repository. Get the ID of a comment with issue_read's get_comments method.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_ISSUE_COMMENT_USER_TITLE", "Delete issue comment"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the comment to delete"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredBigInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Issues.DeleteComment(ctx, owner, repo, commentID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete comment %d", commentID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(issueCommentDeletedMessage), nil
+		}
+}
+
 // SubIssueWrite creates a tool to add a sub-issue to a parent issue.
 func SubIssueWrite(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("sub_issue_write",
@@ -860,16 +977,17 @@ func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner stri
 }
 
 // SearchIssues creates a tool to search for issues.
-func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_issues",
-			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues in GitHub repositories using issues search syntax already scoped to is:issue")),
+			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues across GitHub repositories using issues search syntax already scoped to is:issue. Returns the matching issues with their repository, author, labels and assignees.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_ISSUES_USER_TITLE", "Search issues"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[MinimalSearchIssuesResult](),
 			mcp.WithString("query",
 				mcp.Required(),
-				mcp.Description("Search query using GitHub issues search syntax"),
+				mcp.Description("Search query using GitHub issues search syntax. Date qualifiers also accept relative dates such as created:\"last monday\", updated:>=3-days-ago, closed:last-week or created:2024-W05 (ISO week)"),
 			),
 			mcp.WithString("owner",
 				mcp.Description("Optional repository owner. If provided with repo, only issues for this repository are listed."),
@@ -900,7 +1018,20 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchHandler(ctx, getClient, request, "issue", "failed to search issues")
+			result, errResult, err := searchIssuesAndPullRequests(ctx, getClient, request, "issue", "failed to search issues", flags.now())
+			if result == nil {
+				return errResult, err
+			}
+
+			minimalResult := MinimalSearchIssuesResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]MinimalIssue, 0, len(result.Issues)),
+			}
+			for _, issue := range result.Issues {
+				minimalResult.Items = append(minimalResult.Items, convertToMinimalIssue(issue))
+			}
+			return MarshalledStructuredResult(minimalResult), nil
 		}
 }
 
@@ -1252,6 +1383,7 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_LIST_ISSUES_USER_TITLE", "List issues"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[IssuesListResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1272,6 +1404,12 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 					},
 				),
 			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee login, or '*' for issues assigned to anyone"),
+			),
+			mcp.WithString("milestone",
+				mcp.Description("Filter by milestone number, or '*' for issues in any milestone"),
+			),
 			mcp.WithString("orderBy",
 				mcp.Description("Order issues by field. If provided, the 'direction' also needs to be provided."),
 				mcp.Enum("CREATED_AT", "UPDATED_AT", "COMMENTS"),
@@ -1352,6 +1490,15 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 			}
 			hasLabels := len(labels) > 0
 
+			assignee, err := OptionalParam[string](request, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestone, err := OptionalParam[string](request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			// Get pagination parameters and convert to GraphQL format
 			pagination, err := OptionalCursorPaginationParams(request)
 			if err != nil {
@@ -1413,7 +1560,27 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				vars["since"] = githubv4.DateTime{Time: sinceTime}
 			}
 
-			issueQuery := getIssueQueryType(hasLabels, hasSince)
+			var issueQuery any
+			if assignee != "" || milestone != "" {
+				// The assignee and milestone filters are only taken in filterBy, so all the filters are set there.
+				filters := githubv4.IssueFilters{
+					Assignee:        issueFilterValue(assignee),
+					MilestoneNumber: issueFilterValue(milestone),
+				}
+				if hasLabels {
+					labels := vars["labels"].([]githubv4.String)
+					filters.Labels = &labels
+					delete(vars, "labels")
+				}
+				if hasSince {
+					filters.Since = &githubv4.DateTime{Time: sinceTime}
+					delete(vars, "since")
+				}
+				vars["filterBy"] = filters
+				issueQuery = &ListIssuesQueryWithFilters{}
+			} else {
+				issueQuery = getIssueQueryType(hasLabels, hasSince)
+			}
 			if err := client.Query(ctx, issueQuery, vars); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -1437,25 +1604,37 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				totalCount = fragment.TotalCount
 			}
 
-			// Create response with issues
-			response := map[string]interface{}{
-				"issues": issues,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     pageInfo.HasNextPage,
-					"hasPreviousPage": pageInfo.HasPreviousPage,
-					"startCursor":     string(pageInfo.StartCursor),
-					"endCursor":       string(pageInfo.EndCursor),
-				},
-				"totalCount": totalCount,
+			if issues == nil {
+				issues = []*github.Issue{}
 			}
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issues: %w", err)
-			}
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledStructuredResult(IssuesListResult{
+				Issues: issues,
+				PageInfo: CursorPageInfo{
+					HasNextPage:     bool(pageInfo.HasNextPage),
+					HasPreviousPage: bool(pageInfo.HasPreviousPage),
+					StartCursor:     string(pageInfo.StartCursor),
+					EndCursor:       string(pageInfo.EndCursor),
+				},
+				TotalCount: totalCount,
+			}), nil
 		}
 }
 
+// CursorPageInfo describes the page of a cursor-paginated GraphQL connection.
+type CursorPageInfo struct {
+	HasNextPage     bool   `json:"hasNextPage"`
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+	StartCursor     string `json:"startCursor"`
+	EndCursor       string `json:"endCursor"`
+}
+
+// IssuesListResult is the structured content of list_issues.
+type IssuesListResult struct {
+	Issues     []*github.Issue `json:"issues"`
+	PageInfo   CursorPageInfo  `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
 // mvpDescription is an MVP idea for generating tool descriptions from structured data in a shared format.
 // It is not intended for widespread usage and is not a complete implementation.
 type mvpDescription struct {
@@ -1487,6 +1666,7 @@ func (d *mvpDescription) String() string {
 }
 
 func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	copilotAssignedMessage := t("RESULT_COPILOT_ASSIGNED", "successfully assigned copilot to issue")
 	description := mvpDescription{
 		summary: "Assign Copilot to a specific issue in a GitHub repository.",
 		outcomes: []string{
@@ -1641,7 +1821,7 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 				return nil, fmt.Errorf("failed to replace actors for assignable: %w", err)
 			}
 
-			return mcp.NewToolResultText("successfully assigned copilot to issue"), nil
+			return mcp.NewToolResultText(copilotAssignedMessage), nil
 		}
 }
 
@@ -132,7 +132,8 @@ func Test_GetIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "issue_number"})
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock issue for success case
 	mockIssue := &github.Issue{
@@ -195,6 +196,44 @@ func Test_GetIssue(t *testing.T) {
 			},
 			expectedIssue: mockIssue,
 		},
+		{
+			name: "issue retrieval by reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					expectPath(t, "/repos/owner/repo/issues/42").andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"method": "get",
+				"ref":    "owner/repo#42",
+			},
+			expectedIssue: mockIssue,
+		},
+		{
+			name:         "reference with issue number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"method":       "get",
+				"ref":          "owner/repo#42",
+				"issue_number": float64(42),
+			},
+			expectResultError: true,
+			expectedErrMsg:    "pass either ref or owner, repo and issue_number, not both",
+		},
+		{
+			name:         "missing issue",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"method": "get",
+				"owner":  "owner",
+				"repo":   "repo",
+			},
+			expectResultError: true,
+			expectedErrMsg:    "missing required parameter: issue_number",
+		},
 		{
 			name: "issue not found",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -378,7 +417,8 @@ func Test_AddIssueComment(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
 	assert.Contains(t, tool.InputSchema.Properties, "body")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "body"})
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"body"})
 
 	// Setup mock comment for success case
 	mockComment := &github.IssueComment{
@@ -415,6 +455,23 @@ func Test_AddIssueComment(t *testing.T) {
 			expectError:     false,
 			expectedComment: mockComment,
 		},
+		{
+			name: "comment creation by reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectPath(t, "/repos/owner/repo/issues/42/comments").andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"ref":  "owner/repo#42",
+				"body": "This is a test comment",
+			},
+			expectError:     false,
+			expectedComment: mockComment,
+		},
 		{
 			name: "comment creation fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -480,10 +537,100 @@ func Test_AddIssueComment(t *testing.T) {
 	}
 }
 
+func Test_UpdateIssueComment(t *testing.T) {
+	tool, _ := UpdateIssueComment(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_issue_comment", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "comment_id", "body"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectError  bool
+		errContains  string
+	}{
+		{
+			name: "comment updated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+					expectRequestBody(t, map[string]any{"body": "Edited"}).andThen(
+						mockResponse(t, http.StatusOK, &github.IssueComment{ID: github.Ptr(int64(99)), Body: github.Ptr("Edited")}),
+					),
+				),
+			),
+		},
+		{
+			name: "comment not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError: true,
+			errContains: "failed to update comment 99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := UpdateIssueComment(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(99),
+				"body":       "Edited",
+			}))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.errContains)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var comment github.IssueComment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &comment))
+			assert.Equal(t, int64(99), comment.GetID())
+			assert.Equal(t, "Edited", comment.GetBody())
+		})
+	}
+}
+
+func Test_DeleteIssueComment(t *testing.T) {
+	tool, _ := DeleteIssueComment(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_issue_comment", tool.Name)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "comment_id"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposIssuesCommentsByOwnerByRepoByCommentId,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+	_, handler := DeleteIssueComment(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"comment_id": float64(99),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+	assert.Equal(t, "issue comment successfully deleted", getTextResult(t, result).Text)
+}
+
 func Test_SearchIssues(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := SearchIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SearchIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "search_issues", tool.Name)
@@ -512,6 +659,8 @@ func Test_SearchIssues(t *testing.T) {
 				User: &github.User{
 					Login: github.Ptr("user1"),
 				},
+				RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+				Labels:        []*github.Label{{Name: github.Ptr("bug")}},
 			},
 			{
 				Number:   github.Ptr(43),
@@ -523,6 +672,8 @@ func Test_SearchIssues(t *testing.T) {
 				User: &github.User{
 					Login: github.Ptr("user2"),
 				},
+				RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+				Labels:        []*github.Label{{Name: github.Ptr("bug")}},
 			},
 		},
 	}
@@ -593,6 +744,29 @@ func Test_SearchIssues(t *testing.T) {
 			expectError:    false,
 			expectedResult: mockSearchResult,
 		},
+		{
+			name: "issues search with relative date qualifier",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "is:issue repo:owner/repo created:2024-01-29..2024-02-04",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "repo:owner/repo created:2024-W05",
+			},
+			expectError:    false,
+			expectedResult: mockSearchResult,
+		},
 		{
 			name: "issues search with only owner parameter (should ignore it)",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -772,7 +946,7 @@ func Test_SearchIssues(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -793,18 +967,20 @@ func Test_SearchIssues(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedResult github.IssuesSearchResult
+			var returnedResult MinimalSearchIssuesResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.Issues, len(tc.expectedResult.Issues))
-			for i, issue := range returnedResult.Issues {
-				assert.Equal(t, *tc.expectedResult.Issues[i].Number, *issue.Number)
-				assert.Equal(t, *tc.expectedResult.Issues[i].Title, *issue.Title)
-				assert.Equal(t, *tc.expectedResult.Issues[i].State, *issue.State)
-				assert.Equal(t, *tc.expectedResult.Issues[i].HTMLURL, *issue.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.Issues[i].User.Login, *issue.User.Login)
+			assert.Equal(t, *tc.expectedResult.Total, returnedResult.TotalCount)
+			assert.Equal(t, *tc.expectedResult.IncompleteResults, returnedResult.IncompleteResults)
+			assert.Len(t, returnedResult.Items, len(tc.expectedResult.Issues))
+			for i, issue := range returnedResult.Items {
+				assert.Equal(t, *tc.expectedResult.Issues[i].Number, issue.Number)
+				assert.Equal(t, *tc.expectedResult.Issues[i].Title, issue.Title)
+				assert.Equal(t, *tc.expectedResult.Issues[i].State, issue.State)
+				assert.Equal(t, *tc.expectedResult.Issues[i].HTMLURL, issue.HTMLURL)
+				assert.Equal(t, *tc.expectedResult.Issues[i].User.Login, issue.Author)
+				assert.Equal(t, "owner/repo", issue.Repository)
+				assert.Equal(t, []string{"bug"}, issue.Labels)
 			}
 		})
 	}
@@ -987,6 +1163,8 @@ func Test_ListIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "orderBy")
 	assert.Contains(t, tool.InputSchema.Properties, "direction")
 	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
 	assert.Contains(t, tool.InputSchema.Properties, "after")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
@@ -1141,6 +1319,21 @@ func Test_ListIssues(t *testing.T) {
 		"after":     (*string)(nil),
 	}
 
+	varsWithFilters := map[string]interface{}{
+		"owner":     "owner",
+		"repo":      "repo",
+		"states":    []interface{}{"OPEN"},
+		"orderBy":   "CREATED_AT",
+		"direction": "DESC",
+		"first":     float64(30),
+		"after":     (*string)(nil),
+		"filterBy": map[string]interface{}{
+			"assignee":        "user1",
+			"milestoneNumber": "3",
+			"labels":          []interface{}{"bug"},
+		},
+	}
+
 	varsRepoNotFound := map[string]interface{}{
 		"owner":     "owner",
 		"repo":      "nonexistent-repo",
@@ -1198,6 +1391,19 @@ func Test_ListIssues(t *testing.T) {
 			expectError:   false,
 			expectedCount: 2,
 		},
+		{
+			name: "filter by assignee and milestone",
+			reqParams: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "OPEN",
+				"labels":    []any{"bug"},
+				"assignee":  "user1",
+				"milestone": "3",
+			},
+			expectError:   false,
+			expectedCount: 2,
+		},
 		{
 			name: "repository not found error",
 			reqParams: map[string]interface{}{
@@ -1212,6 +1418,7 @@ func Test_ListIssues(t *testing.T) {
 	// Define the actual query strings that match the implementation
 	qBasicNoLabels := "query($after:String$direction:OrderDirection!$first:Int!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qWithLabels := "query($after:String$direction:OrderDirection!$first:Int!$labels:[String!]!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, labels: $labels, states: $states, orderBy: {field: $orderBy, direction: $direction}){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qWithFilters := "query($after:String$direction:OrderDirection!$filterBy:IssueFilters!$first:Int!$orderBy:IssueOrderField!$owner:String!$repo:String!$states:[IssueState!]!){repository(owner: $owner, name: $repo){issues(first: $first, after: $after, states: $states, orderBy: {field: $orderBy, direction: $direction}, filterBy: $filterBy){nodes{number,title,body,state,databaseId,author{login},createdAt,updatedAt,labels(first: 100){nodes{name,id,description}},comments{totalCount}},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1230,6 +1437,9 @@ func Test_ListIssues(t *testing.T) {
 			case "filter by labels":
 				matcher := githubv4mock.NewQueryMatcher(qWithLabels, varsWithLabels, mockResponseListAll)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "filter by assignee and milestone":
+				matcher := githubv4mock.NewQueryMatcher(qWithFilters, varsWithFilters, mockResponseOpenOnly)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
 			case "repository not found error":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoLabels, varsRepoNotFound, mockErrorRepoNotFound)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
@@ -1264,6 +1474,8 @@ func Test_ListIssues(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Len(t, response.Issues, tc.expectedCount, "Expected %d issues, got %d", tc.expectedCount, len(response.Issues))
+			require.IsType(t, IssuesListResult{}, res.StructuredContent)
+			assert.Len(t, res.StructuredContent.(IssuesListResult).Issues, tc.expectedCount)
 
 			// Verify order if verifyOrder function is provided
 			if tc.verifyOrder != nil {
@@ -1839,7 +2051,7 @@ func Test_GetIssueComments(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "issue_number"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock comments for success case
 	mockComments := []*github.IssueComment{
@@ -2028,7 +2240,7 @@ func Test_GetIssueLabels(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "issue_number"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	tests := []struct {
 		name               string
@@ -2796,7 +3008,7 @@ func Test_GetSubIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "issue_number"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock sub-issues for success case
 	mockSubIssues := []*github.Issue{
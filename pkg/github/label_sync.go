@@ -0,0 +1,301 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	LabelSyncStatusInSync      = "in_sync"
+	LabelSyncStatusWouldUpdate = "would_update"
+	LabelSyncStatusUpdated     = "updated"
+	LabelSyncStatusError       = "error"
+)
+
+// LabelChange is a single change made, or planned, to the labels of a repository.
+type LabelChange struct {
+	Action string `json:"action"`
+	Label  string `json:"label"`
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// LabelSyncResult describes the reconciliation of the labels of a single repository.
+type LabelSyncResult struct {
+	Repository string        `json:"repository"`
+	Status     string        `json:"status"`
+	Changes    []LabelChange `json:"changes"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// labelSync holds the canonical label set and mapping rules shared by every repository that is reconciled.
+type labelSync struct {
+	labels         []LabelSpec
+	renames        map[string]string
+	deleteUnlisted bool
+	dryRun         bool
+}
+
+// listAllLabels returns all labels of a repository.
+func listAllLabels(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Label, *github.Response, error) {
+	var labels []*github.Label
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Issues.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		labels = append(labels, page...)
+		if resp.NextPage == 0 {
+			return labels, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// planLabelChanges computes the changes needed to reconcile the existing labels of a repository with a canonical set.
+// Renames are planned first so that issues keep their labels, then labels are created or recolored, and finally,
+// if requested, labels that are not part of the canonical set are deleted.
+func planLabelChanges(existing []*github.Label, s labelSync) []LabelChange {
+	current := map[string]*github.Label{}
+	for _, label := range existing {
+		current[strings.ToLower(label.GetName())] = label
+	}
+
+	changes := []LabelChange{}
+	oldNames := make([]string, 0, len(s.renames))
+	for oldName := range s.renames {
+		oldNames = append(oldNames, oldName)
+	}
+	sort.Strings(oldNames)
+	for _, oldName := range oldNames {
+		newName := s.renames[oldName]
+		label, ok := current[strings.ToLower(oldName)]
+		if !ok || strings.EqualFold(oldName, newName) {
+			continue
+		}
+		if _, exists := current[strings.ToLower(newName)]; exists {
+			// Both labels exist, so the old one can only be removed. Issues keep the new label, if any.
+			changes = append(changes, LabelChange{Action: "delete", Label: label.GetName(), Detail: fmt.Sprintf("replaced by existing label %q", newName)})
+			delete(current, strings.ToLower(oldName))
+			continue
+		}
+		changes = append(changes, LabelChange{Action: "rename", Label: label.GetName(), Detail: newName})
+		delete(current, strings.ToLower(oldName))
+		current[strings.ToLower(newName)] = &github.Label{Name: github.Ptr(newName), Color: label.Color, Description: label.Description}
+	}
+
+	canonical := map[string]bool{}
+	for _, spec := range s.labels {
+		canonical[strings.ToLower(spec.Name)] = true
+		label, ok := current[strings.ToLower(spec.Name)]
+		if !ok {
+			changes = append(changes, LabelChange{Action: "create", Label: spec.Name})
+			continue
+		}
+		var details []string
+		if spec.Color != "" && normalizeLabelColor(label.GetColor()) != normalizeLabelColor(spec.Color) {
+			details = append(details, fmt.Sprintf("color %s -> %s", label.GetColor(), normalizeLabelColor(spec.Color)))
+		}
+		if spec.Description != "" && label.GetDescription() != spec.Description {
+			details = append(details, "description")
+		}
+		if label.GetName() != spec.Name {
+			details = append(details, fmt.Sprintf("name %s -> %s", label.GetName(), spec.Name))
+		}
+		if len(details) > 0 {
+			changes = append(changes, LabelChange{Action: "update", Label: label.GetName(), Detail: strings.Join(details, ", ")})
+		}
+	}
+
+	if s.deleteUnlisted {
+		names := make([]string, 0, len(current))
+		for key, label := range current {
+			if !canonical[key] {
+				names = append(names, label.GetName())
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			changes = append(changes, LabelChange{Action: "delete", Label: name, Detail: "not in the canonical label set"})
+		}
+	}
+	return changes
+}
+
+// applyLabelChange makes a single planned change to the labels of a repository.
+// For creates and updates, spec is the canonical label the change is made for.
+func applyLabelChange(ctx context.Context, client *github.Client, owner, repo string, change LabelChange, spec LabelSpec) (*github.Response, error) {
+	switch change.Action {
+	case "rename":
+		_, resp, err := client.Issues.EditLabel(ctx, owner, repo, change.Label, &github.Label{Name: github.Ptr(change.Detail)})
+		return resp, err
+	case "create", "update":
+		label := &github.Label{Name: github.Ptr(spec.Name)}
+		if spec.Color != "" {
+			label.Color = github.Ptr(normalizeLabelColor(spec.Color))
+		}
+		if spec.Description != "" {
+			label.Description = github.Ptr(spec.Description)
+		}
+		if change.Action == "create" {
+			_, resp, err := client.Issues.CreateLabel(ctx, owner, repo, label)
+			return resp, err
+		}
+		_, resp, err := client.Issues.EditLabel(ctx, owner, repo, change.Label, label)
+		return resp, err
+	case "delete":
+		return client.Issues.DeleteLabel(ctx, owner, repo, change.Label)
+	}
+	return nil, fmt.Errorf("unknown label change %q", change.Action)
+}
+
+// syncRepositoryLabels reconciles the labels of a single repository. Failures are reported in the returned result
+// rather than as an error so that a batch can continue.
+func syncRepositoryLabels(ctx context.Context, client *github.Client, fullName string, s labelSync) LabelSyncResult {
+	result := LabelSyncResult{Repository: fullName, Changes: []LabelChange{}}
+	owner, repo, err := parseRepoFullName(fullName)
+	if err != nil {
+		result.Status = LabelSyncStatusError
+		result.Error = err.Error()
+		return result
+	}
+
+	existing, resp, err := listAllLabels(ctx, client, owner, repo)
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to list labels", resp, err)
+		result.Status = LabelSyncStatusError
+		result.Error = fmt.Sprintf("failed to list labels: %s", err)
+		return result
+	}
+
+	result.Changes = planLabelChanges(existing, s)
+	switch {
+	case len(result.Changes) == 0:
+		result.Status = LabelSyncStatusInSync
+		return result
+	case s.dryRun:
+		result.Status = LabelSyncStatusWouldUpdate
+		return result
+	}
+
+	specs := make(map[string]LabelSpec, len(s.labels))
+	for _, spec := range s.labels {
+		specs[strings.ToLower(spec.Name)] = spec
+	}
+	result.Status = LabelSyncStatusUpdated
+	for i, change := range result.Changes {
+		resp, err := applyLabelChange(ctx, client, owner, repo, change, specs[strings.ToLower(change.Label)])
+		if err != nil {
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, fmt.Sprintf("failed to %s label", change.Action), resp, err)
+			result.Changes[i].Error = err.Error()
+			result.Status = LabelSyncStatusError
+			result.Error = "some label changes failed"
+			continue
+		}
+		_ = resp.Body.Close()
+	}
+	return result
+}
+
+// SyncLabels creates a tool to reconcile the labels of many repositories with a canonical label set.
+func SyncLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_labels",
+			mcp.WithDescription(t("TOOL_SYNC_LABELS_DESCRIPTION", "Reconcile the labels of multiple repositories with a canonical label set: rename labels according to mapping rules so issues keep their labels, create missing labels, update colors and descriptions, and optionally delete labels that are not in the set. Use dry_run to only report the changes. Returns the changes per repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SYNC_LABELS_USER_TITLE", "Sync labels across repositories"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Repositories to reconcile, in 'owner/repo' format"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Canonical label set"),
+				mcp.Items(labelSpecSchema),
+			),
+			mcp.WithObject("rename",
+				mcp.Description("Mapping rules from old label names to canonical names, e.g. {\"type: bug\": \"bug\"}. If both labels exist, the old label is deleted"),
+			),
+			mcp.WithBoolean("delete_unlisted",
+				mcp.Description("Delete labels that are not in the canonical set"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, only report the changes without making them"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("missing required parameter: repositories"), nil
+			}
+			labels, err := parseLabelSpecs(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if labels == nil {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+			renameRules, err := OptionalParam[map[string]any](request, "rename")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deleteUnlisted, err := OptionalBoolParamWithDefault(request, "delete_unlisted", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			s := labelSync{labels: labels, renames: map[string]string{}, deleteUnlisted: deleteUnlisted, dryRun: dryRun}
+			for oldName, newName := range renameRules {
+				name, ok := newName.(string)
+				if !ok || strings.TrimSpace(name) == "" {
+					return mcp.NewToolResultError(fmt.Sprintf("rename: new name for %q must be a non-empty string", oldName)), nil
+				}
+				s.renames[oldName] = name
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := make([]LabelSyncResult, 0, len(repositories))
+			for _, fullName := range repositories {
+				results = append(results, syncRepositoryLabels(ctx, client, fullName, s))
+			}
+
+			response := map[string]any{
+				"dry_run": dryRun,
+				"results": results,
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,163 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PlanLabelChanges(t *testing.T) {
+	existing := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")},
+		{Name: github.Ptr("type: feature"), Color: github.Ptr("ffffff")},
+		{Name: github.Ptr("kind/bug"), Color: github.Ptr("000000")},
+		{Name: github.Ptr("Docs"), Color: github.Ptr("0075ca")},
+		{Name: github.Ptr("wontfix"), Color: github.Ptr("ffffff")},
+	}
+	s := labelSync{
+		labels: []LabelSpec{
+			{Name: "bug", Color: "D73A4A"},
+			{Name: "enhancement", Color: "a2eeef"},
+			{Name: "docs", Color: "0075ca", Description: "Documentation"},
+			{Name: "triage", Color: "fbca04"},
+		},
+		renames: map[string]string{
+			"type: feature": "enhancement",
+			"kind/bug":      "bug",
+			"missing":       "triage",
+		},
+		deleteUnlisted: true,
+	}
+
+	assert.Equal(t, []LabelChange{
+		{Action: "delete", Label: "kind/bug", Detail: `replaced by existing label "bug"`},
+		{Action: "rename", Label: "type: feature", Detail: "enhancement"},
+		{Action: "update", Label: "enhancement", Detail: "color ffffff -> a2eeef"},
+		{Action: "update", Label: "Docs", Detail: "description, name Docs -> docs"},
+		{Action: "create", Label: "triage"},
+		{Action: "delete", Label: "wontfix", Detail: "not in the canonical label set"},
+	}, planLabelChanges(existing, s))
+
+	s.deleteUnlisted = false
+	s.renames = nil
+	s.labels = []LabelSpec{{Name: "bug"}}
+	assert.Empty(t, planLabelChanges(existing, s))
+}
+
+func Test_SyncLabels(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SyncLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "sync_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"repositories", "labels"})
+
+	labelsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/octo/in-sync/labels":
+			mockResponse(t, http.StatusOK, []*github.Label{{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")}})(w, r)
+		case "/repos/octo/drifted/labels":
+			mockResponse(t, http.StatusOK, []*github.Label{
+				{Name: github.Ptr("defect"), Color: github.Ptr("d73a4a")},
+				{Name: github.Ptr("question"), Color: github.Ptr("d876e3")},
+			})(w, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	})
+	requestArgs := map[string]interface{}{
+		"repositories":    []interface{}{"octo/in-sync", "octo/drifted", "octo/missing", "invalid"},
+		"labels":          []interface{}{map[string]interface{}{"name": "bug", "color": "d73a4a"}},
+		"rename":          map[string]interface{}{"defect": "bug"},
+		"delete_unlisted": true,
+	}
+
+	tests := []struct {
+		name             string
+		dryRun           bool
+		mockedClient     *http.Client
+		expectedStatuses []string
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposLabelsByOwnerByRepo, labelsHandler),
+			),
+			expectedStatuses: []string{LabelSyncStatusInSync, LabelSyncStatusWouldUpdate, LabelSyncStatusError, LabelSyncStatusError},
+		},
+		{
+			name: "apply changes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposLabelsByOwnerByRepo, labelsHandler),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposLabelsByOwnerByRepoByName,
+					expectPath(t, "/repos/octo/drifted/labels/defect").andThen(
+						expectRequestBody(t, map[string]any{"name": "bug"}).andThen(
+							mockResponse(t, http.StatusOK, &github.Label{}),
+						),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposLabelsByOwnerByRepoByName,
+					expectPath(t, "/repos/octo/drifted/labels/question").andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			expectedStatuses: []string{LabelSyncStatusInSync, LabelSyncStatusUpdated, LabelSyncStatusError, LabelSyncStatusError},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := SyncLabels(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{"dry_run": tc.dryRun}
+			for k, v := range requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			var response struct {
+				DryRun  bool              `json:"dry_run"`
+				Results []LabelSyncResult `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.dryRun, response.DryRun)
+			statuses := []string{}
+			for _, r := range response.Results {
+				statuses = append(statuses, r.Status)
+			}
+			assert.Equal(t, tc.expectedStatuses, statuses)
+			assert.Equal(t, []LabelChange{
+				{Action: "rename", Label: "defect", Detail: "bug"},
+				{Action: "delete", Label: "question", Detail: "not in the canonical label set"},
+			}, response.Results[1].Changes)
+			assert.Contains(t, response.Results[3].Error, "invalid repository")
+		})
+	}
+
+	t.Run("missing labels", func(t *testing.T) {
+		_, handler := SyncLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"repositories": []interface{}{"octo/repo"},
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, "missing required parameter: labels")
+	})
+}
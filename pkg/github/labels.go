@@ -2,7 +2,6 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -13,6 +12,20 @@ import (
 	"github.com/shurcooL/githubv4"
 )
 
+// LabelResult is a label of a repository.
+type LabelResult struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// LabelsListResult is the result of listing the labels of a repository.
+type LabelsListResult struct {
+	Labels     []LabelResult `json:"labels"`
+	TotalCount int           `json:"totalCount"`
+}
+
 // GetLabel retrieves a specific label by name from a GitHub repository
 func GetLabel(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool(
@@ -22,6 +35,7 @@ func GetLabel(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_GET_LABEL_TITLE", "Get a specific label from a repository."),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[LabelResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner (username or organization name)"),
@@ -81,19 +95,12 @@ func GetLabel(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("label '%s' not found in %s/%s", name, owner, repo)), nil
 			}
 
-			label := map[string]any{
-				"id":          fmt.Sprintf("%v", query.Repository.Label.ID),
-				"name":        string(query.Repository.Label.Name),
-				"color":       string(query.Repository.Label.Color),
-				"description": string(query.Repository.Label.Description),
-			}
-
-			out, err := json.Marshal(label)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal label: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledStructuredResult(LabelResult{
+				ID:          fmt.Sprintf("%v", query.Repository.Label.ID),
+				Name:        string(query.Repository.Label.Name),
+				Color:       string(query.Repository.Label.Color),
+				Description: string(query.Repository.Label.Description),
+			}), nil
 		}
 }
 
@@ -106,6 +113,7 @@ func ListLabels(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_LIST_LABEL_DESCRIPTION", "List labels from a repository."),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[LabelsListResult](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner (username or organization name) - required for all operations"),
@@ -154,32 +162,28 @@ func ListLabels(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to list labels", err), nil
 			}
 
-			labels := make([]map[string]any, len(query.Repository.Labels.Nodes))
+			labels := make([]LabelResult, len(query.Repository.Labels.Nodes))
 			for i, labelNode := range query.Repository.Labels.Nodes {
-				labels[i] = map[string]any{
-					"id":          fmt.Sprintf("%v", labelNode.ID),
-					"name":        string(labelNode.Name),
-					"color":       string(labelNode.Color),
-					"description": string(labelNode.Description),
+				labels[i] = LabelResult{
+					ID:          fmt.Sprintf("%v", labelNode.ID),
+					Name:        string(labelNode.Name),
+					Color:       string(labelNode.Color),
+					Description: string(labelNode.Description),
 				}
 			}
 
-			response := map[string]any{
-				"labels":     labels,
-				"totalCount": int(query.Repository.Labels.TotalCount),
-			}
-
-			out, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal labels: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(out)), nil
+			return MarshalledStructuredResult(LabelsListResult{
+				Labels:     labels,
+				TotalCount: int(query.Repository.Labels.TotalCount),
+			}), nil
 		}
 }
 
 // LabelWrite handles create, update, and delete operations for GitHub labels
 func LabelWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	labelCreatedMessage := t("RESULT_LABEL_CREATED", "label '%s' created successfully")
+	labelUpdatedMessage := t("RESULT_LABEL_UPDATED", "label '%s' updated successfully")
+	labelDeletedMessage := t("RESULT_LABEL_DELETED", "label '%s' deleted successfully")
 	return mcp.NewTool(
 			"label_write",
 			mcp.WithDescription(t("TOOL_LABEL_WRITE_DESCRIPTION", "Perform write operations on repository labels. To set labels on issues, use the 'update_issue' tool.")),
@@ -283,7 +287,7 @@ func LabelWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to create label", err), nil
 				}
 
-				return mcp.NewToolResultText(fmt.Sprintf("label '%s' created successfully", mutation.CreateLabel.Label.Name)), nil
+				return mcp.NewToolResultText(fmt.Sprintf(labelCreatedMessage, mutation.CreateLabel.Label.Name)), nil
 
 			case "update":
 				// Validate required params for update
@@ -326,7 +330,7 @@ func LabelWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to update label", err), nil
 				}
 
-				return mcp.NewToolResultText(fmt.Sprintf("label '%s' updated successfully", mutation.UpdateLabel.Label.Name)), nil
+				return mcp.NewToolResultText(fmt.Sprintf(labelUpdatedMessage, mutation.UpdateLabel.Label.Name)), nil
 
 			case "delete":
 				// Get the label ID
@@ -349,7 +353,7 @@ func LabelWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to delete label", err), nil
 				}
 
-				return mcp.NewToolResultText(fmt.Sprintf("label '%s' deleted successfully", name)), nil
+				return mcp.NewToolResultText(fmt.Sprintf(labelDeletedMessage, name)), nil
 
 			default:
 				return mcp.NewToolResultError(fmt.Sprintf("unknown method: %s. Supported methods are: create, update, delete", method)), nil
@@ -0,0 +1,439 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// closingKeywordRe matches GitHub closing keywords followed by an issue reference, e.g. "Fixes #12" or "closes octo/repo#3".
+var closingKeywordRe = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?)\s*:?\s+((?:[\w.-]+/[\w.-]+)?#\d+)\b`)
+
+// issueReferenceRe matches an issue reference in '123', '#123' or 'owner/repo#123' format.
+var issueReferenceRe = regexp.MustCompile(`^(?:([\w.-]+)/([\w.-]+))?#?(\d+)$`)
+
+// normalizeIssueReference converts an issue reference to '#123' for issues in owner/repo, or 'other/repo#123' otherwise.
+func normalizeIssueReference(ref, owner, repo string) (string, error) {
+	m := issueReferenceRe.FindStringSubmatch(strings.TrimSpace(ref))
+	if m == nil {
+		return "", fmt.Errorf("invalid issue reference %q: expected format '123', '#123' or 'owner/repo#123'", ref)
+	}
+	if m[1] == "" || (strings.EqualFold(m[1], owner) && strings.EqualFold(m[2], repo)) {
+		return "#" + m[3], nil
+	}
+	return fmt.Sprintf("%s/%s#%s", m[1], m[2], m[3]), nil
+}
+
+// parseClosingReferences returns the normalized issue references that are prefixed by a closing keyword in body.
+func parseClosingReferences(body, owner, repo string) []string {
+	refs := []string{}
+	seen := map[string]bool{}
+	for _, m := range closingKeywordRe.FindAllStringSubmatch(body, -1) {
+		ref, err := normalizeIssueReference(m[1], owner, repo)
+		if err != nil || seen[strings.ToLower(ref)] {
+			continue
+		}
+		seen[strings.ToLower(ref)] = true
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// LinkPullRequestToIssues creates a tool to add closing keywords for issues to the body of a pull request.
+func LinkPullRequestToIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("link_pull_request_to_issues",
+			mcp.WithDescription(t("TOOL_LINK_PULL_REQUEST_TO_ISSUES_DESCRIPTION", "Link a pull request to issues by adding closing keywords (e.g. 'Fixes #123') to its description, so the issues are closed when the pull request is merged. Issues that are already referenced with a closing keyword are left untouched.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LINK_PULL_REQUEST_TO_ISSUES_USER_TITLE", "Link pull request to issues"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("issues",
+				mcp.Required(),
+				mcp.Description("Issues to link, as '123', '#123' or 'owner/repo#123'"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("keyword",
+				mcp.Description("Closing keyword to use"),
+				mcp.Enum("Closes", "Fixes", "Resolves"),
+				mcp.DefaultString("Fixes"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issues, err := OptionalStringArrayParam(request, "issues")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(issues) == 0 {
+				return mcp.NewToolResultError("missing required parameter: issues"), nil
+			}
+			keyword, err := OptionalParam[string](request, "keyword")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if keyword == "" {
+				keyword = "Fixes"
+			}
+
+			requested := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				ref, err := normalizeIssueReference(issue, owner, repo)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				requested = append(requested, ref)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			linked := map[string]bool{}
+			for _, ref := range parseClosingReferences(pr.GetBody(), owner, repo) {
+				linked[strings.ToLower(ref)] = true
+			}
+
+			added := []string{}
+			alreadyLinked := []string{}
+			for _, ref := range requested {
+				if linked[strings.ToLower(ref)] {
+					alreadyLinked = append(alreadyLinked, ref)
+					continue
+				}
+				linked[strings.ToLower(ref)] = true
+				added = append(added, ref)
+			}
+
+			body := pr.GetBody()
+			if len(added) > 0 {
+				lines := make([]string, 0, len(added))
+				for _, ref := range added {
+					lines = append(lines, keyword+" "+ref)
+				}
+				body = strings.TrimRight(body, "\n ")
+				if body != "" {
+					body += "\n\n"
+				}
+				body += strings.Join(lines, "\n")
+
+				pr, resp, err = client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to update pull request",
+						resp,
+						err,
+					), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+			}
+
+			response := map[string]any{
+				"updated":        len(added) > 0,
+				"added":          added,
+				"already_linked": alreadyLinked,
+				"url":            pr.GetHTMLURL(),
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// LinkedIssue is an issue that will be closed when a pull request is merged.
+type LinkedIssue struct {
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	URL        string `json:"url"`
+}
+
+// PullRequestLinkedIssues is the result of listing the issues linked to a pull request.
+type PullRequestLinkedIssues struct {
+	PullRequest string        `json:"pull_request"`
+	Issues      []LinkedIssue `json:"issues"`
+	TotalCount  int           `json:"total_count"`
+}
+
+// PullRequestClosingIssuesQuery is the query structure for fetching the issues linked to a pull request.
+type PullRequestClosingIssuesQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ClosingIssuesReferences struct {
+				Nodes []struct {
+					Number     githubv4.Int
+					Title      githubv4.String
+					State      githubv4.String
+					URL        githubv4.String `graphql:"url"`
+					Repository struct {
+						NameWithOwner githubv4.String
+					}
+				}
+				TotalCount githubv4.Int
+			} `graphql:"closingIssuesReferences(first: 100)"`
+		} `graphql:"pullRequest(number: $pullNumber)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListPullRequestLinkedIssues creates a tool to list the issues that will be closed when a pull request is merged.
+func ListPullRequestLinkedIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_request_linked_issues",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUEST_LINKED_ISSUES_DESCRIPTION", "List the issues linked to a pull request, either with closing keywords in its description or manually through the development sidebar. These issues will be closed when the pull request is merged.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUEST_LINKED_ISSUES_USER_TITLE", "List issues linked to a pull request"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[PullRequestLinkedIssues](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			var query PullRequestClosingIssuesQuery
+			vars := map[string]any{
+				"owner":      githubv4.String(owner),
+				"repo":       githubv4.String(repo),
+				"pullNumber": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get linked issues", err), nil
+			}
+
+			refs := query.Repository.PullRequest.ClosingIssuesReferences
+			issues := make([]LinkedIssue, 0, len(refs.Nodes))
+			for _, node := range refs.Nodes {
+				issues = append(issues, LinkedIssue{
+					Repository: string(node.Repository.NameWithOwner),
+					Number:     int(node.Number),
+					Title:      string(node.Title),
+					State:      string(node.State),
+					URL:        string(node.URL),
+				})
+			}
+
+			return MarshalledStructuredResult(PullRequestLinkedIssues{
+				PullRequest: owner + "/" + repo + "#" + strconv.Itoa(pullNumber),
+				Issues:      issues,
+				TotalCount:  int(refs.TotalCount),
+			}), nil
+		}
+}
+
+// ClosingReference is the result of validating an issue referenced with a closing keyword.
+type ClosingReference struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Title     string `json:"title,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Problem   string `json:"problem,omitempty"`
+}
+
+// ClosingReferencesValidation is the result of validating the closing references of an issue or pull request.
+type ClosingReferencesValidation struct {
+	Valid       bool               `json:"valid"`
+	References  []ClosingReference `json:"references"`
+	BrokenCount int                `json:"broken_count"`
+}
+
+// validateClosingReference looks up an issue referenced with a closing keyword and reports whether merging would
+// close it. The reference must be normalized with normalizeIssueReference.
+func validateClosingReference(ctx context.Context, client *github.Client, owner, repo, ref string) (ClosingReference, error) {
+	result := ClosingReference{Reference: ref}
+	m := issueReferenceRe.FindStringSubmatch(ref)
+	if m[1] != "" {
+		owner, repo = m[1], m[2]
+	}
+	number, err := strconv.Atoi(m[3])
+	if err != nil {
+		return result, err
+	}
+
+	issue, resp, err := client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+			result.Status = "not_found"
+			result.Problem = fmt.Sprintf("issue %s/%s#%d does not exist or is not accessible", owner, repo, number)
+			return result, nil
+		}
+		return result, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	result.Title = issue.GetTitle()
+	result.URL = issue.GetHTMLURL()
+	switch {
+	case issue.IsPullRequest():
+		result.Status = "pull_request"
+		result.Problem = "reference is a pull request; closing keywords only close issues"
+	case issue.GetState() == "closed":
+		result.Status = "closed"
+		result.Problem = "issue is already closed"
+	default:
+		result.Status = "open"
+	}
+	return result, nil
+}
+
+// ValidateClosingReferences creates a tool to check that the issues referenced with closing keywords in the body of
+// an issue or pull request exist and are open.
+func ValidateClosingReferences(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("validate_closing_references",
+			mcp.WithDescription(t("TOOL_VALIDATE_CLOSING_REFERENCES_DESCRIPTION", "Find the issues referenced with closing keywords (e.g. 'Fixes #123') in the body of a pull request or issue, or in the given text, and check that they exist and are open. Reports broken references, such as issues that do not exist, are already closed or are pull requests. Useful before merging a pull request.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_VALIDATE_CLOSING_REFERENCES_USER_TITLE", "Validate closing references"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ClosingReferencesValidation](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("number",
+				mcp.Description("Number of the pull request or issue whose body to validate. Either number or body is required"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Text to validate instead of the body of an existing pull request or issue, e.g. a draft description"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := OptionalIntParam(request, "number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if number == 0 && body == "" {
+				return mcp.NewToolResultError("either number or body is required"), nil
+			}
+			if number != 0 && body != "" {
+				return mcp.NewToolResultError("only one of number or body can be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if number != 0 {
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, number)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get pull request or issue #%d", number),
+						resp,
+						err,
+					), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+				body = issue.GetBody()
+			}
+
+			references := []ClosingReference{}
+			broken := 0
+			for _, ref := range parseClosingReferences(body, owner, repo) {
+				result, err := validateClosingReference(ctx, client, owner, repo, ref)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to validate reference %s: %v", ref, err)), nil
+				}
+				if result.Problem != "" {
+					broken++
+				}
+				references = append(references, result)
+			}
+
+			return MarshalledStructuredResult(ClosingReferencesValidation{
+				Valid:       broken == 0,
+				References:  references,
+				BrokenCount: broken,
+			}), nil
+		}
+}
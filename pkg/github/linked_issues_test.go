@@ -0,0 +1,453 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseClosingReferences(t *testing.T) {
+	body := "Refactors the handler.\n\nFixes #12\ncloses: #13\nResolved octo/repo#14\nSee #15\nfixes other/repo#3\nFIXES #12"
+	assert.Equal(t, []string{"#12", "#13", "#14", "other/repo#3"}, parseClosingReferences(body, "octo", "repo"))
+	assert.Empty(t, parseClosingReferences("", "octo", "repo"))
+}
+
+func Test_normalizeIssueReference(t *testing.T) {
+	tests := []struct {
+		ref         string
+		expected    string
+		expectError bool
+	}{
+		{ref: "12", expected: "#12"},
+		{ref: "#12", expected: "#12"},
+		{ref: "octo/repo#12", expected: "#12"},
+		{ref: "Octo/Repo#12", expected: "#12"},
+		{ref: "other/repo#12", expected: "other/repo#12"},
+		{ref: "issue twelve", expectError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.ref, func(t *testing.T) {
+			ref, err := normalizeIssueReference(tc.ref, "octo", "repo")
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+}
+
+func Test_LinkPullRequestToIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := LinkPullRequestToIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "link_pull_request_to_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "issues")
+	assert.Contains(t, tool.InputSchema.Properties, "keyword")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "issues"})
+
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Body:    github.Ptr("Adds caching.\n\nFixes #10\n"),
+		HTMLURL: github.Ptr("https://github.com/octo/repo/pull/42"),
+	}
+
+	tests := []struct {
+		name                  string
+		mockedClient          *http.Client
+		requestArgs           map[string]interface{}
+		expectError           bool
+		expectedErrMsg        string
+		expectedUpdated       bool
+		expectedAdded         []string
+		expectedAlreadyLinked []string
+	}{
+		{
+			name: "adds closing keywords for new issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]any{
+						"body": "Adds caching.\n\nFixes #10\n\nCloses #11\nCloses other/repo#5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"issues":     []interface{}{"#10", "11", "other/repo#5"},
+				"keyword":    "Closes",
+			},
+			expectedUpdated:       true,
+			expectedAdded:         []string{"#11", "other/repo#5"},
+			expectedAlreadyLinked: []string{"#10"},
+		},
+		{
+			name: "does not update when all issues are already linked",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"issues":     []interface{}{"octo/repo#10"},
+			},
+			expectedUpdated:       false,
+			expectedAdded:         []string{},
+			expectedAlreadyLinked: []string{"#10"},
+		},
+		{
+			name:         "invalid issue reference",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"issues":     []interface{}{"not-an-issue"},
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid issue reference",
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+				"issues":     []interface{}{"1"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := LinkPullRequestToIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Updated       bool     `json:"updated"`
+				Added         []string `json:"added"`
+				AlreadyLinked []string `json:"already_linked"`
+				URL           string   `json:"url"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedUpdated, response.Updated)
+			assert.Equal(t, tc.expectedAdded, response.Added)
+			assert.Equal(t, tc.expectedAlreadyLinked, response.AlreadyLinked)
+			assert.Equal(t, "https://github.com/octo/repo/pull/42", response.URL)
+		})
+	}
+}
+
+func Test_ListPullRequestLinkedIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := githubv4.NewClient(nil)
+	tool, _ := ListPullRequestLinkedIssues(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_linked_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	vars := map[string]any{
+		"owner":      githubv4.String("octo"),
+		"repo":       githubv4.String("repo"),
+		"pullNumber": githubv4.Int(42),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedIssues []LinkedIssue
+	}{
+		{
+			name: "lists linked issues",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					PullRequestClosingIssuesQuery{},
+					vars,
+					githubv4mock.DataResponse(map[string]any{
+						"repository": map[string]any{
+							"pullRequest": map[string]any{
+								"closingIssuesReferences": map[string]any{
+									"nodes": []map[string]any{
+										{
+											"number":     10,
+											"title":      "Cache misses on cold start",
+											"state":      "OPEN",
+											"url":        "https://github.com/octo/repo/issues/10",
+											"repository": map[string]any{"nameWithOwner": "octo/repo"},
+										},
+									},
+									"totalCount": 1,
+								},
+							},
+						},
+					}),
+				),
+			),
+			expectedIssues: []LinkedIssue{
+				{
+					Repository: "octo/repo",
+					Number:     10,
+					Title:      "Cache misses on cold start",
+					State:      "OPEN",
+					URL:        "https://github.com/octo/repo/issues/10",
+				},
+			},
+		},
+		{
+			name: "query fails",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					PullRequestClosingIssuesQuery{},
+					vars,
+					githubv4mock.ErrorResponse("Could not resolve to a PullRequest with the number of 42."),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get linked issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := githubv4.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestLinkedIssues(stubGetGQLClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "octo",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				PullRequest string        `json:"pull_request"`
+				Issues      []LinkedIssue `json:"issues"`
+				TotalCount  int           `json:"total_count"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, "octo/repo#42", response.PullRequest)
+			assert.Equal(t, tc.expectedIssues, response.Issues)
+			assert.Equal(t, len(tc.expectedIssues), response.TotalCount)
+		})
+	}
+}
+
+func Test_ValidateClosingReferences(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ValidateClosingReferences(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "validate_closing_references", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "number")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	issues := map[string]*github.Issue{
+		"/repos/octo/repo/issues/42": {
+			Number: github.Ptr(42),
+			Body:   github.Ptr("Fixes #10, closes #11\nResolves other/repo#3\nFixes #12\nSee #13"),
+		},
+		"/repos/octo/repo/issues/10": {
+			Number:  github.Ptr(10),
+			Title:   github.Ptr("Open issue"),
+			State:   github.Ptr("open"),
+			HTMLURL: github.Ptr("https://github.com/octo/repo/issues/10"),
+		},
+		"/repos/octo/repo/issues/11": {
+			Number: github.Ptr(11),
+			Title:  github.Ptr("Closed issue"),
+			State:  github.Ptr("closed"),
+		},
+		"/repos/octo/repo/issues/12": {
+			Number:           github.Ptr(12),
+			Title:            github.Ptr("A pull request"),
+			State:            github.Ptr("open"),
+			PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/octo/repo/pulls/12")},
+		},
+	}
+	issueHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issue, ok := issues[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		mockResponse(t, http.StatusOK, issue)(w, r)
+	})
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedValid    bool
+		expectedStatuses map[string]string
+	}{
+		{
+			name: "validates references in pull request body",
+			requestArgs: map[string]interface{}{
+				"owner":  "octo",
+				"repo":   "repo",
+				"number": float64(42),
+			},
+			expectedValid: false,
+			expectedStatuses: map[string]string{
+				"#10":          "open",
+				"#11":          "closed",
+				"other/repo#3": "not_found",
+				"#12":          "pull_request",
+			},
+		},
+		{
+			name: "validates references in given text",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"body":  "Fixes octo/repo#10",
+			},
+			expectedValid:    true,
+			expectedStatuses: map[string]string{"#10": "open"},
+		},
+		{
+			name: "no closing references",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"body":  "Refactors the handler.",
+			},
+			expectedValid:    true,
+			expectedStatuses: map[string]string{},
+		},
+		{
+			name: "missing number and body",
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "either number or body is required",
+		},
+		{
+			name: "pull request not found",
+			requestArgs: map[string]interface{}{
+				"owner":  "octo",
+				"repo":   "repo",
+				"number": float64(99),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request or issue #99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposIssuesByOwnerByRepoByIssueNumber, issueHandler),
+			))
+			_, handler := ValidateClosingReferences(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Valid       bool               `json:"valid"`
+				References  []ClosingReference `json:"references"`
+				BrokenCount int                `json:"broken_count"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedValid, response.Valid)
+			statuses := map[string]string{}
+			broken := 0
+			for _, ref := range response.References {
+				statuses[ref.Reference] = ref.Status
+				if ref.Problem != "" {
+					broken++
+				}
+			}
+			assert.Equal(t, tc.expectedStatuses, statuses)
+			assert.Equal(t, broken, response.BrokenCount)
+		})
+	}
+}
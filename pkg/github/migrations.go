@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MigrationStateExported is the state of a migration whose archive is ready to download.
+const MigrationStateExported = "exported"
+
+// OrgMigration is an export of repositories of an organization.
+type OrgMigration struct {
+	ID    int64  `json:"id"`
+	GUID  string `json:"guid"`
+	State string `json:"state"`
+	// Repositories are the full names of the exported repositories.
+	Repositories       []string `json:"repositories"`
+	LockRepositories   bool     `json:"lock_repositories"`
+	ExcludeAttachments bool     `json:"exclude_attachments"`
+	CreatedAt          string   `json:"created_at,omitempty"`
+	UpdatedAt          string   `json:"updated_at,omitempty"`
+	// ArchiveURL is a short-lived URL to download the archive, once the migration is exported.
+	ArchiveURL string `json:"archive_url,omitempty"`
+}
+
+func convertToOrgMigration(migration *github.Migration) OrgMigration {
+	result := OrgMigration{
+		ID:                 migration.GetID(),
+		GUID:               migration.GetGUID(),
+		State:              migration.GetState(),
+		Repositories:       []string{},
+		LockRepositories:   migration.GetLockRepositories(),
+		ExcludeAttachments: migration.GetExcludeAttachments(),
+		CreatedAt:          migration.GetCreatedAt(),
+		UpdatedAt:          migration.GetUpdatedAt(),
+	}
+	for _, repo := range migration.Repositories {
+		result.Repositories = append(result.Repositories, repo.GetFullName())
+	}
+	return result
+}
+
+// StartOrgMigration creates a tool to start exporting repositories of an organization.
+func StartOrgMigration(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("start_org_migration",
+			mcp.WithDescription(t("TOOL_START_ORG_MIGRATION_DESCRIPTION", "Start exporting repositories of an organization to a migration archive, e.g. to move them between GitHub.com and GitHub Enterprise Server. Poll the migration with get_org_migration until it is exported to get the archive URL.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_START_ORG_MIGRATION_USER_TITLE", "Start organization migration"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[OrgMigration](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Names of the repositories to export, e.g. ['api', 'web']"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("lock_repositories",
+				mcp.Description("Lock the repositories during the export so they cannot change. They stay locked until unlocked or deleted"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithBoolean("exclude_attachments",
+				mcp.Description("Exclude attachments from the archive to make it smaller"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithBoolean("exclude_releases",
+				mcp.Description("Exclude releases from the archive to make it smaller"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("missing required parameter: repositories"), nil
+			}
+			lockRepositories, err := OptionalBoolParamWithDefault(request, "lock_repositories", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			excludeAttachments, err := OptionalBoolParamWithDefault(request, "exclude_attachments", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			excludeReleases, err := OptionalBoolParamWithDefault(request, "exclude_releases", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			migration, resp, err := client.Migrations.StartMigration(ctx, org, repositories, &github.MigrationOptions{
+				LockRepositories:   lockRepositories,
+				ExcludeAttachments: excludeAttachments,
+				ExcludeReleases:    excludeReleases,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to start migration of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(convertToOrgMigration(migration)), nil
+		}
+}
+
+// GetOrgMigration creates a tool to get the state of a migration of an organization, and its archive URL once exported.
+func GetOrgMigration(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_org_migration",
+			mcp.WithDescription(t("TOOL_GET_ORG_MIGRATION_DESCRIPTION", "Get the state of a migration of an organization: pending, exporting, exported or failed. Once exported, also returns a short-lived URL to download the archive.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORG_MIGRATION_USER_TITLE", "Get organization migration"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[OrgMigration](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("migration_id",
+				mcp.Required(),
+				mcp.Description("The ID of the migration"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			migrationID, err := RequiredBigInt(request, "migration_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			migration, resp, err := client.Migrations.MigrationStatus(ctx, org, migrationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get migration %d of organization '%s'", migrationID, org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := convertToOrgMigration(migration)
+			if result.State == MigrationStateExported {
+				result.ArchiveURL, err = client.Migrations.MigrationArchiveURL(ctx, org, migrationID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get archive URL of migration %d", migrationID),
+						nil,
+						err,
+					), nil
+				}
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
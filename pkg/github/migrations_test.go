@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StartOrgMigration(t *testing.T) {
+	tool, _ := StartOrgMigration(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "repositories"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostOrgsMigrationsByOrg,
+			expectRequestBody(t, map[string]any{
+				"repositories":        []any{"api", "web"},
+				"lock_repositories":   true,
+				"exclude_attachments": false,
+				"exclude_releases":    true,
+			}).andThen(
+				mockResponse(t, http.StatusCreated, &github.Migration{
+					ID:               github.Ptr(int64(79)),
+					GUID:             github.Ptr("0b989ba4"),
+					State:            github.Ptr("pending"),
+					LockRepositories: github.Ptr(true),
+					Repositories:     []*github.Repository{{FullName: github.Ptr("octo/api")}, {FullName: github.Ptr("octo/web")}},
+				}),
+			),
+		),
+	))
+	_, handler := StartOrgMigration(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":               "octo",
+		"repositories":      []any{"api", "web"},
+		"lock_repositories": true,
+		"exclude_releases":  true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response OrgMigration
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, OrgMigration{
+		ID:               79,
+		GUID:             "0b989ba4",
+		State:            "pending",
+		Repositories:     []string{"octo/api", "octo/web"},
+		LockRepositories: true,
+	}, response)
+}
+
+func Test_GetOrgMigration(t *testing.T) {
+	tool, _ := GetOrgMigration(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "migration_id"})
+
+	archiveURL := "https://objects.example.com/migrations/79.tar.gz?token=abc"
+	tests := []struct {
+		name     string
+		state    string
+		expected string
+	}{
+		{name: "exporting", state: "exporting"},
+		{name: "exported", state: MigrationStateExported, expected: archiveURL},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsMigrationsByOrgByMigrationId, &github.Migration{
+					ID:    github.Ptr(int64(79)),
+					State: github.Ptr(tc.state),
+				}),
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsMigrationsArchiveByOrgByMigrationId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						http.Redirect(w, r, archiveURL, http.StatusFound)
+					}),
+				),
+			))
+			_, handler := GetOrgMigration(stubGetClientFn(client), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"org":          "octo",
+				"migration_id": float64(79),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response OrgMigration
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.state, response.State)
+			assert.Equal(t, tc.expected, response.ArchiveURL)
+		})
+	}
+}
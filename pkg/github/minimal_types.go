@@ -1,6 +1,11 @@
 package github
 
-import "github.com/google/go-github/v79/github"
+import (
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+)
 
 // MinimalUser is the output type for user and organization search results.
 type MinimalUser struct {
@@ -106,6 +111,44 @@ type MinimalBranch struct {
 	Protected bool   `json:"protected"`
 }
 
+// MinimalPullRequest is the trimmed output type for pull request objects.
+type MinimalPullRequest struct {
+	Number             int               `json:"number"`
+	Title              string            `json:"title"`
+	State              string            `json:"state"`
+	Draft              bool              `json:"draft,omitempty"`
+	HTMLURL            string            `json:"html_url"`
+	Author             string            `json:"author,omitempty"`
+	Base               string            `json:"base,omitempty"`
+	Head               string            `json:"head,omitempty"`
+	MergedAt           *github.Timestamp `json:"merged_at,omitempty"`
+	MergeCommitSHA     string            `json:"merge_commit_sha,omitempty"`
+	RequestedReviewers []string          `json:"requested_reviewers,omitempty"`
+}
+
+// MinimalIssue is the trimmed output type for issue objects.
+type MinimalIssue struct {
+	Number     int               `json:"number"`
+	Title      string            `json:"title"`
+	State      string            `json:"state"`
+	HTMLURL    string            `json:"html_url"`
+	Repository string            `json:"repository,omitempty"`
+	Author     string            `json:"author,omitempty"`
+	Labels     []string          `json:"labels,omitempty"`
+	Assignees  []string          `json:"assignees,omitempty"`
+	Comments   int               `json:"comments"`
+	CreatedAt  *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt  *github.Timestamp `json:"updated_at,omitempty"`
+	ClosedAt   *github.Timestamp `json:"closed_at,omitempty"`
+}
+
+// MinimalSearchIssuesResult is the trimmed output type for issue search results.
+type MinimalSearchIssuesResult struct {
+	TotalCount        int            `json:"total_count"`
+	IncompleteResults bool           `json:"incomplete_results"`
+	Items             []MinimalIssue `json:"items"`
+}
+
 // MinimalResponse represents a minimal response for all CRUD operations.
 // Success is implicit in the HTTP response status, and all other information
 // can be derived from the URL or fetched separately if needed.
@@ -129,6 +172,22 @@ type MinimalProject struct {
 	Number           *int              `json:"number,omitempty"`
 	ShortDescription *string           `json:"short_description,omitempty"`
 	DeletedBy        *MinimalUser      `json:"deleted_by,omitempty"`
+
+	// Closed, ItemCount and LatestStatusUpdate are only set by list_projects.
+	Closed             *bool                       `json:"closed,omitempty"`
+	ItemCount          *int                        `json:"item_count,omitempty"`
+	LatestStatusUpdate *MinimalProjectStatusUpdate `json:"latest_status_update,omitempty"`
+}
+
+// MinimalProjectStatusUpdate is the trimmed output type for project status updates.
+type MinimalProjectStatusUpdate struct {
+	ID         string    `json:"id,omitempty"`
+	Status     string    `json:"status,omitempty"`
+	Body       string    `json:"body,omitempty"`
+	StartDate  string    `json:"start_date,omitempty"`
+	TargetDate string    `json:"target_date,omitempty"`
+	Creator    string    `json:"creator,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 // Helper functions
@@ -256,3 +315,52 @@ func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 		Protected: branch.GetProtected(),
 	}
 }
+
+// convertToMinimalPullRequest converts a GitHub API PullRequest to MinimalPullRequest
+func convertToMinimalPullRequest(pr *github.PullRequest) MinimalPullRequest {
+	minimalPR := MinimalPullRequest{
+		Number:         pr.GetNumber(),
+		Title:          pr.GetTitle(),
+		State:          pr.GetState(),
+		Draft:          pr.GetDraft(),
+		HTMLURL:        pr.GetHTMLURL(),
+		Author:         pr.GetUser().GetLogin(),
+		Base:           pr.GetBase().GetRef(),
+		Head:           pr.GetHead().GetRef(),
+		MergedAt:       pr.MergedAt,
+		MergeCommitSHA: pr.GetMergeCommitSHA(),
+	}
+	for _, reviewer := range pr.RequestedReviewers {
+		minimalPR.RequestedReviewers = append(minimalPR.RequestedReviewers, reviewer.GetLogin())
+	}
+	for _, team := range pr.RequestedTeams {
+		minimalPR.RequestedReviewers = append(minimalPR.RequestedReviewers, team.GetSlug())
+	}
+	return minimalPR
+}
+
+// convertToMinimalIssue converts a GitHub API Issue to MinimalIssue
+func convertToMinimalIssue(issue *github.Issue) MinimalIssue {
+	minimalIssue := MinimalIssue{
+		Number:    issue.GetNumber(),
+		Title:     issue.GetTitle(),
+		State:     issue.GetState(),
+		HTMLURL:   issue.GetHTMLURL(),
+		Author:    issue.GetUser().GetLogin(),
+		Comments:  issue.GetComments(),
+		CreatedAt: issue.CreatedAt,
+		UpdatedAt: issue.UpdatedAt,
+		ClosedAt:  issue.ClosedAt,
+	}
+	// Search results link to the repository rather than embed it.
+	if _, fullName, ok := strings.Cut(issue.GetRepositoryURL(), "/repos/"); ok {
+		minimalIssue.Repository = fullName
+	}
+	for _, label := range issue.Labels {
+		minimalIssue.Labels = append(minimalIssue.Labels, label.GetName())
+	}
+	for _, assignee := range issue.Assignees {
+		minimalIssue.Assignees = append(minimalIssue.Assignees, assignee.GetLogin())
+	}
+	return minimalIssue
+}
@@ -0,0 +1,154 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ModelsInferenceURL is the chat completions endpoint of the GitHub Models inference API.
+	ModelsInferenceURL = "https://models.github.ai/inference/chat/completions"
+	// DefaultModel is the model used when the caller does not pick one.
+	DefaultModel = "openai/gpt-4.1-mini"
+)
+
+type modelMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type modelRequest struct {
+	Model       string         `json:"model"`
+	Messages    []modelMessage `json:"messages"`
+	MaxTokens   int            `json:"max_tokens,omitempty"`
+	Temperature *float64       `json:"temperature,omitempty"`
+}
+
+type modelResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message      modelMessage `json:"message"`
+		FinishReason string       `json:"finish_reason"`
+	} `json:"choices"`
+	Usage ModelUsage `json:"usage"`
+}
+
+// ModelUsage is the number of tokens used by a model inference.
+type ModelUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ModelOutput is the output of a model inference.
+type ModelOutput struct {
+	Model        string     `json:"model"`
+	Output       string     `json:"output"`
+	FinishReason string     `json:"finish_reason"`
+	Usage        ModelUsage `json:"usage"`
+}
+
+// GenerateWithModel creates a tool to run a prompt against a model of the GitHub Models inference API, using the
+// token of the server.
+func GenerateWithModel(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("generate_with_model",
+			mcp.WithDescription(t("TOOL_GENERATE_WITH_MODEL_DESCRIPTION", "Run a prompt against a model of the GitHub Models inference API and return the generated text. Use this for summarization and rewriting steps, such as finding duplicate issues or polishing release notes, when the host has no model of its own. The token needs the models:read permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GENERATE_WITH_MODEL_USER_TITLE", "Generate text with a GitHub Model"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ModelOutput](),
+			mcp.WithString("prompt",
+				mcp.Required(),
+				mcp.Description("The prompt, including any content to summarize or rewrite"),
+			),
+			mcp.WithString("system",
+				mcp.Description("Optional system message with instructions for the model"),
+			),
+			mcp.WithString("model",
+				mcp.Description("ID of the model in the GitHub Models catalog, in the form publisher/name"),
+				mcp.DefaultString(DefaultModel),
+			),
+			mcp.WithNumber("max_tokens",
+				mcp.Description("Maximum number of tokens to generate"),
+			),
+			mcp.WithNumber("temperature",
+				mcp.Description("Sampling temperature between 0 and 1; lower values give more deterministic output"),
+				mcp.Min(0),
+				mcp.Max(1),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			prompt, err := RequiredParam[string](request, "prompt")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			system, err := OptionalParam[string](request, "system")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			model, err := OptionalParam[string](request, "model")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if model == "" {
+				model = DefaultModel
+			}
+			maxTokens, err := OptionalIntParam(request, "max_tokens")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			temperature, ok, err := OptionalParamOK[float64](request, "temperature")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body := modelRequest{
+				Model:     model,
+				MaxTokens: maxTokens,
+			}
+			if ok {
+				body.Temperature = &temperature
+			}
+			if system != "" {
+				body.Messages = append(body.Messages, modelMessage{Role: "system", Content: system})
+			}
+			body.Messages = append(body.Messages, modelMessage{Role: "user", Content: prompt})
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			req, err := client.NewRequest(http.MethodPost, ModelsInferenceURL, body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var completion modelResponse
+			resp, err := client.Do(ctx, req, &completion)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to run model inference",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if len(completion.Choices) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("model %s returned no output", model)), nil
+			}
+
+			return MarshalledStructuredResult(ModelOutput{
+				Model:        completion.Model,
+				Output:       completion.Choices[0].Message.Content,
+				FinishReason: completion.Choices[0].FinishReason,
+				Usage:        completion.Usage,
+			}), nil
+		}
+}
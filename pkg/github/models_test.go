@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateWithModel(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GenerateWithModel(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "generate_with_model", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"prompt"})
+
+	completion := map[string]any{
+		"model": "openai/gpt-4.1-mini",
+		"choices": []any{
+			map[string]any{"message": map[string]any{"role": "assistant", "content": "Issues #1 and #2 are duplicates."}, "finish_reason": "stop"},
+		},
+		"usage": map[string]any{"prompt_tokens": 20, "completion_tokens": 8, "total_tokens": 28},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "generates text with system message",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/inference/chat/completions", Method: http.MethodPost},
+					expectRequestBody(t, map[string]any{
+						"model": "openai/gpt-4.1-mini",
+						"messages": []any{
+							map[string]any{"role": "system", "content": "Find duplicate issues."},
+							map[string]any{"role": "user", "content": "#1 crash on start\n#2 crashes at startup"},
+						},
+						"max_tokens":  float64(100),
+						"temperature": float64(0),
+					}).andThen(mockResponse(t, http.StatusOK, completion)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"prompt":      "#1 crash on start\n#2 crashes at startup",
+				"system":      "Find duplicate issues.",
+				"max_tokens":  float64(100),
+				"temperature": float64(0),
+			},
+		},
+		{
+			name: "model not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/inference/chat/completions", Method: http.MethodPost},
+					mockResponse(t, http.StatusNotFound, `{"message": "Unknown model: acme/unknown"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"prompt": "Summarize",
+				"model":  "acme/unknown",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to run model inference",
+		},
+		{
+			name: "no output",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/inference/chat/completions", Method: http.MethodPost},
+					mockResponse(t, http.StatusOK, map[string]any{"model": "openai/gpt-4.1-mini", "choices": []any{}}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"prompt": "Summarize",
+			},
+			expectError:    true,
+			expectedErrMsg: "model openai/gpt-4.1-mini returned no output",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GenerateWithModel(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Model        string `json:"model"`
+				Output       string `json:"output"`
+				FinishReason string `json:"finish_reason"`
+				Usage        struct {
+					TotalTokens int `json:"total_tokens"`
+				} `json:"usage"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "openai/gpt-4.1-mini", response.Model)
+			assert.Equal(t, "Issues #1 and #2 are duplicates.", response.Output)
+			assert.Equal(t, "stop", response.FinishReason)
+			assert.Equal(t, 28, response.Usage.TotalTokens)
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// interactionLimitNone is the limit value used to remove an interaction limit.
+const interactionLimitNone = "none"
+
+// InteractionLimits describes the interaction limit in place for an organization or repository.
+type InteractionLimits struct {
+	Scope     string            `json:"scope"`
+	Target    string            `json:"target"`
+	Limit     string            `json:"limit"`
+	Origin    string            `json:"origin,omitempty"`
+	ExpiresAt *github.Timestamp `json:"expires_at,omitempty"`
+}
+
+func newInteractionLimits(owner, repo string, restriction *github.InteractionRestriction) InteractionLimits {
+	limits := InteractionLimits{
+		Scope:  "organization",
+		Target: owner,
+		Limit:  restriction.GetLimit(),
+		Origin: restriction.GetOrigin(),
+	}
+	if repo != "" {
+		limits.Scope = "repository"
+		limits.Target = owner + "/" + repo
+	}
+	if limits.Limit == "" {
+		limits.Limit = interactionLimitNone
+	} else {
+		limits.ExpiresAt = restriction.ExpiresAt
+	}
+	return limits
+}
+
+// GetInteractionLimits creates a tool to get the interaction limits of an organization or repository.
+func GetInteractionLimits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_interaction_limits",
+			mcp.WithDescription(t("TOOL_GET_INTERACTION_LIMITS_DESCRIPTION", "Get the interaction limits of an organization, or of a repository if 'repo' is provided. Interaction limits temporarily restrict which users can comment, open issues or create pull requests.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_INTERACTION_LIMITS_USER_TITLE", "Get interaction limits"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[InteractionLimits](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization name, or repository owner if 'repo' is provided"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. If omitted, the organization's interaction limits are returned"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var restriction *github.InteractionRestriction
+			var resp *github.Response
+			if repo != "" {
+				restriction, resp, err = client.Interactions.GetRestrictionsForRepo(ctx, owner, repo)
+			} else {
+				restriction, resp, err = client.Interactions.GetRestrictionsForOrg(ctx, owner)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get interaction limits",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(newInteractionLimits(owner, repo, restriction)), nil
+		}
+}
+
+// SetInteractionLimits creates a tool to set or remove the interaction limits of an organization or repository.
+func SetInteractionLimits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_interaction_limits",
+			mcp.WithDescription(t("TOOL_SET_INTERACTION_LIMITS_DESCRIPTION", "Set or remove the interaction limits of an organization, or of a repository if 'repo' is provided. Use this to temporarily restrict comments, issues and pull requests to existing users, contributors or collaborators, e.g. during a spam wave.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_INTERACTION_LIMITS_USER_TITLE", "Set interaction limits"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization name, or repository owner if 'repo' is provided"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. If omitted, the limit applies to all repositories of the organization"),
+			),
+			mcp.WithString("limit",
+				mcp.Required(),
+				mcp.Description("Users allowed to interact: 'existing_users' (accounts older than 24 hours), 'contributors_only', 'collaborators_only', or 'none' to remove the limit"),
+				mcp.Enum("existing_users", "contributors_only", "collaborators_only", interactionLimitNone),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := RequiredParam[string](request, "limit")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			restriction := &github.InteractionRestriction{}
+			var resp *github.Response
+			switch {
+			case limit == interactionLimitNone && repo != "":
+				resp, err = client.Interactions.RemoveRestrictionsFromRepo(ctx, owner, repo)
+			case limit == interactionLimitNone:
+				resp, err = client.Interactions.RemoveRestrictionsFromOrg(ctx, owner)
+			case repo != "":
+				restriction, resp, err = client.Interactions.UpdateRestrictionsForRepo(ctx, owner, repo, limit)
+			default:
+				restriction, resp, err = client.Interactions.UpdateRestrictionsForOrg(ctx, owner, limit)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to set interaction limits",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newInteractionLimits(owner, repo, restriction))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// BlockOrgUser creates a tool to block a user from an organization.
+func BlockOrgUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	orgUserBlockedMessage := t("RESULT_ORG_USER_BLOCKED", "user '%s' blocked from organization '%s'")
+	return mcp.NewTool("block_org_user",
+			mcp.WithDescription(t("TOOL_BLOCK_ORG_USER_DESCRIPTION", "Block a user from an organization. Blocked users cannot comment, open issues or pull requests, or otherwise interact with the organization's repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_BLOCK_ORG_USER_USER_TITLE", "Block user from organization"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to block"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Organizations.BlockUser(ctx, org, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to block user %s", username),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf(orgUserBlockedMessage, username, org)), nil
+		}
+}
+
+// UnblockOrgUser creates a tool to unblock a user from an organization.
+func UnblockOrgUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	orgUserUnblockedMessage := t("RESULT_ORG_USER_UNBLOCKED", "user '%s' unblocked from organization '%s'")
+	return mcp.NewTool("unblock_org_user",
+			mcp.WithDescription(t("TOOL_UNBLOCK_ORG_USER_DESCRIPTION", "Unblock a user that was previously blocked from an organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNBLOCK_ORG_USER_USER_TITLE", "Unblock user from organization"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to unblock"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Organizations.UnblockUser(ctx, org, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to unblock user %s", username),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf(orgUserUnblockedMessage, username, org)), nil
+		}
+}
+
+// BlockUser creates a tool to block a user from the authenticated user's account.
+func BlockUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	userBlockedMessage := t("RESULT_USER_BLOCKED", "user '%s' blocked")
+	return mcp.NewTool("block_user",
+			mcp.WithDescription(t("TOOL_BLOCK_USER_DESCRIPTION", "Block a user from the authenticated user's personal account. Blocked users cannot follow you, comment on or open issues and pull requests in your repositories, or mention you.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_BLOCK_USER_USER_TITLE", "Block user"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to block"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Users.BlockUser(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to block user %s", username),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf(userBlockedMessage, username)), nil
+		}
+}
+
+// UnblockUser creates a tool to unblock a user from the authenticated user's account.
+func UnblockUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	userUnblockedMessage := t("RESULT_USER_UNBLOCKED", "user '%s' unblocked")
+	return mcp.NewTool("unblock_user",
+			mcp.WithDescription(t("TOOL_UNBLOCK_USER_DESCRIPTION", "Unblock a user that was previously blocked from the authenticated user's personal account.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNBLOCK_USER_USER_TITLE", "Unblock user"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to unblock"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Users.UnblockUser(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to unblock user %s", username),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf(userUnblockedMessage, username)), nil
+		}
+}
+
+// ListBlockedUsers creates a tool to list the users blocked by the authenticated user.
+func ListBlockedUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_blocked_users",
+			mcp.WithDescription(t("TOOL_LIST_BLOCKED_USERS_DESCRIPTION", "List the users blocked by the authenticated user's personal account.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_BLOCKED_USERS_USER_TITLE", "List blocked users"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[MinimalUser](),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			users, resp, err := client.Users.ListBlockedUsers(ctx, &github.ListOptions{
+				PerPage: pagination.PerPage,
+				Page:    pagination.Page,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list blocked users",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalUsers := make([]MinimalUser, 0, len(users))
+			for _, user := range users {
+				minimalUsers = append(minimalUsers, MinimalUser{
+					Login:      user.GetLogin(),
+					ID:         user.GetID(),
+					ProfileURL: user.GetHTMLURL(),
+					AvatarURL:  user.GetAvatarURL(),
+				})
+			}
+
+			return MarshalledStructuredListResult(minimalUsers), nil
+		}
+}
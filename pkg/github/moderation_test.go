@@ -0,0 +1,601 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetInteractionLimits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetInteractionLimits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_interaction_limits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	expiresAt := &github.Timestamp{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedLimits InteractionLimits
+	}{
+		{
+			name: "organization limits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsInteractionLimitsByOrg,
+					&github.InteractionRestriction{
+						Limit:     github.Ptr("existing_users"),
+						Origin:    github.Ptr("organization"),
+						ExpiresAt: expiresAt,
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+			},
+			expectedLimits: InteractionLimits{
+				Scope:     "organization",
+				Target:    "octo",
+				Limit:     "existing_users",
+				Origin:    "organization",
+				ExpiresAt: expiresAt,
+			},
+		},
+		{
+			name: "repository without limits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposInteractionLimitsByOwnerByRepo,
+					&github.InteractionRestriction{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+			},
+			expectedLimits: InteractionLimits{
+				Scope:  "repository",
+				Target: "octo/repo",
+				Limit:  "none",
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsInteractionLimitsByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get interaction limits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetInteractionLimits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var limits InteractionLimits
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &limits))
+			assert.Equal(t, tc.expectedLimits.Scope, limits.Scope)
+			assert.Equal(t, tc.expectedLimits.Target, limits.Target)
+			assert.Equal(t, tc.expectedLimits.Limit, limits.Limit)
+			assert.Equal(t, tc.expectedLimits.Origin, limits.Origin)
+			if tc.expectedLimits.ExpiresAt == nil {
+				assert.Nil(t, limits.ExpiresAt)
+			} else {
+				require.NotNil(t, limits.ExpiresAt)
+				assert.True(t, tc.expectedLimits.ExpiresAt.Equal(*limits.ExpiresAt))
+			}
+		})
+	}
+}
+
+func Test_SetInteractionLimits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetInteractionLimits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_interaction_limits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "limit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "limit"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedLimits InteractionLimits
+	}{
+		{
+			name: "limit organization to collaborators",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsInteractionLimitsByOrg,
+					expectRequestBody(t, map[string]any{
+						"limit": "collaborators_only",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.InteractionRestriction{
+							Limit:  github.Ptr("collaborators_only"),
+							Origin: github.Ptr("organization"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"limit": "collaborators_only",
+			},
+			expectedLimits: InteractionLimits{Scope: "organization", Target: "octo", Limit: "collaborators_only", Origin: "organization"},
+		},
+		{
+			name: "limit repository to existing users",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposInteractionLimitsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"limit": "existing_users",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.InteractionRestriction{
+							Limit:  github.Ptr("existing_users"),
+							Origin: github.Ptr("repository"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"limit": "existing_users",
+			},
+			expectedLimits: InteractionLimits{Scope: "repository", Target: "octo/repo", Limit: "existing_users", Origin: "repository"},
+		},
+		{
+			name: "remove repository limits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposInteractionLimitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"repo":  "repo",
+				"limit": "none",
+			},
+			expectedLimits: InteractionLimits{Scope: "repository", Target: "octo/repo", Limit: "none"},
+		},
+		{
+			name: "update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsInteractionLimitsByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin rights"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo",
+				"limit": "contributors_only",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to set interaction limits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetInteractionLimits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var limits InteractionLimits
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &limits))
+			assert.Equal(t, tc.expectedLimits, limits)
+		})
+	}
+}
+
+func Test_BlockOrgUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := BlockOrgUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "block_org_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "blocks user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsBlocksByOrgByUsername,
+					expectPath(t, "/orgs/octo/blocks/spammer").andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						},
+					),
+				),
+			),
+		},
+		{
+			name: "block fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsBlocksByOrgByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Blocked user has already been blocked"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to block user spammer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := BlockOrgUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"org":      "octo",
+				"username": "spammer",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, "user 'spammer' blocked from organization 'octo'", textContent.Text)
+		})
+	}
+}
+
+func Test_UnblockOrgUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnblockOrgUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unblock_org_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "unblocks user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsBlocksByOrgByUsername,
+					expectPath(t, "/orgs/octo/blocks/spammer").andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						},
+					),
+				),
+			),
+		},
+		{
+			name: "unblock fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsBlocksByOrgByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to unblock user spammer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UnblockOrgUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"org":      "octo",
+				"username": "spammer",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, "user 'spammer' unblocked from organization 'octo'", textContent.Text)
+		})
+	}
+}
+
+func Test_BlockUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := BlockUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "block_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "blocks user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutUserBlocksByUsername,
+					expectPath(t, "/user/blocks/troll").andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						},
+					),
+				),
+			),
+		},
+		{
+			name: "block fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutUserBlocksByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to block user troll",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := BlockUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"username": "troll",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, "user 'troll' blocked", textContent.Text)
+		})
+	}
+}
+
+func Test_UnblockUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnblockUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "unblock_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteUserBlocksByUsername,
+			expectPath(t, "/user/blocks/troll").andThen(
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				},
+			),
+		),
+	))
+	_, handler := UnblockUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"username": "troll",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "user 'troll' unblocked", getTextResult(t, result).Text)
+}
+
+func Test_ListBlockedUsers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListBlockedUsers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_blocked_users", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedUsers  []MinimalUser
+	}{
+		{
+			name: "lists blocked users",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserBlocks,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.User{
+							{
+								Login:     github.Ptr("troll"),
+								ID:        github.Ptr(int64(7)),
+								HTMLURL:   github.Ptr("https://github.com/troll"),
+								AvatarURL: github.Ptr("https://avatars.githubusercontent.com/u/7"),
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedUsers: []MinimalUser{
+				{
+					Login:      "troll",
+					ID:         7,
+					ProfileURL: "https://github.com/troll",
+					AvatarURL:  "https://avatars.githubusercontent.com/u/7",
+				},
+			},
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserBlocks,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnauthorized)
+						_, _ = w.Write([]byte(`{"message": "Requires authentication"}`))
+					}),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "failed to list blocked users",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListBlockedUsers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var users []MinimalUser
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &users))
+			assert.Equal(t, tc.expectedUsers, users)
+		})
+	}
+}
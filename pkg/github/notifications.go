@@ -30,6 +30,7 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 				Title:        t("TOOL_LIST_NOTIFICATIONS_USER_TITLE", "List notifications"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.Notification](),
 			mcp.WithString("filter",
 				mcp.Description("Filter notifications to, use default unless specified. Read notifications are ones that have already been acknowledged by the user. Participating notifications are those that the user is directly involved in, such as issues or pull requests they have commented on or created."),
 				mcp.Enum(FilterDefault, FilterIncludeRead, FilterOnlyParticipating),
@@ -136,17 +137,13 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 
 			// Marshal response to JSON
-			r, err := json.Marshal(notifications)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(notifications), nil
 		}
 }
 
 // DismissNotification creates a tool to mark a notification as read/done.
 func DismissNotification(getclient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	notificationMarkedMessage := t("RESULT_NOTIFICATION_MARKED", "Notification marked as %s")
 	return mcp.NewTool("dismiss_notification",
 			mcp.WithDescription(t("TOOL_DISMISS_NOTIFICATION_DESCRIPTION", "Dismiss a notification by marking it as read or done")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -208,12 +205,13 @@ func DismissNotification(getclient GetClientFn, t translations.TranslationHelper
 				return mcp.NewToolResultError(fmt.Sprintf("failed to mark notification as %s: %s", state, string(body))), nil
 			}
 
-			return mcp.NewToolResultText(fmt.Sprintf("Notification marked as %s", state)), nil
+			return mcp.NewToolResultText(fmt.Sprintf(notificationMarkedMessage, state)), nil
 		}
 }
 
 // MarkAllNotificationsRead creates a tool to mark all notifications as read.
 func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	allNotificationsReadMessage := t("RESULT_ALL_NOTIFICATIONS_READ", "All notifications marked as read")
 	return mcp.NewTool("mark_all_notifications_read",
 			mcp.WithDescription(t("TOOL_MARK_ALL_NOTIFICATIONS_READ_DESCRIPTION", "Mark all notifications as read")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -287,7 +285,7 @@ func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationH
 				return mcp.NewToolResultError(fmt.Sprintf("failed to mark all notifications as read: %s", string(body))), nil
 			}
 
-			return mcp.NewToolResultText("All notifications marked as read"), nil
+			return mcp.NewToolResultText(allNotificationsReadMessage), nil
 		}
 }
 
@@ -299,6 +297,7 @@ func GetNotificationDetails(getClient GetClientFn, t translations.TranslationHel
 				Title:        t("TOOL_GET_NOTIFICATION_DETAILS_USER_TITLE", "Get notification details"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.Notification](),
 			mcp.WithString("notificationID",
 				mcp.Required(),
 				mcp.Description("The ID of the notification"),
@@ -333,12 +332,7 @@ func GetNotificationDetails(getClient GetClientFn, t translations.TranslationHel
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get notification details: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(thread)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(thread), nil
 		}
 }
 
@@ -351,6 +345,7 @@ const (
 
 // ManageNotificationSubscription creates a tool to manage a notification subscription (ignore, watch, delete)
 func ManageNotificationSubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	notificationSubscriptionDeletedMessage := t("RESULT_NOTIFICATION_SUBSCRIPTION_DELETED", "Notification subscription deleted")
 	return mcp.NewTool("manage_notification_subscription",
 			mcp.WithDescription(t("TOOL_MANAGE_NOTIFICATION_SUBSCRIPTION_DESCRIPTION", "Manage a notification subscription: ignore, watch, or delete a notification thread subscription.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -417,7 +412,7 @@ func ManageNotificationSubscription(getClient GetClientFn, t translations.Transl
 
 			if action == NotificationActionDelete {
 				// Special case for delete as there is no response body
-				return mcp.NewToolResultText("Notification subscription deleted"), nil
+				return mcp.NewToolResultText(notificationSubscriptionDeletedMessage), nil
 			}
 
 			r, err := json.Marshal(result)
@@ -436,6 +431,7 @@ const (
 
 // ManageRepositoryNotificationSubscription creates a tool to manage a repository notification subscription (ignore, watch, delete)
 func ManageRepositoryNotificationSubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	repositorySubscriptionDeletedMessage := t("RESULT_REPOSITORY_SUBSCRIPTION_DELETED", "Repository subscription deleted")
 	return mcp.NewTool("manage_repository_notification_subscription",
 			mcp.WithDescription(t("TOOL_MANAGE_REPOSITORY_NOTIFICATION_SUBSCRIPTION_DESCRIPTION", "Manage a repository notification subscription: ignore, watch, or delete repository notifications subscription for the provided repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -513,7 +509,7 @@ func ManageRepositoryNotificationSubscription(getClient GetClientFn, t translati
 
 			if action == RepositorySubscriptionActionDelete {
 				// Special case for delete as there is no response body
-				return mcp.NewToolResultText("Repository subscription deleted"), nil
+				return mcp.NewToolResultText(repositorySubscriptionDeletedMessage), nil
 			}
 
 			r, err := json.Marshal(result)
@@ -0,0 +1,258 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	NotifyStatusWouldComment = "would_comment"
+	NotifyStatusCommented    = "commented"
+	NotifyStatusSkipped      = "skipped"
+	NotifyStatusError        = "error"
+
+	// DefaultNotifyMaxItems is the default number of items notify_on_items comments on.
+	DefaultNotifyMaxItems = 20
+	// MaxNotifyItems is the maximum number of items notify_on_items comments on in one call.
+	MaxNotifyItems = 100
+	// notifyMinRateRemaining is the number of remaining API requests below which notify_on_items stops commenting.
+	notifyMinRateRemaining = 10
+	// notifyCancelledReason is reported for the items not commented on because the request was cancelled.
+	notifyCancelledReason = "stopped because the request was cancelled; retry with a query excluding the items commented on"
+)
+
+// notifyPacingDelay is the pause between two comments. GitHub recommends waiting at least a second between requests
+// that create content to avoid secondary rate limits. It is a variable so that tests can shorten it.
+var notifyPacingDelay = time.Second
+
+// NotifyResult is the outcome of commenting on a single issue or pull request.
+type NotifyResult struct {
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Status     string `json:"status"`
+	Body       string `json:"body,omitempty"`
+	CommentURL string `json:"comment_url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// renderNotifyTemplate fills the placeholders of a comment template for an issue or pull request.
+func renderNotifyTemplate(template string, issue *github.Issue, mentions []string) string {
+	mention := func(logins []string) string {
+		result := make([]string, 0, len(logins))
+		for _, login := range logins {
+			result = append(result, "@"+strings.TrimPrefix(strings.TrimSpace(login), "@"))
+		}
+		return strings.Join(result, " ")
+	}
+	assignees := make([]string, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		assignees = append(assignees, assignee.GetLogin())
+	}
+
+	body := strings.NewReplacer(
+		"{{number}}", fmt.Sprintf("%d", issue.GetNumber()),
+		"{{title}}", issue.GetTitle(),
+		"{{url}}", issue.GetHTMLURL(),
+		"{{author}}", mention([]string{issue.GetUser().GetLogin()}),
+		"{{assignees}}", mention(assignees),
+		"{{mentions}}", mention(mentions),
+	).Replace(template)
+	if len(mentions) > 0 && !strings.Contains(template, "{{mentions}}") {
+		body = mention(mentions) + " " + body
+	}
+	return body
+}
+
+// repositoryFromURL returns the owner and name of an API repository URL such as https://api.github.com/repos/owner/repo.
+func repositoryFromURL(url string) (string, string) {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	if len(parts) < 2 {
+		return "", ""
+	}
+	return parts[len(parts)-2], parts[len(parts)-1]
+}
+
+// isRateLimitError reports whether an error is caused by a primary or secondary rate limit.
+func isRateLimitError(err error) bool {
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	return errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr)
+}
+
+// NotifyOnItems creates a tool to post a templated comment on every issue or pull request that matches a search query.
+func NotifyOnItems(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("notify_on_items",
+			mcp.WithDescription(t("TOOL_NOTIFY_ON_ITEMS_DESCRIPTION", "Post a templated comment, e.g. a reminder or an escalation with @mentions, on every issue or pull request matching a search query. Comments are paced to respect GitHub's rate limits, and the tool stops early when the rate limit is nearly exhausted. Use dry_run to list the items and rendered comments without posting.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_NOTIFY_ON_ITEMS_USER_TITLE", "Comment on matching issues and pull requests"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Issue and pull request search query, e.g. 'repo:owner/repo is:open label:needs-info updated:<2 weeks ago'"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment template. Supports the placeholders {{number}}, {{title}}, {{url}}, {{author}}, {{assignees}} and {{mentions}}. Users are mentioned as @login"),
+			),
+			mcp.WithArray("mentions",
+				mcp.Description("Users or teams to mention. They are put in place of {{mentions}}, or at the start of the comment if the template has no such placeholder"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithNumber("max_items",
+				mcp.Description(fmt.Sprintf("Maximum number of items to comment on (max %d)", MaxNotifyItems)),
+				mcp.DefaultNumber(DefaultNotifyMaxItems),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, only list the items and rendered comments without posting"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := RequiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query = normalizeQueryDates(query, flags.now())
+			template, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mentions, err := OptionalStringArrayParam(request, "mentions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxItems, err := OptionalIntParamWithDefault(request, "max_items", DefaultNotifyMaxItems)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxItems < 1 || maxItems > MaxNotifyItems {
+				return mcp.NewToolResultError(fmt.Sprintf("max_items must be between 1 and %d", MaxNotifyItems)), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var issues []*github.Issue
+			total := 0
+			opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: min(maxItems, 100)}}
+			for len(issues) < maxItems {
+				result, resp, err := client.Search.Issues(ctx, query, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to search issues",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				total = result.GetTotal()
+				issues = append(issues, result.Issues...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+			if len(issues) > maxItems {
+				issues = issues[:maxItems]
+			}
+
+			results := make([]NotifyResult, 0, len(issues))
+			stopReason := ""
+			cancelled := false
+			for i, issue := range issues {
+				owner, repo := repositoryFromURL(issue.GetRepositoryURL())
+				item := NotifyResult{
+					Repository: owner + "/" + repo,
+					Number:     issue.GetNumber(),
+					Title:      issue.GetTitle(),
+					URL:        issue.GetHTMLURL(),
+				}
+				body := renderNotifyTemplate(template, issue, mentions)
+
+				switch {
+				case dryRun:
+					item.Status = NotifyStatusWouldComment
+					item.Body = body
+				case stopReason != "":
+					item.Status = NotifyStatusSkipped
+					item.Error = stopReason
+				default:
+					if i > 0 {
+						select {
+						case <-ctx.Done():
+						case <-time.After(notifyPacingDelay):
+						}
+					}
+					// Report the comments posted so far rather than an error, so that a retry does not post them twice.
+					if ctx.Err() != nil {
+						cancelled = true
+						stopReason = notifyCancelledReason
+						item.Status = NotifyStatusSkipped
+						item.Error = stopReason
+						break
+					}
+					comment, resp, err := client.Issues.CreateComment(ctx, owner, repo, issue.GetNumber(), &github.IssueComment{Body: github.Ptr(body)})
+					if err != nil {
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to create comment", resp, err)
+						item.Status = NotifyStatusError
+						item.Error = err.Error()
+						switch {
+						case ctx.Err() != nil:
+							cancelled = true
+							stopReason = notifyCancelledReason
+						case isRateLimitError(err) || (resp != nil && resp.StatusCode == http.StatusTooManyRequests):
+							stopReason = "stopped after hitting a rate limit; retry later"
+						}
+						break
+					}
+					_ = resp.Body.Close()
+					item.Status = NotifyStatusCommented
+					item.CommentURL = comment.GetHTMLURL()
+					if resp.Rate.Limit > 0 && resp.Rate.Remaining < notifyMinRateRemaining {
+						stopReason = fmt.Sprintf("stopped because only %d API requests remain until %s", resp.Rate.Remaining, resp.Rate.Reset.Format(time.RFC3339))
+					}
+				}
+				results = append(results, item)
+			}
+
+			response := map[string]any{
+				"dry_run":     dryRun,
+				"query":       query,
+				"total_count": total,
+				"items":       results,
+			}
+			if stopReason != "" {
+				response["stopped"] = stopReason
+			}
+			if cancelled {
+				response["cancelled"] = true
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderNotifyTemplate(t *testing.T) {
+	issue := &github.Issue{
+		Number:    github.Ptr(42),
+		Title:     github.Ptr("Flaky test"),
+		HTMLURL:   github.Ptr("https://github.com/octo/repo/issues/42"),
+		User:      &github.User{Login: github.Ptr("reporter")},
+		Assignees: []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}},
+	}
+
+	assert.Equal(t,
+		"@lead: #42 Flaky test (https://github.com/octo/repo/issues/42) by @reporter is waiting on @alice @bob",
+		renderNotifyTemplate("{{mentions}}: #{{number}} {{title}} ({{url}}) by {{author}} is waiting on {{assignees}}", issue, []string{"@lead"}),
+	)
+	assert.Equal(t, "@lead @org/team Any update?", renderNotifyTemplate("Any update?", issue, []string{"lead", "org/team"}))
+}
+
+func Test_NotifyOnItems(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := NotifyOnItems(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "notify_on_items", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query", "body"})
+
+	defer func(delay time.Duration) { notifyPacingDelay = delay }(notifyPacingDelay)
+	notifyPacingDelay = 0
+
+	searchResult := &github.IssuesSearchResult{
+		Total: github.Ptr(3),
+		Issues: []*github.Issue{
+			{Number: github.Ptr(1), Title: github.Ptr("First"), RepositoryURL: github.Ptr("https://api.github.com/repos/octo/repo")},
+			{Number: github.Ptr(2), Title: github.Ptr("Second"), RepositoryURL: github.Ptr("https://api.github.com/repos/octo/other")},
+			{Number: github.Ptr(3), Title: github.Ptr("Third"), RepositoryURL: github.Ptr("https://api.github.com/repos/octo/repo")},
+		},
+	}
+	comment := &github.IssueComment{HTMLURL: github.Ptr("https://github.com/octo/repo/issues/1#issuecomment-1")}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedStatuses []string
+		expectStopped    bool
+	}{
+		{
+			name: "dry run lists items",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchIssues, searchResult),
+			),
+			requestArgs: map[string]interface{}{
+				"query":   "is:open label:needs-info",
+				"body":    "Ping {{mentions}}",
+				"dry_run": true,
+			},
+			expectedStatuses: []string{NotifyStatusWouldComment, NotifyStatusWouldComment, NotifyStatusWouldComment},
+		},
+		{
+			name: "comments up to max_items",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchIssues, searchResult),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{"body": "@lead Any update on First?"}).andThen(
+						mockResponse(t, http.StatusCreated, comment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":     "is:open label:needs-info",
+				"body":      "Any update on {{title}}?",
+				"mentions":  []interface{}{"lead"},
+				"max_items": float64(1),
+			},
+			expectedStatuses: []string{NotifyStatusCommented},
+		},
+		{
+			name: "stops when the rate limit is nearly exhausted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchIssues, searchResult),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("X-RateLimit-Limit", "5000")
+						w.Header().Set("X-RateLimit-Remaining", "3")
+						mockResponse(t, http.StatusCreated, comment)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "is:open label:needs-info",
+				"body":  "Any update?",
+			},
+			expectedStatuses: []string{NotifyStatusCommented, NotifyStatusSkipped, NotifyStatusSkipped},
+			expectStopped:    true,
+		},
+		{
+			name: "continues after a failed comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchIssues, searchResult),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/octo/other/issues/2/comments" {
+							w.WriteHeader(http.StatusForbidden)
+							_, _ = w.Write([]byte(`{"message": "Issue is locked"}`))
+							return
+						}
+						mockResponse(t, http.StatusCreated, comment)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "is:open label:needs-info",
+				"body":  "Any update?",
+			},
+			expectedStatuses: []string{NotifyStatusCommented, NotifyStatusError, NotifyStatusCommented},
+		},
+		{
+			name:         "max_items out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query":     "is:open",
+				"body":      "Any update?",
+				"max_items": float64(101),
+			},
+			expectError:    true,
+			expectedErrMsg: "max_items must be between 1 and 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := NotifyOnItems(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Items   []NotifyResult `json:"items"`
+				Stopped string         `json:"stopped"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			statuses := []string{}
+			for _, item := range response.Items {
+				statuses = append(statuses, item.Status)
+			}
+			assert.Equal(t, tc.expectedStatuses, statuses)
+			assert.Equal(t, tc.expectStopped, response.Stopped != "")
+		})
+	}
+}
+
+func Test_NotifyOnItems_Cancelled(t *testing.T) {
+	defer func(delay time.Duration) { notifyPacingDelay = delay }(notifyPacingDelay)
+	notifyPacingDelay = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	posted := 0
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetSearchIssues, &github.IssuesSearchResult{
+			Total: github.Ptr(2),
+			Issues: []*github.Issue{
+				{Number: github.Ptr(1), Title: github.Ptr("First"), RepositoryURL: github.Ptr("https://api.github.com/repos/octo/repo")},
+				{Number: github.Ptr(2), Title: github.Ptr("Second"), RepositoryURL: github.Ptr("https://api.github.com/repos/octo/repo")},
+			},
+		}),
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				posted++
+				// The client goes away while waiting to post the next comment.
+				time.AfterFunc(10*time.Millisecond, cancel)
+				mockResponse(t, http.StatusCreated, &github.IssueComment{HTMLURL: github.Ptr("https://github.com/octo/repo/issues/1#issuecomment-1")})(w, r)
+			}),
+		),
+	)
+	_, handler := NotifyOnItems(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+
+	result, err := handler(ctx, createMCPRequest(map[string]interface{}{
+		"query": "is:open label:needs-info",
+		"body":  "Any update?",
+	}))
+	require.NoError(t, err)
+
+	var response struct {
+		Items     []NotifyResult `json:"items"`
+		Stopped   string         `json:"stopped"`
+		Cancelled bool           `json:"cancelled"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Items, 2)
+	assert.Equal(t, NotifyStatusCommented, response.Items[0].Status)
+	assert.Equal(t, NotifyStatusSkipped, response.Items[1].Status)
+	assert.True(t, response.Cancelled)
+	assert.Contains(t, response.Stopped, "cancelled")
+	assert.Equal(t, 1, posted)
+}
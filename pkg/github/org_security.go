@@ -0,0 +1,128 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// OrgSecurityPosture reports the account security of the members and outside collaborators of an organization.
+type OrgSecurityPosture struct {
+	Org string `json:"org"`
+	// TwoFactorRequired is whether the organization requires two-factor authentication, when it is visible.
+	TwoFactorRequired *bool    `json:"two_factor_required,omitempty"`
+	Members           int      `json:"members"`
+	OwnersCount       int      `json:"owners_count"`
+	Owners            []string `json:"owners"`
+	MembersWithout2FA []string `json:"members_without_2fa"`
+	// OutsideCollaborators have access to repositories of the organization without being members.
+	OutsideCollaborators           []string `json:"outside_collaborators"`
+	OutsideCollaboratorsWithout2FA []string `json:"outside_collaborators_without_2fa"`
+}
+
+// listAllUsers loads all the pages of a list of users and returns their sorted logins.
+func listAllUsers(list func(opts github.ListOptions) ([]*github.User, *github.Response, error)) ([]string, *github.Response, error) {
+	logins := []string{}
+	opts := github.ListOptions{PerPage: 100}
+	for {
+		users, resp, err := list(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, user := range users {
+			logins = append(logins, user.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			sort.Strings(logins)
+			return logins, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// GetOrgSecurityPosture creates a tool to report the members without two-factor authentication, the owners and the
+// outside collaborators of an organization.
+func GetOrgSecurityPosture(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_org_security_posture",
+			mcp.WithDescription(t("TOOL_GET_ORG_SECURITY_POSTURE_DESCRIPTION", "Report the security posture of the accounts of an organization for access reviews: whether two-factor authentication is required, the members and outside collaborators without two-factor authentication, the owners and the outside collaborators. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORG_SECURITY_POSTURE_USER_TITLE", "Get organization security posture"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[OrgSecurityPosture](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			organization, resp, err := client.Organizations.Get(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			members := func(role, filter string) ([]string, *github.Response, error) {
+				return listAllUsers(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+					return client.Organizations.ListMembers(ctx, org, &github.ListMembersOptions{Role: role, Filter: filter, ListOptions: opts})
+				})
+			}
+			outsideCollaborators := func(filter string) ([]string, *github.Response, error) {
+				return listAllUsers(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+					return client.Organizations.ListOutsideCollaborators(ctx, org, &github.ListOutsideCollaboratorsOptions{Filter: filter, ListOptions: opts})
+				})
+			}
+
+			posture := OrgSecurityPosture{Org: org, TwoFactorRequired: organization.TwoFactorRequirementEnabled}
+			lists := []struct {
+				description string
+				list        func() ([]string, *github.Response, error)
+				logins      *[]string
+			}{
+				{"members", func() ([]string, *github.Response, error) { return members("all", "") }, nil},
+				{"owners", func() ([]string, *github.Response, error) { return members("admin", "") }, &posture.Owners},
+				{"members without two-factor authentication", func() ([]string, *github.Response, error) { return members("all", "2fa_disabled") }, &posture.MembersWithout2FA},
+				{"outside collaborators", func() ([]string, *github.Response, error) { return outsideCollaborators("") }, &posture.OutsideCollaborators},
+				{"outside collaborators without two-factor authentication", func() ([]string, *github.Response, error) { return outsideCollaborators("2fa_disabled") }, &posture.OutsideCollaboratorsWithout2FA},
+			}
+			for _, l := range lists {
+				logins, resp, err := l.list()
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list %s of organization '%s'", l.description, org),
+						resp,
+						err,
+					), nil
+				}
+				if l.logins == nil {
+					posture.Members = len(logins)
+					continue
+				}
+				*l.logins = logins
+			}
+			posture.OwnersCount = len(posture.Owners)
+
+			return MarshalledStructuredResult(posture), nil
+		}
+}
@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetOrgSecurityPosture(t *testing.T) {
+	tool, _ := GetOrgSecurityPosture(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	users := func(logins ...string) []*github.User {
+		result := []*github.User{}
+		for _, login := range logins {
+			result = append(result, &github.User{Login: github.Ptr(login)})
+		}
+		return result
+	}
+	membersHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Get("role") == "admin":
+			mockResponse(t, http.StatusOK, users("alice"))(w, r)
+		case q.Get("filter") == "2fa_disabled":
+			mockResponse(t, http.StatusOK, users("carol"))(w, r)
+		case q.Get("page") == "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/octo/members?page=2>; rel="next"`)
+			mockResponse(t, http.StatusOK, users("carol", "alice"))(w, r)
+		default:
+			mockResponse(t, http.StatusOK, users("bob"))(w, r)
+		}
+	})
+	collaboratorsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("filter") == "2fa_disabled" {
+			mockResponse(t, http.StatusOK, users())(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, users("contractor"))(w, r)
+	})
+
+	t.Run("posture", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetOrgsByOrg, &github.Organization{Login: github.Ptr("octo"), TwoFactorRequirementEnabled: github.Ptr(false)}),
+			mock.WithRequestMatchHandler(mock.GetOrgsMembersByOrg, membersHandler),
+			mock.WithRequestMatchHandler(mock.GetOrgsOutsideCollaboratorsByOrg, collaboratorsHandler),
+		))
+		_, handler := GetOrgSecurityPosture(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response OrgSecurityPosture
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, OrgSecurityPosture{
+			Org:                            "octo",
+			TwoFactorRequired:              github.Ptr(false),
+			Members:                        3,
+			OwnersCount:                    1,
+			Owners:                         []string{"alice"},
+			MembersWithout2FA:              []string{"carol"},
+			OutsideCollaborators:           []string{"contractor"},
+			OutsideCollaboratorsWithout2FA: []string{},
+		}, response)
+	})
+
+	t.Run("not an owner", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetOrgsByOrg, &github.Organization{Login: github.Ptr("octo")}),
+			mock.WithRequestMatchHandler(mock.GetOrgsMembersByOrg, membersHandler),
+			mock.WithRequestMatchHandler(mock.GetOrgsOutsideCollaboratorsByOrg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"message": "Must be an organization owner"}`))
+			})),
+		))
+		_, handler := GetOrgSecurityPosture(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to list outside collaborators of organization 'octo'")
+	})
+}
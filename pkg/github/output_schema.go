@@ -0,0 +1,97 @@
+package github
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var (
+	timestampType = reflect.TypeOf(github.Timestamp{})
+	userType      = reflect.TypeOf(github.User{})
+
+	// recursiveTypes are go-github types that refer to themselves through nested types, which cannot be inlined.
+	recursiveTypes = map[reflect.Type]bool{
+		userType:                              true,
+		reflect.TypeOf(github.Repository{}):   true,
+		reflect.TypeOf(github.Organization{}): true,
+		reflect.TypeOf(github.Team{}):         true,
+		reflect.TypeOf(github.Commit{}):       true,
+	}
+)
+
+// outputSchemaReflector generates MCP output schemas from the Go types returned as structured content.
+// Schemas are inlined, as not all clients resolve references.
+var outputSchemaReflector = &jsonschema.Reflector{
+	DoNotReference:            true,
+	Anonymous:                 true,
+	AllowAdditionalProperties: true,
+	Mapper:                    mapOutputSchemaType,
+}
+
+// mapOutputSchemaType overrides the schema of go-github types that do not reflect to a usable schema.
+func mapOutputSchemaType(t reflect.Type) *jsonschema.Schema {
+	switch {
+	case t == timestampType:
+		return &jsonschema.Schema{Type: "string", Format: "date-time"}
+	case recursiveTypes[t]:
+		return &jsonschema.Schema{Type: "object"}
+	}
+	return nil
+}
+
+// OutputSchema generates the JSON schema of the structured content of type T.
+func OutputSchema[T any]() json.RawMessage {
+	var zero T
+	schema := outputSchemaReflector.Reflect(zero)
+	schema.Version = ""
+	data, err := json.Marshal(schema)
+	if err != nil {
+		panic("failed to marshal output schema: " + err.Error())
+	}
+	return data
+}
+
+// WithOutputSchema declares that a tool returns structured content of type T.
+func WithOutputSchema[T any]() mcp.ToolOption {
+	return mcp.WithRawOutputSchema(OutputSchema[T]())
+}
+
+// WithListOutputSchema declares that a tool returns structured content of type ListResult[T].
+func WithListOutputSchema[T any]() mcp.ToolOption {
+	return WithOutputSchema[ListResult[T]]()
+}
+
+// ListResult is the structured content of tools that return a list. Structured content must be a JSON object, so
+// the list is wrapped in an object.
+type ListResult[T any] struct {
+	Items []T `json:"items"`
+}
+
+// MarshalledStructuredResult returns v as structured content, along with its JSON serialization as text for clients
+// that do not support structured content. v must serialize to a JSON object.
+func MarshalledStructuredResult(v any) *mcp.CallToolResult {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to marshal structured result to json", err)
+	}
+
+	return mcp.NewToolResultStructured(v, string(data))
+}
+
+// MarshalledStructuredListResult returns items wrapped in a ListResult as structured content. The text content keeps
+// the plain JSON array for clients that do not support structured content.
+func MarshalledStructuredListResult[T any](items []T) *mcp.CallToolResult {
+	if items == nil {
+		items = []T{}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to marshal structured result to json", err)
+	}
+
+	return mcp.NewToolResultStructured(ListResult[T]{Items: items}, string(data))
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OutputSchema(t *testing.T) {
+	var schema struct {
+		Type       string                     `json:"type"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(OutputSchema[github.ProjectV2Item](), &schema))
+
+	assert.Equal(t, "object", schema.Type)
+	assert.JSONEq(t, `{"type":"string","format":"date-time"}`, string(schema.Properties["created_at"]))
+	assert.JSONEq(t, `{"type":"object"}`, string(schema.Properties["creator"]))
+}
+
+func Test_ListOutputSchema(t *testing.T) {
+	var schema struct {
+		Type       string `json:"type"`
+		Properties struct {
+			Items struct {
+				Type  string          `json:"type"`
+				Items json.RawMessage `json:"items"`
+			} `json:"items"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	require.NoError(t, json.Unmarshal(OutputSchema[ListResult[string]](), &schema))
+
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, "array", schema.Properties.Items.Type)
+	assert.JSONEq(t, `{"type":"string"}`, string(schema.Properties.Items.Items))
+	assert.Equal(t, []string{"items"}, schema.Required)
+}
@@ -0,0 +1,383 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PagesBuildSummary is a build of a Pages site built from a branch.
+type PagesBuildSummary struct {
+	Status     string `json:"status"`
+	Commit     string `json:"commit,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int    `json:"duration_ms,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+}
+
+// PagesDeploymentStatus is a deployment of a Pages site made with the Pages deployment API.
+type PagesDeploymentStatus struct {
+	ID        string `json:"id,omitempty"`
+	Status    string `json:"status,omitempty"`
+	StatusURL string `json:"status_url,omitempty"`
+	PageURL   string `json:"page_url,omitempty"`
+}
+
+// PagesStatus is the configuration and the state of the latest build or deployment of a Pages site.
+type PagesStatus struct {
+	URL    string `json:"url"`
+	Status string `json:"status"`
+	// BuildType is legacy for sites built from a branch, or workflow for sites deployed by an Actions workflow.
+	BuildType     string `json:"build_type"`
+	SourceBranch  string `json:"source_branch,omitempty"`
+	SourcePath    string `json:"source_path,omitempty"`
+	CustomDomain  string `json:"custom_domain,omitempty"`
+	HTTPSEnforced bool   `json:"https_enforced"`
+	// CertificateState is the state of the HTTPS certificate of the custom domain, e.g. approved or dns_changed.
+	CertificateState     string                 `json:"certificate_state,omitempty"`
+	CertificateExpiresAt string                 `json:"certificate_expires_at,omitempty"`
+	LatestBuild          *PagesBuildSummary     `json:"latest_build,omitempty"`
+	Deployment           *PagesDeploymentStatus `json:"deployment,omitempty"`
+}
+
+// PagesDomainCheck is the DNS and HTTPS verification state of a custom domain of a Pages site.
+type PagesDomainCheck struct {
+	Host string `json:"host"`
+	// Valid is whether the DNS records of the domain are correctly configured, Reason tells why not.
+	Valid                   bool   `json:"valid"`
+	Reason                  string `json:"reason,omitempty"`
+	DNSResolves             bool   `json:"dns_resolves"`
+	ApexDomain              bool   `json:"apex_domain"`
+	PointedToGitHubPagesIP  bool   `json:"pointed_to_github_pages_ip"`
+	CNAMEToGitHubUserDomain bool   `json:"cname_to_github_user_domain"`
+	NonGitHubPagesIPPresent bool   `json:"non_github_pages_ip_present"`
+	ServedByPages           bool   `json:"served_by_pages"`
+	HTTPSEligible           bool   `json:"https_eligible"`
+	RespondsToHTTPS         bool   `json:"responds_to_https"`
+	EnforcesHTTPS           bool   `json:"enforces_https"`
+	HTTPSError              string `json:"https_error,omitempty"`
+	CAAError                string `json:"caa_error,omitempty"`
+}
+
+// PagesDomainHealth is the verification state of the custom domain of a Pages site and of its alternate domain, e.g.
+// www.example.com for example.com.
+type PagesDomainHealth struct {
+	Domain    *PagesDomainCheck `json:"domain,omitempty"`
+	AltDomain *PagesDomainCheck `json:"alt_domain,omitempty"`
+}
+
+// pagesDeploymentRequest is the body of the Pages deployment API, which go-github does not support.
+type pagesDeploymentRequest struct {
+	ArtifactID        int64  `json:"artifact_id,omitempty"`
+	ArtifactURL       string `json:"artifact_url,omitempty"`
+	Environment       string `json:"environment,omitempty"`
+	PagesBuildVersion string `json:"pages_build_version"`
+	OIDCToken         string `json:"oidc_token"`
+}
+
+type pagesDeploymentResponse struct {
+	// ID is a number or a string.
+	ID        json.RawMessage `json:"id"`
+	Status    string          `json:"status"`
+	StatusURL string          `json:"status_url"`
+	PageURL   string          `json:"page_url"`
+}
+
+func convertToPagesDomainCheck(domain *github.PagesDomain) *PagesDomainCheck {
+	if domain == nil {
+		return nil
+	}
+	return &PagesDomainCheck{
+		Host:                    domain.GetHost(),
+		Valid:                   domain.GetIsValid(),
+		Reason:                  domain.GetReason(),
+		DNSResolves:             domain.GetDNSResolves(),
+		ApexDomain:              domain.GetIsApexDomain(),
+		PointedToGitHubPagesIP:  domain.GetIsPointedToGithubPagesIP(),
+		CNAMEToGitHubUserDomain: domain.GetIsCNAMEToGithubUserDomain(),
+		NonGitHubPagesIPPresent: domain.GetIsNonGithubPagesIPPresent(),
+		ServedByPages:           domain.GetIsServedByPages(),
+		HTTPSEligible:           domain.GetIsHTTPSEligible(),
+		RespondsToHTTPS:         domain.GetRespondsToHTTPS(),
+		EnforcesHTTPS:           domain.GetEnforcesHTTPS(),
+		HTTPSError:              domain.GetHTTPSError(),
+		CAAError:                domain.GetCAAError(),
+	}
+}
+
+// GetPagesStatus creates a tool to get the configuration of a Pages site and the state of its latest build or of a
+// deployment.
+func GetPagesStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pages_status",
+			mcp.WithDescription(t("TOOL_GET_PAGES_STATUS_DESCRIPTION", "Get the status of the GitHub Pages site of a repository: its URL, source, custom domain and HTTPS certificate state, and the latest build for sites built from a branch. Pass a deployment ID to also get the state of a deployment created with create_pages_deployment.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PAGES_STATUS_USER_TITLE", "Get Pages status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[PagesStatus](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("deployment_id",
+				mcp.Description("ID of a Pages deployment, or the commit SHA it was created for"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deploymentID, err := OptionalParam[string](request, "deployment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pages, resp, err := client.Repositories.GetPagesInfo(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get Pages site of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := PagesStatus{
+				URL:                  pages.GetHTMLURL(),
+				Status:               pages.GetStatus(),
+				BuildType:            pages.GetBuildType(),
+				SourceBranch:         pages.GetSource().GetBranch(),
+				SourcePath:           pages.GetSource().GetPath(),
+				CustomDomain:         pages.GetCNAME(),
+				HTTPSEnforced:        pages.GetHTTPSEnforced(),
+				CertificateState:     pages.GetHTTPSCertificate().GetState(),
+				CertificateExpiresAt: pages.GetHTTPSCertificate().GetExpiresAt(),
+			}
+
+			if result.BuildType != "workflow" {
+				build, resp, err := client.Repositories.GetLatestPagesBuild(ctx, owner, repo)
+				switch {
+				case err == nil:
+					_ = resp.Body.Close()
+					result.LatestBuild = &PagesBuildSummary{
+						Status:     build.GetStatus(),
+						Commit:     build.GetCommit(),
+						Error:      build.GetError().GetMessage(),
+						DurationMs: build.GetDuration(),
+						CreatedAt:  formatTimestamp(build.CreatedAt),
+					}
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					// The site has not been built yet.
+				default:
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get latest Pages build of %s/%s", owner, repo),
+						resp,
+						err,
+					), nil
+				}
+			}
+
+			if deploymentID != "" {
+				req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/pages/deployments/%s", owner, repo, deploymentID), nil)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create request: %w", err)
+				}
+				var deployment pagesDeploymentResponse
+				resp, err := client.Do(ctx, req, &deployment)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get Pages deployment %s", deploymentID),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				result.Deployment = &PagesDeploymentStatus{ID: deploymentID, Status: deployment.Status}
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
+
+// CreatePagesDeployment creates a tool to deploy an Actions artifact to a Pages site.
+func CreatePagesDeployment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pages_deployment",
+			mcp.WithDescription(t("TOOL_CREATE_PAGES_DEPLOYMENT_DESCRIPTION", "Deploy a site built as an Actions artifact to the GitHub Pages site of a repository, using the Pages deployment API. Requires an OIDC token of the workflow run that uploaded the artifact. Follow the deployment with get_pages_status.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PAGES_DEPLOYMENT_USER_TITLE", "Create Pages deployment"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[PagesDeploymentStatus](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("artifact_id",
+				mcp.Description("ID of the artifact containing the site. Either artifact_id or artifact_url is required"),
+			),
+			mcp.WithString("artifact_url",
+				mcp.Description("URL of the artifact containing the site"),
+			),
+			mcp.WithString("pages_build_version",
+				mcp.Required(),
+				mcp.Description("Unique version of the build, usually the commit SHA the site was built from"),
+			),
+			mcp.WithString("oidc_token",
+				mcp.Required(),
+				mcp.Description("OIDC token issued to the workflow run that uploaded the artifact"),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Deployment environment"),
+				mcp.DefaultString("github-pages"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactID, err := OptionalIntParam(request, "artifact_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			artifactURL, err := OptionalParam[string](request, "artifact_url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			buildVersion, err := RequiredParam[string](request, "pages_build_version")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			oidcToken, err := RequiredParam[string](request, "oidc_token")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (artifactID == 0) == (artifactURL == "") {
+				return mcp.NewToolResultError("exactly one of artifact_id and artifact_url is required"), nil
+			}
+			if environment == "" {
+				environment = "github-pages"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github has no method for the Pages deployment API.
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/pages/deployments", owner, repo), &pagesDeploymentRequest{
+				ArtifactID:        int64(artifactID),
+				ArtifactURL:       artifactURL,
+				Environment:       environment,
+				PagesBuildVersion: buildVersion,
+				OIDCToken:         oidcToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var deployment pagesDeploymentResponse
+			resp, err := client.Do(ctx, req, &deployment)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create Pages deployment of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(PagesDeploymentStatus{
+				ID:        strings.Trim(string(deployment.ID), `"`),
+				StatusURL: deployment.StatusURL,
+				PageURL:   deployment.PageURL,
+			}), nil
+		}
+}
+
+// GetPagesDomainHealth creates a tool to check the DNS and HTTPS verification state of the custom domain of a Pages
+// site.
+func GetPagesDomainHealth(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pages_domain_health",
+			mcp.WithDescription(t("TOOL_GET_PAGES_DOMAIN_HEALTH_DESCRIPTION", "Check whether the DNS records of the custom domain of the GitHub Pages site of a repository, and of its alternate www domain, are correctly configured and whether HTTPS can be and is enforced. The first check of a domain is computed in the background, retry when told to.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PAGES_DOMAIN_HEALTH_USER_TITLE", "Get Pages custom domain health"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[PagesDomainHealth](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			health, resp, err := client.Repositories.GetPageHealthCheck(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText("The health check of the custom domain is being computed, retry in a few seconds"), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to check custom domain of Pages site of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(PagesDomainHealth{
+				Domain:    convertToPagesDomainCheck(health.Domain),
+				AltDomain: convertToPagesDomainCheck(health.AltDomain),
+			}), nil
+		}
+}
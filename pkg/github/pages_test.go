@@ -0,0 +1,244 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPagesStatus(t *testing.T) {
+	tool, _ := GetPagesStatus(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+		expected     PagesStatus
+	}{
+		{
+			name: "site built from a branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPagesByOwnerByRepo, &github.Pages{
+					HTMLURL:       github.Ptr("https://docs.example.com/"),
+					Status:        github.Ptr("built"),
+					BuildType:     github.Ptr("legacy"),
+					Source:        &github.PagesSource{Branch: github.Ptr("gh-pages"), Path: github.Ptr("/")},
+					CNAME:         github.Ptr("docs.example.com"),
+					HTTPSEnforced: github.Ptr(true),
+					HTTPSCertificate: &github.PagesHTTPSCertificate{
+						State:     github.Ptr("approved"),
+						ExpiresAt: github.Ptr("2025-01-31"),
+					},
+				}),
+				mock.WithRequestMatch(mock.GetReposPagesBuildsLatestByOwnerByRepo, &github.PagesBuild{
+					Status:    github.Ptr("errored"),
+					Commit:    github.Ptr("abc123"),
+					Error:     &github.PagesError{Message: github.Ptr("Page build failed.")},
+					Duration:  github.Ptr(2104),
+					CreatedAt: &github.Timestamp{Time: time.Date(2024, 11, 5, 9, 30, 0, 0, time.UTC)},
+				}),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "docs"},
+			expected: PagesStatus{
+				URL:                  "https://docs.example.com/",
+				Status:               "built",
+				BuildType:            "legacy",
+				SourceBranch:         "gh-pages",
+				SourcePath:           "/",
+				CustomDomain:         "docs.example.com",
+				HTTPSEnforced:        true,
+				CertificateState:     "approved",
+				CertificateExpiresAt: "2025-01-31",
+				LatestBuild: &PagesBuildSummary{
+					Status:     "errored",
+					Commit:     "abc123",
+					Error:      "Page build failed.",
+					DurationMs: 2104,
+					CreatedAt:  "2024-11-05T09:30:00Z",
+				},
+			},
+		},
+		{
+			name: "site deployed by a workflow",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPagesByOwnerByRepo, &github.Pages{
+					HTMLURL:   github.Ptr("https://octo.github.io/docs/"),
+					Status:    github.Ptr("building"),
+					BuildType: github.Ptr("workflow"),
+				}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPagesDeploymentsByOwnerByRepoByPagesDeploymentId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"status": "deployment_in_progress"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "docs", "deployment_id": "4fd754f7e594640989b406850d0bc8f06a121251"},
+			expected: PagesStatus{
+				URL:       "https://octo.github.io/docs/",
+				Status:    "building",
+				BuildType: "workflow",
+				Deployment: &PagesDeploymentStatus{
+					ID:     "4fd754f7e594640989b406850d0bc8f06a121251",
+					Status: "deployment_in_progress",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetPagesStatus(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response PagesStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
+
+func Test_CreatePagesDeployment(t *testing.T) {
+	tool, _ := CreatePagesDeployment(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pages_build_version", "oidc_token"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposPagesDeploymentsByOwnerByRepo,
+			expectRequestBody(t, map[string]any{
+				"artifact_id":         float64(4242),
+				"environment":         "github-pages",
+				"pages_build_version": "4fd754f7e594640989b406850d0bc8f06a121251",
+				"oidc_token":          "eyJhbGciOiJSUzI1NiJ9",
+			}).andThen(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{
+						"id": "4fd754f7e594640989b406850d0bc8f06a121251",
+						"status_url": "https://api.github.com/repos/octo/docs/pages/deployments/4fd754f7e594640989b406850d0bc8f06a121251/status",
+						"page_url": "https://octo.github.io/docs/"
+					}`))
+				}),
+			),
+		),
+	))
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "deploy artifact",
+			requestArgs: map[string]any{
+				"owner":               "octo",
+				"repo":                "docs",
+				"artifact_id":         float64(4242),
+				"pages_build_version": "4fd754f7e594640989b406850d0bc8f06a121251",
+				"oidc_token":          "eyJhbGciOiJSUzI1NiJ9",
+			},
+		},
+		{
+			name: "missing artifact",
+			requestArgs: map[string]any{
+				"owner":               "octo",
+				"repo":                "docs",
+				"pages_build_version": "4fd754f7e594640989b406850d0bc8f06a121251",
+				"oidc_token":          "eyJhbGciOiJSUzI1NiJ9",
+			},
+			expectError:    true,
+			expectedErrMsg: "exactly one of artifact_id and artifact_url is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := CreatePagesDeployment(stubGetClientFn(client), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response PagesDeploymentStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "4fd754f7e594640989b406850d0bc8f06a121251", response.ID)
+			assert.Equal(t, "https://octo.github.io/docs/", response.PageURL)
+			assert.Contains(t, response.StatusURL, "/pages/deployments/")
+		})
+	}
+}
+
+func Test_GetPagesDomainHealth(t *testing.T) {
+	tool, _ := GetPagesDomainHealth(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	t.Run("domain checked", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposPagesHealthByOwnerByRepo, &github.PagesHealthCheckResponse{
+				Domain: &github.PagesDomain{
+					Host:                     github.Ptr("docs.example.com"),
+					IsValid:                  github.Ptr(false),
+					Reason:                   github.Ptr("Domain's DNS record could not be retrieved"),
+					DNSResolves:              github.Ptr(true),
+					IsPointedToGithubPagesIP: github.Ptr(false),
+					IsHTTPSEligible:          github.Ptr(false),
+				},
+			}),
+		))
+		_, handler := GetPagesDomainHealth(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "octo", "repo": "docs"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response PagesDomainHealth
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, PagesDomainHealth{
+			Domain: &PagesDomainCheck{
+				Host:        "docs.example.com",
+				Reason:      "Domain's DNS record could not be retrieved",
+				DNSResolves: true,
+			},
+		}, response)
+	})
+
+	t.Run("check in progress", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposPagesHealthByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusAccepted)
+					_, _ = w.Write([]byte(`{}`))
+				}),
+			),
+		))
+		_, handler := GetPagesDomainHealth(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "octo", "repo": "docs"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "being computed")
+	})
+}
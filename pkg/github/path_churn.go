@@ -0,0 +1,259 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultPathChurnMaxCommits is the default number of commits analyzed by get_path_churn.
+	DefaultPathChurnMaxCommits = 100
+	// MaxPathChurnMaxCommits bounds the number of commits analyzed by get_path_churn, as each commit requires an API call.
+	MaxPathChurnMaxCommits = 500
+	// pathChurnRootDirectory is the bucket used for files at the top of the analyzed tree.
+	pathChurnRootDirectory = "/"
+)
+
+// PathChurn aggregates the commit activity of a single directory.
+type PathChurn struct {
+	Path      string `json:"path"`
+	Commits   int    `json:"commits"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Churn     int    `json:"churn"`
+	Files     int    `json:"files_changed"`
+}
+
+// PathChurnReport is the commit activity of the directories of a repository over a time window.
+type PathChurnReport struct {
+	Since           string      `json:"since"`
+	Until           string      `json:"until,omitempty"`
+	CommitsAnalyzed int         `json:"commits_analyzed"`
+	Truncated       bool        `json:"truncated"`
+	Paths           []PathChurn `json:"paths"`
+}
+
+// GetPathChurn creates a tool to aggregate commit counts and line churn per directory over a time window.
+func GetPathChurn(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_path_churn",
+			mcp.WithDescription(t("TOOL_GET_PATH_CHURN_DESCRIPTION", "Aggregate commit counts and line churn (additions + deletions) per directory of a repository over a time window, to identify hotspots. Directories are sorted by churn, highest first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PATH_CHURN_USER_TITLE", "Get churn per path"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[PathChurnReport](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Commit SHA, branch or tag name to analyze. Defaults to the repository's default branch"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only include commits after this date (ISO 8601 timestamp, e.g. 2024-01-01 or 2024-01-01T00:00:00Z). Defaults to 30 days ago"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Only include commits before this date (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only include changes under this directory. Directories are aggregated relative to it"),
+			),
+			mcp.WithNumber("depth",
+				mcp.Description("Number of directory levels to aggregate by. 1 aggregates by top-level directory"),
+				mcp.Min(1),
+				mcp.DefaultNumber(1),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description(fmt.Sprintf("Maximum number of commits to analyze (max %d)", MaxPathChurnMaxCommits)),
+				mcp.Min(1),
+				mcp.Max(MaxPathChurnMaxCommits),
+				mcp.DefaultNumber(DefaultPathChurnMaxCommits),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			until, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			depth, err := OptionalIntParamWithDefault(request, "depth", 1)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(request, "max_commits", DefaultPathChurnMaxCommits)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if depth < 1 {
+				depth = 1
+			}
+			if maxCommits < 1 || maxCommits > MaxPathChurnMaxCommits {
+				maxCommits = MaxPathChurnMaxCommits
+			}
+
+			opts := &github.CommitsListOptions{
+				SHA:         sha,
+				Path:        strings.Trim(path, "/"),
+				ListOptions: github.ListOptions{PerPage: min(maxCommits, 100)},
+			}
+			if since != "" {
+				opts.Since, err = parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to parse since: %s", err)), nil
+				}
+			} else {
+				opts.Since = time.Now().AddDate(0, 0, -30)
+			}
+			if until != "" {
+				opts.Until, err = parseISOTimestamp(until)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to parse until: %s", err)), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var commits []*github.RepositoryCommit
+			truncated := false
+			for {
+				page, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list commits",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				commits = append(commits, page...)
+				if len(commits) >= maxCommits {
+					truncated = len(commits) > maxCommits || resp.NextPage != 0
+					commits = commits[:maxCommits]
+					break
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			prefix := strings.Trim(path, "/")
+			churn := map[string]*PathChurn{}
+			for _, c := range commits {
+				commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, c.GetSHA(), nil)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get commit: %s", c.GetSHA()),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				touched := map[string]bool{}
+				for _, f := range commit.Files {
+					dir, ok := churnDirectory(f.GetFilename(), prefix, depth)
+					if !ok {
+						continue
+					}
+					entry, ok := churn[dir]
+					if !ok {
+						entry = &PathChurn{Path: dir}
+						churn[dir] = entry
+					}
+					entry.Additions += f.GetAdditions()
+					entry.Deletions += f.GetDeletions()
+					entry.Churn += f.GetAdditions() + f.GetDeletions()
+					entry.Files++
+					if !touched[dir] {
+						touched[dir] = true
+						entry.Commits++
+					}
+				}
+			}
+
+			paths := make([]PathChurn, 0, len(churn))
+			for _, entry := range churn {
+				paths = append(paths, *entry)
+			}
+			sort.Slice(paths, func(i, j int) bool {
+				if paths[i].Churn != paths[j].Churn {
+					return paths[i].Churn > paths[j].Churn
+				}
+				return paths[i].Path < paths[j].Path
+			})
+
+			response := PathChurnReport{
+				Since:           opts.Since.Format(time.RFC3339),
+				CommitsAnalyzed: len(commits),
+				Truncated:       truncated,
+				Paths:           paths,
+			}
+			if !opts.Until.IsZero() {
+				response.Until = opts.Until.Format(time.RFC3339)
+			}
+
+			return MarshalledStructuredResult(response), nil
+		}
+}
+
+// churnDirectory returns the directory, at most depth levels below prefix, that a changed file is aggregated into.
+// Files directly under prefix are aggregated into prefix itself, and files outside prefix are not aggregated.
+func churnDirectory(filename, prefix string, depth int) (string, bool) {
+	rel := filename
+	if prefix != "" {
+		if !strings.HasPrefix(filename, prefix+"/") {
+			return "", false
+		}
+		rel = strings.TrimPrefix(filename, prefix+"/")
+	}
+
+	parts := strings.Split(rel, "/")
+	dirs := parts[:len(parts)-1]
+	if len(dirs) > depth {
+		dirs = dirs[:depth]
+	}
+	if prefix != "" {
+		dirs = append([]string{prefix}, dirs...)
+	}
+	if len(dirs) == 0 {
+		return pathChurnRootDirectory, true
+	}
+	return strings.Join(dirs, "/"), true
+}
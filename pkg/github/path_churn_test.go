@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPathChurn(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPathChurn(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_path_churn", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "until")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "depth")
+	assert.Contains(t, tool.InputSchema.Properties, "max_commits")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	commitFiles := map[string][]*github.CommitFile{
+		"sha1": {
+			{Filename: github.Ptr("pkg/github/issues.go"), Additions: github.Ptr(10), Deletions: github.Ptr(2)},
+			{Filename: github.Ptr("pkg/github/issues_test.go"), Additions: github.Ptr(20), Deletions: github.Ptr(0)},
+			{Filename: github.Ptr("README.md"), Additions: github.Ptr(1), Deletions: github.Ptr(1)},
+		},
+		"sha2": {
+			{Filename: github.Ptr("pkg/log/io.go"), Additions: github.Ptr(3), Deletions: github.Ptr(3)},
+			{Filename: github.Ptr("cmd/server/main.go"), Additions: github.Ptr(5), Deletions: github.Ptr(0)},
+		},
+	}
+
+	mockedClient := func(perPage string) *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsByOwnerByRepo,
+				expectQueryParams(t, map[string]string{
+					"since":    "2024-01-01T00:00:00Z",
+					"per_page": perPage,
+				}).andThen(
+					mockResponse(t, http.StatusOK, []*github.RepositoryCommit{
+						{SHA: github.Ptr("sha1")},
+						{SHA: github.Ptr("sha2")},
+					}),
+				),
+			),
+			mock.WithRequestMatchHandler(
+				mock.GetReposCommitsByOwnerByRepoByRef,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(mock.MustMarshal(&github.RepositoryCommit{
+						SHA:   github.Ptr(sha),
+						Files: commitFiles[sha],
+					}))
+				}),
+			),
+		)
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedErrMsg    string
+		expectedPaths     []PathChurn
+		expectedAnalyzed  int
+		expectedTruncated bool
+	}{
+		{
+			name:         "aggregates churn by top-level directory",
+			mockedClient: mockedClient("100"),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2024-01-01",
+			},
+			expectedAnalyzed: 2,
+			expectedPaths: []PathChurn{
+				{Path: "pkg", Commits: 2, Additions: 33, Deletions: 5, Churn: 38, Files: 3},
+				{Path: "cmd", Commits: 1, Additions: 5, Deletions: 0, Churn: 5, Files: 1},
+				{Path: "/", Commits: 1, Additions: 1, Deletions: 1, Churn: 2, Files: 1},
+			},
+		},
+		{
+			name:         "aggregates nested directories",
+			mockedClient: mockedClient("100"),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2024-01-01",
+				"depth": float64(2),
+			},
+			expectedAnalyzed: 2,
+			expectedPaths: []PathChurn{
+				{Path: "pkg/github", Commits: 1, Additions: 30, Deletions: 2, Churn: 32, Files: 2},
+				{Path: "pkg/log", Commits: 1, Additions: 3, Deletions: 3, Churn: 6, Files: 1},
+				{Path: "cmd/server", Commits: 1, Additions: 5, Deletions: 0, Churn: 5, Files: 1},
+				{Path: "/", Commits: 1, Additions: 1, Deletions: 1, Churn: 2, Files: 1},
+			},
+		},
+		{
+			name:         "limits the number of analyzed commits",
+			mockedClient: mockedClient("1"),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"since":       "2024-01-01",
+				"max_commits": float64(1),
+			},
+			expectedAnalyzed:  1,
+			expectedTruncated: true,
+			expectedPaths: []PathChurn{
+				{Path: "pkg", Commits: 1, Additions: 30, Deletions: 2, Churn: 32, Files: 2},
+				{Path: "/", Commits: 1, Additions: 1, Deletions: 1, Churn: 2, Files: 1},
+			},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last week",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to parse since",
+		},
+		{
+			name: "list commits fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPathChurn(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				CommitsAnalyzed int         `json:"commits_analyzed"`
+				Truncated       bool        `json:"truncated"`
+				Paths           []PathChurn `json:"paths"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedAnalyzed, response.CommitsAnalyzed)
+			assert.Equal(t, tc.expectedTruncated, response.Truncated)
+			assert.Equal(t, tc.expectedPaths, response.Paths)
+		})
+	}
+}
+
+func Test_churnDirectory(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		prefix   string
+		depth    int
+		expected string
+		ok       bool
+	}{
+		{name: "root file", filename: "README.md", depth: 1, expected: "/", ok: true},
+		{name: "top-level directory", filename: "pkg/github/tools.go", depth: 1, expected: "pkg", ok: true},
+		{name: "depth shorter than path", filename: "pkg/github/tools.go", depth: 5, expected: "pkg/github", ok: true},
+		{name: "relative to prefix", filename: "pkg/github/tools.go", prefix: "pkg", depth: 1, expected: "pkg/github", ok: true},
+		{name: "file directly under prefix", filename: "pkg/doc.go", prefix: "pkg", depth: 1, expected: "pkg", ok: true},
+		{name: "outside prefix", filename: "cmd/main.go", prefix: "pkg", depth: 1, ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, ok := churnDirectory(tc.filename, tc.prefix, tc.depth)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, dir)
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// FineGrainedPAT is a fine-grained personal access token granted access to the resources of an organization.
+type FineGrainedPAT struct {
+	// ID identifies the grant of the token in the organization.
+	ID                  int64  `json:"id"`
+	Owner               string `json:"owner"`
+	TokenID             int64  `json:"token_id"`
+	TokenName           string `json:"token_name"`
+	RepositorySelection string `json:"repository_selection"`
+	// Permissions map the kinds of permissions, organization, repository and other, to the access level of each
+	// permission, e.g. {"repository": {"contents": "write"}}.
+	Permissions     map[string]map[string]string `json:"permissions"`
+	AccessGrantedAt string                       `json:"access_granted_at,omitempty"`
+	ExpiresAt       string                       `json:"expires_at,omitempty"`
+	Expired         bool                         `json:"expired"`
+	LastUsedAt      string                       `json:"last_used_at,omitempty"`
+}
+
+// PATRequest is a pending request of a fine-grained personal access token to access the resources of an organization.
+type PATRequest struct {
+	ID                  int64                        `json:"id"`
+	Owner               string                       `json:"owner"`
+	TokenID             int64                        `json:"token_id"`
+	TokenName           string                       `json:"token_name"`
+	Reason              string                       `json:"reason,omitempty"`
+	RepositorySelection string                       `json:"repository_selection"`
+	RepositoryCount     int64                        `json:"repository_count,omitempty"`
+	Permissions         map[string]map[string]string `json:"permissions"`
+	CreatedAt           string                       `json:"created_at,omitempty"`
+	ExpiresAt           string                       `json:"expires_at,omitempty"`
+	Expired             bool                         `json:"expired"`
+	LastUsedAt          string                       `json:"last_used_at,omitempty"`
+}
+
+// patRequest is a request of the REST API, which has fields missing from github.PersonalAccessTokenRequest.
+type patRequest struct {
+	ID                  int64                                  `json:"id"`
+	Owner               *github.User                           `json:"owner"`
+	TokenID             int64                                  `json:"token_id"`
+	TokenName           string                                 `json:"token_name"`
+	Reason              string                                 `json:"reason"`
+	RepositorySelection string                                 `json:"repository_selection"`
+	RepositoryCount     int64                                  `json:"repository_count"`
+	Permissions         *github.PersonalAccessTokenPermissions `json:"permissions"`
+	CreatedAt           *github.Timestamp                      `json:"created_at"`
+	TokenExpired        bool                                   `json:"token_expired"`
+	TokenExpiresAt      *github.Timestamp                      `json:"token_expires_at"`
+	TokenLastUsedAt     *github.Timestamp                      `json:"token_last_used_at"`
+}
+
+func formatTimestamp(ts *github.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.Format(time.RFC3339)
+}
+
+func convertPATPermissions(permissions *github.PersonalAccessTokenPermissions) map[string]map[string]string {
+	result := map[string]map[string]string{}
+	if permissions == nil {
+		return result
+	}
+	for kind, levels := range map[string]map[string]string{
+		"organization": permissions.Org,
+		"repository":   permissions.Repo,
+		"other":        permissions.Other,
+	} {
+		if len(levels) > 0 {
+			result[kind] = levels
+		}
+	}
+	return result
+}
+
+// ListOrgPATGrants creates a tool to list the fine-grained personal access tokens with access to an organization.
+func ListOrgPATGrants(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_pat_grants",
+			mcp.WithDescription(t("TOOL_LIST_ORG_PAT_GRANTS_DESCRIPTION", "List the fine-grained personal access tokens of members that were granted access to the resources of an organization, with their permissions, expiry and last use, e.g. to audit token access. Only available to GitHub Apps with the organization personal access tokens permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_PAT_GRANTS_USER_TITLE", "List organization fine-grained personal access tokens"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[FineGrainedPAT](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithArray("owners",
+				mcp.Description("Only list the tokens of these users"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("last_used_before",
+				mcp.Description("Only list the tokens last used before this date, in YYYY-MM-DD format, e.g. to find stale tokens"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owners, err := OptionalStringArrayParam(request, "owners")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			lastUsedBefore, err := OptionalParam[string](request, "last_used_before")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if lastUsedBefore != "" {
+				date, err := time.Parse(queryDateLayout, lastUsedBefore)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid last_used_before date: %s", lastUsedBefore)), nil
+				}
+				lastUsedBefore = date.Format(time.RFC3339)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := []FineGrainedPAT{}
+			opts := &github.ListFineGrainedPATOptions{
+				Owner:          owners,
+				LastUsedBefore: lastUsedBefore,
+				ListOptions:    github.ListOptions{PerPage: 100},
+			}
+			for {
+				tokens, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, org, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list fine-grained personal access tokens of organization '%s'", org),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				for _, token := range tokens {
+					result = append(result, FineGrainedPAT{
+						ID:                  token.GetID(),
+						Owner:               token.GetOwner().GetLogin(),
+						TokenID:             token.GetTokenID(),
+						TokenName:           token.GetTokenName(),
+						RepositorySelection: token.GetRepositorySelection(),
+						Permissions:         convertPATPermissions(token.Permissions),
+						AccessGrantedAt:     formatTimestamp(token.AccessGrantedAt),
+						ExpiresAt:           formatTimestamp(token.TokenExpiresAt),
+						Expired:             token.GetTokenExpired(),
+						LastUsedAt:          formatTimestamp(token.TokenLastUsedAt),
+					})
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			return MarshalledStructuredListResult(result), nil
+		}
+}
+
+// ListOrgPATRequests creates a tool to list the pending requests of fine-grained personal access tokens to access an
+// organization.
+func ListOrgPATRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_pat_requests",
+			mcp.WithDescription(t("TOOL_LIST_ORG_PAT_REQUESTS_DESCRIPTION", "List the pending requests of fine-grained personal access tokens to access the resources of an organization, with the requested permissions and the reason given. Review them with review_org_pat_request. Only available to GitHub Apps with the organization personal access token requests permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_PAT_REQUESTS_USER_TITLE", "List organization personal access token requests"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[PATRequest](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := []PATRequest{}
+			page := 1
+			for {
+				// go-github has no method to list the requests.
+				req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("orgs/%s/personal-access-token-requests?per_page=100&page=%d", org, page), nil)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create request: %w", err)
+				}
+				var requests []patRequest
+				resp, err := client.Do(ctx, req, &requests)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list personal access token requests of organization '%s'", org),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				for _, r := range requests {
+					result = append(result, PATRequest{
+						ID:                  r.ID,
+						Owner:               r.Owner.GetLogin(),
+						TokenID:             r.TokenID,
+						TokenName:           r.TokenName,
+						Reason:              r.Reason,
+						RepositorySelection: r.RepositorySelection,
+						RepositoryCount:     r.RepositoryCount,
+						Permissions:         convertPATPermissions(r.Permissions),
+						CreatedAt:           formatTimestamp(r.CreatedAt),
+						ExpiresAt:           formatTimestamp(r.TokenExpiresAt),
+						Expired:             r.TokenExpired,
+						LastUsedAt:          formatTimestamp(r.TokenLastUsedAt),
+					})
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				page = resp.NextPage
+			}
+
+			return MarshalledStructuredListResult(result), nil
+		}
+}
+
+// ReviewOrgPATRequest creates a tool to approve or deny a request of a fine-grained personal access token to access an
+// organization.
+func ReviewOrgPATRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("review_org_pat_request",
+			mcp.WithDescription(t("TOOL_REVIEW_ORG_PAT_REQUEST_DESCRIPTION", "Approve or deny a pending request of a fine-grained personal access token to access the resources of an organization. Only available to GitHub Apps with the organization personal access token requests permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REVIEW_ORG_PAT_REQUEST_USER_TITLE", "Review organization personal access token request"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("request_id",
+				mcp.Required(),
+				mcp.Description("The ID of the request, from list_org_pat_requests"),
+			),
+			mcp.WithString("action",
+				mcp.Required(),
+				mcp.Description("Whether to approve or deny the request"),
+				mcp.Enum("approve", "deny"),
+			),
+			mcp.WithString("reason",
+				mcp.Description("Reason for the decision, shown to the owner of the token"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			requestID, err := RequiredBigInt(request, "request_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			action, err := RequiredParam[string](request, "action")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if action != "approve" && action != "deny" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid action: %s", action)), nil
+			}
+			reason, err := OptionalParam[string](request, "reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := github.ReviewPersonalAccessTokenRequestOptions{Action: action}
+			if reason != "" {
+				opts.Reason = github.Ptr(reason)
+			}
+			resp, err := client.Organizations.ReviewPersonalAccessTokenRequest(ctx, org, requestID, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to %s personal access token request %d", action, requestID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			verb := "approved"
+			if action == "deny" {
+				verb = "denied"
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("personal access token request %d %s", requestID, verb)), nil
+		}
+}
@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgPATGrants(t *testing.T) {
+	tool, _ := ListOrgPATGrants(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	lastUsed := &github.Timestamp{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsPersonalAccessTokensByOrg,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				assert.Equal(t, []string{"alice"}, q["owner[]"])
+				assert.Equal(t, "2024-06-01T00:00:00Z", q.Get("last_used_before"))
+				if q.Get("page") == "" {
+					w.Header().Set("Link", `<https://api.github.com/orgs/octo/personal-access-tokens?page=2>; rel="next"`)
+					mockResponse(t, http.StatusOK, []*github.PersonalAccessToken{{
+						ID:                  github.Ptr(int64(1)),
+						Owner:               &github.User{Login: github.Ptr("alice")},
+						TokenID:             github.Ptr(int64(11)),
+						TokenName:           github.Ptr("deploy"),
+						RepositorySelection: github.Ptr("subset"),
+						Permissions: &github.PersonalAccessTokenPermissions{
+							Repo: map[string]string{"contents": "write"},
+						},
+						TokenLastUsedAt: lastUsed,
+					}})(w, r)
+					return
+				}
+				mockResponse(t, http.StatusOK, []*github.PersonalAccessToken{{
+					ID:           github.Ptr(int64(2)),
+					Owner:        &github.User{Login: github.Ptr("alice")},
+					TokenName:    github.Ptr("old"),
+					TokenExpired: github.Ptr(true),
+				}})(w, r)
+			}),
+		),
+	))
+	_, handler := ListOrgPATGrants(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":              "octo",
+		"owners":           []any{"alice"},
+		"last_used_before": "2024-06-01",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response []FineGrainedPAT
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []FineGrainedPAT{
+		{
+			ID:                  1,
+			Owner:               "alice",
+			TokenID:             11,
+			TokenName:           "deploy",
+			RepositorySelection: "subset",
+			Permissions:         map[string]map[string]string{"repository": {"contents": "write"}},
+			LastUsedAt:          "2024-01-02T03:04:05Z",
+		},
+		{
+			ID:          2,
+			Owner:       "alice",
+			TokenName:   "old",
+			Permissions: map[string]map[string]string{},
+			Expired:     true,
+		},
+	}, response)
+
+	_, handler = ListOrgPATGrants(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err = handler(context.Background(), createMCPRequest(map[string]any{
+		"org":              "octo",
+		"last_used_before": "June",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "invalid last_used_before date: June")
+}
+
+func Test_ListOrgPATRequests(t *testing.T) {
+	tool, _ := ListOrgPATRequests(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsPersonalAccessTokenRequestsByOrg,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`[{
+					"id": 25381,
+					"reason": "Deploy the docs site",
+					"owner": {"login": "bob"},
+					"repository_selection": "subset",
+					"repository_count": 2,
+					"permissions": {"organization": {"members": "read"}, "repository": {"pages": "write"}},
+					"created_at": "2024-05-01T10:00:00Z",
+					"token_id": 98716,
+					"token_name": "docs",
+					"token_expired": false,
+					"token_expires_at": "2024-08-01T10:00:00Z",
+					"token_last_used_at": null
+				}]`))
+			}),
+		),
+	))
+	_, handler := ListOrgPATRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response []PATRequest
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []PATRequest{{
+		ID:                  25381,
+		Owner:               "bob",
+		TokenID:             98716,
+		TokenName:           "docs",
+		Reason:              "Deploy the docs site",
+		RepositorySelection: "subset",
+		RepositoryCount:     2,
+		Permissions: map[string]map[string]string{
+			"organization": {"members": "read"},
+			"repository":   {"pages": "write"},
+		},
+		CreatedAt: "2024-05-01T10:00:00Z",
+		ExpiresAt: "2024-08-01T10:00:00Z",
+	}}, response)
+}
+
+func Test_ReviewOrgPATRequest(t *testing.T) {
+	tool, _ := ReviewOrgPATRequest(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "request_id", "action"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult string
+	}{
+		{
+			name: "deny with reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsPersonalAccessTokenRequestsByOrgByPatRequestId,
+					expectRequestBody(t, map[string]any{
+						"action": "deny",
+						"reason": "Use the deploy app instead",
+					}).andThen(
+						mockResponse(t, http.StatusNoContent, nil),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":        "octo",
+				"request_id": float64(25381),
+				"action":     "deny",
+				"reason":     "Use the deploy app instead",
+			},
+			expectedResult: "personal access token request 25381 denied",
+		},
+		{
+			name: "not a GitHub App",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsPersonalAccessTokenRequestsByOrgByPatRequestId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by personal access token"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"org":        "octo",
+				"request_id": float64(25381),
+				"action":     "approve",
+			},
+			expectError:    true,
+			expectedResult: "failed to approve personal access token request 25381",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := ReviewOrgPATRequest(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedResult)
+		})
+	}
+}
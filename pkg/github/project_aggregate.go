@@ -0,0 +1,93 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// NoFieldValue is the group of project items without a value in the field they are grouped by.
+const NoFieldValue = "No value"
+
+// ProjectItemGroup is the number of items of a project with a value in the field they are grouped by.
+type ProjectItemGroup struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// fieldValueLabels returns the values of a field of a project item as they are shown on a board: the name of the
+// selected option, the title of the iteration, the logins of the assignees and so on. Fields holding several values,
+// such as assignees and labels, return each of them.
+func fieldValueLabels(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	case []any:
+		var labels []string
+		for _, element := range v {
+			labels = append(labels, fieldValueLabels(element)...)
+		}
+		return labels
+	case map[string]any:
+		if name := selectedOptionName(v); name != "" {
+			return []string{name}
+		}
+		for _, key := range []string{"title", "login", "raw", "name"} {
+			switch field := v[key].(type) {
+			case string:
+				if field != "" {
+					return []string{field}
+				}
+			case map[string]any:
+				if raw, _ := field["raw"].(string); raw != "" {
+					return []string{raw}
+				}
+			}
+		}
+		return nil
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// groupProjectItems counts the items of a project by the values of a field. Items without a value are counted in the
+// NoFieldValue group, and items with several values in the group of each. Groups are sorted by descending count.
+func groupProjectItems(items []*github.ProjectV2Item, fieldID int64) []ProjectItemGroup {
+	counts := map[string]int{}
+	for _, item := range items {
+		labels := fieldValueLabels(itemFieldValue(item, fieldID))
+		if len(labels) == 0 {
+			labels = []string{NoFieldValue}
+		}
+		seen := map[string]bool{}
+		for _, label := range labels {
+			if !seen[label] {
+				seen[label] = true
+				counts[label]++
+			}
+		}
+	}
+
+	groups := make([]ProjectItemGroup, 0, len(counts))
+	for value, count := range counts {
+		groups = append(groups, ProjectItemGroup{Value: value, Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Value < groups[j].Value
+	})
+	return groups
+}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FieldValueLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected []string
+	}{
+		{name: "unset", value: nil, expected: nil},
+		{name: "text", value: "Needs design", expected: []string{"Needs design"}},
+		{name: "number", value: float64(3.5), expected: []string{"3.5"}},
+		{name: "single select", value: map[string]any{"id": "opt-1", "name": map[string]any{"raw": "Todo", "html": "Todo"}}, expected: []string{"Todo"}},
+		{name: "iteration", value: map[string]any{"id": "it-1", "title": "Sprint 4", "start_date": "2024-05-01"}, expected: []string{"Sprint 4"}},
+		{name: "assignees", value: []any{map[string]any{"login": "octocat"}, map[string]any{"login": "hubot"}}, expected: []string{"octocat", "hubot"}},
+		{name: "title", value: map[string]any{"raw": "Fix the build", "html": "Fix the build"}, expected: []string{"Fix the build"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, fieldValueLabels(tc.value))
+		})
+	}
+}
+
+func Test_ListProjectItems_GroupBy(t *testing.T) {
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, []map[string]any{{"id": 101, "name": "Status", "data_type": "single_select"}}),
+	)
+	// The items are served in two pages, linked by an after cursor.
+	itemsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "101", r.URL.Query().Get("fields"))
+			assert.Equal(t, "is:issue", r.URL.Query().Get("q"))
+			if r.URL.Query().Get("after") == "" {
+				w.Header().Set("Link", `<https://api.github.com/orgs/octo-agg/projectsV2/1/items?after=cursor1>; rel="next"`)
+				mockResponse(t, http.StatusOK, []any{
+					statusItem(1, "In Progress"),
+					statusItem(2, "Todo"),
+					statusItem(3, "In Progress"),
+				}).ServeHTTP(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, []any{
+				statusItem(4, "Done"),
+				statusItem(5, ""),
+			}).ServeHTTP(w, r)
+		}),
+	)
+
+	_, handler := ListProjectItems(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint, itemsEndpoint))), translations.NullTranslationHelper, FeatureFlags{})
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner_type":     "org",
+		"owner":          "octo-agg",
+		"project_number": float64(1),
+		"query":          "is:issue",
+		"group_by":       "status",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	assert.Equal(t, ProjectItemsListResult{
+		Items:   []ProjectItem{},
+		GroupBy: "status",
+		Groups: []ProjectItemGroup{
+			{Value: "In Progress", Count: 2},
+			{Value: "Done", Count: 1},
+			{Value: NoFieldValue, Count: 1},
+			{Value: "Todo", Count: 1},
+		},
+		TotalCount: 5,
+	}, result.StructuredContent)
+}
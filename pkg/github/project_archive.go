@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectItemArchiveResult is the result of archiving or unarchiving a project item.
+type ProjectItemArchiveResult struct {
+	ItemID   int64  `json:"item_id"`
+	Archived bool   `json:"archived"`
+	ItemURL  string `json:"item_url,omitempty"`
+}
+
+// projectItemArchiveMutationResult is the payload of the archiveProjectV2Item and unarchiveProjectV2Item mutations.
+type projectItemArchiveMutationResult struct {
+	Item struct {
+		ID githubv4.ID
+	}
+}
+
+// ArchiveProjectItem creates a tool to archive an item of a project, hiding it from views without deleting it.
+func ArchiveProjectItem(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return projectItemArchiveTool("archive_project_item", true, getClient, getGQLClient,
+		mcp.WithDescription(t("TOOL_ARCHIVE_PROJECT_ITEM_DESCRIPTION", "Archive a Project item for a user or org. Archived items are hidden from the project's views but keep their field values and history, and can be restored with unarchive_project_item. Prefer this over delete_project_item to clean up completed items.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_ARCHIVE_PROJECT_ITEM_USER_TITLE", "Archive project item"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	)
+}
+
+// UnarchiveProjectItem creates a tool to restore an archived item of a project.
+func UnarchiveProjectItem(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return projectItemArchiveTool("unarchive_project_item", false, getClient, getGQLClient,
+		mcp.WithDescription(t("TOOL_UNARCHIVE_PROJECT_ITEM_DESCRIPTION", "Restore an archived Project item for a user or org, so that it shows in the project's views again. List archived items with list_project_items and archived_only.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_UNARCHIVE_PROJECT_ITEM_USER_TITLE", "Unarchive project item"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+	)
+}
+
+// projectItemArchiveTool creates the tool archiving or unarchiving a project item. The item is looked up with the
+// REST API for the node IDs of the item and its project, which the GraphQL mutations take.
+func projectItemArchiveTool(name string, archive bool, getClient GetClientFn, getGQLClient GetGQLClientFn, opts ...mcp.ToolOption) (mcp.Tool, server.ToolHandlerFunc) {
+	opts = append(opts,
+		WithOutputSchema[ProjectItemArchiveResult](),
+		mcp.WithString("owner_type",
+			mcp.Required(),
+			mcp.Description("Owner type"),
+			mcp.Enum("user", "org"),
+		),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+		),
+		mcp.WithNumber("project_number",
+			mcp.Required(),
+			mcp.Description("The project's number."),
+		),
+		mcp.WithNumber("item_id",
+			mcp.Required(),
+			mcp.Description("The internal project item ID (not the issue or pull request ID)."),
+		),
+	)
+	return mcp.NewTool(name, opts...),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredBigInt(req, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project item",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			action := "unarchive"
+			if archive {
+				action = "archive"
+				var mutation struct {
+					ArchiveProjectV2Item projectItemArchiveMutationResult `graphql:"archiveProjectV2Item(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.ArchiveProjectV2ItemInput{
+					ProjectID: githubv4.ID(item.GetProjectNodeID()),
+					ItemID:    githubv4.ID(item.GetNodeID()),
+				}, nil)
+			} else {
+				var mutation struct {
+					UnarchiveProjectV2Item projectItemArchiveMutationResult `graphql:"unarchiveProjectV2Item(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.UnarchiveProjectV2ItemInput{
+					ProjectID: githubv4.ID(item.GetProjectNodeID()),
+					ItemID:    githubv4.ID(item.GetNodeID()),
+				}, nil)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to %s project item", action),
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(ProjectItemArchiveResult{
+				ItemID:   itemID,
+				Archived: archive,
+				ItemURL:  item.GetItemURL(),
+			}), nil
+		}
+}
@@ -0,0 +1,117 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ArchiveProjectItem(t *testing.T) {
+	for _, archive := range []bool{true, false} {
+		newTool := UnarchiveProjectItem
+		if archive {
+			newTool = ArchiveProjectItem
+		}
+		tool, _ := newTool(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+		require.NoError(t, toolsnaps.Test(tool.Name, tool))
+		assert.False(t, *tool.Annotations.ReadOnlyHint)
+		assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_id"})
+	}
+
+	item := map[string]any{"id": 301, "node_id": "PVTI_301", "project_node_id": "PVT_7", "item_url": "https://api.github.com/orgs/octo-org/projectsV2/7/items/301"}
+
+	tests := []struct {
+		name           string
+		archive        bool
+		restClient     *http.Client
+		gqlClient      *http.Client
+		expectedErrMsg string
+	}{
+		{
+			name:    "archives item",
+			archive: true,
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+					expectPath(t, "/orgs/octo-org/projectsV2/7/items/301").andThen(mockResponse(t, http.StatusOK, item)),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				struct {
+					ArchiveProjectV2Item projectItemArchiveMutationResult `graphql:"archiveProjectV2Item(input: $input)"`
+				}{},
+				githubv4.ArchiveProjectV2ItemInput{ProjectID: githubv4.ID("PVT_7"), ItemID: githubv4.ID("PVTI_301")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"archiveProjectV2Item": map[string]any{"item": map[string]any{"id": "PVTI_301"}}}),
+			)),
+		},
+		{
+			name:    "unarchives item",
+			archive: false,
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, item),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				struct {
+					UnarchiveProjectV2Item projectItemArchiveMutationResult `graphql:"unarchiveProjectV2Item(input: $input)"`
+				}{},
+				githubv4.UnarchiveProjectV2ItemInput{ProjectID: githubv4.ID("PVT_7"), ItemID: githubv4.ID("PVTI_301")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"unarchiveProjectV2Item": map[string]any{"item": map[string]any{"id": "PVTI_301"}}}),
+			)),
+		},
+		{
+			name:    "item not found",
+			archive: true,
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "failed to get project item",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			newTool := UnarchiveProjectItem
+			if tc.archive {
+				newTool = ArchiveProjectItem
+			}
+			_, handler := newTool(stubGetClientFn(gh.NewClient(tc.restClient)), stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"item_id":        float64(301),
+			}))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, ProjectItemArchiveResult{
+				ItemID:   301,
+				Archived: tc.archive,
+				ItemURL:  "https://api.github.com/orgs/octo-org/projectsV2/7/items/301",
+			}, result.StructuredContent)
+		})
+	}
+}
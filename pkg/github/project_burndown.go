@@ -0,0 +1,247 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// contentClosedAtQuery loads when a batch of issues and pull requests were closed, given by node ID.
+type contentClosedAtQuery struct {
+	Nodes []struct {
+		Typename githubv4.String `graphql:"__typename"`
+		Issue    struct {
+			ID       githubv4.ID
+			ClosedAt *githubv4.DateTime
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID       githubv4.ID
+			ClosedAt *githubv4.DateTime
+		} `graphql:"... on PullRequest"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// BurndownPoint is the number of open and closed items of an iteration at the end of a day.
+type BurndownPoint struct {
+	Date   string `json:"date"`
+	Open   int    `json:"open"`
+	Closed int    `json:"closed"`
+}
+
+// IterationBurndown is the number of open and closed items of an iteration at the end of every day.
+type IterationBurndown struct {
+	Iteration    *ProjectIteration `json:"iteration"`
+	EndDate      string            `json:"end_date"`
+	TotalItems   int               `json:"total_items"`
+	SkippedItems int               `json:"skipped_items"`
+	Series       []BurndownPoint   `json:"series"`
+}
+
+// burndownItem is an item of an iteration, with the times it was added to the project and closed, if it was.
+type burndownItem struct {
+	added  time.Time
+	closed *time.Time
+}
+
+// burndown counts the open and closed items at the end of every day from start to end, inclusive. Items count from
+// the day they were added to the project, or from the start of the iteration if they were added before.
+func burndown(items []burndownItem, start, end time.Time) []BurndownPoint {
+	points := []BurndownPoint{}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		endOfDay := day.AddDate(0, 0, 1)
+		point := BurndownPoint{Date: day.Format(queryDateLayout)}
+		for _, item := range items {
+			if !item.added.Before(endOfDay) {
+				continue
+			}
+			if item.closed != nil && item.closed.Before(endOfDay) {
+				point.Closed++
+			} else {
+				point.Open++
+			}
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// GetIterationBurndown creates a tool to reconstruct the number of open and closed items of an iteration per day.
+func GetIterationBurndown(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_iteration_burndown",
+			mcp.WithDescription(t("TOOL_GET_ITERATION_BURNDOWN_DESCRIPTION", "Reconstruct the number of open and closed issues and pull requests of a project iteration at the end of every day, from the start of the iteration until today or its end, based on when items were added to the project and closed. Returns a compact time series for burndown or burnup charts.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ITERATION_BURNDOWN_USER_TITLE", "Get iteration burndown"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[IterationBurndown](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("iteration",
+				mcp.Description("Title of the iteration, or '@current' for the iteration containing today"),
+				mcp.DefaultString(CurrentIteration),
+			),
+			mcp.WithString("iteration_field",
+				mcp.Description("ID or name of the iteration field"),
+				mcp.DefaultString(DefaultIterationField),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the field."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			iterationFilter, err := OptionalParam[string](req, "iteration")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if iterationFilter == "" {
+				iterationFilter = CurrentIteration
+			}
+			iterationFieldRef, err := OptionalParam[string](req, "iteration_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if iterationFieldRef == "" {
+				iterationFieldRef = DefaultIterationField
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			iterationField := findProjectField(fields, iterationFieldRef)
+			if iterationField == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", iterationFieldRef)), nil
+			}
+			if iterationField.GetDataType() != "iteration" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; expected an iteration field", iterationField.GetName(), iterationField.GetDataType())), nil
+			}
+
+			projectItems, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, []int64{iterationField.GetID()}, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			now := flags.now()
+			var iteration *ProjectIteration
+			added := map[string]time.Time{}
+			var ids []githubv4.ID
+			skipped := 0
+			for _, item := range projectItems {
+				value, ok := iterationValue(itemFieldValue(item, iterationField.GetID()))
+				if !ok {
+					continue
+				}
+				if strings.EqualFold(iterationFilter, CurrentIteration) {
+					if !value.contains(now) {
+						continue
+					}
+				} else if !strings.EqualFold(iterationFilter, value.Title) {
+					continue
+				}
+				iteration = &value
+
+				// Draft issues cannot be closed, so only issues and pull requests burn down.
+				if item.GetContentType() == "DraftIssue" || item.GetContentNodeID() == "" {
+					skipped++
+					continue
+				}
+				if _, ok := added[item.GetContentNodeID()]; !ok {
+					ids = append(ids, githubv4.ID(item.GetContentNodeID()))
+				}
+				added[item.GetContentNodeID()] = item.GetCreatedAt().In(now.Location())
+			}
+			if iteration == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("no items found in iteration %q", iterationFilter)), nil
+			}
+
+			var items []burndownItem
+			for start := 0; start < len(ids); start += maxNodesPerQuery {
+				var q contentClosedAtQuery
+				vars := map[string]any{
+					"ids": ids[start:min(start+maxNodesPerQuery, len(ids))],
+				}
+				if err := gqlClient.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to get issue and pull request close dates",
+						err,
+					), nil
+				}
+				for _, node := range q.Nodes {
+					id, closedAt := node.Issue.ID, node.Issue.ClosedAt
+					if node.Typename == "PullRequest" {
+						id, closedAt = node.PullRequest.ID, node.PullRequest.ClosedAt
+					}
+					item := burndownItem{added: added[fmt.Sprint(id)]}
+					if closedAt != nil {
+						closed := closedAt.In(now.Location())
+						item.closed = &closed
+					}
+					items = append(items, item)
+				}
+			}
+
+			start, err := time.ParseInLocation(queryDateLayout, iteration.StartDate, now.Location())
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid start date %q of iteration %q", iteration.StartDate, iteration.Title)), nil
+			}
+			end := start.AddDate(0, 0, iteration.Duration-1)
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			if today.Before(end) {
+				end = today
+			}
+
+			return MarshalledStructuredResult(IterationBurndown{
+				Iteration:    iteration,
+				EndDate:      start.AddDate(0, 0, iteration.Duration-1).Format(queryDateLayout),
+				TotalItems:   len(items),
+				SkippedItems: skipped,
+				Series:       burndown(items, start, end),
+			}), nil
+		}
+}
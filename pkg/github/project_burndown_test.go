@@ -0,0 +1,131 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Burndown(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	start := time.Date(2024, 5, 6, 0, 0, 0, 0, berlin)
+	// Closed late on May 6th in Berlin, which is already May 7th in UTC.
+	closed := time.Date(2024, 5, 6, 23, 30, 0, 0, berlin)
+	items := []burndownItem{
+		{added: start.AddDate(0, 0, -3), closed: &closed},
+		{added: start.AddDate(0, 0, 1)},
+	}
+	assert.Equal(t, []BurndownPoint{
+		{Date: "2024-05-06", Open: 0, Closed: 1},
+		{Date: "2024-05-07", Open: 1, Closed: 1},
+	}, burndown(items, start, start.AddDate(0, 0, 1)))
+}
+
+func Test_GetIterationBurndown(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIterationBurndown(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_iteration_burndown", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	fields := []map[string]any{
+		{"id": 202, "name": "Iteration", "data_type": "iteration"},
+	}
+	sprint1 := map[string]any{"id": "it1", "title": "Sprint 1", "start_date": "2024-05-06", "duration": 3}
+	sprint2 := map[string]any{"id": "it2", "title": "Sprint 2", "start_date": "2024-05-09", "duration": 3}
+	item := func(id int, contentType, nodeID, createdAt string, iteration map[string]any) map[string]any {
+		return map[string]any{
+			"id":              id,
+			"content_type":    contentType,
+			"content_node_id": nodeID,
+			"created_at":      createdAt,
+			"fields":          []any{map[string]any{"id": 202, "data_type": "iteration", "value": iteration}},
+		}
+	}
+	projectItems := []any{
+		item(1, "Issue", "I_1", "2024-05-01T09:00:00Z", sprint1),
+		item(2, "PullRequest", "PR_1", "2024-05-07T12:00:00Z", sprint1),
+		item(3, "Issue", "I_2", "2024-05-01T09:00:00Z", sprint1),
+		item(4, "DraftIssue", "DI_1", "2024-05-01T09:00:00Z", sprint1),
+		item(5, "Issue", "I_3", "2024-05-01T09:00:00Z", sprint2),
+	}
+	newClients := func() (*github.Client, *githubv4.Client) {
+		restClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+				mockResponse(t, http.StatusOK, fields),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+				mockResponse(t, http.StatusOK, projectItems),
+			),
+		)
+		// The query is constructed from the typed IDs, but the request variables are compared in their decoded JSON form.
+		matcher := githubv4mock.NewQueryMatcher(contentClosedAtQuery{}, map[string]any{"ids": []githubv4.ID{"I_1"}}, githubv4mock.DataResponse(map[string]any{
+			"nodes": []any{
+				map[string]any{"__typename": "Issue", "id": "I_1", "closedAt": "2024-05-07T10:00:00Z"},
+				map[string]any{"__typename": "PullRequest", "id": "PR_1", "closedAt": nil},
+				map[string]any{"__typename": "Issue", "id": "I_2", "closedAt": "2024-05-03T10:00:00Z"},
+			},
+		}))
+		matcher.Variables = map[string]any{"ids": []any{"I_1", "PR_1", "I_2"}}
+		return github.NewClient(restClient), githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+	}
+
+	t.Run("counts open and closed items per day", func(t *testing.T) {
+		client, gqlClient := newClients()
+		_, handler := GetIterationBurndown(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(701),
+			"iteration":      "sprint 1",
+		}))
+		require.NoError(t, err)
+
+		var response struct {
+			EndDate      string          `json:"end_date"`
+			TotalItems   int             `json:"total_items"`
+			SkippedItems int             `json:"skipped_items"`
+			Series       []BurndownPoint `json:"series"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "2024-05-08", response.EndDate)
+		assert.Equal(t, 3, response.TotalItems)
+		assert.Equal(t, 1, response.SkippedItems)
+		assert.Equal(t, []BurndownPoint{
+			{Date: "2024-05-06", Open: 1, Closed: 1},
+			{Date: "2024-05-07", Open: 1, Closed: 2},
+			{Date: "2024-05-08", Open: 1, Closed: 2},
+		}, response.Series)
+	})
+
+	t.Run("unknown iteration", func(t *testing.T) {
+		client, gqlClient := newClients()
+		_, handler := GetIterationBurndown(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(702),
+			"iteration":      "Sprint 9",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `no items found in iteration "Sprint 9"`)
+	})
+}
@@ -0,0 +1,324 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultPointsField is the name of the number field holding the estimate of project items.
+	DefaultPointsField = "Estimate"
+	// DefaultIterationField is the name of the iteration field of project items.
+	DefaultIterationField = "Iteration"
+	// CurrentIteration selects the iteration containing today.
+	CurrentIteration = "@current"
+
+	AllocationOver  = "over"
+	AllocationUnder = "under"
+	AllocationAt    = "at"
+
+	// unassignedAssignee is the assignee the points of items without assignees are reported under.
+	unassignedAssignee = "unassigned"
+)
+
+// ProjectIteration is an iteration of a project, as selected in the iteration field of an item.
+type ProjectIteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	Duration  int    `json:"duration"`
+}
+
+// AssigneeAllocation is the number of points assigned to a person in an iteration, compared to their capacity.
+type AssigneeAllocation struct {
+	Assignee   string   `json:"assignee"`
+	Points     float64  `json:"points"`
+	Items      int      `json:"items"`
+	Capacity   *float64 `json:"capacity,omitempty"`
+	Remaining  *float64 `json:"remaining,omitempty"`
+	Allocation string   `json:"allocation,omitempty"`
+}
+
+// IterationCapacity is the allocation of points to people in an iteration.
+type IterationCapacity struct {
+	ProjectIteration
+	TotalPoints      float64              `json:"total_points"`
+	UnestimatedItems int                  `json:"unestimated_items"`
+	Assignees        []AssigneeAllocation `json:"assignees"`
+}
+
+// IterationCapacityReport is the allocation of points to people in the iterations of a project.
+type IterationCapacityReport struct {
+	PointsField string              `json:"points_field"`
+	Iterations  []IterationCapacity `json:"iterations"`
+}
+
+// itemFieldValue returns the value of a field of a project item, or nil if it is not set.
+func itemFieldValue(item *github.ProjectV2Item, fieldID int64) any {
+	for _, fieldValue := range item.Fields {
+		if fieldValue.GetID() == fieldID {
+			return fieldValue.Value
+		}
+	}
+	return nil
+}
+
+// iterationValue converts the value of an iteration field to the selected iteration, if any.
+func iterationValue(value any) (ProjectIteration, bool) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return ProjectIteration{}, false
+	}
+	var iteration ProjectIteration
+	iteration.ID = fmt.Sprint(obj["id"])
+	iteration.Title, _ = obj["title"].(string)
+	iteration.StartDate, _ = obj["start_date"].(string)
+	if duration, ok := obj["duration"].(float64); ok {
+		iteration.Duration = int(duration)
+	}
+	return iteration, true
+}
+
+// assigneesValue converts the value of an assignees field to the logins of the assignees.
+func assigneesValue(value any) []string {
+	users, _ := value.([]any)
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		if obj, ok := user.(map[string]any); ok {
+			if login, ok := obj["login"].(string); ok && login != "" {
+				logins = append(logins, login)
+			}
+		}
+	}
+	return logins
+}
+
+// contains reports whether a day falls within the iteration.
+func (i ProjectIteration) contains(day time.Time) bool {
+	start, err := time.ParseInLocation(queryDateLayout, i.StartDate, day.Location())
+	if err != nil {
+		return false
+	}
+	return !day.Before(start) && day.Before(start.AddDate(0, 0, i.Duration))
+}
+
+// allocate compares the points assigned to a person with their capacity, if it is known.
+func (a *AssigneeAllocation) allocate(capacity map[string]float64) {
+	for login, c := range capacity {
+		if !strings.EqualFold(login, a.Assignee) {
+			continue
+		}
+		remaining := c - a.Points
+		a.Capacity = &c
+		a.Remaining = &remaining
+		switch {
+		case remaining < 0:
+			a.Allocation = AllocationOver
+		case remaining > 0:
+			a.Allocation = AllocationUnder
+		default:
+			a.Allocation = AllocationAt
+		}
+		return
+	}
+}
+
+// GetIterationCapacityReport creates a tool to sum the points of project items per assignee per iteration, and
+// compare them with the capacity of people.
+func GetIterationCapacityReport(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_iteration_capacity_report",
+			mcp.WithDescription(t("TOOL_GET_ITERATION_CAPACITY_REPORT_DESCRIPTION", "Sum the points of a project's items per assignee per iteration, and compare them with the capacity of each person configured on the server to report who is over- or under-allocated. Points of items with several assignees are split evenly between them. Use this for capacity planning of iterations.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ITERATION_CAPACITY_REPORT_USER_TITLE", "Get iteration capacity report"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[IterationCapacityReport](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("points_field",
+				mcp.Description("ID or name of the number field holding the points of items"),
+				mcp.DefaultString(DefaultPointsField),
+			),
+			mcp.WithString("iteration_field",
+				mcp.Description("ID or name of the iteration field"),
+				mcp.DefaultString(DefaultIterationField),
+			),
+			mcp.WithString("iteration",
+				mcp.Description("Only report this iteration, by title, or '@current' for the iteration containing today. Reports every iteration if omitted."),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the fields."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pointsFieldRef, err := OptionalParam[string](req, "points_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if pointsFieldRef == "" {
+				pointsFieldRef = DefaultPointsField
+			}
+			iterationFieldRef, err := OptionalParam[string](req, "iteration_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if iterationFieldRef == "" {
+				iterationFieldRef = DefaultIterationField
+			}
+			iterationFilter, err := OptionalParam[string](req, "iteration")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pointsField := findProjectField(fields, pointsFieldRef)
+			if pointsField == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", pointsFieldRef)), nil
+			}
+			if pointsField.GetDataType() != "number" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; points must be stored in a number field", pointsField.GetName(), pointsField.GetDataType())), nil
+			}
+			iterationField := findProjectField(fields, iterationFieldRef)
+			if iterationField == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", iterationFieldRef)), nil
+			}
+			if iterationField.GetDataType() != "iteration" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; expected an iteration field", iterationField.GetName(), iterationField.GetDataType())), nil
+			}
+			var assigneesField *github.ProjectV2Field
+			for _, field := range fields {
+				if field.GetDataType() == "assignees" {
+					assigneesField = field
+				}
+			}
+			if assigneesField == nil {
+				return mcp.NewToolResultError("project has no assignees field"), nil
+			}
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber,
+				[]int64{pointsField.GetID(), iterationField.GetID(), assigneesField.GetID()}, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			today := flags.now()
+			reports := map[string]*IterationCapacity{}
+			allocations := map[string]map[string]*AssigneeAllocation{}
+			for _, item := range items {
+				iteration, ok := iterationValue(itemFieldValue(item, iterationField.GetID()))
+				if !ok {
+					continue
+				}
+				switch {
+				case iterationFilter == "":
+				case strings.EqualFold(iterationFilter, CurrentIteration):
+					if !iteration.contains(today) {
+						continue
+					}
+				case !strings.EqualFold(iterationFilter, iteration.Title):
+					continue
+				}
+
+				report, ok := reports[iteration.ID]
+				if !ok {
+					report = &IterationCapacity{ProjectIteration: iteration}
+					reports[iteration.ID] = report
+					allocations[iteration.ID] = map[string]*AssigneeAllocation{}
+				}
+				points, ok := itemFieldValue(item, pointsField.GetID()).(float64)
+				if !ok {
+					report.UnestimatedItems++
+				}
+				report.TotalPoints += points
+
+				assignees := assigneesValue(itemFieldValue(item, assigneesField.GetID()))
+				if len(assignees) == 0 {
+					assignees = []string{unassignedAssignee}
+				}
+				for _, assignee := range assignees {
+					allocation, ok := allocations[iteration.ID][strings.ToLower(assignee)]
+					if !ok {
+						allocation = &AssigneeAllocation{Assignee: assignee}
+						allocations[iteration.ID][strings.ToLower(assignee)] = allocation
+					}
+					allocation.Points += points / float64(len(assignees))
+					allocation.Items++
+				}
+			}
+
+			iterations := make([]IterationCapacity, 0, len(reports))
+			for id, report := range reports {
+				report.Assignees = make([]AssigneeAllocation, 0, len(allocations[id]))
+				for _, allocation := range allocations[id] {
+					if allocation.Assignee != unassignedAssignee {
+						allocation.allocate(flags.IterationCapacity)
+					}
+					report.Assignees = append(report.Assignees, *allocation)
+				}
+				// People are listed alphabetically, followed by unassignedAssignee items.
+				sort.Slice(report.Assignees, func(i, j int) bool {
+					a, b := report.Assignees[i].Assignee, report.Assignees[j].Assignee
+					if (a == unassignedAssignee) != (b == unassignedAssignee) {
+						return b == unassignedAssignee
+					}
+					return strings.ToLower(a) < strings.ToLower(b)
+				})
+				iterations = append(iterations, *report)
+			}
+			sort.Slice(iterations, func(i, j int) bool {
+				return iterations[i].StartDate < iterations[j].StartDate
+			})
+
+			return MarshalledStructuredResult(IterationCapacityReport{
+				PointsField: pointsField.GetName(),
+				Iterations:  iterations,
+			}), nil
+		}
+}
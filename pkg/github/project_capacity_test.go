@@ -0,0 +1,143 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectIterationContains(t *testing.T) {
+	iteration := ProjectIteration{StartDate: "2024-05-06", Duration: 14}
+	assert.False(t, iteration.contains(time.Date(2024, 5, 5, 23, 0, 0, 0, time.UTC)))
+	assert.True(t, iteration.contains(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, iteration.contains(time.Date(2024, 5, 19, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, iteration.contains(time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_GetIterationCapacityReport(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIterationCapacityReport(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_iteration_capacity_report", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	fields := []map[string]any{
+		{"id": 201, "name": "Estimate", "data_type": "number"},
+		{"id": 202, "name": "Iteration", "data_type": "iteration"},
+		{"id": 203, "name": "Assignees", "data_type": "assignees"},
+		{"id": 204, "name": "Status", "data_type": "single_select"},
+	}
+	sprint1 := map[string]any{"id": "it1", "title": "Sprint 1", "start_date": "2024-05-06", "duration": 14}
+	sprint2 := map[string]any{"id": "it2", "title": "Sprint 2", "start_date": "2024-05-20", "duration": 14}
+	item := func(id int, points any, iteration map[string]any, assignees ...string) map[string]any {
+		users := []any{}
+		for _, login := range assignees {
+			users = append(users, map[string]any{"login": login})
+		}
+		return map[string]any{"id": id, "fields": []any{
+			map[string]any{"id": 201, "data_type": "number", "value": points},
+			map[string]any{"id": 202, "data_type": "iteration", "value": iteration},
+			map[string]any{"id": 203, "data_type": "assignees", "value": users},
+		}}
+	}
+	projectItems := []any{
+		item(1, 5, sprint1, "alice"),
+		item(2, 4, sprint1, "alice", "bob"),
+		item(3, 3, sprint1),
+		item(4, nil, sprint1, "Alice"),
+		item(5, 8, sprint2, "bob"),
+		item(6, 1, nil, "bob"),
+	}
+	newClient := func() *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+				mockResponse(t, http.StatusOK, fields),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+				expectQueryParams(t, map[string]string{"fields": "201,202,203", "per_page": "50"}).andThen(
+					mockResponse(t, http.StatusOK, projectItems),
+				),
+			),
+		)
+	}
+	flags := FeatureFlags{IterationCapacity: map[string]float64{"alice": 6, "bob": 2}}
+	floatPtr := func(f float64) *float64 { return &f }
+
+	t.Run("reports allocation per iteration", func(t *testing.T) {
+		_, handler := GetIterationCapacityReport(stubGetClientFn(github.NewClient(newClient())), translations.NullTranslationHelper, flags)
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(501),
+		}))
+		require.NoError(t, err)
+
+		var response struct {
+			Iterations []IterationCapacity `json:"iterations"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Iterations, 2)
+
+		first := response.Iterations[0]
+		assert.Equal(t, "Sprint 1", first.Title)
+		assert.Equal(t, float64(12), first.TotalPoints)
+		assert.Equal(t, 1, first.UnestimatedItems)
+		assert.Equal(t, []AssigneeAllocation{
+			{Assignee: "alice", Points: 7, Items: 3, Capacity: floatPtr(6), Remaining: floatPtr(-1), Allocation: AllocationOver},
+			{Assignee: "bob", Points: 2, Items: 1, Capacity: floatPtr(2), Remaining: floatPtr(0), Allocation: AllocationAt},
+			{Assignee: "unassigned", Points: 3, Items: 1},
+		}, first.Assignees)
+
+		second := response.Iterations[1]
+		assert.Equal(t, "Sprint 2", second.Title)
+		assert.Equal(t, []AssigneeAllocation{
+			{Assignee: "bob", Points: 8, Items: 1, Capacity: floatPtr(2), Remaining: floatPtr(-6), Allocation: AllocationOver},
+		}, second.Assignees)
+	})
+
+	t.Run("filters by iteration title", func(t *testing.T) {
+		_, handler := GetIterationCapacityReport(stubGetClientFn(github.NewClient(newClient())), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(502),
+			"iteration":      "sprint 2",
+		}))
+		require.NoError(t, err)
+
+		var response struct {
+			Iterations []IterationCapacity `json:"iterations"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Iterations, 1)
+		assert.Equal(t, "it2", response.Iterations[0].ID)
+		assert.Equal(t, []AssigneeAllocation{{Assignee: "bob", Points: 8, Items: 1}}, response.Iterations[0].Assignees)
+	})
+
+	t.Run("points field is not a number field", func(t *testing.T) {
+		_, handler := GetIterationCapacityReport(stubGetClientFn(github.NewClient(newClient())), translations.NullTranslationHelper, flags)
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(503),
+			"points_field":   "Status",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `project field "Status" is a single_select field`)
+	})
+}
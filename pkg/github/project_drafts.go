@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectDraftIssueResult is the result of adding a draft issue to a project.
+type ProjectDraftIssueResult struct {
+	ItemID     int64  `json:"item_id"`
+	ItemNodeID string `json:"item_node_id"`
+	Title      string `json:"title"`
+	Body       string `json:"body,omitempty"`
+}
+
+// AddDraftIssueToProject creates a tool to add a draft issue, which lives only in the project, to a project.
+func AddDraftIssueToProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_draft_issue_to_project",
+			mcp.WithDescription(t("TOOL_ADD_DRAFT_ISSUE_TO_PROJECT_DESCRIPTION", "Add a draft issue to a Project for a user or org. Draft issues live only in the project until they are converted to an issue, which makes them the quickest way to capture a task on a board. Returns the ID of the new project item, for use with the other project item tools.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DRAFT_ISSUE_TO_PROJECT_USER_TITLE", "Add draft issue to project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[ProjectDraftIssueResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("The title of the draft issue."),
+			),
+			mcp.WithString("body",
+				mcp.Description("The body of the draft issue, in Markdown."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](req, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](req, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			input := githubv4.AddProjectV2DraftIssueInput{
+				ProjectID: githubv4.ID(project.GetNodeID()),
+				Title:     githubv4.String(title),
+			}
+			if body != "" {
+				input.Body = githubv4.NewString(githubv4.String(body))
+			}
+			var mutation struct {
+				AddProjectV2DraftIssue struct {
+					ProjectItem struct {
+						ID         githubv4.ID
+						DatabaseID githubv4.Int `graphql:"databaseId"`
+					}
+				} `graphql:"addProjectV2DraftIssue(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to add draft issue to project",
+					err,
+				), nil
+			}
+
+			item := mutation.AddProjectV2DraftIssue.ProjectItem
+			return MarshalledStructuredResult(ProjectDraftIssueResult{
+				ItemID:     int64(item.DatabaseID),
+				ItemNodeID: fmt.Sprint(item.ID),
+				Title:      title,
+				Body:       body,
+			}), nil
+		}
+}
+
+// ProjectDraftConversionResult is the result of converting a draft issue of a project to an issue.
+type ProjectDraftConversionResult struct {
+	ItemID      int64                  `json:"item_id"`
+	ItemNodeID  string                 `json:"item_node_id"`
+	ContentType string                 `json:"content_type"`
+	Issue       ProjectDraftIssueIssue `json:"issue"`
+}
+
+// ProjectDraftIssueIssue is the issue a draft issue was converted to.
+type ProjectDraftIssueIssue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+	NodeID  string `json:"node_id"`
+}
+
+// ConvertDraftToIssue creates a tool to convert a draft issue of a project to an issue in a repository. The project
+// item is kept, with its field values, and now tracks the issue.
+func ConvertDraftToIssue(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_draft_to_issue",
+			mcp.WithDescription(t("TOOL_CONVERT_DRAFT_TO_ISSUE_DESCRIPTION", "Convert a draft issue of a Project for a user or org to an issue in a repository. The project item keeps its field values and tracks the new issue. Returns the new issue and the updated project item.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_DRAFT_TO_ISSUE_USER_TITLE", "Convert draft issue to issue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[ProjectDraftConversionResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The internal project item ID of the draft issue."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository to create the issue in."),
+			),
+			mcp.WithString("repo_owner",
+				mcp.Description("The owner of the repository to create the issue in. Defaults to the owner of the project."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredBigInt(req, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](req, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoOwner, err := OptionalParam[string](req, "repo_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if repoOwner == "" {
+				repoOwner = owner
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project item",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			if item.GetContentType() != "DraftIssue" {
+				return mcp.NewToolResultError(fmt.Sprintf("project item %d is not a draft issue, its content type is %s", itemID, item.GetContentType())), nil
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, repoOwner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			var mutation struct {
+				ConvertProjectV2DraftIssueItemToIssue struct {
+					Item struct {
+						ID         githubv4.ID
+						DatabaseID githubv4.Int `graphql:"databaseId"`
+						Content    struct {
+							Issue struct {
+								ID     githubv4.ID
+								Number githubv4.Int
+								Title  githubv4.String
+								URL    githubv4.String `graphql:"url"`
+							} `graphql:"... on Issue"`
+						}
+					}
+				} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.ConvertProjectV2DraftIssueItemToIssueInput{
+				ItemID:       githubv4.ID(item.GetNodeID()),
+				RepositoryID: githubv4.ID(repository.GetNodeID()),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to convert draft issue to issue",
+					err,
+				), nil
+			}
+
+			converted := mutation.ConvertProjectV2DraftIssueItemToIssue.Item
+			return MarshalledStructuredResult(ProjectDraftConversionResult{
+				ItemID:      int64(converted.DatabaseID),
+				ItemNodeID:  fmt.Sprint(converted.ID),
+				ContentType: "Issue",
+				Issue: ProjectDraftIssueIssue{
+					Number:  int(converted.Content.Issue.Number),
+					Title:   string(converted.Content.Issue.Title),
+					HTMLURL: string(converted.Content.Issue.URL),
+					NodeID:  fmt.Sprint(converted.Content.Issue.ID),
+				},
+			}), nil
+		}
+}
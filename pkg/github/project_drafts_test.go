@@ -0,0 +1,250 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddDraftIssueToProject(t *testing.T) {
+	tool, _ := AddDraftIssueToProject(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "title"})
+
+	mutation := struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem struct {
+				ID         githubv4.ID
+				DatabaseID githubv4.Int `graphql:"databaseId"`
+			}
+		} `graphql:"addProjectV2DraftIssue(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		restClient     *http.Client
+		gqlClient      *http.Client
+		expected       ProjectDraftIssueResult
+		expectedErrMsg string
+	}{
+		{
+			name: "adds draft issue",
+			args: map[string]any{"title": "Write release notes", "body": "For v2.0"},
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+					expectPath(t, "/orgs/octo-org/projectsV2/7").andThen(mockResponse(t, http.StatusOK, map[string]any{"id": 7, "node_id": "PVT_7"})),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.AddProjectV2DraftIssueInput{
+					ProjectID: githubv4.ID("PVT_7"),
+					Title:     githubv4.String("Write release notes"),
+					Body:      githubv4.NewString("For v2.0"),
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"addProjectV2DraftIssue": map[string]any{"projectItem": map[string]any{"id": "PVTI_401", "databaseId": 401}}}),
+			)),
+			expected: ProjectDraftIssueResult{ItemID: 401, ItemNodeID: "PVTI_401", Title: "Write release notes", Body: "For v2.0"},
+		},
+		{
+			name: "adds draft issue without body",
+			args: map[string]any{"title": "Triage backlog"},
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, map[string]any{"id": 7, "node_id": "PVT_7"}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.AddProjectV2DraftIssueInput{ProjectID: githubv4.ID("PVT_7"), Title: githubv4.String("Triage backlog")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"addProjectV2DraftIssue": map[string]any{"projectItem": map[string]any{"id": "PVTI_402", "databaseId": 402}}}),
+			)),
+			expected: ProjectDraftIssueResult{ItemID: 402, ItemNodeID: "PVTI_402", Title: "Triage backlog"},
+		},
+		{
+			name: "project not found",
+			args: map[string]any{"title": "Write release notes"},
+			restClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "failed to get project",
+		},
+		{
+			name:           "missing title",
+			args:           map[string]any{},
+			restClient:     mock.NewMockedHTTPClient(),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "missing required parameter: title",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := AddDraftIssueToProject(stubGetClientFn(gh.NewClient(tc.restClient)), stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, tc.expected, result.StructuredContent)
+		})
+	}
+}
+
+func Test_ConvertDraftToIssue(t *testing.T) {
+	tool, _ := ConvertDraftToIssue(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_id", "repo"})
+
+	mutation := struct {
+		ConvertProjectV2DraftIssueItemToIssue struct {
+			Item struct {
+				ID         githubv4.ID
+				DatabaseID githubv4.Int `graphql:"databaseId"`
+				Content    struct {
+					Issue struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						Title  githubv4.String
+						URL    githubv4.String `graphql:"url"`
+					} `graphql:"... on Issue"`
+				}
+			}
+		} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+	}{}
+	getItem := func(contentType string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+			expectPath(t, "/orgs/octo-org/projectsV2/7/items/401").andThen(mockResponse(t, http.StatusOK, map[string]any{"id": 401, "node_id": "PVTI_401", "content_type": contentType})),
+		)
+	}
+
+	getRepository := func(path string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetReposByOwnerByRepo,
+			expectPath(t, path).andThen(mockResponse(t, http.StatusOK, map[string]any{"id": 9, "node_id": "R_9"})),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		repoOwner      string
+		restClient     *http.Client
+		gqlClient      *http.Client
+		expectedErrMsg string
+	}{
+		{
+			name: "converts draft issue",
+			restClient: mock.NewMockedHTTPClient(
+				getItem("DraftIssue"),
+				getRepository("/repos/octo-org/web-app"),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.ConvertProjectV2DraftIssueItemToIssueInput{ItemID: githubv4.ID("PVTI_401"), RepositoryID: githubv4.ID("R_9")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"convertProjectV2DraftIssueItemToIssue": map[string]any{"item": map[string]any{
+					"id":         "PVTI_401",
+					"databaseId": 401,
+					"content":    map[string]any{"id": "I_42", "number": 42, "title": "Write release notes", "url": "https://github.com/octo-org/web-app/issues/42"},
+				}}}),
+			)),
+		},
+		{
+			name:      "converts draft issue to repository of another owner",
+			repoOwner: "octocat",
+			restClient: mock.NewMockedHTTPClient(
+				getItem("DraftIssue"),
+				getRepository("/repos/octocat/web-app"),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.ConvertProjectV2DraftIssueItemToIssueInput{ItemID: githubv4.ID("PVTI_401"), RepositoryID: githubv4.ID("R_9")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"convertProjectV2DraftIssueItemToIssue": map[string]any{"item": map[string]any{
+					"id":         "PVTI_401",
+					"databaseId": 401,
+					"content":    map[string]any{"id": "I_42", "number": 42, "title": "Write release notes", "url": "https://github.com/octo-org/web-app/issues/42"},
+				}}}),
+			)),
+		},
+		{
+			name:           "item is not a draft issue",
+			restClient:     mock.NewMockedHTTPClient(getItem("Issue")),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "project item 401 is not a draft issue, its content type is Issue",
+		},
+		{
+			name: "repository not found",
+			restClient: mock.NewMockedHTTPClient(
+				getItem("DraftIssue"),
+				mock.WithRequestMatchHandler(mock.GetReposByOwnerByRepo, mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})),
+			),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := ConvertDraftToIssue(stubGetClientFn(gh.NewClient(tc.restClient)), stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"item_id":        float64(401),
+				"repo":           "web-app",
+			}
+			if tc.repoOwner != "" {
+				args["repo_owner"] = tc.repoOwner
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, ProjectDraftConversionResult{
+				ItemID:      401,
+				ItemNodeID:  "PVTI_401",
+				ContentType: "Issue",
+				Issue:       ProjectDraftIssueIssue{Number: 42, Title: "Write release notes", HTMLURL: "https://github.com/octo-org/web-app/issues/42", NodeID: "I_42"},
+			}, result.StructuredContent)
+		})
+	}
+}
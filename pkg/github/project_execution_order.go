@@ -0,0 +1,346 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxNodesPerQuery is the maximum number of node IDs GitHub's GraphQL API accepts in a single nodes query.
+const maxNodesPerQuery = 100
+
+// issueDependenciesQuery loads the issues blocking a batch of issues, given by node ID.
+type issueDependenciesQuery struct {
+	Nodes []struct {
+		Issue struct {
+			ID         githubv4.ID
+			Number     githubv4.Int
+			Title      githubv4.String
+			State      githubv4.String
+			URL        githubv4.String `graphql:"url"`
+			Repository struct {
+				NameWithOwner githubv4.String
+			}
+			BlockedBy struct {
+				Nodes []struct {
+					ID         githubv4.ID
+					Number     githubv4.Int
+					State      githubv4.String
+					Repository struct {
+						NameWithOwner githubv4.String
+					}
+				}
+			} `graphql:"blockedBy(first: 100)"`
+		} `graphql:"... on Issue"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// ExecutionItem is an issue of a project in execution order. Items of the same level do not block each other and
+// may be worked on in parallel.
+type ExecutionItem struct {
+	ItemID           int64    `json:"item_id"`
+	Issue            string   `json:"issue"`
+	Title            string   `json:"title"`
+	State            string   `json:"state"`
+	URL              string   `json:"url"`
+	Level            int      `json:"level"`
+	BlockedBy        []string `json:"blocked_by,omitempty"`
+	ExternalBlockers []string `json:"external_blockers,omitempty"`
+
+	nodeID     string
+	blockerIDs []string
+	blockers   []int
+}
+
+// ExecutionOrder is the order in which the issues of a project can be worked on.
+type ExecutionOrder struct {
+	Order []*ExecutionItem `json:"order"`
+	// Cycles are the issues blocking each other, which cannot be ordered.
+	Cycles [][]string `json:"cycles"`
+	// Unordered are the items blocked by a cycle.
+	Unordered    []*ExecutionItem `json:"unordered"`
+	SkippedItems int              `json:"skipped_items"`
+}
+
+// executionOrder sorts items topologically so that every item comes after the items blocking it, keeping the
+// original order among items that are ready at the same time. It returns the ordered items, the cycles of items
+// blocking each other, and the items that cannot be ordered because they are blocked by a cycle.
+func executionOrder(items []*ExecutionItem) ([]*ExecutionItem, [][]string, []*ExecutionItem) {
+	blocks := make([][]int, len(items))
+	pending := make([]int, len(items))
+	for i, item := range items {
+		pending[i] = len(item.blockers)
+		for _, b := range item.blockers {
+			blocks[b] = append(blocks[b], i)
+		}
+	}
+
+	ordered := make([]*ExecutionItem, 0, len(items))
+	done := make([]bool, len(items))
+	for level := 0; ; level++ {
+		var ready []int
+		for i := range items {
+			if !done[i] && pending[i] == 0 {
+				ready = append(ready, i)
+			}
+		}
+		if len(ready) == 0 {
+			break
+		}
+		for _, i := range ready {
+			done[i] = true
+			items[i].Level = level
+			ordered = append(ordered, items[i])
+		}
+		for _, i := range ready {
+			for _, j := range blocks[i] {
+				pending[j]--
+			}
+		}
+	}
+
+	// The remaining items are part of a cycle or blocked by one. Cycles are the strongly connected components of the
+	// remaining items with more than one item, or an item blocking itself.
+	var cycles [][]string
+	inCycle := make([]bool, len(items))
+	for _, component := range stronglyConnectedComponents(items, done) {
+		i := component[0]
+		selfBlocking := false
+		for _, b := range items[i].blockers {
+			selfBlocking = selfBlocking || b == i
+		}
+		if len(component) == 1 && !selfBlocking {
+			continue
+		}
+		cycle := make([]string, 0, len(component))
+		for _, i := range component {
+			inCycle[i] = true
+			cycle = append(cycle, items[i].Issue)
+		}
+		cycles = append(cycles, cycle)
+	}
+	var unordered []*ExecutionItem
+	for i, item := range items {
+		if !done[i] && !inCycle[i] {
+			unordered = append(unordered, item)
+		}
+	}
+	return ordered, cycles, unordered
+}
+
+// stronglyConnectedComponents returns the strongly connected components of the blocking graph of the items that
+// are not skipped, using Tarjan's algorithm. Items within a component are in their original order.
+func stronglyConnectedComponents(items []*ExecutionItem, skip []bool) [][]int {
+	index := make([]int, len(items))
+	low := make([]int, len(items))
+	onStack := make([]bool, len(items))
+	for i := range index {
+		index[i] = -1
+	}
+	var stack []int
+	var components [][]int
+	next := 0
+
+	var visit func(i int)
+	visit = func(i int) {
+		index[i], low[i] = next, next
+		next++
+		stack = append(stack, i)
+		onStack[i] = true
+		for _, b := range items[i].blockers {
+			if skip[b] {
+				continue
+			}
+			if index[b] < 0 {
+				visit(b)
+				low[i] = min(low[i], low[b])
+			} else if onStack[b] {
+				low[i] = min(low[i], index[b])
+			}
+		}
+		if low[i] != index[i] {
+			return
+		}
+		var component []int
+		for {
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[j] = false
+			component = append(component, j)
+			if j == i {
+				break
+			}
+		}
+		sort.Ints(component)
+		components = append(components, component)
+	}
+
+	for i := range items {
+		if !skip[i] && index[i] < 0 {
+			visit(i)
+		}
+	}
+	return components
+}
+
+// GetExecutionOrder creates a tool to order the issues of a project by their blocked-by relationships.
+func GetExecutionOrder(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_execution_order",
+			mcp.WithDescription(t("TOOL_GET_EXECUTION_ORDER_DESCRIPTION", "Order the issues of a project so that every issue comes after the issues blocking it, based on their blocked-by relationships. Issues of the same level do not block each other and can be worked on in parallel. Also reports cycles of issues blocking each other, issues that cannot be ordered because a cycle blocks them, and open blockers outside the project. Use this to propose a realistic sequence of work.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_EXECUTION_ORDER_USER_TITLE", "Get execution order of project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ExecutionOrder](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("query",
+				mcp.Description("Only order items matching this project filter, e.g. 'iteration:@current'. Date qualifiers also accept relative dates such as updated:>=3-days-ago."),
+			),
+			mcp.WithBoolean("include_closed",
+				mcp.Description("Include closed issues in the order. By default closed issues are left out, and do not block other issues."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query, err := OptionalParam[string](req, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if query != "" {
+				query = normalizeQueryDates(query, flags.now())
+			}
+			includeClosed, err := OptionalParam[bool](req, "include_closed")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			projectItems, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, nil, query)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			// Only issues have blocked-by relationships; draft issues and pull requests are left out.
+			itemIDs := map[string]int64{}
+			var ids []githubv4.ID
+			skipped := 0
+			for _, item := range projectItems {
+				if item.GetContentType() != "Issue" || item.GetContentNodeID() == "" {
+					skipped++
+					continue
+				}
+				itemIDs[item.GetContentNodeID()] = item.GetID()
+				ids = append(ids, githubv4.ID(item.GetContentNodeID()))
+			}
+
+			var items []*ExecutionItem
+			for start := 0; start < len(ids); start += maxNodesPerQuery {
+				var q issueDependenciesQuery
+				vars := map[string]any{
+					"ids": ids[start:min(start+maxNodesPerQuery, len(ids))],
+				}
+				if err := gqlClient.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to get issue dependencies",
+						err,
+					), nil
+				}
+				for _, node := range q.Nodes {
+					issue := node.Issue
+					if !includeClosed && issue.State != "OPEN" {
+						skipped++
+						continue
+					}
+					item := &ExecutionItem{
+						ItemID: itemIDs[fmt.Sprint(issue.ID)],
+						Issue:  fmt.Sprintf("%s#%d", issue.Repository.NameWithOwner, issue.Number),
+						Title:  string(issue.Title),
+						State:  string(issue.State),
+						URL:    string(issue.URL),
+						nodeID: fmt.Sprint(issue.ID),
+					}
+					for _, blocker := range issue.BlockedBy.Nodes {
+						if _, ok := itemIDs[fmt.Sprint(blocker.ID)]; ok {
+							item.blockerIDs = append(item.blockerIDs, fmt.Sprint(blocker.ID))
+						} else if blocker.State == "OPEN" {
+							item.ExternalBlockers = append(item.ExternalBlockers, fmt.Sprintf("%s#%d", blocker.Repository.NameWithOwner, blocker.Number))
+						}
+					}
+					items = append(items, item)
+				}
+			}
+
+			// Blockers within the project are resolved to the items left after skipping closed issues, which no
+			// longer block others.
+			positions := make(map[string]int, len(items))
+			for i, item := range items {
+				positions[item.nodeID] = i
+			}
+			for _, item := range items {
+				for _, id := range item.blockerIDs {
+					if i, ok := positions[id]; ok {
+						item.blockers = append(item.blockers, i)
+						item.BlockedBy = append(item.BlockedBy, items[i].Issue)
+					}
+				}
+			}
+
+			ordered, cycles, unordered := executionOrder(items)
+			if ordered == nil {
+				ordered = []*ExecutionItem{}
+			}
+			if cycles == nil {
+				cycles = [][]string{}
+			}
+			if unordered == nil {
+				unordered = []*ExecutionItem{}
+			}
+
+			return MarshalledStructuredResult(ExecutionOrder{
+				Order:        ordered,
+				Cycles:       cycles,
+				Unordered:    unordered,
+				SkippedItems: skipped,
+			}), nil
+		}
+}
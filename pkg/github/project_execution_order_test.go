@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExecutionOrderSelfBlocking(t *testing.T) {
+	items := []*ExecutionItem{
+		{Issue: "octo/repo#1", blockers: []int{0}},
+		{Issue: "octo/repo#2"},
+	}
+	ordered, cycles, unordered := executionOrder(items)
+	require.Len(t, ordered, 1)
+	assert.Equal(t, "octo/repo#2", ordered[0].Issue)
+	assert.Equal(t, [][]string{{"octo/repo#1"}}, cycles)
+	assert.Empty(t, unordered)
+}
+
+func Test_GetExecutionOrder(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetExecutionOrder(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_execution_order", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	projectItems := []any{
+		map[string]any{"id": 11, "content_type": "Issue", "content_node_id": "I_1"},
+		map[string]any{"id": 12, "content_type": "Issue", "content_node_id": "I_2"},
+		map[string]any{"id": 13, "content_type": "Issue", "content_node_id": "I_3"},
+		map[string]any{"id": 14, "content_type": "Issue", "content_node_id": "I_4"},
+		map[string]any{"id": 15, "content_type": "Issue", "content_node_id": "I_5"},
+		map[string]any{"id": 16, "content_type": "Issue", "content_node_id": "I_6"},
+		map[string]any{"id": 17, "content_type": "Issue", "content_node_id": "I_7"},
+		map[string]any{"id": 18, "content_type": "DraftIssue", "content_node_id": "DI_1"},
+	}
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+			mockResponse(t, http.StatusOK, projectItems),
+		),
+	)
+
+	blocker := func(id string, number int, state string) map[string]any {
+		return map[string]any{"id": id, "number": number, "state": state, "repository": map[string]any{"nameWithOwner": "octo/repo"}}
+	}
+	issue := func(id string, number int, state string, blockedBy ...map[string]any) map[string]any {
+		if blockedBy == nil {
+			blockedBy = []map[string]any{}
+		}
+		return map[string]any{
+			"id":         id,
+			"number":     number,
+			"title":      "Issue",
+			"state":      state,
+			"url":        "https://github.com/octo/repo/issues/1",
+			"repository": map[string]any{"nameWithOwner": "octo/repo"},
+			"blockedBy":  map[string]any{"nodes": blockedBy},
+		}
+	}
+	ids := []githubv4.ID{"I_1", "I_2", "I_3", "I_4", "I_5", "I_6", "I_7"}
+	response := githubv4mock.DataResponse(map[string]any{
+		"nodes": []any{
+			issue("I_1", 1, "OPEN"),
+			// #2 is blocked by #1, and by #7, which is closed and no longer blocks it.
+			issue("I_2", 2, "OPEN", blocker("I_1", 1, "OPEN"), blocker("I_7", 7, "CLOSED")),
+			// #3 is blocked by #1, and by #9 and #8 outside the project, of which only #9 is open.
+			issue("I_3", 3, "OPEN", blocker("I_1", 1, "OPEN"), blocker("I_9", 9, "OPEN"), blocker("I_8", 8, "CLOSED")),
+			// #4 and #5 block each other, and #6 is blocked by the cycle.
+			issue("I_4", 4, "OPEN", blocker("I_5", 5, "OPEN")),
+			issue("I_5", 5, "OPEN", blocker("I_4", 4, "OPEN")),
+			issue("I_6", 6, "OPEN", blocker("I_4", 4, "OPEN")),
+			issue("I_7", 7, "CLOSED"),
+		},
+	})
+	// The query is constructed from the typed IDs, but the request variables are compared in their decoded JSON form.
+	matcher := githubv4mock.NewQueryMatcher(issueDependenciesQuery{}, map[string]any{"ids": ids}, response)
+	matcher.Variables = map[string]any{"ids": []any{"I_1", "I_2", "I_3", "I_4", "I_5", "I_6", "I_7"}}
+	gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+
+	_, handler := GetExecutionOrder(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper, FeatureFlags{})
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner_type":     "org",
+		"owner":          "octo",
+		"project_number": float64(1),
+	}))
+	require.NoError(t, err)
+
+	var got struct {
+		Order        []ExecutionItem `json:"order"`
+		Cycles       [][]string      `json:"cycles"`
+		Unordered    []ExecutionItem `json:"unordered"`
+		SkippedItems int             `json:"skipped_items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &got))
+
+	require.Len(t, got.Order, 3)
+	assert.Equal(t, "octo/repo#1", got.Order[0].Issue)
+	assert.Equal(t, int64(11), got.Order[0].ItemID)
+	assert.Equal(t, 0, got.Order[0].Level)
+	assert.Equal(t, "octo/repo#2", got.Order[1].Issue)
+	assert.Equal(t, 1, got.Order[1].Level)
+	assert.Equal(t, []string{"octo/repo#1"}, got.Order[1].BlockedBy)
+	assert.Equal(t, "octo/repo#3", got.Order[2].Issue)
+	assert.Equal(t, 1, got.Order[2].Level)
+	assert.Equal(t, []string{"octo/repo#9"}, got.Order[2].ExternalBlockers)
+
+	assert.Equal(t, [][]string{{"octo/repo#4", "octo/repo#5"}}, got.Cycles)
+	require.Len(t, got.Unordered, 1)
+	assert.Equal(t, "octo/repo#6", got.Unordered[0].Issue)
+	assert.Equal(t, 2, got.SkippedItems)
+}
@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultExternalLinkField is the name of the project field external links are stored in by default.
+	DefaultExternalLinkField = "External Link"
+
+	ExternalLinkFormatURL = "url"
+	ExternalLinkFormatKey = "key"
+)
+
+// externalKeyPattern matches issue keys of external trackers, such as 'PROJ-123' in Jira or 'ENG-42' in Linear.
+var externalKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// normalizeExternalLink validates an external link in the given format and returns it in canonical form.
+func normalizeExternalLink(value, format string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch format {
+	case ExternalLinkFormatKey:
+		if !externalKeyPattern.MatchString(value) {
+			return "", fmt.Errorf("invalid key %q: expected a key such as 'PROJ-123'", value)
+		}
+		return strings.ToUpper(value), nil
+	default:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", fmt.Errorf("invalid URL %q: expected an absolute http or https URL", value)
+		}
+		return u.String(), nil
+	}
+}
+
+// findProjectField returns the field of a project with the given ID or name. Names are matched case-insensitively.
+func findProjectField(fields []*github.ProjectV2Field, ref string) *github.ProjectV2Field {
+	ref = strings.TrimSpace(ref)
+	id, err := strconv.ParseInt(ref, 10, 64)
+	for _, field := range fields {
+		if (err == nil && field.GetID() == id) || strings.EqualFold(field.GetName(), ref) {
+			return field
+		}
+	}
+	return nil
+}
+
+// SetProjectItemExternalLink creates a tool to read or write the link between a project item and an issue in an
+// external tracker, stored in a designated text field of the project.
+func SetProjectItemExternalLink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_external_link",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_EXTERNAL_LINK_DESCRIPTION", "Read or write the link between a project item and an issue in an external tracker such as Jira or Linear. The link is stored in a designated text field of the project, e.g. 'Jira Key'. Pass value to set the link, which is validated as a URL or an issue key, an empty value to clear it, or omit value to read the current link.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEM_EXTERNAL_LINK_USER_TITLE", "Set project item external link"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the project item. This is not the issue or pull request ID."),
+			),
+			mcp.WithString("field",
+				mcp.Description("ID or name of the text field holding the external link"),
+				mcp.DefaultString(DefaultExternalLinkField),
+			),
+			mcp.WithString("value",
+				mcp.Description("External link to set. An empty string clears the link. Omit to read the current link"),
+			),
+			mcp.WithString("format",
+				mcp.Description("Format of the external link: 'url' for an absolute http(s) URL, or 'key' for an issue key such as 'PROJ-123'"),
+				mcp.Enum(ExternalLinkFormatURL, ExternalLinkFormatKey),
+				mcp.DefaultString(ExternalLinkFormatURL),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the field."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredBigInt(req, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldRef, err := OptionalParam[string](req, "field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if fieldRef == "" {
+				fieldRef = DefaultExternalLinkField
+			}
+			value, write, err := OptionalParamOK[string](req, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalParam[string](req, "format")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if format == "" {
+				format = ExternalLinkFormatURL
+			}
+			if format != ExternalLinkFormatURL && format != ExternalLinkFormatKey {
+				return mcp.NewToolResultError("format must be either 'url' or 'key'"), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if write && strings.TrimSpace(value) != "" {
+				if value, err = normalizeExternalLink(value, format); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, fieldRef)
+			if field == nil && !refresh {
+				// The field may have been created after the definitions were cached.
+				if fields, err = projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, true); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				field = findProjectField(fields, fieldRef)
+			}
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found; create a text field to hold external links first", fieldRef)), nil
+			}
+			if field.GetDataType() != "text" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; external links must be stored in a text field", field.GetName(), field.GetDataType())), nil
+			}
+
+			var resp *github.Response
+			var item *github.ProjectV2Item
+			if write {
+				var newValue any
+				if strings.TrimSpace(value) != "" {
+					newValue = value
+				}
+				update := &github.UpdateProjectItemOptions{
+					Fields: []*github.UpdateProjectV2Field{{ID: field.GetID(), Value: newValue}},
+				}
+				if ownerType == "org" {
+					item, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, owner, projectNumber, itemID, update)
+				} else {
+					item, resp, err = client.Projects.UpdateUserProjectItem(ctx, owner, projectNumber, itemID, update)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						ProjectUpdateFailedError,
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+			} else {
+				opts := &github.GetProjectItemOptions{Fields: []int64{field.GetID()}}
+				if ownerType == "org" {
+					item, resp, err = client.Projects.GetOrganizationProjectItem(ctx, owner, projectNumber, itemID, opts)
+				} else {
+					item, resp, err = client.Projects.GetUserProjectItem(ctx, owner, projectNumber, itemID, opts)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get project item",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				value = ""
+				for _, fieldValue := range item.Fields {
+					if fieldValue.GetID() == field.GetID() {
+						value, _ = fieldValue.Value.(string)
+					}
+				}
+			}
+
+			response := map[string]any{
+				"item_id":  itemID,
+				"field":    field.GetName(),
+				"field_id": field.GetID(),
+				"value":    value,
+				"updated":  write,
+			}
+			if item.GetItemURL() != "" {
+				response["item_url"] = item.GetItemURL()
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
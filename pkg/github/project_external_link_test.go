@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeExternalLink(t *testing.T) {
+	link, err := normalizeExternalLink(" https://example.atlassian.net/browse/PROJ-1 ", ExternalLinkFormatURL)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.atlassian.net/browse/PROJ-1", link)
+
+	_, err = normalizeExternalLink("example.atlassian.net/browse/PROJ-1", ExternalLinkFormatURL)
+	require.ErrorContains(t, err, "expected an absolute http or https URL")
+	_, err = normalizeExternalLink("javascript:alert(1)", ExternalLinkFormatURL)
+	require.Error(t, err)
+
+	link, err = normalizeExternalLink("eng-42", ExternalLinkFormatKey)
+	require.NoError(t, err)
+	assert.Equal(t, "ENG-42", link)
+
+	_, err = normalizeExternalLink("ENG 42", ExternalLinkFormatKey)
+	require.ErrorContains(t, err, `invalid key "ENG 42"`)
+}
+
+func Test_SetProjectItemExternalLink(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetProjectItemExternalLink(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "set_project_item_external_link", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_id"})
+
+	fields := []map[string]any{
+		{"id": 101, "name": "Status", "data_type": "single_select"},
+		{"id": 102, "name": "Jira Key", "data_type": "text"},
+	}
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, fields),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedValue  string
+	}{
+		{
+			name: "sets a key",
+			mockedClient: mock.NewMockedHTTPClient(
+				fieldsEndpoint,
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{map[string]any{"id": float64(102), "value": "PROJ-7"}},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{"id": 5}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(201),
+				"item_id":        float64(5),
+				"field":          "jira key",
+				"value":          "proj-7",
+				"format":         "key",
+			},
+			expectedValue: "PROJ-7",
+		},
+		{
+			name: "clears the link",
+			mockedClient: mock.NewMockedHTTPClient(
+				fieldsEndpoint,
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{map[string]any{"id": float64(102), "value": nil}},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{"id": 5}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(202),
+				"item_id":        float64(5),
+				"field":          "102",
+				"value":          "",
+			},
+			expectedValue: "",
+		},
+		{
+			name: "reads the link",
+			mockedClient: mock.NewMockedHTTPClient(
+				fieldsEndpoint,
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+					expectQueryParams(t, map[string]string{"fields": "102"}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{
+							"id":     5,
+							"fields": []any{map[string]any{"id": 102, "name": "Jira Key", "data_type": "text", "value": "PROJ-3"}},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(203),
+				"item_id":        float64(5),
+				"field":          "Jira Key",
+			},
+			expectedValue: "PROJ-3",
+		},
+		{
+			name:         "invalid URL",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(204),
+				"item_id":        float64(5),
+				"value":          "not a url",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid URL "not a url"`,
+		},
+		{
+			name:         "field is not a text field",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(205),
+				"item_id":        float64(5),
+				"field":          "Status",
+				"value":          "https://linear.app/octo/issue/ENG-1",
+			},
+			expectError:    true,
+			expectedErrMsg: `project field "Status" is a single_select field`,
+		},
+		{
+			name:         "field not found",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(206),
+				"item_id":        float64(5),
+			},
+			expectError:    true,
+			expectedErrMsg: `project field "External Link" not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := SetProjectItemExternalLink(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				FieldID int64  `json:"field_id"`
+				Value   string `json:"value"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, int64(102), response.FieldID)
+			assert.Equal(t, tc.expectedValue, response.Value)
+		})
+	}
+}
@@ -0,0 +1,577 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultIterationDuration is the length in days of the iterations of a new iteration field.
+	DefaultIterationDuration = 14
+	// DefaultIterationCount is the number of iterations a new iteration field starts with.
+	DefaultIterationCount = 3
+)
+
+// projectFieldDataTypes maps the data types of the fields that can be created to their GraphQL names.
+var projectFieldDataTypes = map[string]string{
+	"text":          "TEXT",
+	"number":        "NUMBER",
+	"date":          "DATE",
+	"single_select": "SINGLE_SELECT",
+	"iteration":     "ITERATION",
+}
+
+// projectFieldOptionColors are the colors a single select option can have.
+var projectFieldOptionColors = []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"}
+
+// projectFieldOptionSchema is the JSON schema of a single select option in tool parameters.
+var projectFieldOptionSchema = map[string]interface{}{
+	"type":                 "object",
+	"additionalProperties": false,
+	"required":             []string{"name"},
+	"properties": map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":        "string",
+			"description": "Option name",
+		},
+		"color": map[string]interface{}{
+			"type":        "string",
+			"description": "Option color, GRAY by default",
+			"enum":        projectFieldOptionColors,
+		},
+		"description": map[string]interface{}{
+			"type":        "string",
+			"description": "Option description",
+		},
+	},
+}
+
+// CreateProjectV2FieldInput mirrors the GraphQL input of createProjectV2Field, including the iteration
+// configuration githubv4 does not know about yet.
+type CreateProjectV2FieldInput struct {
+	ProjectID              githubv4.ID                                `json:"projectId"`
+	DataType               githubv4.ProjectV2CustomFieldType          `json:"dataType"`
+	Name                   githubv4.String                            `json:"name"`
+	SingleSelectOptions    *[]projectV2SingleSelectFieldOptionInput   `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *projectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+// UpdateProjectV2FieldInput mirrors the GraphQL input of updateProjectV2Field.
+type UpdateProjectV2FieldInput struct {
+	FieldID             githubv4.ID                              `json:"fieldId"`
+	Name                *githubv4.String                         `json:"name,omitempty"`
+	SingleSelectOptions *[]projectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+}
+
+// projectV2SingleSelectFieldOptionInput is an option of a single select field. Existing options are identified by
+// their ID, so that the values of items using them are kept when the options of a field are replaced.
+type projectV2SingleSelectFieldOptionInput struct {
+	ID          *githubv4.String                               `json:"id,omitempty"`
+	Name        githubv4.String                                `json:"name"`
+	Color       githubv4.ProjectV2SingleSelectFieldOptionColor `json:"color"`
+	Description githubv4.String                                `json:"description"`
+}
+
+type projectV2IterationFieldConfigurationInput struct {
+	StartDate  githubv4.Date             `json:"startDate"`
+	Duration   githubv4.Int              `json:"duration"`
+	Iterations []projectV2IterationInput `json:"iterations"`
+}
+
+type projectV2IterationInput struct {
+	StartDate githubv4.Date   `json:"startDate"`
+	Duration  githubv4.Int    `json:"duration"`
+	Title     githubv4.String `json:"title"`
+}
+
+// parseProjectFieldOptions reads the single select options in parameter p.
+func parseProjectFieldOptions(request mcp.CallToolRequest, p string) ([]projectV2SingleSelectFieldOptionInput, error) {
+	raw, ok := request.GetArguments()[p]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of option objects", p)
+	}
+	options := make([]projectV2SingleSelectFieldOptionInput, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each option in %s must be an object", p)
+		}
+		name, _ := obj["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("each option in %s must have a name", p)
+		}
+		color, _ := obj["color"].(string)
+		color = strings.ToUpper(strings.TrimSpace(color))
+		if color == "" {
+			color = "GRAY"
+		}
+		valid := false
+		for _, c := range projectFieldOptionColors {
+			valid = valid || c == color
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid color %q for option %q, must be one of %s", color, name, strings.Join(projectFieldOptionColors, ", "))
+		}
+		description, _ := obj["description"].(string)
+		options = append(options, projectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(strings.TrimSpace(name)),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(color),
+			Description: githubv4.String(description),
+		})
+	}
+	return options, nil
+}
+
+// buildIterationConfiguration creates count consecutive iterations of duration days, starting on start.
+func buildIterationConfiguration(start time.Time, duration, count int) *projectV2IterationFieldConfigurationInput {
+	config := &projectV2IterationFieldConfigurationInput{
+		StartDate:  githubv4.Date{Time: start},
+		Duration:   githubv4.Int(duration),
+		Iterations: make([]projectV2IterationInput, 0, count),
+	}
+	for i := 0; i < count; i++ {
+		config.Iterations = append(config.Iterations, projectV2IterationInput{
+			StartDate: githubv4.Date{Time: start.AddDate(0, 0, i*duration)},
+			Duration:  githubv4.Int(duration),
+			Title:     githubv4.String(fmt.Sprintf("Iteration %d", i+1)),
+		})
+	}
+	return config
+}
+
+// projectFieldByID returns the field with the given database ID from the freshly fetched field definitions of a
+// project, which also brings the cached definitions up to date.
+func projectFieldByID(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, id int64) (*github.ProjectV2Field, error) {
+	fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		if field.GetID() == id {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("project field %d not found", id)
+}
+
+// projectFieldMutationResult selects the database ID of the field returned by a field mutation.
+type projectFieldMutationResult struct {
+	ProjectV2Field struct {
+		Common struct {
+			DatabaseID githubv4.Int
+		} `graphql:"... on ProjectV2FieldCommon"`
+	}
+}
+
+// CreateProjectField creates a tool to add a custom field to a project.
+func CreateProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_field",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FIELD_DESCRIPTION", "Create a custom field in a Project for a user or org. Single select fields need at least one option; iteration fields start with a number of consecutive iterations.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_FIELD_USER_TITLE", "Create project field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[github.ProjectV2Field](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("The field's name"),
+			),
+			mcp.WithString("data_type",
+				mcp.Required(),
+				mcp.Description("The field's data type"),
+				mcp.Enum("text", "number", "date", "single_select", "iteration"),
+			),
+			mcp.WithArray("options",
+				mcp.Description("Options of a single_select field"),
+				mcp.Items(projectFieldOptionSchema),
+			),
+			mcp.WithString("iteration_start_date",
+				mcp.Description("Start date of the first iteration of an iteration field, as YYYY-MM-DD. Defaults to today"),
+			),
+			mcp.WithNumber("iteration_duration",
+				mcp.Description(fmt.Sprintf("Length of the iterations of an iteration field in days (default %d)", DefaultIterationDuration)),
+			),
+			mcp.WithNumber("iteration_count",
+				mcp.Description(fmt.Sprintf("Number of iterations an iteration field starts with (default %d)", DefaultIterationCount)),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](req, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dataType, err := RequiredParam[string](req, "data_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlDataType, ok := projectFieldDataTypes[dataType]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported data_type %q", dataType)), nil
+			}
+			options, err := parseProjectFieldOptions(req, "options")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startDate, err := OptionalParam[string](req, "iteration_start_date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			duration, err := OptionalIntParamWithDefault(req, "iteration_duration", DefaultIterationDuration)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			count, err := OptionalIntParamWithDefault(req, "iteration_count", DefaultIterationCount)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := CreateProjectV2FieldInput{
+				DataType: githubv4.ProjectV2CustomFieldType(gqlDataType),
+				Name:     githubv4.String(name),
+			}
+			switch dataType {
+			case "single_select":
+				if len(options) == 0 {
+					return mcp.NewToolResultError("options are required for a single_select field"), nil
+				}
+				input.SingleSelectOptions = &options
+			case "iteration":
+				if duration < 1 || count < 1 {
+					return mcp.NewToolResultError("iteration_duration and iteration_count must be at least 1"), nil
+				}
+				start := time.Now().UTC().Truncate(24 * time.Hour)
+				if startDate != "" {
+					if start, err = time.Parse("2006-01-02", startDate); err != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("invalid iteration_start_date %q, expected YYYY-MM-DD", startDate)), nil
+					}
+				}
+				input.IterationConfiguration = buildIterationConfiguration(start, duration, count)
+			}
+			if len(options) > 0 && dataType != "single_select" {
+				return mcp.NewToolResultError("options can only be set for a single_select field"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			input.ProjectID = githubv4.ID(project.GetNodeID())
+			var mutation struct {
+				CreateProjectV2Field projectFieldMutationResult `graphql:"createProjectV2Field(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to create project field",
+					err,
+				), nil
+			}
+
+			field, err := projectFieldByID(ctx, client, ownerType, owner, projectNumber, int64(mutation.CreateProjectV2Field.ProjectV2Field.Common.DatabaseID))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q was created, but %s", name, err)), nil
+			}
+			return MarshalledStructuredResult(field), nil
+		}
+}
+
+// UpdateProjectField creates a tool to rename a project field and to add or remove the options of a single select
+// field.
+func UpdateProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_field",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_FIELD_DESCRIPTION", "Rename a custom field of a Project for a user or org, or add and remove the options of a single select field. Items set to a removed option lose their value.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_FIELD_USER_TITLE", "Update project field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[github.ProjectV2Field](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("field",
+				mcp.Required(),
+				mcp.Description("ID or name of the field"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the field"),
+			),
+			mcp.WithArray("add_options",
+				mcp.Description("Options to add to a single_select field"),
+				mcp.Items(projectFieldOptionSchema),
+			),
+			mcp.WithArray("remove_options",
+				mcp.Description("Names of the options to remove from a single_select field"),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldRef, err := RequiredParam[string](req, "field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, renamed, err := OptionalParamOK[string](req, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if renamed && strings.TrimSpace(name) == "" {
+				return mcp.NewToolResultError("name must not be empty"), nil
+			}
+			addOptions, err := parseProjectFieldOptions(req, "add_options")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			removeOptions, err := OptionalStringArrayParam(req, "remove_options")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !renamed && len(addOptions) == 0 && len(removeOptions) == 0 {
+				return mcp.NewToolResultError("at least one of name, add_options or remove_options must be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// The options are replaced as a whole, so they are built from the current definition of the field.
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, fieldRef)
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", fieldRef)), nil
+			}
+
+			input := UpdateProjectV2FieldInput{FieldID: githubv4.ID(field.GetNodeID())}
+			if renamed {
+				input.Name = githubv4.NewString(githubv4.String(strings.TrimSpace(name)))
+			}
+			if len(addOptions) > 0 || len(removeOptions) > 0 {
+				if field.GetDataType() != "single_select" {
+					return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; only single_select fields have options", field.GetName(), field.GetDataType())), nil
+				}
+				options, err := mergeProjectFieldOptions(field.Options, addOptions, removeOptions)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.SingleSelectOptions = &options
+			}
+
+			var mutation struct {
+				UpdateProjectV2Field projectFieldMutationResult `graphql:"updateProjectV2Field(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to update project field",
+					err,
+				), nil
+			}
+
+			updated, err := projectFieldByID(ctx, client, ownerType, owner, projectNumber, field.GetID())
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q was updated, but %s", field.GetName(), err)), nil
+			}
+			return MarshalledStructuredResult(updated), nil
+		}
+}
+
+// mergeProjectFieldOptions returns the options of a single select field after removing the options named in remove
+// and appending add. Option names are matched case-insensitively.
+func mergeProjectFieldOptions(current []*github.ProjectV2FieldOption, add []projectV2SingleSelectFieldOptionInput, remove []string) ([]projectV2SingleSelectFieldOptionInput, error) {
+	removed := map[string]bool{}
+	for _, name := range remove {
+		removed[strings.ToLower(strings.TrimSpace(name))] = false
+	}
+
+	options := make([]projectV2SingleSelectFieldOptionInput, 0, len(current)+len(add))
+	names := map[string]bool{}
+	for _, option := range current {
+		name := option.GetName().GetRaw()
+		key := strings.ToLower(name)
+		if _, ok := removed[key]; ok {
+			removed[key] = true
+			continue
+		}
+		names[key] = true
+		options = append(options, projectV2SingleSelectFieldOptionInput{
+			ID:          githubv4.NewString(githubv4.String(option.GetID())),
+			Name:        githubv4.String(name),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(strings.ToUpper(option.GetColor())),
+			Description: githubv4.String(option.GetDescription().GetRaw()),
+		})
+	}
+	for _, name := range remove {
+		if !removed[strings.ToLower(strings.TrimSpace(name))] {
+			return nil, fmt.Errorf("option %q not found", name)
+		}
+	}
+	for _, option := range add {
+		key := strings.ToLower(string(option.Name))
+		if names[key] {
+			return nil, fmt.Errorf("option %q already exists", option.Name)
+		}
+		names[key] = true
+		options = append(options, option)
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("a single_select field must keep at least one option")
+	}
+	return options, nil
+}
+
+// DeleteProjectField creates a tool to delete a custom field from a project.
+func DeleteProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	projectFieldDeletedMessage := t("RESULT_PROJECT_FIELD_DELETED", "project field %q successfully deleted")
+	return mcp.NewTool("delete_project_field",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_FIELD_DESCRIPTION", "Delete a custom field from a Project for a user or org, including its values on all items. This cannot be undone.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_FIELD_USER_TITLE", "Delete project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("field",
+				mcp.Required(),
+				mcp.Description("ID or name of the field"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldRef, err := RequiredParam[string](req, "field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, fieldRef)
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", fieldRef)), nil
+			}
+
+			var mutation struct {
+				DeleteProjectV2Field projectFieldMutationResult `graphql:"deleteProjectV2Field(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.DeleteProjectV2FieldInput{
+				FieldID: githubv4.ID(field.GetNodeID()),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to delete project field",
+					err,
+				), nil
+			}
+			projectFieldCache.Invalidate(ownerType, owner, projectNumber)
+
+			return mcp.NewToolResultText(fmt.Sprintf(projectFieldDeletedMessage, field.GetName())), nil
+		}
+}
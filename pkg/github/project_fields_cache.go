@@ -0,0 +1,189 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/muesli/cache2go"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultProjectFieldCacheTTL is how long field definitions of a project are reused before being fetched again.
+	DefaultProjectFieldCacheTTL = 5 * time.Minute
+	// maxProjectFieldPages bounds the number of pages fetched when loading the field definitions of a project.
+	maxProjectFieldPages = 10
+)
+
+// ProjectFieldCache caches the field definitions of projects so that tools can resolve field names to IDs
+// without listing the project fields on every call.
+type ProjectFieldCache struct {
+	cache *cache2go.CacheTable
+	ttl   time.Duration
+}
+
+type projectFieldCacheEntry struct {
+	fields    []*github.ProjectV2Field
+	fetchedAt time.Time
+	// layout is loaded on first use by Layout and expires on its own, as the fields may be stored again before.
+	layout *projectLayout
+}
+
+// NewProjectFieldCache creates a project field cache backed by the named cache table.
+func NewProjectFieldCache(name string, ttl time.Duration) *ProjectFieldCache {
+	return &ProjectFieldCache{
+		cache: cache2go.Cache(name),
+		ttl:   ttl,
+	}
+}
+
+// projectFieldCache is shared by all project tools.
+var projectFieldCache = NewProjectFieldCache("project-field-cache", DefaultProjectFieldCacheTTL)
+
+func projectFieldCacheKey(ownerType, owner string, projectNumber int) string {
+	return fmt.Sprintf("%s:%s:%d", ownerType, strings.ToLower(owner), projectNumber)
+}
+
+// Fields returns the field definitions of a project, fetching all pages from the API when they are not cached,
+// have expired, or refresh is set.
+func (c *ProjectFieldCache) Fields(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, refresh bool) ([]*github.ProjectV2Field, error) {
+	fields, _, err := c.fields(ctx, client, ownerType, owner, projectNumber, refresh)
+	return fields, err
+}
+
+// Store caches the complete field definitions of a project, e.g. when they were already listed by a tool.
+func (c *ProjectFieldCache) Store(ownerType, owner string, projectNumber int, fields []*github.ProjectV2Field) {
+	key := projectFieldCacheKey(ownerType, owner, projectNumber)
+	entry := &projectFieldCacheEntry{fields: fields, fetchedAt: time.Now()}
+	if item, err := c.cache.Value(key); err == nil {
+		entry.layout = item.Data().(*projectFieldCacheEntry).layout
+	}
+	c.cache.Add(key, c.ttl, entry)
+}
+
+// Layout returns the default view of a project and the layout of the fields with the given IDs, in the order of
+// fieldIDs. The layout is cached with the field definitions of the project, and fetched again when it has expired
+// or misses one of the fields, e.g. one created since. It is not cached when the field definitions are not.
+func (c *ProjectFieldCache) Layout(ctx context.Context, gqlClient *githubv4.Client, ownerType, owner string, projectNumber int, fieldIDs []int64) (*ProjectView, []ProjectFieldLayout, error) {
+	key := projectFieldCacheKey(ownerType, owner, projectNumber)
+	var entry *projectFieldCacheEntry
+	if item, err := c.cache.Value(key); err == nil {
+		entry = item.Data().(*projectFieldCacheEntry)
+		if layout := entry.layout; layout != nil && time.Since(layout.fetchedAt) < c.ttl {
+			if fields, ok := layout.describe(fieldIDs); ok {
+				return layout.defaultView, fields, nil
+			}
+		}
+	}
+
+	layout, err := queryProjectLayout(ctx, gqlClient, ownerType, owner, projectNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry != nil {
+		// Entries are replaced rather than updated, as other tool calls may be reading them.
+		c.cache.Add(key, c.ttl, &projectFieldCacheEntry{fields: entry.fields, fetchedAt: entry.fetchedAt, layout: layout})
+	}
+	fields, _ := layout.describe(fieldIDs)
+	return layout.defaultView, fields, nil
+}
+
+// Invalidate drops the cached field definitions of a project, e.g. after one of its fields was deleted.
+func (c *ProjectFieldCache) Invalidate(ownerType, owner string, projectNumber int) {
+	_, _ = c.cache.Delete(projectFieldCacheKey(ownerType, owner, projectNumber))
+}
+
+func (c *ProjectFieldCache) fields(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, refresh bool) ([]*github.ProjectV2Field, bool, error) {
+	if !refresh {
+		// The fetch time is checked explicitly, as cache2go extends the lifespan of an item on every access.
+		if item, err := c.cache.Value(projectFieldCacheKey(ownerType, owner, projectNumber)); err == nil {
+			entry := item.Data().(*projectFieldCacheEntry)
+			if time.Since(entry.fetchedAt) < c.ttl {
+				return entry.fields, true, nil
+			}
+		}
+	}
+
+	var fields []*github.ProjectV2Field
+	perPage := MaxProjectsPerPage
+	opts := &github.ListProjectsOptions{
+		ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: &perPage},
+	}
+	for page := 0; page < maxProjectFieldPages; page++ {
+		var pageFields []*github.ProjectV2Field
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			pageFields, resp, err = client.Projects.ListOrganizationProjectFields(ctx, owner, projectNumber, opts)
+		} else {
+			pageFields, resp, err = client.Projects.ListUserProjectFields(ctx, owner, projectNumber, opts)
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list project fields: %w", err)
+		}
+		_ = resp.Body.Close()
+
+		fields = append(fields, pageFields...)
+		if resp.After == "" {
+			break
+		}
+		after := resp.After
+		opts.After = &after
+	}
+
+	c.Store(ownerType, owner, projectNumber, fields)
+	return fields, false, nil
+}
+
+// ResolveFieldIDs converts a list of field IDs or field names to field IDs. Names are matched case-insensitively
+// against the cached field definitions of the project, which are only loaded if at least one name is given.
+func (c *ProjectFieldCache) ResolveFieldIDs(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, fields []string, refresh bool) ([]int64, error) {
+	ids := make([]int64, 0, len(fields))
+	var definitions []*github.ProjectV2Field
+	loaded, cached := false, false
+	for _, field := range fields {
+		if id, err := strconv.ParseInt(strings.TrimSpace(field), 10, 64); err == nil {
+			ids = append(ids, id)
+			continue
+		}
+
+		if !loaded {
+			var err error
+			definitions, cached, err = c.fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return nil, err
+			}
+			loaded = true
+		}
+
+		id, ok := findProjectFieldID(definitions, field)
+		if !ok && cached {
+			// The field may have been created after the definitions were cached.
+			var err error
+			definitions, cached, err = c.fields(ctx, client, ownerType, owner, projectNumber, true)
+			if err != nil {
+				return nil, err
+			}
+			id, ok = findProjectFieldID(definitions, field)
+		}
+		if !ok {
+			return nil, fmt.Errorf("project field %q not found", field)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func findProjectFieldID(fields []*github.ProjectV2Field, name string) (int64, bool) {
+	name = strings.TrimSpace(name)
+	for _, field := range fields {
+		if strings.EqualFold(field.GetName(), name) {
+			return field.GetID(), true
+		}
+	}
+	return 0, false
+}
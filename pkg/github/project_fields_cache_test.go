@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectFieldCache(t *testing.T) {
+	var calls atomic.Int32
+	fields := []map[string]any{
+		{"id": 1, "name": "Status", "data_type": "single_select"},
+	}
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(mock.MustMarshal(fields))
+			}),
+		),
+	))
+	ctx := context.Background()
+
+	t.Run("reuses cached field definitions", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewProjectFieldCache(t.Name(), time.Minute)
+
+		ids, err := cache.ResolveFieldIDs(ctx, client, "org", "octo", 1, []string{"status", "42"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 42}, ids)
+
+		ids, err = cache.ResolveFieldIDs(ctx, client, "org", "Octo", 1, []string{"Status"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, ids)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not load definitions for numeric IDs", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewProjectFieldCache(t.Name(), time.Minute)
+
+		ids, err := cache.ResolveFieldIDs(ctx, client, "org", "octo", 1, []string{"7", "8"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{7, 8}, ids)
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("refresh bypasses the cache", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewProjectFieldCache(t.Name(), time.Minute)
+
+		_, err := cache.Fields(ctx, client, "org", "octo", 1, false)
+		require.NoError(t, err)
+		_, err = cache.Fields(ctx, client, "org", "octo", 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("expired definitions are fetched again", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewProjectFieldCache(t.Name(), time.Nanosecond)
+
+		_, err := cache.Fields(ctx, client, "org", "octo", 1, false)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = cache.Fields(ctx, client, "org", "octo", 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("unknown names reload cached definitions once", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewProjectFieldCache(t.Name(), time.Minute)
+		cache.Store("org", "octo", 1, []*github.ProjectV2Field{})
+
+		ids, err := cache.ResolveFieldIDs(ctx, client, "org", "octo", 1, []string{"Status"}, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, ids)
+
+		_, err = cache.ResolveFieldIDs(ctx, client, "org", "octo", 1, []string{"Estimate"}, false)
+		require.ErrorContains(t, err, `project field "Estimate" not found`)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
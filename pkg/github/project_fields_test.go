@@ -0,0 +1,334 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MergeProjectFieldOptions(t *testing.T) {
+	current := []*gh.ProjectV2FieldOption{
+		{ID: gh.Ptr("a1"), Name: &gh.ProjectV2TextContent{Raw: gh.Ptr("Todo")}, Color: gh.Ptr("gray")},
+		{ID: gh.Ptr("b2"), Name: &gh.ProjectV2TextContent{Raw: gh.Ptr("Done")}, Color: gh.Ptr("green"), Description: &gh.ProjectV2TextContent{Raw: gh.Ptr("Shipped")}},
+	}
+	add := []projectV2SingleSelectFieldOptionInput{{Name: "Blocked", Color: "RED"}}
+
+	options, err := mergeProjectFieldOptions(current, add, []string{"todo"})
+	require.NoError(t, err)
+	assert.Equal(t, []projectV2SingleSelectFieldOptionInput{
+		{ID: githubv4.NewString("b2"), Name: "Done", Color: "GREEN", Description: "Shipped"},
+		{Name: "Blocked", Color: "RED"},
+	}, options)
+
+	_, err = mergeProjectFieldOptions(current, nil, []string{"Missing"})
+	require.ErrorContains(t, err, `option "Missing" not found`)
+	_, err = mergeProjectFieldOptions(current, []projectV2SingleSelectFieldOptionInput{{Name: "done", Color: "GRAY"}}, nil)
+	require.ErrorContains(t, err, `option "done" already exists`)
+	_, err = mergeProjectFieldOptions(current, nil, []string{"Todo", "Done"})
+	require.ErrorContains(t, err, "at least one option")
+}
+
+func Test_CreateProjectField(t *testing.T) {
+	tool, _ := CreateProjectField(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_project_field", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "name", "data_type"})
+
+	mutation := struct {
+		CreateProjectV2Field projectFieldMutationResult `graphql:"createProjectV2Field(input: $input)"`
+	}{}
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		projectNumber  int
+		requestArgs    map[string]any
+		input          CreateProjectV2FieldInput
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:          "creates single select field",
+			projectNumber: 901,
+			requestArgs: map[string]any{
+				"name":      "Priority",
+				"data_type": "single_select",
+				"options": []any{
+					map[string]any{"name": "High", "color": "red", "description": "Do it now"},
+					map[string]any{"name": "Low"},
+				},
+			},
+			input: CreateProjectV2FieldInput{
+				DataType: "SINGLE_SELECT",
+				Name:     "Priority",
+				SingleSelectOptions: &[]projectV2SingleSelectFieldOptionInput{
+					{Name: "High", Color: "RED", Description: "Do it now"},
+					{Name: "Low", Color: "GRAY"},
+				},
+			},
+		},
+		{
+			name:          "creates iteration field",
+			projectNumber: 902,
+			requestArgs: map[string]any{
+				"name":                 "Sprint",
+				"data_type":            "iteration",
+				"iteration_start_date": "2025-01-06",
+				"iteration_duration":   float64(7),
+				"iteration_count":      float64(2),
+			},
+			input: CreateProjectV2FieldInput{
+				DataType: "ITERATION",
+				Name:     "Sprint",
+				IterationConfiguration: &projectV2IterationFieldConfigurationInput{
+					StartDate: githubv4.Date{Time: start},
+					Duration:  7,
+					Iterations: []projectV2IterationInput{
+						{StartDate: githubv4.Date{Time: start}, Duration: 7, Title: "Iteration 1"},
+						{StartDate: githubv4.Date{Time: start.AddDate(0, 0, 7)}, Duration: 7, Title: "Iteration 2"},
+					},
+				},
+			},
+		},
+		{
+			name:           "single select field without options",
+			projectNumber:  907,
+			requestArgs:    map[string]any{"name": "Priority", "data_type": "single_select"},
+			expectError:    true,
+			expectedErrMsg: "options are required for a single_select field",
+		},
+		{
+			name:           "options on text field",
+			projectNumber:  907,
+			requestArgs:    map[string]any{"name": "Notes", "data_type": "text", "options": []any{map[string]any{"name": "A"}}},
+			expectError:    true,
+			expectedErrMsg: "options can only be set for a single_select field",
+		},
+		{
+			name:           "invalid option color",
+			projectNumber:  907,
+			requestArgs:    map[string]any{"name": "Priority", "data_type": "single_select", "options": []any{map[string]any{"name": "A", "color": "teal"}}},
+			expectError:    true,
+			expectedErrMsg: `invalid color "TEAL"`,
+		},
+		{
+			name:           "invalid iteration start date",
+			projectNumber:  907,
+			requestArgs:    map[string]any{"name": "Sprint", "data_type": "iteration", "iteration_start_date": "next monday"},
+			expectError:    true,
+			expectedErrMsg: "invalid iteration_start_date",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.input.ProjectID = githubv4.ID("PVT_1")
+			restClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1", "number": tc.projectNumber}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 10, "node_id": "PVTF_10", "name": "Title", "data_type": "title"},
+						{"id": 77, "node_id": "PVTF_77", "name": tc.input.Name, "data_type": tc.requestArgs["data_type"]},
+					}),
+				),
+			)
+			gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(mutation, tc.input, nil,
+				githubv4mock.DataResponse(map[string]any{
+					"createProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 77}},
+				}),
+			))
+			_, handler := CreateProjectField(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "octo-org", "owner_type": "org", "project_number": float64(tc.projectNumber)}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var field gh.ProjectV2Field
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &field))
+			assert.Equal(t, int64(77), field.GetID())
+			assert.Equal(t, string(tc.input.Name), field.GetName())
+		})
+	}
+}
+
+func Test_UpdateProjectField(t *testing.T) {
+	tool, _ := UpdateProjectField(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_project_field", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "field"})
+
+	fields := []map[string]any{
+		{"id": 20, "node_id": "PVTF_20", "name": "Notes", "data_type": "text"},
+		{"id": 21, "node_id": "PVTSSF_21", "name": "Status", "data_type": "single_select", "options": []map[string]any{
+			{"id": "a1", "name": map[string]any{"raw": "Todo"}, "color": "GRAY"},
+			{"id": "b2", "name": map[string]any{"raw": "Done"}, "color": "GREEN", "description": map[string]any{"raw": "Shipped"}},
+		}},
+	}
+	mutation := struct {
+		UpdateProjectV2Field projectFieldMutationResult `graphql:"updateProjectV2Field(input: $input)"`
+	}{}
+
+	tests := []struct {
+		name           string
+		projectNumber  int
+		requestArgs    map[string]any
+		input          UpdateProjectV2FieldInput
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:          "renames field",
+			projectNumber: 903,
+			requestArgs:   map[string]any{"field": "notes", "name": "Remarks"},
+			input:         UpdateProjectV2FieldInput{FieldID: "PVTF_20", Name: githubv4.NewString("Remarks")},
+		},
+		{
+			name:          "adds and removes options",
+			projectNumber: 904,
+			requestArgs: map[string]any{
+				"field":          "21",
+				"add_options":    []any{map[string]any{"name": "Blocked", "color": "RED"}},
+				"remove_options": []any{"Todo"},
+			},
+			input: UpdateProjectV2FieldInput{
+				FieldID: "PVTSSF_21",
+				SingleSelectOptions: &[]projectV2SingleSelectFieldOptionInput{
+					{ID: githubv4.NewString("b2"), Name: "Done", Color: "GREEN", Description: "Shipped"},
+					{Name: "Blocked", Color: "RED"},
+				},
+			},
+		},
+		{
+			name:           "options on text field",
+			projectNumber:  905,
+			requestArgs:    map[string]any{"field": "Notes", "remove_options": []any{"Todo"}},
+			expectError:    true,
+			expectedErrMsg: `project field "Notes" is a text field`,
+		},
+		{
+			name:           "field not found",
+			projectNumber:  905,
+			requestArgs:    map[string]any{"field": "Estimate", "name": "Points"},
+			expectError:    true,
+			expectedErrMsg: `project field "Estimate" not found`,
+		},
+		{
+			name:           "nothing to update",
+			projectNumber:  905,
+			requestArgs:    map[string]any{"field": "Notes"},
+			expectError:    true,
+			expectedErrMsg: "at least one of name, add_options or remove_options must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			restClient := mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, fields),
+				),
+			)
+			gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(mutation, tc.input, nil,
+				githubv4mock.DataResponse(map[string]any{
+					"updateProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 21}},
+				}),
+			))
+			_, handler := UpdateProjectField(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "octo-org", "owner_type": "org", "project_number": float64(tc.projectNumber)}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_DeleteProjectField(t *testing.T) {
+	tool, _ := DeleteProjectField(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_project_field", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "field"})
+
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.EndpointPattern{Pattern: "/users/{username}/projectsV2/{project}/fields", Method: http.MethodGet},
+			mockResponse(t, http.StatusOK, []map[string]any{
+				{"id": 30, "node_id": "PVTF_30", "name": "Estimate", "data_type": "number"},
+			}),
+		),
+	)
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+		struct {
+			DeleteProjectV2Field projectFieldMutationResult `graphql:"deleteProjectV2Field(input: $input)"`
+		}{},
+		githubv4.DeleteProjectV2FieldInput{FieldID: githubv4.ID("PVTF_30")},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"deleteProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 30}},
+		}),
+	))
+	_, handler := DeleteProjectField(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":          "octocat",
+		"owner_type":     "user",
+		"project_number": float64(906),
+		"field":          "estimate",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+	assert.Equal(t, `project field "Estimate" successfully deleted`, getTextResult(t, result).Text)
+
+	result, err = handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":          "octocat",
+		"owner_type":     "user",
+		"project_number": float64(906),
+		"field":          "Priority",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, `project field "Priority" not found`)
+}
@@ -0,0 +1,341 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectLinkResult is the result of linking a project to, or unlinking it from, a repository or team.
+type ProjectLinkResult struct {
+	ProjectNumber int    `json:"project_number"`
+	Repository    string `json:"repository,omitempty"`
+	Team          string `json:"team,omitempty"`
+	Linked        bool   `json:"linked"`
+}
+
+// ProjectLinkedRepository is a repository a project is linked to.
+type ProjectLinkedRepository struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ProjectLinkedTeam is a team a project is linked to.
+type ProjectLinkedTeam struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ProjectLinksResult is the repositories and teams a project is linked to.
+type ProjectLinksResult struct {
+	Repositories []ProjectLinkedRepository `json:"repositories"`
+	Teams        []ProjectLinkedTeam       `json:"teams"`
+}
+
+// projectLinkMutationResult is the payload of the mutations linking and unlinking projects.
+type projectLinkMutationResult struct {
+	ClientMutationID githubv4.String
+}
+
+// projectLinksQuery selects the repositories and teams a project is linked to.
+type projectLinksQuery struct {
+	Repositories struct {
+		Nodes []struct {
+			NameWithOwner githubv4.String
+			URL           githubv4.String `graphql:"url"`
+		}
+	} `graphql:"repositories(first: 100)"`
+	Teams struct {
+		Nodes []struct {
+			Slug githubv4.String
+			Name githubv4.String
+			URL  githubv4.String `graphql:"url"`
+		}
+	} `graphql:"teams(first: 100)"`
+}
+
+// LinkProjectToRepository creates a tool to link a project to a repository of its owner, or to remove the link.
+func LinkProjectToRepository(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("link_project_to_repository",
+			mcp.WithDescription(t("TOOL_LINK_PROJECT_TO_REPOSITORY_DESCRIPTION", "Link a Project for a user or org to a repository of the same owner, so that it is listed in the repository's Projects tab, or remove the link with unlink. Use list_project_links to see which repositories a project is linked to.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LINK_PROJECT_TO_REPOSITORY_USER_TITLE", "Link project to repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[ProjectLinkResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive. The repository must belong to this owner."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository to link the project to."),
+			),
+			mcp.WithBoolean("unlink",
+				mcp.Description("Remove the link between the project and the repository instead of creating it."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](req, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unlink, err := OptionalParam[bool](req, "unlink")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			projectID, repositoryID := githubv4.ID(project.GetNodeID()), githubv4.ID(repository.GetNodeID())
+			action := "link project to"
+			if unlink {
+				action = "unlink project from"
+				var mutation struct {
+					UnlinkProjectV2FromRepository projectLinkMutationResult `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.UnlinkProjectV2FromRepositoryInput{ProjectID: projectID, RepositoryID: repositoryID}, nil)
+			} else {
+				var mutation struct {
+					LinkProjectV2ToRepository projectLinkMutationResult `graphql:"linkProjectV2ToRepository(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.LinkProjectV2ToRepositoryInput{ProjectID: projectID, RepositoryID: repositoryID}, nil)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to %s repository", action),
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(ProjectLinkResult{
+				ProjectNumber: projectNumber,
+				Repository:    repository.GetFullName(),
+				Linked:        !unlink,
+			}), nil
+		}
+}
+
+// LinkProjectToTeam creates a tool to link an organization project to a team of the organization, or to remove the
+// link.
+func LinkProjectToTeam(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("link_project_to_team",
+			mcp.WithDescription(t("TOOL_LINK_PROJECT_TO_TEAM_DESCRIPTION", "Link a Project of an organization to a team of the organization, so that it is listed in the team's Projects tab, or remove the link with unlink. Use list_project_links to see which teams a project is linked to.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LINK_PROJECT_TO_TEAM_USER_TITLE", "Link project to team"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[ProjectLinkResult](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("The organization owning the project and the team. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team to link the project to."),
+			),
+			mcp.WithBoolean("unlink",
+				mcp.Description("Remove the link between the project and the team instead of creating it."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](req, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](req, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unlink, err := OptionalParam[bool](req, "unlink")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, "org", org, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			team, resp, err := client.Teams.GetTeamBySlug(ctx, org, teamSlug)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get team",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			projectID, teamID := githubv4.ID(project.GetNodeID()), githubv4.ID(team.GetNodeID())
+			action := "link project to"
+			if unlink {
+				action = "unlink project from"
+				var mutation struct {
+					UnlinkProjectV2FromTeam projectLinkMutationResult `graphql:"unlinkProjectV2FromTeam(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.UnlinkProjectV2FromTeamInput{ProjectID: projectID, TeamID: teamID}, nil)
+			} else {
+				var mutation struct {
+					LinkProjectV2ToTeam projectLinkMutationResult `graphql:"linkProjectV2ToTeam(input: $input)"`
+				}
+				err = gqlClient.Mutate(ctx, &mutation, githubv4.LinkProjectV2ToTeamInput{ProjectID: projectID, TeamID: teamID}, nil)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to %s team", action),
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(ProjectLinkResult{
+				ProjectNumber: projectNumber,
+				Team:          fmt.Sprintf("%s/%s", org, team.GetSlug()),
+				Linked:        !unlink,
+			}), nil
+		}
+}
+
+// ListProjectLinks creates a tool to list the repositories and teams a project is linked to.
+func ListProjectLinks(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_links",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_LINKS_DESCRIPTION", "List the repositories and teams a Project for a user or org is linked to, which list the project in their Projects tab.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_LINKS_USER_TITLE", "List project links"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectLinksResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, err := queryProjectV2[projectLinksQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list project links",
+					err,
+				), nil
+			}
+
+			result := ProjectLinksResult{
+				Repositories: []ProjectLinkedRepository{},
+				Teams:        []ProjectLinkedTeam{},
+			}
+			for _, node := range project.Repositories.Nodes {
+				result.Repositories = append(result.Repositories, ProjectLinkedRepository{
+					Name: string(node.NameWithOwner),
+					URL:  string(node.URL),
+				})
+			}
+			for _, node := range project.Teams.Nodes {
+				result.Teams = append(result.Teams, ProjectLinkedTeam{
+					Slug: string(node.Slug),
+					Name: string(node.Name),
+					URL:  string(node.URL),
+				})
+			}
+			return MarshalledStructuredResult(result), nil
+		}
+}
@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LinkProjectToRepository(t *testing.T) {
+	tool, _ := LinkProjectToRepository(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "repo"})
+
+	restClient := func() *http.Client {
+		return mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+				map[string]any{"id": 7, "node_id": "PVT_7"},
+			),
+			mock.WithRequestMatch(mock.GetReposByOwnerByRepo, map[string]any{"node_id": "R_1", "full_name": "octo-org/web"}),
+		)
+	}
+
+	tests := []struct {
+		name      string
+		unlink    bool
+		gqlClient *http.Client
+	}{
+		{
+			name: "links repository",
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				struct {
+					LinkProjectV2ToRepository projectLinkMutationResult `graphql:"linkProjectV2ToRepository(input: $input)"`
+				}{},
+				githubv4.LinkProjectV2ToRepositoryInput{ProjectID: githubv4.ID("PVT_7"), RepositoryID: githubv4.ID("R_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"linkProjectV2ToRepository": map[string]any{"clientMutationId": ""}}),
+			)),
+		},
+		{
+			name:   "unlinks repository",
+			unlink: true,
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				struct {
+					UnlinkProjectV2FromRepository projectLinkMutationResult `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+				}{},
+				githubv4.UnlinkProjectV2FromRepositoryInput{ProjectID: githubv4.ID("PVT_7"), RepositoryID: githubv4.ID("R_1")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"unlinkProjectV2FromRepository": map[string]any{"clientMutationId": ""}}),
+			)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := LinkProjectToRepository(stubGetClientFn(gh.NewClient(restClient())), stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner_type":     "org",
+				"owner":          "octo-org",
+				"project_number": float64(7),
+				"repo":           "web",
+				"unlink":         tc.unlink,
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response ProjectLinkResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, ProjectLinkResult{ProjectNumber: 7, Repository: "octo-org/web", Linked: !tc.unlink}, response)
+		})
+	}
+}
+
+func Test_LinkProjectToTeam(t *testing.T) {
+	tool, _ := LinkProjectToTeam(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "project_number", "team_slug"})
+
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+			map[string]any{"id": 7, "node_id": "PVT_7"},
+		),
+		mock.WithRequestMatch(mock.GetOrgsTeamsByOrgByTeamSlug, map[string]any{"node_id": "T_1", "slug": "platform"}),
+	)
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+		struct {
+			LinkProjectV2ToTeam projectLinkMutationResult `graphql:"linkProjectV2ToTeam(input: $input)"`
+		}{},
+		githubv4.LinkProjectV2ToTeamInput{ProjectID: githubv4.ID("PVT_7"), TeamID: githubv4.ID("T_1")},
+		nil,
+		githubv4mock.DataResponse(map[string]any{"linkProjectV2ToTeam": map[string]any{"clientMutationId": ""}}),
+	))
+
+	_, handler := LinkProjectToTeam(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":            "octo-org",
+		"project_number": float64(7),
+		"team_slug":      "platform",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response ProjectLinkResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, ProjectLinkResult{ProjectNumber: 7, Team: "octo-org/platform", Linked: true}, response)
+}
+
+func Test_LinkProjectToTeam_TeamNotFound(t *testing.T) {
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+			map[string]any{"id": 7, "node_id": "PVT_7"},
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsTeamsByOrgByTeamSlug,
+			mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+		),
+	)
+	_, handler := LinkProjectToTeam(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"org":            "octo-org",
+		"project_number": float64(7),
+		"team_slug":      "missing",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "failed to get team")
+}
+
+func Test_ListProjectLinks(t *testing.T) {
+	tool, _ := ListProjectLinks(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectLinksQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"repositories": map[string]any{"nodes": []any{
+				map[string]any{"nameWithOwner": "octo-org/web", "url": "https://github.com/octo-org/web"},
+			}},
+			"teams": map[string]any{"nodes": []any{
+				map[string]any{"slug": "platform", "name": "Platform", "url": "https://github.com/orgs/octo-org/teams/platform"},
+			}},
+		}}}),
+	))
+	_, handler := ListProjectLinks(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner_type":     "org",
+		"owner":          "octo-org",
+		"project_number": float64(7),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response ProjectLinksResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, ProjectLinksResult{
+		Repositories: []ProjectLinkedRepository{{Name: "octo-org/web", URL: "https://github.com/octo-org/web"}},
+		Teams:        []ProjectLinkedTeam{{Slug: "platform", Name: "Platform", URL: "https://github.com/orgs/octo-org/teams/platform"}},
+	}, response)
+}
@@ -0,0 +1,399 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	MirrorActionAdded     = "added"
+	MirrorActionUpdated   = "updated"
+	MirrorActionUnchanged = "unchanged"
+	MirrorActionFailed    = "failed"
+)
+
+// mirrorableFieldTypes are the data types of fields whose values can be mirrored between projects.
+var mirrorableFieldTypes = map[string]bool{
+	"text":          true,
+	"number":        true,
+	"date":          true,
+	"single_select": true,
+	"iteration":     true,
+}
+
+// contentRefsQuery loads the URL and database ID of a batch of issues and pull requests, given by node ID.
+type contentRefsQuery struct {
+	Nodes []struct {
+		Typename githubv4.String `graphql:"__typename"`
+		Issue    struct {
+			ID         githubv4.ID
+			DatabaseID githubv4.Int    `graphql:"databaseId"`
+			URL        githubv4.String `graphql:"url"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID         githubv4.ID
+			DatabaseID githubv4.Int    `graphql:"databaseId"`
+			URL        githubv4.String `graphql:"url"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// contentRef identifies the issue or pull request of a project item.
+type contentRef struct {
+	typename   string
+	databaseID int64
+	url        string
+}
+
+// MirroredItem is the result of mirroring an item to the target project.
+type MirroredItem struct {
+	Content       string   `json:"content"`
+	SourceItemID  int64    `json:"source_item_id"`
+	TargetItemID  int64    `json:"target_item_id,omitempty"`
+	Action        string   `json:"action"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	Warnings      []string `json:"warnings,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// mirroredField is a field mirrored from the source to the target project.
+type mirroredField struct {
+	source *github.ProjectV2Field
+	target *github.ProjectV2Field
+}
+
+// loadContentRefs returns the URL and database ID of issues and pull requests, keyed by node ID.
+func loadContentRefs(ctx context.Context, client *githubv4.Client, nodeIDs []string) (map[string]contentRef, error) {
+	refs := make(map[string]contentRef, len(nodeIDs))
+	for start := 0; start < len(nodeIDs); start += maxNodesPerQuery {
+		ids := make([]githubv4.ID, 0, maxNodesPerQuery)
+		for _, id := range nodeIDs[start:min(start+maxNodesPerQuery, len(nodeIDs))] {
+			ids = append(ids, githubv4.ID(id))
+		}
+		var q contentRefsQuery
+		if err := client.Query(ctx, &q, map[string]any{"ids": ids}); err != nil {
+			return nil, err
+		}
+		for _, node := range q.Nodes {
+			id, databaseID, url := node.Issue.ID, node.Issue.DatabaseID, node.Issue.URL
+			if node.Typename == "PullRequest" {
+				id, databaseID, url = node.PullRequest.ID, node.PullRequest.DatabaseID, node.PullRequest.URL
+			}
+			refs[fmt.Sprint(id)] = contentRef{typename: string(node.Typename), databaseID: int64(databaseID), url: string(url)}
+		}
+	}
+	return refs, nil
+}
+
+// mirrorFieldValue converts the value of a field of a source item to the value to set in the target field. Options
+// and iterations are matched by name, as their IDs differ between projects.
+func mirrorFieldValue(value any, target *github.ProjectV2Field) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch target.GetDataType() {
+	case "single_select":
+		name := selectedOptionName(value)
+		if name == "" {
+			return nil, nil
+		}
+		option := findFieldOption(target, name)
+		if option == nil {
+			return nil, fmt.Errorf("option %q does not exist in field %q of the target project", name, target.GetName())
+		}
+		return option.GetID(), nil
+	case "iteration":
+		iteration, ok := iterationValue(value)
+		if !ok {
+			return nil, nil
+		}
+		for _, candidate := range target.GetConfiguration().Iterations {
+			if strings.EqualFold(candidate.GetTitle().GetRaw(), iteration.Title) {
+				return candidate.GetID(), nil
+			}
+		}
+		return nil, fmt.Errorf("iteration %q does not exist in field %q of the target project", iteration.Title, target.GetName())
+	default:
+		return value, nil
+	}
+}
+
+// currentFieldValue converts the value of a field of a target item to the form it is set in.
+func currentFieldValue(value any, field *github.ProjectV2Field) any {
+	switch field.GetDataType() {
+	case "single_select", "iteration":
+		if obj, ok := value.(map[string]any); ok {
+			return obj["id"]
+		}
+		return nil
+	default:
+		return value
+	}
+}
+
+// MirrorProject creates a tool to sync the items and selected field values of a project to another project.
+func MirrorProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mirror_project",
+			mcp.WithDescription(t("TOOL_MIRROR_PROJECT_DESCRIPTION", "One-way sync of the issues and pull requests of a source project, and the values of selected fields, to a target project, e.g. a portfolio board in another organization. Items are matched by the URL of their issue or pull request. Missing items are added to the target project and field values are only written when they differ, so the sync can be run repeatedly. Fields are matched by name, and single select options and iterations by name or title. Items are never removed from the target project.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MIRROR_PROJECT_USER_TITLE", "Mirror project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("source_owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the source project"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("source_owner",
+				mcp.Required(),
+				mcp.Description("User or organization owning the source project"),
+			),
+			mcp.WithNumber("source_project_number",
+				mcp.Required(),
+				mcp.Description("The source project's number"),
+			),
+			mcp.WithString("target_owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the target project"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("target_owner",
+				mcp.Required(),
+				mcp.Description("User or organization owning the target project"),
+			),
+			mcp.WithNumber("target_project_number",
+				mcp.Required(),
+				mcp.Description("The target project's number"),
+			),
+			mcp.WithArray("fields",
+				mcp.Description("Names of the fields whose values to mirror, e.g. [\"Status\", \"Iteration\"]. The fields must exist with the same name and type in both projects. Only items are mirrored if omitted."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Only report the changes that would be made, without updating the target project"),
+				mcp.DefaultBool(false),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			sourceOwnerType, err := RequiredParam[string](req, "source_owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sourceOwner, err := RequiredParam[string](req, "source_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sourceProjectNumber, err := RequiredInt(req, "source_project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwnerType, err := RequiredParam[string](req, "target_owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwner, err := RequiredParam[string](req, "target_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetProjectNumber, err := RequiredInt(req, "target_project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldNames, err := OptionalStringArrayParam(req, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(req, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			var mirrored []mirroredField
+			if len(fieldNames) > 0 {
+				sourceFields, err := projectFieldCache.Fields(ctx, client, sourceOwnerType, sourceOwner, sourceProjectNumber, false)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				targetFields, err := projectFieldCache.Fields(ctx, client, targetOwnerType, targetOwner, targetProjectNumber, false)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				for _, name := range fieldNames {
+					source := findProjectField(sourceFields, name)
+					target := findProjectField(targetFields, name)
+					switch {
+					case source == nil:
+						return mcp.NewToolResultError(fmt.Sprintf("project field %q not found in the source project", name)), nil
+					case target == nil:
+						return mcp.NewToolResultError(fmt.Sprintf("project field %q not found in the target project", name)), nil
+					case source.GetDataType() != target.GetDataType():
+						return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field in the source project but a %s field in the target project", name, source.GetDataType(), target.GetDataType())), nil
+					case !mirrorableFieldTypes[source.GetDataType()]:
+						return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field, which cannot be mirrored", name, source.GetDataType())), nil
+					}
+					mirrored = append(mirrored, mirroredField{source: source, target: target})
+				}
+			}
+			sourceFieldIDs := make([]int64, 0, len(mirrored))
+			targetFieldIDs := make([]int64, 0, len(mirrored))
+			for _, f := range mirrored {
+				sourceFieldIDs = append(sourceFieldIDs, f.source.GetID())
+				targetFieldIDs = append(targetFieldIDs, f.target.GetID())
+			}
+
+			sourceItems, resp, err := listAllProjectItems(ctx, client, sourceOwnerType, sourceOwner, sourceProjectNumber, sourceFieldIDs, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list source project items",
+					resp,
+					err,
+				), nil
+			}
+			targetItems, resp, err := listAllProjectItems(ctx, client, targetOwnerType, targetOwner, targetProjectNumber, targetFieldIDs, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list target project items",
+					resp,
+					err,
+				), nil
+			}
+
+			// Draft issues only exist within a project, so they cannot be matched or added to another project.
+			var nodeIDs []string
+			seen := map[string]bool{}
+			for _, item := range append(append([]*github.ProjectV2Item{}, sourceItems...), targetItems...) {
+				id := item.GetContentNodeID()
+				if item.GetContentType() == "DraftIssue" || id == "" || seen[id] {
+					continue
+				}
+				seen[id] = true
+				nodeIDs = append(nodeIDs, id)
+			}
+			refs, err := loadContentRefs(ctx, gqlClient, nodeIDs)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get issues and pull requests",
+					err,
+				), nil
+			}
+
+			targetByURL := map[string]*github.ProjectV2Item{}
+			for _, item := range targetItems {
+				if ref, ok := refs[item.GetContentNodeID()]; ok {
+					targetByURL[ref.url] = item
+				}
+			}
+
+			results := []MirroredItem{}
+			counts := map[string]int{}
+			skipped := 0
+			for _, item := range sourceItems {
+				ref, ok := refs[item.GetContentNodeID()]
+				if !ok || item.GetContentType() == "DraftIssue" {
+					skipped++
+					continue
+				}
+				result := MirroredItem{Content: ref.url, SourceItemID: item.GetID(), Action: MirrorActionUnchanged}
+
+				target := targetByURL[ref.url]
+				if target == nil {
+					result.Action = MirrorActionAdded
+					if !dryRun {
+						options := &github.AddProjectItemOptions{Type: ref.typename, ID: ref.databaseID}
+						var added *github.ProjectV2Item
+						var resp *github.Response
+						if targetOwnerType == "org" {
+							added, resp, err = client.Projects.AddOrganizationProjectItem(ctx, targetOwner, targetProjectNumber, options)
+						} else {
+							added, resp, err = client.Projects.AddUserProjectItem(ctx, targetOwner, targetProjectNumber, options)
+						}
+						if err != nil {
+							_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, ProjectAddFailedError, resp, err)
+							result.Action = MirrorActionFailed
+							result.Error = err.Error()
+							results = append(results, result)
+							counts[result.Action]++
+							continue
+						}
+						_ = resp.Body.Close()
+						result.TargetItemID = added.GetID()
+					}
+					target = &github.ProjectV2Item{}
+				} else {
+					result.TargetItemID = target.GetID()
+				}
+
+				var updates []*github.UpdateProjectV2Field
+				for _, f := range mirrored {
+					want, err := mirrorFieldValue(itemFieldValue(item, f.source.GetID()), f.target)
+					if err != nil {
+						result.Warnings = append(result.Warnings, err.Error())
+						continue
+					}
+					if fmt.Sprint(want) == fmt.Sprint(currentFieldValue(itemFieldValue(target, f.target.GetID()), f.target)) {
+						continue
+					}
+					updates = append(updates, &github.UpdateProjectV2Field{ID: f.target.GetID(), Value: want})
+					result.ChangedFields = append(result.ChangedFields, f.target.GetName())
+				}
+				if len(updates) > 0 {
+					if result.Action == MirrorActionUnchanged {
+						result.Action = MirrorActionUpdated
+					}
+					if !dryRun {
+						update := &github.UpdateProjectItemOptions{Fields: updates}
+						var resp *github.Response
+						if targetOwnerType == "org" {
+							_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, targetOwner, targetProjectNumber, result.TargetItemID, update)
+						} else {
+							_, resp, err = client.Projects.UpdateUserProjectItem(ctx, targetOwner, targetProjectNumber, result.TargetItemID, update)
+						}
+						if err != nil {
+							_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, ProjectUpdateFailedError, resp, err)
+							result.Action = MirrorActionFailed
+							result.Error = err.Error()
+						} else {
+							_ = resp.Body.Close()
+						}
+					}
+				}
+
+				counts[result.Action]++
+				if result.Action != MirrorActionUnchanged || len(result.Warnings) > 0 {
+					results = append(results, result)
+				}
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"dry_run":       dryRun,
+				"added":         counts[MirrorActionAdded],
+				"updated":       counts[MirrorActionUpdated],
+				"unchanged":     counts[MirrorActionUnchanged],
+				"failed":        counts[MirrorActionFailed],
+				"skipped_items": skipped,
+				"items":         results,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MirrorFieldValue(t *testing.T) {
+	target := &github.ProjectV2Field{
+		Name:     github.Ptr("Iteration"),
+		DataType: github.Ptr("iteration"),
+		Configuration: &github.ProjectV2FieldConfiguration{
+			Iterations: []*github.ProjectV2FieldIteration{
+				{ID: github.Ptr("t-it1"), Title: &github.ProjectV2TextContent{Raw: github.Ptr("Sprint 1")}},
+			},
+		},
+	}
+	value, err := mirrorFieldValue(map[string]any{"id": "s-it1", "title": "sprint 1"}, target)
+	require.NoError(t, err)
+	assert.Equal(t, "t-it1", value)
+
+	_, err = mirrorFieldValue(map[string]any{"id": "s-it2", "title": "Sprint 2"}, target)
+	assert.EqualError(t, err, `iteration "Sprint 2" does not exist in field "Iteration" of the target project`)
+
+	value, err = mirrorFieldValue(nil, target)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test_MirrorProject(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := MirrorProject(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "mirror_project", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"source_owner_type", "source_owner", "source_project_number", "target_owner_type", "target_owner", "target_project_number"})
+
+	statusField := func(id int, prefix string, options ...string) map[string]any {
+		opts := []any{}
+		for _, name := range options {
+			opts = append(opts, map[string]any{"id": prefix + strings.ToLower(name), "name": map[string]any{"raw": name}})
+		}
+		return map[string]any{"id": id, "name": "Status", "data_type": "single_select", "options": opts}
+	}
+	sourceFields := []any{statusField(101, "s-", "Todo", "Blocked", "Done"), map[string]any{"id": 102, "name": "Notes", "data_type": "text"}}
+	targetFields := []any{statusField(201, "t-", "Todo", "Done"), map[string]any{"id": 202, "name": "Notes", "data_type": "text"}}
+
+	item := func(id int64, contentType, nodeID string, statusID int, status string, notesID int, notes any) map[string]any {
+		var statusValue any
+		if status != "" {
+			statusValue = map[string]any{"id": "x-" + strings.ToLower(status), "name": map[string]any{"raw": status}}
+			if statusID == 201 {
+				statusValue.(map[string]any)["id"] = "t-" + strings.ToLower(status)
+			}
+		}
+		return map[string]any{
+			"id":              id,
+			"content_type":    contentType,
+			"content_node_id": nodeID,
+			"fields": []any{
+				map[string]any{"id": statusID, "data_type": "single_select", "value": statusValue},
+				map[string]any{"id": notesID, "data_type": "text", "value": notes},
+			},
+		}
+	}
+	sourceItems := []any{
+		item(1, "Issue", "I_1", 101, "Done", 102, "a"),
+		item(2, "Issue", "I_2", 101, "Todo", 102, "b"),
+		item(3, "PullRequest", "PR_1", 101, "Blocked", 102, "c"),
+		item(4, "DraftIssue", "DI_1", 101, "Todo", 102, nil),
+	}
+	targetItems := []any{
+		item(91, "Issue", "I_1", 201, "Done", 202, "a"),
+		item(92, "Issue", "I_2", 201, "Done", 202, nil),
+	}
+	byOrg := func(src, dst any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/orgs/src/") {
+				mockResponse(t, http.StatusOK, src).ServeHTTP(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, dst).ServeHTTP(w, r)
+		}
+	}
+	newGQLClient := func() *githubv4.Client {
+		// The query is constructed from the typed IDs, but the request variables are compared in their decoded JSON form.
+		matcher := githubv4mock.NewQueryMatcher(contentRefsQuery{}, map[string]any{"ids": []githubv4.ID{"I_1"}}, githubv4mock.DataResponse(map[string]any{
+			"nodes": []any{
+				map[string]any{"__typename": "Issue", "id": "I_1", "databaseId": 301, "url": "https://github.com/src/repo/issues/1"},
+				map[string]any{"__typename": "Issue", "id": "I_2", "databaseId": 302, "url": "https://github.com/src/repo/issues/2"},
+				map[string]any{"__typename": "PullRequest", "id": "PR_1", "databaseId": 303, "url": "https://github.com/src/repo/pull/3"},
+			},
+		}))
+		matcher.Variables = map[string]any{"ids": []any{"I_1", "I_2", "PR_1"}}
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+	}
+	requests := map[string]string{}
+	newClient := func() *github.Client {
+		return github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+				byOrg(sourceFields, targetFields),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+				byOrg(sourceItems, targetItems),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodPost},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body, _ := io.ReadAll(r.Body)
+					requests["POST "+r.URL.Path] = string(body)
+					mockResponse(t, http.StatusCreated, map[string]any{"id": 93}).ServeHTTP(w, r)
+				}),
+			),
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body, _ := io.ReadAll(r.Body)
+					requests["PATCH "+r.URL.Path] = string(body)
+					mockResponse(t, http.StatusOK, map[string]any{"id": 1}).ServeHTTP(w, r)
+				}),
+			),
+		))
+	}
+	args := func(projectNumber float64, dryRun bool) map[string]interface{} {
+		return map[string]interface{}{
+			"source_owner_type":     "org",
+			"source_owner":          "src",
+			"source_project_number": projectNumber,
+			"target_owner_type":     "org",
+			"target_owner":          "dst",
+			"target_project_number": projectNumber + 1,
+			"fields":                []any{"Status", "notes"},
+			"dry_run":               dryRun,
+		}
+	}
+	var response struct {
+		Added        int            `json:"added"`
+		Updated      int            `json:"updated"`
+		Unchanged    int            `json:"unchanged"`
+		SkippedItems int            `json:"skipped_items"`
+		Items        []MirroredItem `json:"items"`
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		_, handler := MirrorProject(stubGetClientFn(newClient()), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(args(801, true)))
+		require.NoError(t, err)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 1, response.Added)
+		assert.Equal(t, 1, response.Updated)
+		assert.Equal(t, 1, response.Unchanged)
+		assert.Equal(t, 1, response.SkippedItems)
+		assert.Equal(t, []MirroredItem{
+			{Content: "https://github.com/src/repo/issues/2", SourceItemID: 2, TargetItemID: 92, Action: MirrorActionUpdated, ChangedFields: []string{"Status", "Notes"}},
+			{Content: "https://github.com/src/repo/pull/3", SourceItemID: 3, Action: MirrorActionAdded, ChangedFields: []string{"Notes"},
+				Warnings: []string{`option "Blocked" does not exist in field "Status" of the target project`}},
+		}, response.Items)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("adds and updates items", func(t *testing.T) {
+		_, handler := MirrorProject(stubGetClientFn(newClient()), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(args(803, false)))
+		require.NoError(t, err)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, int64(93), response.Items[1].TargetItemID)
+		assert.Equal(t, map[string]string{
+			"POST /orgs/dst/projectsV2/804/items":     `{"type":"PullRequest","id":303}` + "\n",
+			"PATCH /orgs/dst/projectsV2/804/items/92": `{"fields":[{"id":201,"value":"t-todo"},{"id":202,"value":"b"}]}` + "\n",
+			"PATCH /orgs/dst/projectsV2/804/items/93": `{"fields":[{"id":202,"value":"c"}]}` + "\n",
+		}, requests)
+	})
+
+	t.Run("field missing in target project", func(t *testing.T) {
+		_, handler := MirrorProject(stubGetClientFn(newClient()), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		request := args(805, true)
+		request["fields"] = []any{"Estimate"}
+		result, err := handler(context.Background(), createMCPRequest(request))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `project field "Estimate" not found in the source project`)
+	})
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectItemPositionResult is the result of moving a project item.
+type ProjectItemPositionResult struct {
+	ItemID      int64 `json:"item_id"`
+	AfterItemID int64 `json:"after_item_id,omitempty"`
+	MovedToTop  bool  `json:"moved_to_top"`
+}
+
+// UpdateProjectItemPosition creates a tool to move an item of a project, setting its position in views sorted manually.
+func UpdateProjectItemPosition(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_item_position",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_POSITION_DESCRIPTION", "Move a Project item for a user or org, e.g. to the top of the backlog. The item is placed right after after_item_id, or at the top of the project if after_item_id is omitted. The position is used by views that are not sorted by a field.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_ITEM_POSITION_USER_TITLE", "Update project item position"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[ProjectItemPositionResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The internal project item ID of the item to move."),
+			),
+			mcp.WithNumber("after_item_id",
+				mcp.Description("The internal project item ID of the item to place the moved item after. Omit to move the item to the top."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredBigInt(req, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			afterItem, err := OptionalParam[float64](req, "after_item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			afterItemID := int64(afterItem)
+			if afterItemID == itemID {
+				return mcp.NewToolResultError("after_item_id must differ from item_id"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project item",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			input := githubv4.UpdateProjectV2ItemPositionInput{
+				ProjectID: githubv4.ID(item.GetProjectNodeID()),
+				ItemID:    githubv4.ID(item.GetNodeID()),
+			}
+			if afterItemID != 0 {
+				after, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, afterItemID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get project item to place the item after",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				afterID := githubv4.ID(after.GetNodeID())
+				input.AfterID = &afterID
+			}
+
+			var mutation struct {
+				UpdateProjectV2ItemPosition struct {
+					ClientMutationID githubv4.String
+				} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to update project item position",
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(ProjectItemPositionResult{
+				ItemID:      itemID,
+				AfterItemID: afterItemID,
+				MovedToTop:  afterItemID == 0,
+			}), nil
+		}
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateProjectItemPosition(t *testing.T) {
+	tool, _ := UpdateProjectItemPosition(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_id"})
+
+	mutation := struct {
+		UpdateProjectV2ItemPosition struct {
+			ClientMutationID githubv4.String
+		} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+	}{}
+	items := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodGet},
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/orgs/octo-org/projectsV2/7/items/301":
+				_, _ = w.Write(mock.MustMarshal(map[string]any{"id": 301, "node_id": "PVTI_301", "project_node_id": "PVT_7"}))
+			case "/orgs/octo-org/projectsV2/7/items/302":
+				_, _ = w.Write(mock.MustMarshal(map[string]any{"id": 302, "node_id": "PVTI_302", "project_node_id": "PVT_7"}))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write(mock.MustMarshal(map[string]string{"message": "Not Found"}))
+			}
+		}),
+	)
+	afterID := githubv4.ID("PVTI_302")
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		gqlClient      *http.Client
+		expected       ProjectItemPositionResult
+		expectedErrMsg string
+	}{
+		{
+			name: "moves item after another",
+			args: map[string]any{"after_item_id": float64(302)},
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.UpdateProjectV2ItemPositionInput{ProjectID: githubv4.ID("PVT_7"), ItemID: githubv4.ID("PVTI_301"), AfterID: &afterID},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"updateProjectV2ItemPosition": map[string]any{"clientMutationId": ""}}),
+			)),
+			expected: ProjectItemPositionResult{ItemID: 301, AfterItemID: 302},
+		},
+		{
+			name: "moves item to top",
+			args: map[string]any{},
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+				mutation,
+				githubv4.UpdateProjectV2ItemPositionInput{ProjectID: githubv4.ID("PVT_7"), ItemID: githubv4.ID("PVTI_301")},
+				nil,
+				githubv4mock.DataResponse(map[string]any{"updateProjectV2ItemPosition": map[string]any{"clientMutationId": ""}}),
+			)),
+			expected: ProjectItemPositionResult{ItemID: 301, MovedToTop: true},
+		},
+		{
+			name:           "item to place after not found",
+			args:           map[string]any{"after_item_id": float64(999)},
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "failed to get project item to place the item after",
+		},
+		{
+			name:           "item after itself",
+			args:           map[string]any{"after_item_id": float64(301)},
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: "after_item_id must differ from item_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := UpdateProjectItemPosition(stubGetClientFn(gh.NewClient(mock.NewMockedHTTPClient(items))), stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"item_id":        float64(301),
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			assert.Equal(t, tc.expected, result.StructuredContent)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// contentStatesQuery loads the state of a batch of issues and pull requests, given by node ID.
+type contentStatesQuery struct {
+	Nodes []struct {
+		Typename githubv4.String `graphql:"__typename"`
+		Issue    struct {
+			ID    githubv4.ID
+			State githubv4.String
+			URL   githubv4.String `graphql:"url"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID    githubv4.ID
+			State githubv4.String
+			URL   githubv4.String `graphql:"url"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// BoardDrift is a project item whose status does not match the state of its issue or pull request.
+type BoardDrift struct {
+	ItemID       int64  `json:"item_id"`
+	Content      string `json:"content"`
+	ContentState string `json:"content_state"`
+	FromStatus   string `json:"from_status"`
+	ToStatus     string `json:"to_status"`
+	Applied      bool   `json:"applied"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ReconcileBoardWithRepo creates a tool to find, and optionally fix, project items whose status is out of sync with
+// the state of their issue or pull request.
+func ReconcileBoardWithRepo(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reconcile_board_with_repo",
+			mcp.WithDescription(t("TOOL_RECONCILE_BOARD_WITH_REPO_DESCRIPTION", "Find project items whose issue or pull request is closed or merged but whose status is not Done, and items marked Done whose issue or pull request is open again. Closed items are moved to the status of the 'done' transition and reopened ones to the status of the 'todo' transition, as configured for transition_project_item. Runs as a dry run by default, returning the changes that would be made.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RECONCILE_BOARD_WITH_REPO_USER_TITLE", "Reconcile project board with repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("status_field",
+				mcp.Description("ID or name of the single select field holding the status"),
+				mcp.DefaultString(DefaultStatusField),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Only report the changes that would be made, without updating any item"),
+				mcp.DefaultBool(true),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the status."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statusField, err := OptionalParam[string](req, "status_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if statusField == "" {
+				statusField = DefaultStatusField
+			}
+			dryRun, err := OptionalBoolParamWithDefault(req, "dry_run", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, statusField)
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", statusField)), nil
+			}
+			if field.GetDataType() != "single_select" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; the status must be a single select field", field.GetName(), field.GetDataType())), nil
+			}
+			transitions := flags.ProjectTransitions.forProject(ownerType, owner, projectNumber)
+			options := map[string]*github.ProjectV2FieldOption{}
+			for _, transition := range []string{"done", "todo"} {
+				if options[transition] = findFieldOption(field, transitions[transition]); options[transition] == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("transition %q maps to status %q, which is not an option of field %q; map the transition to one of the options in the project_transitions configuration for %q",
+						transition, transitions[transition], field.GetName(), projectTransitionsKey(ownerType, owner, projectNumber))), nil
+				}
+			}
+			doneOption, todoOption := options["done"], options["todo"]
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, []int64{field.GetID()}, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			// Draft issues have no state to reconcile the board with.
+			itemsByContent := map[string][]*github.ProjectV2Item{}
+			var ids []githubv4.ID
+			for _, item := range items {
+				contentType := item.GetContentType()
+				if (contentType != "Issue" && contentType != "PullRequest") || item.GetContentNodeID() == "" {
+					continue
+				}
+				if _, ok := itemsByContent[item.GetContentNodeID()]; !ok {
+					ids = append(ids, githubv4.ID(item.GetContentNodeID()))
+				}
+				itemsByContent[item.GetContentNodeID()] = append(itemsByContent[item.GetContentNodeID()], item)
+			}
+
+			drifts := []BoardDrift{}
+			for start := 0; start < len(ids); start += maxNodesPerQuery {
+				var q contentStatesQuery
+				vars := map[string]any{
+					"ids": ids[start:min(start+maxNodesPerQuery, len(ids))],
+				}
+				if err := gqlClient.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to get issue and pull request states",
+						err,
+					), nil
+				}
+				for _, node := range q.Nodes {
+					id, state, url := node.Issue.ID, node.Issue.State, node.Issue.URL
+					if node.Typename == "PullRequest" {
+						id, state, url = node.PullRequest.ID, node.PullRequest.State, node.PullRequest.URL
+					}
+					for _, item := range itemsByContent[fmt.Sprint(id)] {
+						status := singleSelectValue(item, field.GetID())
+						isDone := strings.EqualFold(status, doneOption.GetName().GetRaw())
+						drift := BoardDrift{
+							ItemID:       item.GetID(),
+							Content:      string(url),
+							ContentState: strings.ToLower(string(state)),
+							FromStatus:   status,
+						}
+						switch {
+						case state != "OPEN" && !isDone:
+							drift.ToStatus = doneOption.GetName().GetRaw()
+						case state == "OPEN" && isDone:
+							drift.ToStatus = todoOption.GetName().GetRaw()
+						default:
+							continue
+						}
+						drifts = append(drifts, drift)
+					}
+				}
+			}
+
+			if !dryRun {
+				for i, drift := range drifts {
+					option := doneOption
+					if drift.ContentState == "open" {
+						option = todoOption
+					}
+					update := &github.UpdateProjectItemOptions{
+						Fields: []*github.UpdateProjectV2Field{{ID: field.GetID(), Value: option.GetID()}},
+					}
+					var resp *github.Response
+					var err error
+					if ownerType == "org" {
+						_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, owner, projectNumber, drift.ItemID, update)
+					} else {
+						_, resp, err = client.Projects.UpdateUserProjectItem(ctx, owner, projectNumber, drift.ItemID, update)
+					}
+					if err != nil {
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, ProjectUpdateFailedError, resp, err)
+						drifts[i].Error = err.Error()
+						continue
+					}
+					_ = resp.Body.Close()
+					drifts[i].Applied = true
+				}
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"dry_run":       dryRun,
+				"checked_items": len(items),
+				"changes":       drifts,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,163 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReconcileBoardWithRepo(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReconcileBoardWithRepo(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "reconcile_board_with_repo", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	fields := []map[string]any{
+		{"id": 101, "name": "Status", "data_type": "single_select", "options": []any{
+			map[string]any{"id": "opt-todo", "name": map[string]any{"raw": "Todo"}},
+			map[string]any{"id": "opt-doing", "name": map[string]any{"raw": "In Progress"}},
+			map[string]any{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+		}},
+	}
+	contentItem := func(id int64, contentType, nodeID, status string) map[string]any {
+		item := statusItem(id, status)
+		item["content_type"] = contentType
+		item["content_node_id"] = nodeID
+		return item
+	}
+	projectItems := []any{
+		contentItem(21, "Issue", "I_1", "In Progress"),
+		contentItem(22, "PullRequest", "PR_1", "Done"),
+		contentItem(23, "Issue", "I_2", "Done"),
+		contentItem(24, "Issue", "I_3", "In Progress"),
+		contentItem(25, "DraftIssue", "DI_1", "Done"),
+		contentItem(26, "PullRequest", "PR_2", ""),
+	}
+	node := func(typename, id, state string) map[string]any {
+		return map[string]any{"__typename": typename, "id": id, "state": state, "url": "https://github.com/octo/repo/" + strings.ToLower(id)}
+	}
+	newGQLClient := func() *githubv4.Client {
+		// The query is constructed from the typed IDs, but the request variables are compared in their decoded JSON form.
+		matcher := githubv4mock.NewQueryMatcher(contentStatesQuery{}, map[string]any{"ids": []githubv4.ID{"I_1"}}, githubv4mock.DataResponse(map[string]any{
+			"nodes": []any{
+				node("Issue", "I_1", "CLOSED"),
+				node("PullRequest", "PR_1", "MERGED"),
+				node("Issue", "I_2", "OPEN"),
+				node("Issue", "I_3", "OPEN"),
+				node("PullRequest", "PR_2", "CLOSED"),
+			},
+		}))
+		matcher.Variables = map[string]any{"ids": []any{"I_1", "PR_1", "I_2", "I_3", "PR_2"}}
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+	}
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, fields),
+	)
+	itemsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, projectItems),
+	)
+
+	type change struct {
+		ItemID     int64  `json:"item_id"`
+		FromStatus string `json:"from_status"`
+		ToStatus   string `json:"to_status"`
+		Applied    bool   `json:"applied"`
+		Error      string `json:"error"`
+	}
+	var response struct {
+		DryRun  bool     `json:"dry_run"`
+		Changes []change `json:"changes"`
+	}
+
+	t.Run("dry run reports drift", func(t *testing.T) {
+		_, handler := ReconcileBoardWithRepo(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint, itemsEndpoint))), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(601),
+		}))
+		require.NoError(t, err)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.DryRun)
+		assert.Equal(t, []change{
+			{ItemID: 21, FromStatus: "In Progress", ToStatus: "Done"},
+			{ItemID: 23, FromStatus: "Done", ToStatus: "Todo"},
+			{ItemID: 26, FromStatus: "", ToStatus: "Done"},
+		}, response.Changes)
+	})
+
+	t.Run("applies changes and reports failures", func(t *testing.T) {
+		updated := map[string]string{}
+		restClient := mock.NewMockedHTTPClient(
+			fieldsEndpoint,
+			itemsEndpoint,
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					itemID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+					if itemID == "23" {
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"message": "boom"}`))
+						return
+					}
+					var body struct {
+						Fields []struct {
+							Value string `json:"value"`
+						} `json:"fields"`
+					}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					updated[itemID] = body.Fields[0].Value
+					mockResponse(t, http.StatusOK, map[string]any{"id": 1}).ServeHTTP(w, r)
+				}),
+			),
+		)
+		_, handler := ReconcileBoardWithRepo(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(602),
+			"dry_run":        false,
+		}))
+		require.NoError(t, err)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.False(t, response.DryRun)
+		require.Len(t, response.Changes, 3)
+		assert.True(t, response.Changes[0].Applied)
+		assert.False(t, response.Changes[1].Applied)
+		assert.Contains(t, response.Changes[1].Error, "boom")
+		assert.True(t, response.Changes[2].Applied)
+		assert.Equal(t, map[string]string{"21": "opt-done", "26": "opt-done"}, updated)
+	})
+
+	t.Run("done status is not an option", func(t *testing.T) {
+		flags := FeatureFlags{ProjectTransitions: ProjectTransitions{"default": {"done": "Shipped"}}}
+		_, handler := ReconcileBoardWithRepo(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint))), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper, flags)
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(603),
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `transition "done" maps to status "Shipped", which is not an option of field "Status"`)
+	})
+}
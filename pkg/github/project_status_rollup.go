@@ -0,0 +1,359 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultOverdueOption is the option set on overdue project items by compute_status_rollup.
+	DefaultOverdueOption = "Red"
+	// DefaultBlockedOption is the option set on blocked project items by compute_status_rollup.
+	DefaultBlockedOption = "Yellow"
+	// DefaultOnTrackOption is the option set on project items matching no rule by compute_status_rollup.
+	DefaultOnTrackOption = "Green"
+	// DefaultBlockedLabel is the label marking issues and pull requests as blocked.
+	DefaultBlockedLabel = "blocked"
+)
+
+// contentLabelsQuery loads the state and labels of a batch of issues and pull requests, given by node ID.
+type contentLabelsQuery struct {
+	Nodes []struct {
+		Typename githubv4.String `graphql:"__typename"`
+		Issue    struct {
+			ID     githubv4.ID
+			State  githubv4.String
+			URL    githubv4.String `graphql:"url"`
+			Labels struct {
+				Nodes []struct {
+					Name githubv4.String
+				}
+			} `graphql:"labels(first: 100)"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID     githubv4.ID
+			State  githubv4.String
+			URL    githubv4.String `graphql:"url"`
+			Labels struct {
+				Nodes []struct {
+					Name githubv4.String
+				}
+			} `graphql:"labels(first: 100)"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// contentLabels is the state and labels of an issue or pull request.
+type contentLabels struct {
+	State  string
+	URL    string
+	Labels []string
+}
+
+// StatusRollupChange is a project item whose status field does not match the rules of compute_status_rollup.
+type StatusRollupChange struct {
+	ItemID  int64  `json:"item_id"`
+	Content string `json:"content,omitempty"`
+	// Reason is the rule the item matched, e.g. "overdue since 2024-05-01".
+	Reason     string `json:"reason"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Applied    bool   `json:"applied"`
+	Error      string `json:"error,omitempty"`
+}
+
+// dateValue converts the value of a date field to the date, if any.
+func dateValue(value any) (time.Time, bool) {
+	s, ok := value.(string)
+	if !ok || len(s) < len(queryDateLayout) {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(queryDateLayout, s[:len(queryDateLayout)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// ComputeStatusRollup creates a tool to set a traffic-light single select field of project items from rules on
+// their dates and labels.
+func ComputeStatusRollup(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compute_status_rollup",
+			mcp.WithDescription(t("TOOL_COMPUTE_STATUS_ROLLUP_DESCRIPTION", "Set a traffic-light single select field of every item of a project, e.g. 'Health', from rules: items whose date field is in the past are overdue (red), items whose issue or pull request has a blocked label are blocked (yellow), and the other items are on track (green). Closed issues and merged or closed pull requests are left unchanged. Runs as a dry run by default, returning the changes that would be made.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPUTE_STATUS_ROLLUP_USER_TITLE", "Compute project status rollup"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("field",
+				mcp.Required(),
+				mcp.Description("ID or name of the single select field to set, e.g. 'Health'"),
+			),
+			mcp.WithString("date_field",
+				mcp.Description("ID or name of the date field, e.g. 'Target date'. Items whose date is before today are overdue. Without it, no item is overdue"),
+			),
+			mcp.WithString("overdue_option",
+				mcp.Description("Option set on overdue items"),
+				mcp.DefaultString(DefaultOverdueOption),
+			),
+			mcp.WithArray("blocked_labels",
+				mcp.Description(fmt.Sprintf("Labels marking issues and pull requests as blocked. Defaults to ['%s']", DefaultBlockedLabel)),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("blocked_option",
+				mcp.Description("Option set on blocked items that are not overdue"),
+				mcp.DefaultString(DefaultBlockedOption),
+			),
+			mcp.WithString("on_track_option",
+				mcp.Description("Option set on items matching no rule. Pass an empty string to leave them unchanged"),
+				mcp.DefaultString(DefaultOnTrackOption),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Only report the changes that would be made, without updating any item"),
+				mcp.DefaultBool(true),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the fields."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldRef, err := RequiredParam[string](req, "field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dateFieldRef, err := OptionalParam[string](req, "date_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			blockedLabels, err := OptionalStringArrayParam(req, "blocked_labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(blockedLabels) == 0 {
+				blockedLabels = []string{DefaultBlockedLabel}
+			}
+			optionNames := map[string]string{
+				"overdue_option":  DefaultOverdueOption,
+				"blocked_option":  DefaultBlockedOption,
+				"on_track_option": DefaultOnTrackOption,
+			}
+			for param := range optionNames {
+				name, ok, err := OptionalParamOK[string](req, param)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					optionNames[param] = name
+				}
+			}
+			if optionNames["overdue_option"] == "" || optionNames["blocked_option"] == "" {
+				return mcp.NewToolResultError("overdue_option and blocked_option must not be empty"), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(req, "dry_run", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, fieldRef)
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", fieldRef)), nil
+			}
+			if field.GetDataType() != "single_select" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; it must be a single select field", field.GetName(), field.GetDataType())), nil
+			}
+			options := map[string]*github.ProjectV2FieldOption{}
+			for param, name := range optionNames {
+				if name == "" {
+					continue
+				}
+				if options[param] = findFieldOption(field, name); options[param] == nil {
+					names := make([]string, 0, len(field.Options))
+					for _, o := range field.Options {
+						names = append(names, o.GetName().GetRaw())
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("%s %q is not an option of field %q (options: %s)", param, name, field.GetName(), strings.Join(names, ", "))), nil
+				}
+			}
+			fieldIDs := []int64{field.GetID()}
+			var dateField *github.ProjectV2Field
+			if dateFieldRef != "" {
+				if dateField = findProjectField(fields, dateFieldRef); dateField == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", dateFieldRef)), nil
+				}
+				if dateField.GetDataType() != "date" {
+					return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; it must be a date field", dateField.GetName(), dateField.GetDataType())), nil
+				}
+				fieldIDs = append(fieldIDs, dateField.GetID())
+			}
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, fieldIDs, "")
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			var ids []githubv4.ID
+			seen := map[string]bool{}
+			for _, item := range items {
+				contentType := item.GetContentType()
+				if (contentType != "Issue" && contentType != "PullRequest") || item.GetContentNodeID() == "" || seen[item.GetContentNodeID()] {
+					continue
+				}
+				seen[item.GetContentNodeID()] = true
+				ids = append(ids, githubv4.ID(item.GetContentNodeID()))
+			}
+			contents := map[string]contentLabels{}
+			for start := 0; start < len(ids); start += maxNodesPerQuery {
+				var q contentLabelsQuery
+				vars := map[string]any{
+					"ids": ids[start:min(start+maxNodesPerQuery, len(ids))],
+				}
+				if err := gqlClient.Query(ctx, &q, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to get issue and pull request labels",
+						err,
+					), nil
+				}
+				for _, node := range q.Nodes {
+					id, content, labels := node.Issue.ID, contentLabels{State: string(node.Issue.State), URL: string(node.Issue.URL)}, node.Issue.Labels.Nodes
+					if node.Typename == "PullRequest" {
+						id, content, labels = node.PullRequest.ID, contentLabels{State: string(node.PullRequest.State), URL: string(node.PullRequest.URL)}, node.PullRequest.Labels.Nodes
+					}
+					for _, label := range labels {
+						content.Labels = append(content.Labels, string(label.Name))
+					}
+					contents[fmt.Sprint(id)] = content
+				}
+			}
+
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			changes := []StatusRollupChange{}
+			for _, item := range items {
+				content, hasContent := contents[item.GetContentNodeID()]
+				if hasContent && content.State != "OPEN" {
+					continue
+				}
+
+				param, reason := "on_track_option", "on track"
+				blocked := ""
+				for _, label := range content.Labels {
+					for _, blockedLabel := range blockedLabels {
+						if blocked == "" && strings.EqualFold(label, blockedLabel) {
+							blocked = label
+						}
+					}
+				}
+				if blocked != "" {
+					param, reason = "blocked_option", fmt.Sprintf("labeled %s", blocked)
+				}
+				if dateField != nil {
+					if date, ok := dateValue(itemFieldValue(item, dateField.GetID())); ok && date.Before(today) {
+						param, reason = "overdue_option", fmt.Sprintf("overdue since %s", date.Format(queryDateLayout))
+					}
+				}
+				option := options[param]
+				if option == nil {
+					continue
+				}
+
+				status := singleSelectValue(item, field.GetID())
+				if strings.EqualFold(status, option.GetName().GetRaw()) {
+					continue
+				}
+				changes = append(changes, StatusRollupChange{
+					ItemID:     item.GetID(),
+					Content:    content.URL,
+					Reason:     reason,
+					FromStatus: status,
+					ToStatus:   option.GetName().GetRaw(),
+				})
+			}
+
+			if !dryRun {
+				for i, change := range changes {
+					option := findFieldOption(field, change.ToStatus)
+					update := &github.UpdateProjectItemOptions{
+						Fields: []*github.UpdateProjectV2Field{{ID: field.GetID(), Value: option.GetID()}},
+					}
+					var resp *github.Response
+					var err error
+					if ownerType == "org" {
+						_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, owner, projectNumber, change.ItemID, update)
+					} else {
+						_, resp, err = client.Projects.UpdateUserProjectItem(ctx, owner, projectNumber, change.ItemID, update)
+					}
+					if err != nil {
+						_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, ProjectUpdateFailedError, resp, err)
+						changes[i].Error = err.Error()
+						continue
+					}
+					_ = resp.Body.Close()
+					changes[i].Applied = true
+				}
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"dry_run":       dryRun,
+				"checked_items": len(items),
+				"changes":       changes,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ComputeStatusRollup(t *testing.T) {
+	tool, _ := ComputeStatusRollup(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "field"})
+
+	fields := []map[string]any{
+		{"id": 201, "name": "Health", "data_type": "single_select", "options": []any{
+			map[string]any{"id": "opt-red", "name": map[string]any{"raw": "Red"}},
+			map[string]any{"id": "opt-yellow", "name": map[string]any{"raw": "Yellow"}},
+			map[string]any{"id": "opt-green", "name": map[string]any{"raw": "Green"}},
+		}},
+		{"id": 202, "name": "Target date", "data_type": "date"},
+	}
+	healthItem := func(id int64, contentType, nodeID, health, date string) map[string]any {
+		var healthValue, dateValue any
+		if health != "" {
+			healthValue = map[string]any{"id": "opt-" + strings.ToLower(health), "name": map[string]any{"raw": health}}
+		}
+		if date != "" {
+			dateValue = date
+		}
+		return map[string]any{
+			"id":              id,
+			"content_type":    contentType,
+			"content_node_id": nodeID,
+			"fields": []any{
+				map[string]any{"id": 201, "name": "Health", "data_type": "single_select", "value": healthValue},
+				map[string]any{"id": 202, "name": "Target date", "data_type": "date", "value": dateValue},
+			},
+		}
+	}
+	projectItems := []any{
+		healthItem(31, "Issue", "I_1", "Green", "2020-01-15"),
+		healthItem(32, "Issue", "I_2", "Green", "2999-01-01"),
+		healthItem(33, "PullRequest", "PR_1", "Red", ""),
+		healthItem(34, "Issue", "I_3", "Red", "2020-01-15"),
+		healthItem(35, "DraftIssue", "DI_1", "", "2020-02-01"),
+		healthItem(36, "Issue", "I_4", "Green", ""),
+	}
+	node := func(typename, id, state string, labels ...string) map[string]any {
+		labelNodes := []any{}
+		for _, label := range labels {
+			labelNodes = append(labelNodes, map[string]any{"name": label})
+		}
+		return map[string]any{
+			"__typename": typename,
+			"id":         id,
+			"state":      state,
+			"url":        "https://github.com/octo/repo/" + strings.ToLower(id),
+			"labels":     map[string]any{"nodes": labelNodes},
+		}
+	}
+	newGQLClient := func() *githubv4.Client {
+		// The query is constructed from the typed IDs, but the request variables are compared in their decoded JSON form.
+		matcher := githubv4mock.NewQueryMatcher(contentLabelsQuery{}, map[string]any{"ids": []githubv4.ID{"I_1"}}, githubv4mock.DataResponse(map[string]any{
+			"nodes": []any{
+				node("Issue", "I_1", "OPEN", "Blocked"),
+				node("Issue", "I_2", "OPEN", "blocked"),
+				node("PullRequest", "PR_1", "OPEN"),
+				node("Issue", "I_3", "CLOSED"),
+				node("Issue", "I_4", "OPEN", "bug"),
+			},
+		}))
+		matcher.Variables = map[string]any{"ids": []any{"I_1", "I_2", "PR_1", "I_3", "I_4"}}
+		return githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+	}
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, fields),
+	)
+	itemsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, projectItems),
+	)
+
+	var response struct {
+		DryRun       bool                 `json:"dry_run"`
+		CheckedItems int                  `json:"checked_items"`
+		Changes      []StatusRollupChange `json:"changes"`
+	}
+
+	t.Run("dry run reports changes", func(t *testing.T) {
+		_, handler := ComputeStatusRollup(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint, itemsEndpoint))), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(611),
+			"field":          "Health",
+			"date_field":     "Target date",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 6, response.CheckedItems)
+		assert.Equal(t, []StatusRollupChange{
+			{ItemID: 31, Content: "https://github.com/octo/repo/i_1", Reason: "overdue since 2020-01-15", FromStatus: "Green", ToStatus: "Red"},
+			{ItemID: 32, Content: "https://github.com/octo/repo/i_2", Reason: "labeled blocked", FromStatus: "Green", ToStatus: "Yellow"},
+			{ItemID: 33, Content: "https://github.com/octo/repo/pr_1", Reason: "on track", FromStatus: "Red", ToStatus: "Green"},
+			{ItemID: 35, Reason: "overdue since 2020-02-01", FromStatus: "", ToStatus: "Red"},
+		}, response.Changes)
+	})
+
+	t.Run("applies changes without an on track option", func(t *testing.T) {
+		updated := map[string]string{}
+		restClient := mock.NewMockedHTTPClient(
+			fieldsEndpoint,
+			itemsEndpoint,
+			mock.WithRequestMatchHandler(
+				mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body struct {
+						Fields []struct {
+							Value string `json:"value"`
+						} `json:"fields"`
+					}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					updated[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = body.Fields[0].Value
+					mockResponse(t, http.StatusOK, map[string]any{"id": 1}).ServeHTTP(w, r)
+				}),
+			),
+		)
+		_, handler := ComputeStatusRollup(stubGetClientFn(github.NewClient(restClient)), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner_type":      "org",
+			"owner":           "octo",
+			"project_number":  float64(612),
+			"field":           "Health",
+			"blocked_labels":  []any{"bug"},
+			"on_track_option": "",
+			"dry_run":         false,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.False(t, response.DryRun)
+		require.Len(t, response.Changes, 1)
+		assert.True(t, response.Changes[0].Applied)
+		assert.Equal(t, "labeled bug", response.Changes[0].Reason)
+		assert.Equal(t, map[string]string{"36": "opt-yellow"}, updated)
+	})
+
+	t.Run("option is not an option of the field", func(t *testing.T) {
+		_, handler := ComputeStatusRollup(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint))), stubGetGQLClientFn(newGQLClient()), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(613),
+			"field":          "Health",
+			"overdue_option": "Purple",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getErrorResult(t, result).Text, `overdue_option "Purple" is not an option of field "Health"`)
+	})
+}
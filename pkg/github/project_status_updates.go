@@ -0,0 +1,313 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// DefaultProjectStatusUpdatesPerPage is the default number of status updates listed.
+	DefaultProjectStatusUpdatesPerPage = 10
+	// MaxProjectStatusUpdatesPerPage bounds the number of status updates listed.
+	MaxProjectStatusUpdatesPerPage = 100
+)
+
+// projectStatusUpdateStatuses are the statuses of a status update, as taken by the tools.
+var projectStatusUpdateStatuses = []string{"on_track", "at_risk", "off_track", "complete", "inactive"}
+
+// ProjectStatusUpdatesListResult is the result of listing the status updates of a project.
+type ProjectStatusUpdatesListResult struct {
+	StatusUpdates []MinimalProjectStatusUpdate `json:"status_updates"`
+}
+
+// projectStatusUpdatesQuery selects the latest status updates of a project.
+type projectStatusUpdatesQuery struct {
+	StatusUpdates struct {
+		Nodes []projectStatusUpdateNode
+	} `graphql:"statusUpdates(first: $first, orderBy: {field: CREATED_AT, direction: DESC})"`
+}
+
+// projectStatusUpdateFields are the fields of a status update set by create_project_status_update and
+// update_project_status_update. Fields not given are nil.
+type projectStatusUpdateFields struct {
+	Status     *githubv4.ProjectV2StatusUpdateStatus
+	Body       *githubv4.String
+	StartDate  *githubv4.Date
+	TargetDate *githubv4.Date
+}
+
+// projectStatusUpdateOptions are the parameters of the fields of a status update.
+func projectStatusUpdateOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("status",
+			mcp.Description("The status of the project: on track, at risk, off track, complete or inactive."),
+			mcp.Enum(projectStatusUpdateStatuses...),
+		),
+		mcp.WithString("body",
+			mcp.Description("The body of the status update, in Markdown."),
+		),
+		mcp.WithString("start_date",
+			mcp.Description("The start date of the project, as YYYY-MM-DD."),
+		),
+		mcp.WithString("target_date",
+			mcp.Description("The target date of the project, as YYYY-MM-DD."),
+		),
+	}
+}
+
+// parseProjectStatusUpdateFields reads the parameters of projectStatusUpdateOptions.
+func parseProjectStatusUpdateFields(req mcp.CallToolRequest) (projectStatusUpdateFields, error) {
+	var fields projectStatusUpdateFields
+	status, err := OptionalParam[string](req, "status")
+	if err != nil {
+		return fields, err
+	}
+	if status != "" {
+		if !slices.Contains(projectStatusUpdateStatuses, strings.ToLower(status)) {
+			return fields, fmt.Errorf("status must be one of %s, got %q", strings.Join(projectStatusUpdateStatuses, ", "), status)
+		}
+		value := githubv4.ProjectV2StatusUpdateStatus(strings.ToUpper(status))
+		fields.Status = &value
+	}
+	body, ok, err := OptionalParamOK[string](req, "body")
+	if err != nil {
+		return fields, err
+	}
+	if ok {
+		fields.Body = githubv4.NewString(githubv4.String(body))
+	}
+	for p, date := range map[string]**githubv4.Date{"start_date": &fields.StartDate, "target_date": &fields.TargetDate} {
+		value, err := OptionalParam[string](req, p)
+		if err != nil {
+			return fields, err
+		}
+		if value == "" {
+			continue
+		}
+		day, err := time.Parse(queryDateLayout, value)
+		if err != nil {
+			return fields, fmt.Errorf("%s must be a date as YYYY-MM-DD, got %q", p, value)
+		}
+		*date = githubv4.NewDate(githubv4.Date{Time: day})
+	}
+	return fields, nil
+}
+
+// ListProjectStatusUpdates creates a tool to list the latest status updates of a project.
+func ListProjectStatusUpdates(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_status_updates",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_STATUS_UPDATES_DESCRIPTION", "List the status updates of a Project for a user or org, latest first. Status updates tell whether a project is on track, at risk or off track, with a Markdown body and the project's start and target dates.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_STATUS_UPDATES_USER_TITLE", "List project status updates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectStatusUpdatesListResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("per_page",
+				mcp.Description(fmt.Sprintf("Number of status updates to list (max %d)", MaxProjectStatusUpdatesPerPage)),
+				mcp.Min(1),
+				mcp.Max(MaxProjectStatusUpdatesPerPage),
+				mcp.DefaultNumber(DefaultProjectStatusUpdatesPerPage),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamWithDefault(req, "per_page", DefaultProjectStatusUpdatesPerPage)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if perPage < 1 || perPage > MaxProjectStatusUpdatesPerPage {
+				perPage = MaxProjectStatusUpdatesPerPage
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, err := queryProjectV2[projectStatusUpdatesQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{
+				"first": githubv4.Int(perPage),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list project status updates",
+					err,
+				), nil
+			}
+
+			result := ProjectStatusUpdatesListResult{StatusUpdates: []MinimalProjectStatusUpdate{}}
+			for _, node := range project.StatusUpdates.Nodes {
+				result.StatusUpdates = append(result.StatusUpdates, *node.minimal())
+			}
+			return MarshalledStructuredResult(result), nil
+		}
+}
+
+// CreateProjectStatusUpdate creates a tool to post a status update to a project.
+func CreateProjectStatusUpdate(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	opts := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_CREATE_PROJECT_STATUS_UPDATE_DESCRIPTION", "Post a status update to a Project for a user or org, telling whether the project is on track, at risk or off track, e.g. a weekly status written from the project's items. The latest status update is shown on the project and in project listings.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_CREATE_PROJECT_STATUS_UPDATE_USER_TITLE", "Create project status update"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+		WithOutputSchema[MinimalProjectStatusUpdate](),
+		mcp.WithString("owner_type",
+			mcp.Required(),
+			mcp.Description("Owner type"),
+			mcp.Enum("user", "org"),
+		),
+		mcp.WithString("owner",
+			mcp.Required(),
+			mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+		),
+		mcp.WithNumber("project_number",
+			mcp.Required(),
+			mcp.Description("The project's number."),
+		),
+	}
+	return mcp.NewTool("create_project_status_update", append(opts, projectStatusUpdateOptions()...)...),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields, err := parseProjectStatusUpdateFields(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			var mutation struct {
+				CreateProjectV2StatusUpdate struct {
+					StatusUpdate projectStatusUpdateNode
+				} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.CreateProjectV2StatusUpdateInput{
+				ProjectID:  githubv4.ID(project.GetNodeID()),
+				Status:     fields.Status,
+				Body:       fields.Body,
+				StartDate:  fields.StartDate,
+				TargetDate: fields.TargetDate,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to create project status update",
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(mutation.CreateProjectV2StatusUpdate.StatusUpdate.minimal()), nil
+		}
+}
+
+// UpdateProjectStatusUpdate creates a tool to edit a status update of a project.
+func UpdateProjectStatusUpdate(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	opts := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_UPDATE_PROJECT_STATUS_UPDATE_DESCRIPTION", "Edit a status update of a Project, e.g. to correct its status or body. Only the given fields are changed. Get the ID of a status update with list_project_status_updates.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_UPDATE_PROJECT_STATUS_UPDATE_USER_TITLE", "Update project status update"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+		WithOutputSchema[MinimalProjectStatusUpdate](),
+		mcp.WithString("status_update_id",
+			mcp.Required(),
+			mcp.Description("The ID of the status update, as returned by list_project_status_updates."),
+		),
+	}
+	return mcp.NewTool("update_project_status_update", append(opts, projectStatusUpdateOptions()...)...),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			statusUpdateID, err := RequiredParam[string](req, "status_update_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields, err := parseProjectStatusUpdateFields(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if fields == (projectStatusUpdateFields{}) {
+				return mcp.NewToolResultError("at least one of status, body, start_date or target_date is required"), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var mutation struct {
+				UpdateProjectV2StatusUpdate struct {
+					StatusUpdate projectStatusUpdateNode
+				} `graphql:"updateProjectV2StatusUpdate(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.UpdateProjectV2StatusUpdateInput{
+				StatusUpdateID: githubv4.ID(statusUpdateID),
+				Status:         fields.Status,
+				Body:           fields.Body,
+				StartDate:      fields.StartDate,
+				TargetDate:     fields.TargetDate,
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to update project status update",
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(mutation.UpdateProjectV2StatusUpdate.StatusUpdate.minimal()), nil
+		}
+}
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gh "github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statusUpdateNode(id, status, body string) map[string]any {
+	return map[string]any{
+		"id":         id,
+		"status":     status,
+		"body":       body,
+		"startDate":  "2024-05-01",
+		"targetDate": "2024-06-30",
+		"createdAt":  "2024-05-20T10:00:00Z",
+		"creator":    map[string]any{"login": "octocat"},
+	}
+}
+
+func Test_ListProjectStatusUpdates(t *testing.T) {
+	tool, _ := ListProjectStatusUpdates(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectStatusUpdatesQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7), "first": githubv4.Int(2)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"statusUpdates": map[string]any{"nodes": []any{
+				statusUpdateNode("PVTSU_2", "AT_RISK", "Blocked on review"),
+				statusUpdateNode("PVTSU_1", "ON_TRACK", "Kickoff done"),
+			}},
+		}}}),
+	))
+	_, handler := ListProjectStatusUpdates(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner_type":     "org",
+		"owner":          "octo-org",
+		"project_number": float64(7),
+		"per_page":       float64(2),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response ProjectStatusUpdatesListResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.StatusUpdates, 2)
+	assert.Equal(t, MinimalProjectStatusUpdate{
+		ID:         "PVTSU_2",
+		Status:     "AT_RISK",
+		Body:       "Blocked on review",
+		StartDate:  "2024-05-01",
+		TargetDate: "2024-06-30",
+		Creator:    "octocat",
+		CreatedAt:  time.Date(2024, time.May, 20, 10, 0, 0, 0, time.UTC),
+	}, response.StatusUpdates[0])
+	assert.Equal(t, "PVTSU_1", response.StatusUpdates[1].ID)
+}
+
+func Test_CreateProjectStatusUpdate(t *testing.T) {
+	tool, _ := CreateProjectStatusUpdate(stubGetClientFn(gh.NewClient(nil)), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	restClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}", Method: http.MethodGet},
+			map[string]any{"id": 7, "node_id": "PVT_7"},
+		),
+	)
+	status := githubv4.ProjectV2StatusUpdateStatusAtRisk
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+		struct {
+			CreateProjectV2StatusUpdate struct {
+				StatusUpdate projectStatusUpdateNode
+			} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+		}{},
+		githubv4.CreateProjectV2StatusUpdateInput{
+			ProjectID: githubv4.ID("PVT_7"),
+			Status:    &status,
+			Body:      githubv4.NewString("Blocked on review"),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{"createProjectV2StatusUpdate": map[string]any{
+			"statusUpdate": statusUpdateNode("PVTSU_2", "AT_RISK", "Blocked on review"),
+		}}),
+	))
+
+	_, handler := CreateProjectStatusUpdate(stubGetClientFn(gh.NewClient(restClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner_type":     "org",
+		"owner":          "octo-org",
+		"project_number": float64(7),
+		"status":         "at_risk",
+		"body":           "Blocked on review",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response MinimalProjectStatusUpdate
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, "PVTSU_2", response.ID)
+	assert.Equal(t, "AT_RISK", response.Status)
+}
+
+func Test_CreateProjectStatusUpdate_InvalidFields(t *testing.T) {
+	tests := []struct {
+		name           string
+		field          string
+		value          string
+		expectedErrMsg string
+	}{
+		{
+			name:           "invalid date",
+			field:          "target_date",
+			value:          "next friday",
+			expectedErrMsg: "target_date must be a date as YYYY-MM-DD",
+		},
+		{
+			name:           "invalid status",
+			field:          "status",
+			value:          "blocked",
+			expectedErrMsg: `status must be one of on_track, at_risk, off_track, complete, inactive, got "blocked"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// No GraphQL query is mocked, so the fields are validated before calling the API.
+			_, handler := CreateProjectStatusUpdate(stubGetClientFn(gh.NewClient(mock.NewMockedHTTPClient())), stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner_type":     "org",
+				"owner":          "octo-org",
+				"project_number": float64(7),
+				tc.field:         tc.value,
+			}))
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+		})
+	}
+}
+
+func Test_UpdateProjectStatusUpdate(t *testing.T) {
+	tool, _ := UpdateProjectStatusUpdate(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"status_update_id"})
+
+	t.Run("updates status", func(t *testing.T) {
+		status := githubv4.ProjectV2StatusUpdateStatusOnTrack
+		gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewMutationMatcher(
+			struct {
+				UpdateProjectV2StatusUpdate struct {
+					StatusUpdate projectStatusUpdateNode
+				} `graphql:"updateProjectV2StatusUpdate(input: $input)"`
+			}{},
+			githubv4.UpdateProjectV2StatusUpdateInput{
+				StatusUpdateID: githubv4.ID("PVTSU_2"),
+				Status:         &status,
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{"updateProjectV2StatusUpdate": map[string]any{
+				"statusUpdate": statusUpdateNode("PVTSU_2", "ON_TRACK", "Blocked on review"),
+			}}),
+		))
+		_, handler := UpdateProjectStatusUpdate(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"status_update_id": "PVTSU_2",
+			"status":           "on_track",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalProjectStatusUpdate
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, "ON_TRACK", response.Status)
+	})
+
+	t.Run("nothing to update", func(t *testing.T) {
+		_, handler := UpdateProjectStatusUpdate(stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"status_update_id": "PVTSU_2",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "at least one of status")
+	})
+}
@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultStatusField is the name of the field holding the status of project items.
+	DefaultStatusField = "Status"
+	// defaultTransitionsKey is the key of the transitions applying to every project without its own mapping.
+	defaultTransitionsKey = "default"
+)
+
+// defaultProjectTransitions map transitions to the status options of GitHub's default project templates.
+var defaultProjectTransitions = map[string]string{
+	"todo":  "Todo",
+	"start": "In Progress",
+	"block": "Blocked",
+	"done":  "Done",
+}
+
+// ProjectTransitions maps symbolic transition names, such as "start" or "done", to the status options of projects.
+// Mappings are keyed by "<owner_type>/<owner>/<project_number>", e.g. "org/octo/1". The "default" mapping applies
+// to every project, and project mappings override it. Keys and transition names are matched case-insensitively.
+type ProjectTransitions map[string]map[string]string
+
+// projectTransitionsKey returns the key of the transitions of a project.
+func projectTransitionsKey(ownerType, owner string, projectNumber int) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%d", ownerType, owner, projectNumber))
+}
+
+// forProject returns the transitions of a project: the built-in defaults, overridden by the configured default
+// mapping, overridden by the mapping of the project.
+func (p ProjectTransitions) forProject(ownerType, owner string, projectNumber int) map[string]string {
+	transitions := map[string]string{}
+	for name, status := range defaultProjectTransitions {
+		transitions[name] = status
+	}
+	for key, mapping := range p {
+		if strings.EqualFold(key, defaultTransitionsKey) {
+			for name, status := range mapping {
+				transitions[strings.ToLower(name)] = status
+			}
+		}
+	}
+	for key, mapping := range p {
+		if strings.EqualFold(key, projectTransitionsKey(ownerType, owner, projectNumber)) {
+			for name, status := range mapping {
+				transitions[strings.ToLower(name)] = status
+			}
+		}
+	}
+	return transitions
+}
+
+// findFieldOption returns the option of a single select field with the given name, ignoring case.
+func findFieldOption(field *github.ProjectV2Field, name string) *github.ProjectV2FieldOption {
+	for _, option := range field.Options {
+		if option.GetName() != nil && strings.EqualFold(option.GetName().GetRaw(), name) {
+			return option
+		}
+	}
+	return nil
+}
+
+// TransitionProjectItem creates a tool to move a project item to the status mapped to a symbolic transition.
+func TransitionProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transition_project_item",
+			mcp.WithDescription(t("TOOL_TRANSITION_PROJECT_ITEM_DESCRIPTION", "Move a project item to a new status using a symbolic transition such as 'start', 'block' or 'done', instead of the status option name of a particular project. Transitions are mapped to status options per project in the server configuration, falling back to 'todo' → Todo, 'start' → In Progress, 'block' → Blocked and 'done' → Done.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_TRANSITION_PROJECT_ITEM_USER_TITLE", "Transition project item"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the project item. This is not the issue or pull request ID."),
+			),
+			mcp.WithString("transition",
+				mcp.Required(),
+				mcp.Description("Symbolic transition, e.g. 'start', 'block' or 'done'"),
+			),
+			mcp.WithString("status_field",
+				mcp.Description("ID or name of the single select field holding the status"),
+				mcp.DefaultString(DefaultStatusField),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the status."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := RequiredBigInt(req, "item_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			transition, err := RequiredParam[string](req, "transition")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statusField, err := OptionalParam[string](req, "status_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if statusField == "" {
+				statusField = DefaultStatusField
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			transitions := flags.ProjectTransitions.forProject(ownerType, owner, projectNumber)
+			status, ok := transitions[strings.ToLower(strings.TrimSpace(transition))]
+			if !ok {
+				names := make([]string, 0, len(transitions))
+				for name := range transitions {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				return mcp.NewToolResultError(fmt.Sprintf("unknown transition %q: expected one of %s", transition, strings.Join(names, ", "))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var field *github.ProjectV2Field
+			var option *github.ProjectV2FieldOption
+			for _, reload := range []bool{refresh, true} {
+				fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, reload)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if field = findProjectField(fields, statusField); field != nil {
+					option = findFieldOption(field, status)
+				}
+				// The field or option may have been created after the definitions were cached.
+				if option != nil || reload {
+					break
+				}
+			}
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", statusField)), nil
+			}
+			if field.GetDataType() != "single_select" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; the status must be a single select field", field.GetName(), field.GetDataType())), nil
+			}
+			if option == nil {
+				names := make([]string, 0, len(field.Options))
+				for _, o := range field.Options {
+					names = append(names, o.GetName().GetRaw())
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("transition %q maps to status %q, which is not an option of field %q (options: %s); map the transition to one of the options in the project_transitions configuration for %q",
+					transition, status, field.GetName(), strings.Join(names, ", "), projectTransitionsKey(ownerType, owner, projectNumber))), nil
+			}
+
+			update := &github.UpdateProjectItemOptions{
+				Fields: []*github.UpdateProjectV2Field{{ID: field.GetID(), Value: option.GetID()}},
+			}
+			var resp *github.Response
+			if ownerType == "org" {
+				_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, owner, projectNumber, itemID, update)
+			} else {
+				_, resp, err = client.Projects.UpdateUserProjectItem(ctx, owner, projectNumber, itemID, update)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectUpdateFailedError,
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			r, err := json.Marshal(map[string]any{
+				"item_id":    itemID,
+				"transition": strings.ToLower(strings.TrimSpace(transition)),
+				"field":      field.GetName(),
+				"status":     option.GetName().GetRaw(),
+				"option_id":  option.GetID(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
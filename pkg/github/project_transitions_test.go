@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectTransitionsForProject(t *testing.T) {
+	transitions := ProjectTransitions{
+		"default":      {"Done": "Shipped", "review": "In Review"},
+		"org/octo/1":   {"start": "Doing"},
+		"user/octo/1":  {"start": "Working"},
+		"org/other/10": {"done": "Closed"},
+	}
+
+	assert.Equal(t, map[string]string{
+		"todo":   "Todo",
+		"start":  "Doing",
+		"block":  "Blocked",
+		"done":   "Shipped",
+		"review": "In Review",
+	}, transitions.forProject("org", "Octo", 1))
+	assert.Equal(t, "Working", transitions.forProject("user", "octo", 1)["start"])
+	assert.Equal(t, "In Progress", ProjectTransitions(nil).forProject("org", "octo", 1)["start"])
+}
+
+func Test_TransitionProjectItem(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := TransitionProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "transition_project_item", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_id", "transition"})
+
+	fields := []map[string]any{
+		{"id": 101, "name": "Status", "data_type": "single_select", "options": []any{
+			map[string]any{"id": "opt-todo", "name": map[string]any{"raw": "Backlog"}},
+			map[string]any{"id": "opt-doing", "name": map[string]any{"raw": "Doing"}},
+			map[string]any{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+		}},
+		{"id": 102, "name": "Notes", "data_type": "text"},
+	}
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, fields),
+	)
+	flags := FeatureFlags{ProjectTransitions: ProjectTransitions{
+		"org/octo/301": {"start": "doing"},
+	}}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedStatus   string
+		expectedOptionID string
+	}{
+		{
+			name: "maps a configured transition",
+			mockedClient: mock.NewMockedHTTPClient(
+				fieldsEndpoint,
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{map[string]any{"id": float64(101), "value": "opt-doing"}},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{"id": 5}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(301),
+				"item_id":        float64(5),
+				"transition":     "Start",
+			},
+			expectedStatus:   "Doing",
+			expectedOptionID: "opt-doing",
+		},
+		{
+			name: "falls back to the built-in transitions",
+			mockedClient: mock.NewMockedHTTPClient(
+				fieldsEndpoint,
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{map[string]any{"id": float64(101), "value": "opt-done"}},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{"id": 5}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(302),
+				"item_id":        float64(5),
+				"transition":     "done",
+			},
+			expectedStatus:   "Done",
+			expectedOptionID: "opt-done",
+		},
+		{
+			name:         "unknown transition",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(303),
+				"item_id":        float64(5),
+				"transition":     "archive",
+			},
+			expectError:    true,
+			expectedErrMsg: `unknown transition "archive": expected one of block, done, start, todo`,
+		},
+		{
+			name:         "mapped status is not an option",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(304),
+				"item_id":        float64(5),
+				"transition":     "block",
+			},
+			expectError:    true,
+			expectedErrMsg: `transition "block" maps to status "Blocked", which is not an option of field "Status" (options: Backlog, Doing, Done)`,
+		},
+		{
+			name:         "status field is not a single select field",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(305),
+				"item_id":        float64(5),
+				"transition":     "done",
+				"status_field":   "Notes",
+			},
+			expectError:    true,
+			expectedErrMsg: `project field "Notes" is a text field`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := TransitionProjectItem(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper, flags)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				Status   string `json:"status"`
+				OptionID string `json:"option_id"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expectedStatus, response.Status)
+			assert.Equal(t, tc.expectedOptionID, response.OptionID)
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectView is a saved view of a project: its layout, the filter selecting its items, and the fields it shows,
+// groups and sorts items by.
+type ProjectView struct {
+	Number          int                `json:"number"`
+	Name            string             `json:"name"`
+	Layout          string             `json:"layout"`
+	Filter          string             `json:"filter,omitempty"`
+	URL             string             `json:"url,omitempty"`
+	VisibleFields   []ProjectViewField `json:"visible_fields,omitempty"`
+	GroupBy         []ProjectViewField `json:"group_by,omitempty"`
+	VerticalGroupBy []ProjectViewField `json:"vertical_group_by,omitempty"`
+	SortBy          []ProjectViewSort  `json:"sort_by,omitempty"`
+	CreatedAt       string             `json:"created_at,omitempty"`
+	UpdatedAt       string             `json:"updated_at,omitempty"`
+}
+
+// ProjectViewField is a field shown or used by a project view.
+type ProjectViewField struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	DataType string `json:"data_type"`
+}
+
+// ProjectViewSort is a field a project view sorts items by.
+type ProjectViewSort struct {
+	Field     ProjectViewField `json:"field"`
+	Direction string           `json:"direction"`
+}
+
+// ProjectViewsListResult is the result of listing the views of a project.
+type ProjectViewsListResult struct {
+	Views []ProjectView `json:"views"`
+}
+
+type projectViewFieldNode struct {
+	Common struct {
+		DatabaseID githubv4.Int
+		Name       githubv4.String
+		DataType   githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+type projectViewFieldConnection struct {
+	Nodes []projectViewFieldNode
+}
+
+type projectViewSummaryNode struct {
+	Number githubv4.Int
+	Name   githubv4.String
+	Layout githubv4.String
+	Filter githubv4.String
+}
+
+type projectViewNode struct {
+	projectViewSummaryNode
+	CreatedAt             githubv4.DateTime
+	UpdatedAt             githubv4.DateTime
+	Fields                projectViewFieldConnection `graphql:"fields(first: 100)"`
+	GroupByFields         projectViewFieldConnection `graphql:"groupByFields(first: 10)"`
+	VerticalGroupByFields projectViewFieldConnection `graphql:"verticalGroupByFields(first: 10)"`
+	SortByFields          struct {
+		Nodes []struct {
+			Direction githubv4.String
+			Field     projectViewFieldNode
+		}
+	} `graphql:"sortByFields(first: 10)"`
+}
+
+// projectViewsQuery selects the views of a project, which rarely has more than a handful.
+type projectViewsQuery struct {
+	Views struct {
+		Nodes []projectViewSummaryNode
+	} `graphql:"views(first: 100)"`
+}
+
+type projectViewQuery struct {
+	URL  githubv4.String
+	View *projectViewNode `graphql:"view(number: $viewNumber)"`
+}
+
+// queryProjectV2 runs a GraphQL query selecting T on the project of a user or org, setting the owner and
+// projectNumber variables.
+func queryProjectV2[T any](ctx context.Context, client *githubv4.Client, ownerType, owner string, projectNumber int, vars map[string]any) (*T, error) {
+	vars["owner"] = githubv4.String(owner)
+	vars["projectNumber"] = githubv4.Int(projectNumber)
+	// The API responds with an error rather than a null project when there is no project with the number.
+	if ownerType == "org" {
+		var q struct {
+			Organization struct {
+				ProjectV2 T `graphql:"projectV2(number: $projectNumber)"`
+			} `graphql:"organization(login: $owner)"`
+		}
+		if err := client.Query(ctx, &q, vars); err != nil {
+			return nil, err
+		}
+		return &q.Organization.ProjectV2, nil
+	}
+	var q struct {
+		User struct {
+			ProjectV2 T `graphql:"projectV2(number: $projectNumber)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	if err := client.Query(ctx, &q, vars); err != nil {
+		return nil, err
+	}
+	return &q.User.ProjectV2, nil
+}
+
+// projectViewLayout converts a GraphQL view layout such as BOARD_LAYOUT to the name shown in the UI.
+func projectViewLayout(layout githubv4.String) string {
+	return strings.ToLower(strings.TrimSuffix(string(layout), "_LAYOUT"))
+}
+
+func convertToProjectViewFields(connection projectViewFieldConnection) []ProjectViewField {
+	fields := make([]ProjectViewField, 0, len(connection.Nodes))
+	for _, node := range connection.Nodes {
+		fields = append(fields, convertToProjectViewField(node))
+	}
+	return fields
+}
+
+func convertToProjectViewField(node projectViewFieldNode) ProjectViewField {
+	return ProjectViewField{
+		ID:       int64(node.Common.DatabaseID),
+		Name:     string(node.Common.Name),
+		DataType: strings.ToLower(string(node.Common.DataType)),
+	}
+}
+
+func convertToProjectViewSummary(node projectViewSummaryNode) ProjectView {
+	return ProjectView{
+		Number: int(node.Number),
+		Name:   string(node.Name),
+		Layout: projectViewLayout(node.Layout),
+		Filter: string(node.Filter),
+	}
+}
+
+func convertToProjectView(node *projectViewNode, projectURL string) ProjectView {
+	view := convertToProjectViewSummary(node.projectViewSummaryNode)
+	if projectURL != "" {
+		view.URL = fmt.Sprintf("%s/views/%d", projectURL, view.Number)
+	}
+	view.VisibleFields = convertToProjectViewFields(node.Fields)
+	view.GroupBy = convertToProjectViewFields(node.GroupByFields)
+	view.VerticalGroupBy = convertToProjectViewFields(node.VerticalGroupByFields)
+	for _, sort := range node.SortByFields.Nodes {
+		view.SortBy = append(view.SortBy, ProjectViewSort{
+			Field:     convertToProjectViewField(sort.Field),
+			Direction: strings.ToLower(string(sort.Direction)),
+		})
+	}
+	if !node.CreatedAt.IsZero() {
+		view.CreatedAt = node.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if !node.UpdatedAt.IsZero() {
+		view.UpdatedAt = node.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return view
+}
+
+// ListProjectViews creates a tool to list the saved views of a project.
+func ListProjectViews(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_views",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_VIEWS_DESCRIPTION", "List the saved views of a Project for a user or org, with their layout (table, board or roadmap) and filter. Use get_project_view for the fields a view shows and groups by.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_VIEWS_USER_TITLE", "List project views"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectViewsListResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, err := queryProjectV2[projectViewsQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list project views",
+					err,
+				), nil
+			}
+
+			views := make([]ProjectView, 0, len(project.Views.Nodes))
+			for _, node := range project.Views.Nodes {
+				views = append(views, convertToProjectViewSummary(node))
+			}
+			return MarshalledStructuredResult(ProjectViewsListResult{Views: views}), nil
+		}
+}
+
+// GetProjectView creates a tool to get a saved view of a project, including the fields it shows, groups and
+// sorts by.
+func GetProjectView(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_view",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_VIEW_DESCRIPTION", "Get a saved view of a Project for a user or org: its layout, filter, visible fields, and the fields it groups and sorts items by. Pass the view's filter as query to list_project_items to get the items on the view.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_VIEW_USER_TITLE", "Get project view"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectView](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("view",
+				mcp.Required(),
+				mcp.Description("Number or name of the view. The number is shown in the view's URL, e.g. 3 in /projects/1/views/3"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			viewRef, err := RequiredParam[string](req, "view")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			viewRef = strings.TrimSpace(viewRef)
+			viewNumber, err := strconv.Atoi(viewRef)
+			if err != nil {
+				// Views are addressed by number, so a name is resolved against the list of views first.
+				project, err := queryProjectV2[projectViewsQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to list project views",
+						err,
+					), nil
+				}
+				for _, node := range project.Views.Nodes {
+					if strings.EqualFold(string(node.Name), viewRef) {
+						viewNumber = int(node.Number)
+					}
+				}
+				if viewNumber == 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("project view %q not found", viewRef)), nil
+				}
+			}
+
+			project, err := queryProjectV2[projectViewQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{
+				"viewNumber": githubv4.Int(viewNumber),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get project view",
+					err,
+				), nil
+			}
+			if project.View == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project view %d not found", viewNumber)), nil
+			}
+
+			return MarshalledStructuredResult(convertToProjectView(project.View, string(project.URL))), nil
+		}
+}
+
+// ProjectFieldLayout is where a field sits in a project: its position among the project's fields, and how the default
+// view, the first view tab, groups and sorts items by it.
+type ProjectFieldLayout struct {
+	FieldID         int64  `json:"field_id"`
+	Position        int    `json:"position"`
+	GroupBy         bool   `json:"group_by,omitempty"`
+	VerticalGroupBy bool   `json:"vertical_group_by,omitempty"`
+	SortDirection   string `json:"sort_direction,omitempty"`
+}
+
+// projectFieldLayoutQuery selects the fields of a project in the order the UI shows them, and its default view.
+type projectFieldLayoutQuery struct {
+	Fields projectViewFieldConnection `graphql:"fields(first: 100, orderBy: {field: POSITION, direction: ASC})"`
+	Views  struct {
+		Nodes []projectViewNode
+	} `graphql:"views(first: 1, orderBy: {field: POSITION, direction: ASC})"`
+}
+
+// projectLayout is the default view of a project and the layout of all its fields, by field ID.
+type projectLayout struct {
+	defaultView *ProjectView
+	fields      map[int64]ProjectFieldLayout
+	fetchedAt   time.Time
+}
+
+// describe returns the layout of the fields with the given IDs, in the order of fieldIDs, and whether the layout of
+// all of them is known.
+func (l *projectLayout) describe(fieldIDs []int64) ([]ProjectFieldLayout, bool) {
+	fields := make([]ProjectFieldLayout, 0, len(fieldIDs))
+	for _, id := range fieldIDs {
+		if layout, ok := l.fields[id]; ok {
+			fields = append(fields, layout)
+		}
+	}
+	return fields, len(fields) == len(fieldIDs)
+}
+
+// queryProjectLayout returns the default view of a project and the layout of its fields.
+func queryProjectLayout(ctx context.Context, gqlClient *githubv4.Client, ownerType, owner string, projectNumber int) (*projectLayout, error) {
+	project, err := queryProjectV2[projectFieldLayoutQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	layouts := make(map[int64]*ProjectFieldLayout, len(project.Fields.Nodes))
+	for i, node := range project.Fields.Nodes {
+		id := int64(node.Common.DatabaseID)
+		layouts[id] = &ProjectFieldLayout{FieldID: id, Position: i + 1}
+	}
+	result := &projectLayout{fields: make(map[int64]ProjectFieldLayout, len(layouts)), fetchedAt: time.Now()}
+	if len(project.Views.Nodes) > 0 {
+		node := project.Views.Nodes[0]
+		view := convertToProjectViewSummary(node.projectViewSummaryNode)
+		result.defaultView = &view
+		for _, field := range node.GroupByFields.Nodes {
+			if layout, ok := layouts[int64(field.Common.DatabaseID)]; ok {
+				layout.GroupBy = true
+			}
+		}
+		for _, field := range node.VerticalGroupByFields.Nodes {
+			if layout, ok := layouts[int64(field.Common.DatabaseID)]; ok {
+				layout.VerticalGroupBy = true
+			}
+		}
+		for _, sort := range node.SortByFields.Nodes {
+			if layout, ok := layouts[int64(sort.Field.Common.DatabaseID)]; ok {
+				layout.SortDirection = strings.ToLower(string(sort.Direction))
+			}
+		}
+	}
+	for id, layout := range layouts {
+		result.fields[id] = *layout
+	}
+	return result, nil
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orgProjectQuery[T any] struct {
+	Organization struct {
+		ProjectV2 *T `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"organization(login: $owner)"`
+}
+
+type userProjectQuery[T any] struct {
+	User struct {
+		ProjectV2 *T `graphql:"projectV2(number: $projectNumber)"`
+	} `graphql:"user(login: $owner)"`
+}
+
+var projectViewsResponse = map[string]any{
+	"views": map[string]any{
+		"nodes": []any{
+			map[string]any{"number": 1, "name": "Backlog", "layout": "TABLE_LAYOUT", "filter": ""},
+			map[string]any{"number": 3, "name": "Sprint board", "layout": "BOARD_LAYOUT", "filter": "iteration:@current"},
+		},
+	},
+}
+
+func Test_ListProjectViews(t *testing.T) {
+	tool, _ := ListProjectViews(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_project_views", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		userProjectQuery[projectViewsQuery]{},
+		map[string]any{"owner": githubv4.String("octocat"), "projectNumber": githubv4.Int(5)},
+		githubv4mock.DataResponse(map[string]any{"user": map[string]any{"projectV2": projectViewsResponse}}),
+	))
+	_, handler := ListProjectViews(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":          "octocat",
+		"owner_type":     "user",
+		"project_number": float64(5),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var views ProjectViewsListResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &views))
+	assert.Equal(t, []ProjectView{
+		{Number: 1, Name: "Backlog", Layout: "table"},
+		{Number: 3, Name: "Sprint board", Layout: "board", Filter: "iteration:@current"},
+	}, views.Views)
+}
+
+func Test_GetProjectView(t *testing.T) {
+	tool, _ := GetProjectView(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_project_view", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "view"})
+
+	field := func(id int, name, dataType string) map[string]any {
+		return map[string]any{"databaseId": id, "name": name, "dataType": dataType}
+	}
+	listViews := githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectViewsQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": projectViewsResponse}}),
+	)
+	getView := githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectViewQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7), "viewNumber": githubv4.Int(3)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"url": "https://github.com/orgs/octo-org/projects/7",
+			"view": map[string]any{
+				"number":                3,
+				"name":                  "Sprint board",
+				"layout":                "BOARD_LAYOUT",
+				"filter":                "iteration:@current",
+				"createdAt":             "2025-01-06T09:00:00Z",
+				"updatedAt":             "2025-02-03T10:30:00Z",
+				"fields":                map[string]any{"nodes": []any{field(10, "Title", "TITLE"), field(11, "Assignees", "ASSIGNEES"), field(12, "Status", "SINGLE_SELECT")}},
+				"groupByFields":         map[string]any{"nodes": []any{}},
+				"verticalGroupByFields": map[string]any{"nodes": []any{field(12, "Status", "SINGLE_SELECT")}},
+				"sortByFields": map[string]any{"nodes": []any{
+					map[string]any{"direction": "DESC", "field": field(13, "Priority", "SINGLE_SELECT")},
+				}},
+			},
+		}}}),
+	)
+	missingView := githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectViewQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7), "viewNumber": githubv4.Int(9)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"url":  "https://github.com/orgs/octo-org/projects/7",
+			"view": nil,
+		}}}),
+	)
+
+	expected := ProjectView{
+		Number: 3,
+		Name:   "Sprint board",
+		Layout: "board",
+		Filter: "iteration:@current",
+		URL:    "https://github.com/orgs/octo-org/projects/7/views/3",
+		VisibleFields: []ProjectViewField{
+			{ID: 10, Name: "Title", DataType: "title"},
+			{ID: 11, Name: "Assignees", DataType: "assignees"},
+			{ID: 12, Name: "Status", DataType: "single_select"},
+		},
+		VerticalGroupBy: []ProjectViewField{{ID: 12, Name: "Status", DataType: "single_select"}},
+		SortBy:          []ProjectViewSort{{Field: ProjectViewField{ID: 13, Name: "Priority", DataType: "single_select"}, Direction: "desc"}},
+		CreatedAt:       "2025-01-06T09:00:00Z",
+		UpdatedAt:       "2025-02-03T10:30:00Z",
+	}
+
+	tests := []struct {
+		name           string
+		matchers       []githubv4mock.Matcher
+		view           string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:     "by number",
+			matchers: []githubv4mock.Matcher{getView},
+			view:     "3",
+		},
+		{
+			name:     "by name",
+			matchers: []githubv4mock.Matcher{listViews, getView},
+			view:     "sprint BOARD",
+		},
+		{
+			name:           "unknown name",
+			matchers:       []githubv4mock.Matcher{listViews},
+			view:           "Roadmap",
+			expectError:    true,
+			expectedErrMsg: `project view "Roadmap" not found`,
+		},
+		{
+			name:           "unknown number",
+			matchers:       []githubv4mock.Matcher{missingView},
+			view:           "9",
+			expectError:    true,
+			expectedErrMsg: "project view 9 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4mock.NewMockedHTTPClient(tc.matchers...)
+			_, handler := GetProjectView(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"view":           tc.view,
+			}))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var view ProjectView
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &view))
+			assert.Equal(t, expected, view)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// NoStatus is the status reported for project items without a value in the status field.
+const NoStatus = "No Status"
+
+// WIPStatusCount is the number of items of a project in a status, and the WIP limit of the status if any.
+type WIPStatusCount struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+	Limit  *int   `json:"limit,omitempty"`
+	OverBy int    `json:"over_by,omitempty"`
+}
+
+// WIPLimitsReport is the number of items of a project in each status, and the statuses over their WIP limit.
+type WIPLimitsReport struct {
+	Field      string           `json:"field"`
+	TotalItems int              `json:"total_items"`
+	Statuses   []WIPStatusCount `json:"statuses"`
+	Violations []WIPStatusCount `json:"violations"`
+}
+
+// parseWIPLimits converts the limits parameter of a request to the maximum number of items per status.
+func parseWIPLimits(request mcp.CallToolRequest) (map[string]int, error) {
+	raw, ok := request.GetArguments()["limits"]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: limits")
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok || len(obj) == 0 {
+		return nil, fmt.Errorf("limits must be an object of status names to maximum numbers of items")
+	}
+	limits := make(map[string]int, len(obj))
+	for status, value := range obj {
+		limit, ok := value.(float64)
+		if !ok || limit < 0 || limit != float64(int(limit)) {
+			return nil, fmt.Errorf("limit of status %q must be a non-negative integer", status)
+		}
+		limits[status] = int(limit)
+	}
+	return limits, nil
+}
+
+// listAllProjectItems lists every item of a project matching a query, following pagination to the end.
+func listAllProjectItems(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, fields []int64, query string) ([]*github.ProjectV2Item, *github.Response, error) {
+	perPage := MaxProjectsPerPage
+	opts := &github.ListProjectItemsOptions{
+		Fields: fields,
+		ListProjectsOptions: github.ListProjectsOptions{
+			ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: &perPage},
+		},
+	}
+	if query != "" {
+		opts.Query = &query
+	}
+
+	var items []*github.ProjectV2Item
+	for {
+		var pageItems []*github.ProjectV2Item
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			pageItems, resp, err = client.Projects.ListOrganizationProjectItems(ctx, owner, projectNumber, opts)
+		} else {
+			pageItems, resp, err = client.Projects.ListUserProjectItems(ctx, owner, projectNumber, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		items = append(items, pageItems...)
+		if resp.After == "" {
+			return items, resp, nil
+		}
+		after := resp.After
+		opts.After = &after
+	}
+}
+
+// singleSelectValue returns the name of the option selected in a single select field of a project item, or an
+// empty string if no option is selected.
+func singleSelectValue(item *github.ProjectV2Item, fieldID int64) string {
+	return selectedOptionName(itemFieldValue(item, fieldID))
+}
+
+// selectedOptionName returns the name of the option selected in the value of a single select field, or an empty
+// string if no option is selected.
+func selectedOptionName(value any) string {
+	option, ok := value.(map[string]any)
+	if !ok {
+		return ""
+	}
+	switch name := option["name"].(type) {
+	case string:
+		return name
+	case map[string]any:
+		raw, _ := name["raw"].(string)
+		return raw
+	}
+	return ""
+}
+
+// CheckWIPLimits creates a tool to count the items of a project in each status and report the statuses exceeding
+// their work in progress limits.
+func CheckWIPLimits(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_wip_limits",
+			mcp.WithDescription(t("TOOL_CHECK_WIP_LIMITS_DESCRIPTION", "Count the items of a project in each status and report the statuses exceeding their work in progress (WIP) limits. Every item of the project is counted, across all pages. Use this to flag overloaded columns before suggesting new work.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_WIP_LIMITS_USER_TITLE", "Check WIP limits"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[WIPLimitsReport](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithObject("limits",
+				mcp.Required(),
+				mcp.Description("Maximum number of items per status, keyed by status option name, e.g. {\"In Progress\": 5, \"In Review\": 3}. Statuses without a limit are counted but never violate."),
+			),
+			mcp.WithString("status_field",
+				mcp.Description("ID or name of the single select field holding the status"),
+				mcp.DefaultString(DefaultStatusField),
+			),
+			mcp.WithString("query",
+				mcp.Description("Only count items matching this project filter, e.g. 'is:issue'. Date qualifiers also accept relative dates such as updated:>=3-days-ago."),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the status."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limits, err := parseWIPLimits(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statusField, err := OptionalParam[string](req, "status_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if statusField == "" {
+				statusField = DefaultStatusField
+			}
+			query, err := OptionalParam[string](req, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if query != "" {
+				query = normalizeQueryDates(query, flags.now())
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			field := findProjectField(fields, statusField)
+			if field == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q not found", statusField)), nil
+			}
+			if field.GetDataType() != "single_select" {
+				return mcp.NewToolResultError(fmt.Sprintf("project field %q is a %s field; the status must be a single select field", field.GetName(), field.GetDataType())), nil
+			}
+
+			// Statuses are reported in the order of the field's options, followed by items without a status.
+			counts := make([]WIPStatusCount, 0, len(field.Options)+1)
+			for _, option := range field.Options {
+				counts = append(counts, WIPStatusCount{Status: option.GetName().GetRaw()})
+			}
+			counts = append(counts, WIPStatusCount{Status: NoStatus})
+			index := func(status string) int {
+				for i, c := range counts {
+					if strings.EqualFold(c.Status, status) {
+						return i
+					}
+				}
+				return -1
+			}
+
+			var unknown []string
+			for status, limit := range limits {
+				i := index(status)
+				if i < 0 {
+					unknown = append(unknown, status)
+					continue
+				}
+				counts[i].Limit = &limit
+			}
+			if len(unknown) > 0 {
+				sort.Strings(unknown)
+				names := make([]string, 0, len(counts))
+				for _, c := range counts {
+					names = append(names, c.Status)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("unknown statuses %s: expected options of field %q (%s)", strings.Join(unknown, ", "), field.GetName(), strings.Join(names, ", "))), nil
+			}
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, []int64{field.GetID()}, query)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					ProjectListFailedError,
+					resp,
+					err,
+				), nil
+			}
+
+			for _, item := range items {
+				status := singleSelectValue(item, field.GetID())
+				i := index(status)
+				if status == "" || i < 0 {
+					// Items with an option deleted since the field was cached are counted as without status.
+					i = len(counts) - 1
+				}
+				counts[i].Count++
+			}
+
+			violations := []WIPStatusCount{}
+			for i, c := range counts {
+				if c.Limit != nil && c.Count > *c.Limit {
+					counts[i].OverBy = c.Count - *c.Limit
+					violations = append(violations, counts[i])
+				}
+			}
+			if counts[len(counts)-1].Count == 0 && counts[len(counts)-1].Limit == nil {
+				counts = counts[:len(counts)-1]
+			}
+
+			return MarshalledStructuredResult(WIPLimitsReport{
+				Field:      field.GetName(),
+				TotalItems: len(items),
+				Statuses:   counts,
+				Violations: violations,
+			}), nil
+		}
+}
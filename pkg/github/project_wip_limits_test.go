@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusItem returns a project item with an option selected in the status field 101, or no option if status is empty.
+func statusItem(id int64, status string) map[string]any {
+	var value any
+	if status != "" {
+		value = map[string]any{"id": "opt-" + status, "name": map[string]any{"raw": status, "html": status}}
+	}
+	return map[string]any{
+		"id":     id,
+		"fields": []any{map[string]any{"id": 101, "name": "Status", "data_type": "single_select", "value": value}},
+	}
+}
+
+func Test_CheckWIPLimits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckWIPLimits(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "check_wip_limits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "limits"})
+
+	fields := []map[string]any{
+		{"id": 101, "name": "Status", "data_type": "single_select", "options": []any{
+			map[string]any{"id": "opt-Todo", "name": map[string]any{"raw": "Todo"}},
+			map[string]any{"id": "opt-In Progress", "name": map[string]any{"raw": "In Progress"}},
+			map[string]any{"id": "opt-Done", "name": map[string]any{"raw": "Done"}},
+		}},
+		{"id": 102, "name": "Notes", "data_type": "text"},
+	}
+	fieldsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+		mockResponse(t, http.StatusOK, fields),
+	)
+	// The items are served in two pages, linked by an after cursor.
+	itemsEndpoint := mock.WithRequestMatchHandler(
+		mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "101", r.URL.Query().Get("fields"))
+			if r.URL.Query().Get("after") == "" {
+				w.Header().Set("Link", `<https://api.github.com/orgs/octo/projectsV2/1/items?after=cursor1>; rel="next"`)
+				mockResponse(t, http.StatusOK, []any{
+					statusItem(1, "In Progress"),
+					statusItem(2, "In Progress"),
+					statusItem(3, "Todo"),
+				}).ServeHTTP(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, []any{
+				statusItem(4, "In Progress"),
+				statusItem(5, "Done"),
+				statusItem(6, ""),
+			}).ServeHTTP(w, r)
+		}),
+	)
+
+	t.Run("reports violations across pages", func(t *testing.T) {
+		_, handler := CheckWIPLimits(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient(fieldsEndpoint, itemsEndpoint))), translations.NullTranslationHelper, FeatureFlags{})
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(401),
+			"limits":         map[string]any{"in progress": float64(2), "Todo": float64(3)},
+		}))
+		require.NoError(t, err)
+
+		var response struct {
+			TotalItems int              `json:"total_items"`
+			Statuses   []WIPStatusCount `json:"statuses"`
+			Violations []WIPStatusCount `json:"violations"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, 6, response.TotalItems)
+
+		counts := map[string]int{}
+		for _, s := range response.Statuses {
+			counts[s.Status] = s.Count
+		}
+		assert.Equal(t, map[string]int{"Todo": 1, "In Progress": 3, "Done": 1, NoStatus: 1}, counts)
+
+		require.Len(t, response.Violations, 1)
+		assert.Equal(t, "In Progress", response.Violations[0].Status)
+		assert.Equal(t, 3, response.Violations[0].Count)
+		assert.Equal(t, 1, response.Violations[0].OverBy)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:         "unknown status",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(402),
+				"limits":         map[string]any{"Review": float64(2)},
+			},
+			expectedErrMsg: `unknown statuses Review: expected options of field "Status" (Todo, In Progress, Done, No Status)`,
+		},
+		{
+			name:         "invalid limit",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(403),
+				"limits":         map[string]any{"Todo": float64(-1)},
+			},
+			expectedErrMsg: `limit of status "Todo" must be a non-negative integer`,
+		},
+		{
+			name:         "status field is not a single select field",
+			mockedClient: mock.NewMockedHTTPClient(fieldsEndpoint),
+			requestArgs: map[string]interface{}{
+				"owner_type":     "org",
+				"owner":          "octo",
+				"project_number": float64(404),
+				"limits":         map[string]any{"Todo": float64(1)},
+				"status_field":   "Notes",
+			},
+			expectedErrMsg: `project field "Notes" is a text field`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := CheckWIPLimits(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectWorkflow is a built-in automation of a project, such as setting the status of closed items to Done.
+type ProjectWorkflow struct {
+	Number      int    `json:"number"`
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ProjectWorkflowsListResult is the result of listing the workflows of a project.
+type ProjectWorkflowsListResult struct {
+	Workflows []ProjectWorkflow `json:"workflows"`
+}
+
+// builtInProjectWorkflows describes what the built-in workflows do by default. The API does not expose how a
+// workflow is configured, e.g. which status it sets, so the defaults are the best available explanation.
+var builtInProjectWorkflows = map[string]string{
+	"auto-add to project":            "Adds issues and pull requests matching a filter from a repository to the project",
+	"auto-add sub-issues to project": "Adds the sub-issues of items in the project to the project",
+	"auto-archive items":             "Archives items matching a filter, by default closed items not updated for two weeks",
+	"auto-close issue":               "Closes an issue when its status is set to Done",
+	"code changes requested":         "Sets the status of a pull request when changes are requested in a review",
+	"code review approved":           "Sets the status of a pull request when a review approves it",
+	"item added to project":          "Sets the status of items added to the project, by default to Todo",
+	"item closed":                    "Sets the status of closed issues and pull requests, by default to Done",
+	"item reopened":                  "Sets the status of reopened issues and pull requests, by default to In Progress",
+	"pull request linked to issue":   "Sets the status of an issue when a pull request is linked to it",
+	"pull request merged":            "Sets the status of merged pull requests, by default to Done",
+}
+
+type projectWorkflowNode struct {
+	Number    githubv4.Int
+	Name      githubv4.String
+	Enabled   githubv4.Boolean
+	CreatedAt githubv4.DateTime
+	UpdatedAt githubv4.DateTime
+}
+
+// projectWorkflowsQuery selects the workflows of a project, of which there are a dozen or so.
+type projectWorkflowsQuery struct {
+	URL       githubv4.String
+	Workflows struct {
+		Nodes []projectWorkflowNode
+	} `graphql:"workflows(first: 100, orderBy: {field: NUMBER, direction: ASC})"`
+}
+
+type projectWorkflowQuery struct {
+	URL      githubv4.String
+	Workflow *projectWorkflowNode `graphql:"workflow(number: $workflowNumber)"`
+}
+
+func convertToProjectWorkflow(node projectWorkflowNode, projectURL string) ProjectWorkflow {
+	workflow := ProjectWorkflow{
+		Number:      int(node.Number),
+		Name:        string(node.Name),
+		Enabled:     bool(node.Enabled),
+		Description: builtInProjectWorkflows[strings.ToLower(string(node.Name))],
+	}
+	if projectURL != "" {
+		workflow.URL = fmt.Sprintf("%s/workflows/%d", projectURL, workflow.Number)
+	}
+	if !node.CreatedAt.IsZero() {
+		workflow.CreatedAt = node.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if !node.UpdatedAt.IsZero() {
+		workflow.UpdatedAt = node.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return workflow
+}
+
+// ListProjectWorkflows creates a tool to list the built-in workflows of a project.
+func ListProjectWorkflows(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_workflows",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_WORKFLOWS_DESCRIPTION", "List the built-in workflows (automations) of a Project for a user or org, such as 'Item closed' or 'Auto-add to project', with whether they are enabled and what they do by default. Use this to explain why items were added to a project or moved between statuses.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_WORKFLOWS_USER_TITLE", "List project workflows"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectWorkflowsListResult](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithBoolean("enabled_only",
+				mcp.Description("Only list the workflows that are enabled"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enabledOnly, err := OptionalParam[bool](req, "enabled_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, err := queryProjectV2[projectWorkflowsQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to list project workflows",
+					err,
+				), nil
+			}
+
+			workflows := make([]ProjectWorkflow, 0, len(project.Workflows.Nodes))
+			for _, node := range project.Workflows.Nodes {
+				if enabledOnly && !bool(node.Enabled) {
+					continue
+				}
+				workflows = append(workflows, convertToProjectWorkflow(node, string(project.URL)))
+			}
+			return MarshalledStructuredResult(ProjectWorkflowsListResult{Workflows: workflows}), nil
+		}
+}
+
+// GetProjectWorkflow creates a tool to get a built-in workflow of a project.
+func GetProjectWorkflow(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_workflow",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_WORKFLOW_DESCRIPTION", "Get a built-in workflow (automation) of a Project for a user or org: whether it is enabled, what it does by default and when it was last changed. The API does not expose a workflow's configuration, e.g. the status it sets; see the workflow's URL for that.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_WORKFLOW_USER_TITLE", "Get project workflow"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ProjectWorkflow](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("workflow",
+				mcp.Required(),
+				mcp.Description("Number or name of the workflow, e.g. 'Item closed'"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowRef, err := RequiredParam[string](req, "workflow")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			workflowRef = strings.TrimSpace(workflowRef)
+			workflowNumber, err := strconv.Atoi(workflowRef)
+			if err != nil {
+				// The list already holds everything there is to know about a workflow, so a name is resolved there.
+				project, err := queryProjectV2[projectWorkflowsQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{})
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						"failed to list project workflows",
+						err,
+					), nil
+				}
+				for _, node := range project.Workflows.Nodes {
+					if strings.EqualFold(string(node.Name), workflowRef) {
+						return MarshalledStructuredResult(convertToProjectWorkflow(node, string(project.URL))), nil
+					}
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("project workflow %q not found", workflowRef)), nil
+			}
+
+			project, err := queryProjectV2[projectWorkflowQuery](ctx, gqlClient, ownerType, owner, projectNumber, map[string]any{
+				"workflowNumber": githubv4.Int(workflowNumber),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get project workflow",
+					err,
+				), nil
+			}
+			if project.Workflow == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project workflow %d not found", workflowNumber)), nil
+			}
+
+			return MarshalledStructuredResult(convertToProjectWorkflow(*project.Workflow, string(project.URL))), nil
+		}
+}
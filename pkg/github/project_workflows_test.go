@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var projectWorkflowsResponse = map[string]any{
+	"url": "https://github.com/orgs/octo-org/projects/7",
+	"workflows": map[string]any{
+		"nodes": []any{
+			map[string]any{"number": 1, "name": "Item closed", "enabled": true, "createdAt": "2025-01-06T09:00:00Z", "updatedAt": "2025-02-03T10:30:00Z"},
+			map[string]any{"number": 2, "name": "Auto-archive items", "enabled": false, "createdAt": "2025-01-06T09:00:00Z", "updatedAt": "2025-01-06T09:00:00Z"},
+			map[string]any{"number": 5, "name": "Triage bot", "enabled": true, "createdAt": "2025-03-01T12:00:00Z", "updatedAt": "2025-03-01T12:00:00Z"},
+		},
+	},
+}
+
+var itemClosedWorkflow = ProjectWorkflow{
+	Number:      1,
+	Name:        "Item closed",
+	Enabled:     true,
+	Description: "Sets the status of closed issues and pull requests, by default to Done",
+	URL:         "https://github.com/orgs/octo-org/projects/7/workflows/1",
+	CreatedAt:   "2025-01-06T09:00:00Z",
+	UpdatedAt:   "2025-02-03T10:30:00Z",
+}
+
+func Test_ListProjectWorkflows(t *testing.T) {
+	tool, _ := ListProjectWorkflows(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_project_workflows", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		expected []string
+	}{
+		{
+			name:     "all workflows",
+			args:     map[string]any{},
+			expected: []string{"Item closed", "Auto-archive items", "Triage bot"},
+		},
+		{
+			name:     "enabled only",
+			args:     map[string]any{"enabled_only": true},
+			expected: []string{"Item closed", "Triage bot"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+				orgProjectQuery[projectWorkflowsQuery]{},
+				map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7)},
+				githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": projectWorkflowsResponse}}),
+			))
+			_, handler := ListProjectWorkflows(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{"owner": "octo-org", "owner_type": "org", "project_number": float64(7)}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var workflows ProjectWorkflowsListResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &workflows))
+			names := make([]string, 0, len(workflows.Workflows))
+			for _, workflow := range workflows.Workflows {
+				names = append(names, workflow.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+			assert.Equal(t, itemClosedWorkflow, workflows.Workflows[0])
+			assert.Empty(t, workflows.Workflows[len(workflows.Workflows)-1].Description)
+		})
+	}
+}
+
+func Test_GetProjectWorkflow(t *testing.T) {
+	tool, _ := GetProjectWorkflow(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_project_workflow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "workflow"})
+
+	listWorkflows := githubv4mock.NewQueryMatcher(
+		userProjectQuery[projectWorkflowsQuery]{},
+		map[string]any{"owner": githubv4.String("octocat"), "projectNumber": githubv4.Int(7)},
+		githubv4mock.DataResponse(map[string]any{"user": map[string]any{"projectV2": projectWorkflowsResponse}}),
+	)
+	getWorkflow := func(number int, workflow any) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			userProjectQuery[projectWorkflowQuery]{},
+			map[string]any{"owner": githubv4.String("octocat"), "projectNumber": githubv4.Int(7), "workflowNumber": githubv4.Int(number)},
+			githubv4mock.DataResponse(map[string]any{"user": map[string]any{"projectV2": map[string]any{
+				"url":      "https://github.com/orgs/octo-org/projects/7",
+				"workflow": workflow,
+			}}}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		matchers       []githubv4mock.Matcher
+		workflow       string
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name:     "by number",
+			matchers: []githubv4mock.Matcher{getWorkflow(1, projectWorkflowsResponse["workflows"].(map[string]any)["nodes"].([]any)[0])},
+			workflow: "1",
+		},
+		{
+			name:     "by name",
+			matchers: []githubv4mock.Matcher{listWorkflows},
+			workflow: "item CLOSED",
+		},
+		{
+			name:           "unknown name",
+			matchers:       []githubv4mock.Matcher{listWorkflows},
+			workflow:       "Item reopened",
+			expectError:    true,
+			expectedErrMsg: `project workflow "Item reopened" not found`,
+		},
+		{
+			name:           "unknown number",
+			matchers:       []githubv4mock.Matcher{getWorkflow(9, nil)},
+			workflow:       "9",
+			expectError:    true,
+			expectedErrMsg: "project workflow 9 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4mock.NewMockedHTTPClient(tc.matchers...)
+			_, handler := GetProjectWorkflow(stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(7),
+				"workflow":       tc.workflow,
+			}))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var workflow ProjectWorkflow
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &workflow))
+			assert.Equal(t, itemClosedWorkflow, workflow)
+		})
+	}
+}
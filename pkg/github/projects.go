@@ -2,17 +2,19 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
 )
 
 const (
@@ -23,13 +25,14 @@ const (
 	MaxProjectsPerPage       = 50
 )
 
-func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func ListProjects(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_projects",
 			mcp.WithDescription(t("TOOL_LIST_PROJECTS_DESCRIPTION", `List Projects for a user or organization`)),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_PROJECTS_USER_TITLE", "List projects"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[ProjectsListResult](),
 			mcp.WithString("owner_type",
 				mcp.Required(), mcp.Description("Owner type"), mcp.Enum("user", "org"),
 			),
@@ -38,7 +41,11 @@ func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
 			),
 			mcp.WithString("query",
-				mcp.Description(`Filter projects by title text and open/closed state; permitted qualifiers: is:open, is:closed; examples: "roadmap is:open", "is:open feature planning".`),
+				mcp.Description(`Filter projects by title text, e.g. "roadmap" or "feature planning".`),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter projects by state. Defaults to all."),
+				mcp.Enum("open", "closed", "all"),
 			),
 			mcp.WithNumber("per_page",
 				mcp.Description(fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage)),
@@ -64,6 +71,17 @@ func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			state, err := OptionalParam[string](req, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch state {
+			case "", "all":
+			case "open", "closed":
+				queryStr = strings.TrimSpace(queryStr + " is:" + state)
+			default:
+				return mcp.NewToolResultError("state must be one of 'open', 'closed' or 'all'"), nil
+			}
 
 			pagination, err := extractPaginationOptions(req)
 			if err != nil {
@@ -107,18 +125,20 @@ func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			for _, project := range projects {
 				minimalProjects = append(minimalProjects, *convertToMinimalProject(project))
 			}
-
-			response := map[string]any{
-				"projects": minimalProjects,
-				"pageInfo": buildPageInfo(resp),
+			result := ProjectsListResult{
+				Projects: minimalProjects,
+				PageInfo: buildPageInfo(resp),
 			}
-
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			// The summaries only add to the projects, which are listed without them when there is no GraphQL client,
+			// or with a warning when the GraphQL query fails.
+			if gqlClient, err := getGQLClient(ctx); err == nil {
+				if err := summarizeProjects(ctx, gqlClient, minimalProjects); err != nil {
+					_, _ = ghErrors.NewGitHubGraphQLErrorToCtx(ctx, "failed to summarize projects", err)
+					result.Warnings = append(result.Warnings, fmt.Sprintf("failed to get the state, item count and latest status update of the projects: %s", err))
+				}
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(result), nil
 		}
 }
 
@@ -129,6 +149,7 @@ func GetProject(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				Title:        t("TOOL_GET_PROJECT_USER_TITLE", "Get project"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[MinimalProject](),
 			mcp.WithNumber("project_number",
 				mcp.Required(),
 				mcp.Description("The project's number"),
@@ -189,23 +210,18 @@ func GetProject(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get project: %s", string(body))), nil
 			}
 
-			minimalProject := convertToMinimalProject(project)
-			r, err := json.Marshal(minimalProject)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(convertToMinimalProject(project)), nil
 		}
 }
 
-func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func ListProjectFields(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_project_fields",
-			mcp.WithDescription(t("TOOL_LIST_PROJECT_FIELDS_DESCRIPTION", "List Project fields for a user or org")),
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_FIELDS_DESCRIPTION", "List Project fields for a user or org. Also returns the project's default view, and the position of each field and whether the default view groups or sorts by it, to present the project the way users see it.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_PROJECT_FIELDS_USER_TITLE", "List project fields"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[ProjectFieldsListResult](),
 			mcp.WithString("owner_type",
 				mcp.Required(),
 				mcp.Description("Owner type"),
@@ -275,17 +291,36 @@ func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			response := map[string]any{
-				"fields":   projectFields,
-				"pageInfo": buildPageInfo(resp),
+			// Reuse a complete listing to resolve field names in other project tools.
+			if pagination.After == nil && pagination.Before == nil && resp.After == "" {
+				projectFieldCache.Store(ownerType, owner, projectNumber, projectFields)
 			}
 
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			if projectFields == nil {
+				projectFields = []*github.ProjectV2Field{}
+			}
+			result := ProjectFieldsListResult{
+				Fields:   projectFields,
+				PageInfo: buildPageInfo(resp),
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			// The layout only adds to the fields, which are listed without it when there is no GraphQL client, or
+			// with a warning when the GraphQL query fails.
+			if gqlClient, err := getGQLClient(ctx); err == nil {
+				fieldIDs := make([]int64, 0, len(projectFields))
+				for _, field := range projectFields {
+					fieldIDs = append(fieldIDs, field.GetID())
+				}
+				defaultView, layout, err := projectFieldCache.Layout(ctx, gqlClient, ownerType, owner, projectNumber, fieldIDs)
+				if err != nil {
+					_, _ = ghErrors.NewGitHubGraphQLErrorToCtx(ctx, "failed to get project field layout", err)
+					result.Warnings = append(result.Warnings, fmt.Sprintf("failed to get the default view and the layout of the fields: %s", err))
+				} else {
+					result.DefaultView = defaultView
+					result.Layout = layout
+				}
+			}
+			return MarshalledStructuredResult(result), nil
 		}
 }
 
@@ -296,6 +331,7 @@ func GetProjectField(getClient GetClientFn, t translations.TranslationHelperFunc
 				Title:        t("TOOL_GET_PROJECT_FIELD_USER_TITLE", "Get project field"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.ProjectV2Field](),
 			mcp.WithString("owner_type",
 				mcp.Required(),
 				mcp.Description("Owner type"), mcp.Enum("user", "org")),
@@ -357,22 +393,18 @@ func GetProjectField(getClient GetClientFn, t translations.TranslationHelperFunc
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get project field: %s", string(body))), nil
 			}
-			r, err := json.Marshal(projectField)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(projectField), nil
 		}
 }
 
-func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_project_items",
 			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DESCRIPTION", `Search project items with advanced filtering`)),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_PROJECT_ITEMS_USER_TITLE", "List project items"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[ProjectItemsListResult](),
 			mcp.WithString("owner_type",
 				mcp.Required(),
 				mcp.Description("Owner type"),
@@ -386,7 +418,7 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("The project's number."),
 			),
 			mcp.WithString("query",
-				mcp.Description(`Query string for advanced filtering of project items using GitHub's project filtering syntax.`),
+				mcp.Description(`Query string for advanced filtering of project items using GitHub's project filtering syntax. Date qualifiers also accept relative dates such as updated:"last monday", created:>=3-days-ago or updated:2024-W05 (ISO week).`),
 			),
 			mcp.WithNumber("per_page",
 				mcp.Description(fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage)),
@@ -398,9 +430,24 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("Backward pagination cursor from previous pageInfo.prevCursor (rare)."),
 			),
 			mcp.WithArray("fields",
-				mcp.Description("Field IDs to include (e.g. [\"102589\", \"985201\"]). CRITICAL: Always provide to get field values. Without this, only titles returned."),
+				mcp.Description("Field IDs or field names to include (e.g. [\"102589\", \"Status\"]). CRITICAL: Always provide to get field values. Without this, only titles returned."),
 				mcp.WithStringItems(),
 			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving field names."),
+			),
+			mcp.WithBoolean("archived_only",
+				mcp.Description("List only archived items, which GitHub hides from listings otherwise. Its filter cannot return archived and active items together."),
+			),
+			mcp.WithBoolean("exclude_redacted",
+				mcp.Description("Leave out redacted items, whose content the token cannot see, such as issues of private repositories. Pages may then hold fewer items than per_page."),
+			),
+			mcp.WithString("group_by",
+				mcp.Description("Field ID or field name (e.g. \"Status\") to count the matching items by. When set, every page of items is read by the server and only the number of items per value of the field is returned, without the items. Prefer this over listing items to get an overview of a large project."),
+			),
+			mcp.WithBoolean("date_countdowns",
+				mcp.Description("Add the number of days until the date of each requested date field and whether it is overdue to the items, counted from today in the server's time zone."),
+			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
 			if err != nil {
@@ -422,7 +469,27 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			fields, err := OptionalBigIntArrayParam(req, "fields")
+			fieldRefs, err := OptionalStringArrayParam(req, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archivedOnly, err := OptionalParam[bool](req, "archived_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			groupBy, err := OptionalParam[string](req, "group_by")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			excludeRedacted, err := OptionalParam[bool](req, "exclude_redacted")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			withDateCountdowns, err := OptionalParam[bool](req, "date_countdowns")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -437,14 +504,47 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			fields, err := projectFieldCache.ResolveFieldIDs(ctx, client, ownerType, owner, projectNumber, fieldRefs, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			var resp *github.Response
 			var projectItems []*github.ProjectV2Item
 			var queryPtr *string
 
+			if archivedOnly {
+				queryStr = strings.TrimSpace(queryStr + " is:archived")
+			}
 			if queryStr != "" {
+				queryStr = normalizeQueryDates(queryStr, flags.now())
 				queryPtr = &queryStr
 			}
 
+			if groupBy != "" {
+				groupFields, err := projectFieldCache.ResolveFieldIDs(ctx, client, ownerType, owner, projectNumber, []string{groupBy}, refresh)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				projectItems, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, groupFields, queryStr)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						ProjectListFailedError,
+						resp,
+						err,
+					), nil
+				}
+				if excludeRedacted {
+					projectItems = slices.DeleteFunc(projectItems, isRedactedProjectItem)
+				}
+				return MarshalledStructuredResult(ProjectItemsListResult{
+					Items:      []ProjectItem{},
+					GroupBy:    groupBy,
+					Groups:     groupProjectItems(projectItems, groupFields[0]),
+					TotalCount: len(projectItems),
+				}), nil
+			}
+
 			opts := &github.ListProjectItemsOptions{
 				Fields: fields,
 				ListProjectsOptions: github.ListProjectsOptions{
@@ -468,17 +568,20 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			response := map[string]any{
-				"items":    projectItems,
-				"pageInfo": buildPageInfo(resp),
+			if excludeRedacted {
+				projectItems = slices.DeleteFunc(projectItems, isRedactedProjectItem)
 			}
-
-			r, err := json.Marshal(response)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			items := convertToProjectItems(projectItems)
+			if withDateCountdowns {
+				now := flags.now()
+				for i := range items {
+					items[i].DateCountdowns = dateCountdowns(items[i].ProjectV2Item, now)
+				}
 			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(ProjectItemsListResult{
+				Items:    items,
+				PageInfo: buildPageInfo(resp),
+			}), nil
 		}
 }
 
@@ -489,6 +592,7 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_GET_PROJECT_ITEM_USER_TITLE", "Get project item"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.ProjectV2Item](),
 			mcp.WithString("owner_type",
 				mcp.Required(),
 				mcp.Description("Owner type"),
@@ -507,9 +611,12 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Description("The item's ID."),
 			),
 			mcp.WithArray("fields",
-				mcp.Description("Specific list of field IDs to include in the response (e.g. [\"102589\", \"985201\", \"169875\"]). If not provided, only the title field is included."),
+				mcp.Description("Specific list of field IDs or field names to include in the response (e.g. [\"102589\", \"Status\", \"169875\"]). If not provided, only the title field is included."),
 				mcp.WithStringItems(),
 			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving field names."),
+			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
 			if err != nil {
@@ -529,7 +636,11 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			fields, err := OptionalBigIntArrayParam(req, "fields")
+			fieldRefs, err := OptionalStringArrayParam(req, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			refresh, err := OptionalParam[bool](req, "refresh")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -539,6 +650,11 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			fields, err := projectFieldCache.ResolveFieldIDs(ctx, client, ownerType, owner, projectNumber, fieldRefs, refresh)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			var resp *github.Response
 			var projectItem *github.ProjectV2Item
 			var opts *github.GetProjectItemOptions
@@ -564,12 +680,360 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(projectItem)
+			return MarshalledStructuredResult(projectItem), nil
+		}
+}
+
+func CreateProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_DESCRIPTION", "Create a new Project for a user or org")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_USER_TITLE", "Create project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[MinimalProject](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("The project's title"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the project"),
+			),
+			mcp.WithString("readme",
+				mcp.Description("Readme of the project, in Markdown"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](req, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](req, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readme, err := OptionalParam[string](req, "readme")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Projects can only be created through the GraphQL API, which needs the node ID of the owner.
+			var ownerID githubv4.ID
+			vars := map[string]any{"login": githubv4.String(owner)}
+			if ownerType == "org" {
+				var q struct {
+					Organization struct {
+						ID githubv4.ID
+					} `graphql:"organization(login: $login)"`
+				}
+				err = gqlClient.Query(ctx, &q, vars)
+				ownerID = q.Organization.ID
+			} else {
+				var q struct {
+					User struct {
+						ID githubv4.ID
+					} `graphql:"user(login: $login)"`
+				}
+				err = gqlClient.Query(ctx, &q, vars)
+				ownerID = q.User.ID
+			}
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to find %s %s", ownerType, owner),
+					err,
+				), nil
+			}
+
+			var create struct {
+				CreateProjectV2 struct {
+					ProjectV2 struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+					}
+				} `graphql:"createProjectV2(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &create, githubv4.CreateProjectV2Input{
+				OwnerID: ownerID,
+				Title:   githubv4.String(title),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to create project",
+					err,
+				), nil
+			}
+			projectNumber := int(create.CreateProjectV2.ProjectV2.Number)
+
+			if description != "" || readme != "" {
+				input := githubv4.UpdateProjectV2Input{ProjectID: create.CreateProjectV2.ProjectV2.ID}
+				if description != "" {
+					input.ShortDescription = githubv4.NewString(githubv4.String(description))
+				}
+				if readme != "" {
+					input.Readme = githubv4.NewString(githubv4.String(readme))
+				}
+				var update struct {
+					UpdateProjectV2 struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						}
+					} `graphql:"updateProjectV2(input: $input)"`
+				}
+				if err := gqlClient.Mutate(ctx, &update, input, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						fmt.Sprintf("project %d was created, but failed to set its description and readme", projectNumber),
+						err,
+					), nil
+				}
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("project %d was created, but failed to get it", projectNumber),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(convertToMinimalProject(project)), nil
+		}
+}
+
+func UpdateProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_DESCRIPTION", "Update the title, description, readme, visibility or closed state of a Project for a user or org")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_USER_TITLE", "Update project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[MinimalProject](),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title of the project"),
+			),
+			mcp.WithString("description",
+				mcp.Description("New short description of the project"),
+			),
+			mcp.WithString("readme",
+				mcp.Description("New readme of the project, in Markdown"),
+			),
+			mcp.WithBoolean("public",
+				mcp.Description("Make the project public (true) or private (false)"),
+			),
+			mcp.WithBoolean("closed",
+				mcp.Description("Close (true) or re-open (false) the project"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var input githubv4.UpdateProjectV2Input
+			for _, param := range []struct {
+				name   string
+				target **githubv4.String
+			}{
+				{"title", &input.Title},
+				{"description", &input.ShortDescription},
+				{"readme", &input.Readme},
+			} {
+				value, ok, err := OptionalParamOK[string](req, param.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*param.target = githubv4.NewString(githubv4.String(value))
+				}
+			}
+			for _, param := range []struct {
+				name   string
+				target **githubv4.Boolean
+			}{
+				{"public", &input.Public},
+				{"closed", &input.Closed},
+			} {
+				value, ok, err := OptionalParamOK[bool](req, param.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*param.target = githubv4.NewBoolean(githubv4.Boolean(value))
+				}
+			}
+			if input.Title == nil && input.ShortDescription == nil && input.Readme == nil && input.Public == nil && input.Closed == nil {
+				return mcp.NewToolResultError("at least one of title, description, readme, public or closed must be provided"), nil
+			}
+			if input.Title != nil && *input.Title == "" {
+				return mcp.NewToolResultError("title must not be empty"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			input.ProjectID = githubv4.ID(project.GetNodeID())
+			var update struct {
+				UpdateProjectV2 struct {
+					ProjectV2 struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &update, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to update project",
+					err,
+				), nil
+			}
+
+			project, resp, err = getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("project %d was updated, but failed to get it", projectNumber),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(convertToMinimalProject(project)), nil
+		}
+}
+
+func DeleteProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	projectDeletedMessage := t("RESULT_PROJECT_DELETED", "project %d successfully deleted")
+	return mcp.NewTool("delete_project",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_DESCRIPTION", "Delete a Project for a user or org, including all of its items and fields. This cannot be undone; consider closing the project with update_project instead.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_USER_TITLE", "Delete project"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := RequiredInt(req, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get project",
+					resp,
+					err,
+				), nil
 			}
+			defer func() { _ = resp.Body.Close() }()
 
-			return mcp.NewToolResultText(string(r)), nil
+			var mutation struct {
+				DeleteProjectV2 struct {
+					ProjectV2 struct {
+						ID githubv4.ID
+					}
+				} `graphql:"deleteProjectV2(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, githubv4.DeleteProjectV2Input{
+				ProjectID: githubv4.ID(project.GetNodeID()),
+			}, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to delete project",
+					err,
+				), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(projectDeletedMessage, projectNumber)), nil
 		}
 }
 
@@ -580,6 +1044,7 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				Title:        t("TOOL_ADD_PROJECT_ITEM_USER_TITLE", "Add project item"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
+			WithOutputSchema[github.ProjectV2Item](),
 			mcp.WithString("owner_type",
 				mcp.Required(),
 				mcp.Description("Owner type"), mcp.Enum("user", "org"),
@@ -592,14 +1057,15 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("The project's number."),
 			),
+			mcp.WithString("ref",
+				mcp.Description("The issue or pull request to add, as a reference in 'owner/repo#123' format. Use instead of item_type and item_id."),
+			),
 			mcp.WithString("item_type",
-				mcp.Required(),
-				mcp.Description("The item's type, either issue or pull_request."),
+				mcp.Description("The item's type, either issue or pull_request. Required unless ref is given."),
 				mcp.Enum("issue", "pull_request"),
 			),
 			mcp.WithNumber("item_id",
-				mcp.Required(),
-				mcp.Description("The numeric ID of the issue or pull request to add to the project."),
+				mcp.Description("The numeric ID of the issue or pull request to add to the project. Required unless ref is given."),
 			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
@@ -614,17 +1080,29 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			itemID, err := RequiredBigInt(req, "item_id")
+			ref, hasRef, err := OptionalIssueRef(req, "ref")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			itemType, err := RequiredParam[string](req, "item_type")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			if itemType != "issue" && itemType != "pull_request" {
-				return mcp.NewToolResultError("item_type must be either 'issue' or 'pull_request'"), nil
+			var itemID int64
+			var itemType string
+			if hasRef {
+				if _, ok := req.GetArguments()["item_id"]; ok {
+					return mcp.NewToolResultError("pass either ref or item_type and item_id, not both"), nil
+				}
+			} else {
+				itemID, err = RequiredBigInt(req, "item_id")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				itemType, err = RequiredParam[string](req, "item_type")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if itemType != "issue" && itemType != "pull_request" {
+					return mcp.NewToolResultError("item_type must be either 'issue' or 'pull_request'"), nil
+				}
 			}
 
 			client, err := getClient(ctx)
@@ -632,6 +1110,18 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			if hasRef {
+				var resp *github.Response
+				itemType, itemID, resp, err = resolveIssueRef(ctx, client, ref)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get %s", ref),
+						resp,
+						err,
+					), nil
+				}
+			}
+
 			newItem := &github.AddProjectItemOptions{
 				ID:   itemID,
 				Type: toNewProjectType(itemType),
@@ -662,12 +1152,7 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("%s: %s", ProjectAddFailedError, string(body))), nil
 			}
-			r, err := json.Marshal(addedItem)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(addedItem), nil
 		}
 }
 
@@ -678,6 +1163,7 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 				Title:        t("TOOL_UPDATE_PROJECT_ITEM_USER_TITLE", "Update project item"),
 				ReadOnlyHint: ToBoolPtr(false),
 			}),
+			WithOutputSchema[github.ProjectV2Item](),
 			mcp.WithString("owner_type",
 				mcp.Required(), mcp.Description("Owner type"),
 				mcp.Enum("user", "org"),
@@ -696,7 +1182,10 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			),
 			mcp.WithObject("updated_field",
 				mcp.Required(),
-				mcp.Description("Object consisting of the ID of the project field to update and the new value for the field. To clear the field, set value to null. Example: {\"id\": 123456, \"value\": \"New Value\"}"),
+				mcp.Description("Object consisting of the ID or name of the project field to update and the new value for the field. To clear the field, set value to null. Example: {\"id\": 123456, \"value\": \"New Value\"} or {\"name\": \"Status\", \"value\": \"In Review\"}. When the field is given by name, the value of a single select field may be the name of the option instead of its ID."),
+			),
+			mcp.WithBoolean("refresh",
+				mcp.Description("Reload the project's field definitions instead of using cached ones when resolving the field name."),
 			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
@@ -725,8 +1214,7 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			if !ok || fieldValue == nil {
 				return mcp.NewToolResultError("field_value must be an object"), nil
 			}
-
-			updatePayload, err := buildUpdateProjectItem(fieldValue)
+			refresh, err := OptionalParam[bool](req, "refresh")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -736,6 +1224,24 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			if _, hasID := fieldValue["id"]; !hasID {
+				if name, isString := fieldValue["name"].(string); isString && name != "" {
+					ids, err := projectFieldCache.ResolveFieldIDs(ctx, client, ownerType, owner, projectNumber, []string{name}, refresh)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+					fieldValue["id"] = ids[0]
+					if fieldValue["value"], err = resolveFieldOptionValue(ctx, client, ownerType, owner, projectNumber, ids[0], fieldValue["value"], refresh); err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				}
+			}
+
+			updatePayload, err := buildUpdateProjectItem(fieldValue)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			var resp *github.Response
 			var updatedItem *github.ProjectV2Item
 
@@ -761,16 +1267,12 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("%s: %s", ProjectUpdateFailedError, string(body))), nil
 			}
-			r, err := json.Marshal(updatedItem)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(updatedItem), nil
 		}
 }
 
 func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	projectItemDeletedMessage := t("RESULT_PROJECT_ITEM_DELETED", "project item successfully deleted")
 	return mcp.NewTool("delete_project_item",
 			mcp.WithDescription(t("TOOL_DELETE_PROJECT_ITEM_DESCRIPTION", "Delete a specific Project item for a user or org")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -839,7 +1341,7 @@ func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("%s: %s", ProjectDeleteFailedError, string(body))), nil
 			}
-			return mcp.NewToolResultText("project item successfully deleted"), nil
+			return mcp.NewToolResultText(projectItemDeletedMessage), nil
 		}
 }
 
@@ -850,6 +1352,201 @@ type pageInfo struct {
 	PrevCursor      string `json:"prevCursor,omitempty"`
 }
 
+// ProjectsListResult is the structured content of list_projects.
+type ProjectsListResult struct {
+	Projects []MinimalProject `json:"projects"`
+	PageInfo pageInfo         `json:"pageInfo"`
+	// Warnings report the project details that could not be retrieved.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ProjectFieldsListResult is the structured content of list_project_fields.
+type ProjectFieldsListResult struct {
+	Fields      []*github.ProjectV2Field `json:"fields"`
+	PageInfo    pageInfo                 `json:"pageInfo"`
+	DefaultView *ProjectView             `json:"default_view,omitempty"`
+	Layout      []ProjectFieldLayout     `json:"layout,omitempty"`
+	// Warnings report the project details that could not be retrieved.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ProjectItemsListResult is the structured content of list_project_items.
+type ProjectItemsListResult struct {
+	Items    []ProjectItem `json:"items"`
+	PageInfo pageInfo      `json:"pageInfo"`
+	// GroupBy, Groups and TotalCount are only set when items are grouped, in which case Items is empty.
+	GroupBy    string             `json:"group_by,omitempty"`
+	Groups     []ProjectItemGroup `json:"groups,omitempty"`
+	TotalCount int                `json:"total_count,omitempty"`
+}
+
+// ProjectItem is a listed project item, with whether it is archived, and whether it is redacted, i.e. its content is
+// hidden from the token, as the UI shows it.
+type ProjectItem struct {
+	*github.ProjectV2Item
+	IsArchived bool `json:"is_archived"`
+	IsRedacted bool `json:"is_redacted"`
+	// DateCountdowns are derived from the values of the date fields, when requested.
+	DateCountdowns []DateCountdown `json:"date_countdowns,omitempty"`
+}
+
+// DateCountdown is the number of days until the date of a date field of a project item.
+type DateCountdown struct {
+	Field string `json:"field"`
+	Date  string `json:"date"`
+	// DaysUntilDue is negative once the date has passed.
+	DaysUntilDue int  `json:"days_until_due"`
+	Overdue      bool `json:"overdue"`
+}
+
+// dateCountdowns computes the days until the dates of the date fields of a project item, counted in calendar days
+// from the date of now.
+func dateCountdowns(item *github.ProjectV2Item, now time.Time) []DateCountdown {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var countdowns []DateCountdown
+	for _, field := range item.Fields {
+		if field.DataType != "date" {
+			continue
+		}
+		date, ok := dateValue(field.Value)
+		if !ok {
+			continue
+		}
+		days := int(date.Sub(today).Hours() / 24)
+		countdowns = append(countdowns, DateCountdown{
+			Field:        field.Name,
+			Date:         date.Format(queryDateLayout),
+			DaysUntilDue: days,
+			Overdue:      days < 0,
+		})
+	}
+	return countdowns
+}
+
+func isRedactedProjectItem(item *github.ProjectV2Item) bool {
+	return strings.EqualFold(item.GetContentType(), "Redacted")
+}
+
+func convertToProjectItems(items []*github.ProjectV2Item) []ProjectItem {
+	projectItems := make([]ProjectItem, 0, len(items))
+	for _, item := range items {
+		projectItems = append(projectItems, ProjectItem{
+			ProjectV2Item: item,
+			IsArchived:    item.ArchivedAt != nil,
+			IsRedacted:    isRedactedProjectItem(item),
+		})
+	}
+	return projectItems
+}
+
+// projectSummariesQuery loads the state, the number of items and the latest status update of a batch of projects,
+// given by node ID.
+type projectSummariesQuery struct {
+	Nodes []struct {
+		ProjectV2 struct {
+			ID     githubv4.ID
+			Closed githubv4.Boolean
+			Items  struct {
+				TotalCount githubv4.Int
+			}
+			StatusUpdates struct {
+				Nodes []projectStatusUpdateNode
+			} `graphql:"statusUpdates(first: 1, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"nodes(ids: $ids)"`
+}
+
+// projectStatusUpdateNode is a status update of a project, as loaded with GraphQL.
+type projectStatusUpdateNode struct {
+	ID         githubv4.ID
+	Status     githubv4.String
+	Body       githubv4.String
+	StartDate  githubv4.String
+	TargetDate githubv4.String
+	CreatedAt  githubv4.DateTime
+	Creator    struct {
+		Login githubv4.String
+	}
+}
+
+func (n projectStatusUpdateNode) minimal() *MinimalProjectStatusUpdate {
+	return &MinimalProjectStatusUpdate{
+		ID:         fmt.Sprint(n.ID),
+		Status:     string(n.Status),
+		Body:       string(n.Body),
+		StartDate:  string(n.StartDate),
+		TargetDate: string(n.TargetDate),
+		Creator:    string(n.Creator.Login),
+		CreatedAt:  n.CreatedAt.Time,
+	}
+}
+
+// summarizeProjects sets whether each project is closed, its number of items and its latest status update, which the
+// REST API does not return, with a single GraphQL query.
+func summarizeProjects(ctx context.Context, gqlClient *githubv4.Client, projects []MinimalProject) error {
+	if len(projects) == 0 {
+		return nil
+	}
+	ids := make([]githubv4.ID, 0, len(projects))
+	for _, project := range projects {
+		ids = append(ids, githubv4.ID(*project.NodeID))
+	}
+	var query projectSummariesQuery
+	if err := gqlClient.Query(ctx, &query, map[string]any{"ids": ids}); err != nil {
+		return err
+	}
+
+	for _, node := range query.Nodes {
+		for i := range projects {
+			if fmt.Sprint(node.ProjectV2.ID) != *projects[i].NodeID {
+				continue
+			}
+			projects[i].Closed = github.Ptr(bool(node.ProjectV2.Closed))
+			projects[i].ItemCount = github.Ptr(int(node.ProjectV2.Items.TotalCount))
+			if len(node.ProjectV2.StatusUpdates.Nodes) > 0 {
+				projects[i].LatestStatusUpdate = node.ProjectV2.StatusUpdates.Nodes[0].minimal()
+			}
+		}
+	}
+	return nil
+}
+
+// resolveIssueRef returns the item type and the numeric ID of the issue or pull request named by ref, as taken by
+// the REST API for adding project items. Pull requests are added by their own ID, not that of their issue.
+func resolveIssueRef(ctx context.Context, client *github.Client, ref IssueRef) (string, int64, *github.Response, error) {
+	issue, resp, err := client.Issues.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+	if err != nil {
+		return "", 0, resp, err
+	}
+	_ = resp.Body.Close()
+	if !issue.IsPullRequest() {
+		return "issue", issue.GetID(), resp, nil
+	}
+
+	pr, resp, err := client.PullRequests.Get(ctx, ref.Owner, ref.Repo, ref.Number)
+	if err != nil {
+		return "", 0, resp, err
+	}
+	_ = resp.Body.Close()
+	return "pull_request", pr.GetID(), resp, nil
+}
+
+// getProjectV2 gets a project of a user or org by number.
+func getProjectV2(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int) (*github.ProjectV2, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProject(ctx, owner, projectNumber)
+	}
+	return client.Projects.GetUserProject(ctx, owner, projectNumber)
+}
+
+// getProjectItem gets an item of a project of a user or org with the REST API.
+func getProjectItem(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, itemID int64) (*github.ProjectV2Item, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProjectItem(ctx, owner, projectNumber, itemID, nil)
+	}
+	return client.Projects.GetUserProjectItem(ctx, owner, projectNumber, itemID, nil)
+}
+
 func toNewProjectType(projType string) string {
 	switch strings.ToLower(projType) {
 	case "issue":
@@ -911,6 +1608,43 @@ func buildUpdateProjectItem(input map[string]any) (*github.UpdateProjectItemOpti
 	return payload, nil
 }
 
+// resolveFieldOptionValue returns the option ID to set a single select field to, given the option's ID or its name,
+// matched case-insensitively. Values of other fields are returned as they are.
+func resolveFieldOptionValue(ctx context.Context, client *github.Client, ownerType, owner string, projectNumber int, fieldID int64, value any, refresh bool) (any, error) {
+	name, ok := value.(string)
+	if !ok || name == "" {
+		return value, nil
+	}
+	for reload := refresh; ; reload = true {
+		fields, err := projectFieldCache.Fields(ctx, client, ownerType, owner, projectNumber, reload)
+		if err != nil {
+			return nil, err
+		}
+		var field *github.ProjectV2Field
+		for _, f := range fields {
+			if f.GetID() == fieldID {
+				field = f
+				break
+			}
+		}
+		if field == nil || field.GetDataType() != "single_select" {
+			return value, nil
+		}
+		for _, option := range field.Options {
+			if option.GetID() == name {
+				return name, nil
+			}
+		}
+		if option := findFieldOption(field, name); option != nil {
+			return option.GetID(), nil
+		}
+		// The option may have been added after the definitions were cached.
+		if reload {
+			return nil, fmt.Errorf("option %q not found in project field %q", name, field.GetName())
+		}
+	}
+}
+
 func buildPageInfo(resp *github.Response) pageInfo {
 	return pageInfo{
 		HasNextPage:     resp.After != "",
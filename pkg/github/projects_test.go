@@ -3,21 +3,26 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gh "github.com/google/go-github/v79/github"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_ListProjects(t *testing.T) {
 	mockClient := gh.NewClient(nil)
-	tool, _ := ListProjects(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListProjects(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "list_projects", tool.Name)
@@ -25,6 +30,7 @@ func Test_ListProjects(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "owner_type")
 	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
 	assert.Contains(t, tool.InputSchema.Properties, "per_page")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "owner_type"})
 
@@ -32,13 +38,36 @@ func Test_ListProjects(t *testing.T) {
 	orgProjects := []map[string]any{{"id": 1, "node_id": "NODE1", "title": "Org Project"}}
 	userProjects := []map[string]any{{"id": 2, "node_id": "NODE2", "title": "User Project"}}
 
+	summaries := func(id string, closed bool, statusUpdates ...map[string]any) *http.Client {
+		if statusUpdates == nil {
+			statusUpdates = []map[string]any{}
+		}
+		matcher := githubv4mock.NewQueryMatcher(
+			projectSummariesQuery{},
+			map[string]any{"ids": []githubv4.ID{id}},
+			githubv4mock.DataResponse(map[string]any{"nodes": []any{map[string]any{
+				"id":            id,
+				"closed":        closed,
+				"items":         map[string]any{"totalCount": 12},
+				"statusUpdates": map[string]any{"nodes": statusUpdates},
+			}}}),
+		)
+		matcher.Variables = map[string]any{"ids": []any{id}}
+		return githubv4mock.NewMockedHTTPClient(matcher)
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
+		gqlClient      *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
 		expectedLength int
 		expectedErrMsg string
+		expected       *MinimalProject
+		// expectedWarning is a warning the result must have; results of other cases have none.
+		expectedWarning string
+		noGQLClient     bool
 	}{
 		{
 			name: "success organization",
@@ -51,12 +80,34 @@ func Test_ListProjects(t *testing.T) {
 					}),
 				),
 			),
+			gqlClient: summaries("NODE1", false, map[string]any{
+				"id":         "PVTSU_1",
+				"status":     "ON_TRACK",
+				"body":       "Beta shipped",
+				"startDate":  "2024-05-01",
+				"targetDate": "2024-06-01",
+				"createdAt":  "2024-05-10T12:00:00Z",
+				"creator":    map[string]any{"login": "octocat"},
+			}),
 			requestArgs: map[string]interface{}{
 				"owner":      "octo-org",
 				"owner_type": "org",
 			},
 			expectError:    false,
 			expectedLength: 1,
+			expected: &MinimalProject{
+				Closed:    gh.Ptr(false),
+				ItemCount: gh.Ptr(12),
+				LatestStatusUpdate: &MinimalProjectStatusUpdate{
+					ID:         "PVTSU_1",
+					Status:     "ON_TRACK",
+					Body:       "Beta shipped",
+					StartDate:  "2024-05-01",
+					TargetDate: "2024-06-01",
+					Creator:    "octocat",
+					CreatedAt:  time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC),
+				},
+			},
 		},
 		{
 			name: "success user",
@@ -69,6 +120,7 @@ func Test_ListProjects(t *testing.T) {
 					}),
 				),
 			),
+			gqlClient: summaries("NODE2", true),
 			requestArgs: map[string]interface{}{
 				"owner":      "octocat",
 				"owner_type": "user",
@@ -77,13 +129,14 @@ func Test_ListProjects(t *testing.T) {
 			expectedLength: 1,
 		},
 		{
-			name: "success organization with pagination & query",
+			name:      "success organization with pagination & query",
+			gqlClient: summaries("NODE1", true),
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2", Method: http.MethodGet},
 					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 						q := r.URL.Query()
-						if q.Get("per_page") == "50" && q.Get("q") == "roadmap" {
+						if q.Get("per_page") == "50" && q.Get("q") == "roadmap is:closed" {
 							w.WriteHeader(http.StatusOK)
 							_, _ = w.Write(mock.MustMarshal(orgProjects))
 							return
@@ -98,10 +151,38 @@ func Test_ListProjects(t *testing.T) {
 				"owner_type": "org",
 				"per_page":   float64(50),
 				"query":      "roadmap",
+				"state":      "closed",
 			},
 			expectError:    false,
 			expectedLength: 1,
 		},
+		{
+			name: "summaries unavailable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2", Method: http.MethodGet}, orgProjects),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"owner_type": "org",
+			},
+			expectedLength:  1,
+			expected:        &MinimalProject{},
+			expectedWarning: "failed to get the state, item count and latest status update of the projects",
+		},
+		{
+			name: "no GraphQL client",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2", Method: http.MethodGet}, orgProjects),
+			),
+			noGQLClient: true,
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"owner_type": "org",
+			},
+			expectedLength: 1,
+			expected:       &MinimalProject{},
+		},
 		{
 			name: "api error",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -138,7 +219,13 @@ func Test_ListProjects(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := gh.NewClient(tc.mockedClient)
-			_, handler := ListProjects(stubGetClientFn(client), translations.NullTranslationHelper)
+			getGQLClient := stubGetGQLClientFn(githubv4.NewClient(tc.gqlClient))
+			if tc.noGQLClient {
+				getGQLClient = func(_ context.Context) (*githubv4.Client, error) {
+					return nil, errors.New("no GraphQL client")
+				}
+			}
+			_, handler := ListProjects(stubGetClientFn(client), getGQLClient, translations.NullTranslationHelper)
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(context.Background(), request)
 
@@ -158,7 +245,7 @@ func Test_ListProjects(t *testing.T) {
 				return
 			}
 
-			require.False(t, result.IsError)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
 			textContent := getTextResult(t, result)
 			var response map[string]any
 			err = json.Unmarshal([]byte(textContent.Text), &response)
@@ -166,6 +253,20 @@ func Test_ListProjects(t *testing.T) {
 			projects, ok := response["projects"].([]interface{})
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(projects))
+			structured, ok := result.StructuredContent.(ProjectsListResult)
+			require.True(t, ok)
+			assert.Len(t, structured.Projects, tc.expectedLength)
+			if tc.expectedWarning != "" {
+				require.Len(t, structured.Warnings, 1)
+				assert.Contains(t, structured.Warnings[0], tc.expectedWarning)
+			} else {
+				assert.Empty(t, structured.Warnings)
+			}
+			if tc.expected != nil {
+				assert.Equal(t, tc.expected.Closed, structured.Projects[0].Closed)
+				assert.Equal(t, tc.expected.ItemCount, structured.Projects[0].ItemCount)
+				assert.Equal(t, tc.expected.LatestStatusUpdate, structured.Projects[0].LatestStatusUpdate)
+			}
 			// pageInfo should exist
 			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
@@ -306,7 +407,7 @@ func Test_GetProject(t *testing.T) {
 
 func Test_ListProjectFields(t *testing.T) {
 	mockClient := gh.NewClient(nil)
-	tool, _ := ListProjectFields(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListProjectFields(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "list_project_fields", tool.Name)
@@ -419,7 +520,7 @@ func Test_ListProjectFields(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := gh.NewClient(tc.mockedClient)
-			_, handler := ListProjectFields(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListProjectFields(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(githubv4mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(context.Background(), request)
 
@@ -452,10 +553,90 @@ func Test_ListProjectFields(t *testing.T) {
 			assert.Equal(t, tc.expectedLength, len(fields))
 			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
+			assert.NotContains(t, response, "layout")
+			// The mocked GraphQL API does not answer the layout query.
+			assert.Len(t, response["warnings"], 1)
 		})
 	}
 }
 
+func Test_ListProjectFieldsLayout(t *testing.T) {
+	fields := []map[string]any{
+		{"id": 101, "name": "Status", "data_type": "single_select"},
+		{"id": 102, "name": "Priority", "data_type": "single_select"},
+		{"id": 103, "name": "Estimate", "data_type": "number"},
+	}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet}, fields, fields),
+	)
+	field := func(id int, name, dataType string) map[string]any {
+		return map[string]any{"databaseId": id, "name": name, "dataType": dataType}
+	}
+	gqlClient := githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+		orgProjectQuery[projectFieldLayoutQuery]{},
+		map[string]any{"owner": githubv4.String("octo-org"), "projectNumber": githubv4.Int(7)},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"fields": map[string]any{"nodes": []any{
+				field(100, "Title", "TITLE"),
+				field(102, "Priority", "SINGLE_SELECT"),
+				field(101, "Status", "SINGLE_SELECT"),
+				field(103, "Estimate", "NUMBER"),
+			}},
+			"views": map[string]any{"nodes": []any{map[string]any{
+				"number":                1,
+				"name":                  "Board",
+				"layout":                "BOARD_LAYOUT",
+				"filter":                "",
+				"createdAt":             "2025-01-06T09:00:00Z",
+				"updatedAt":             "2025-02-03T10:30:00Z",
+				"fields":                map[string]any{"nodes": []any{}},
+				"groupByFields":         map[string]any{"nodes": []any{}},
+				"verticalGroupByFields": map[string]any{"nodes": []any{field(101, "Status", "SINGLE_SELECT")}},
+				"sortByFields": map[string]any{"nodes": []any{
+					map[string]any{"direction": "DESC", "field": field(102, "Priority", "SINGLE_SELECT")},
+				}},
+			}}},
+		}}}),
+	))
+	queries := 0
+	transport := gqlClient.Transport
+	gqlClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		queries++
+		return transport.RoundTrip(req)
+	})
+	projectFieldCache.Invalidate("org", "octo-org", 7)
+	_, handler := ListProjectFields(stubGetClientFn(gh.NewClient(mockedClient)), stubGetGQLClientFn(githubv4.NewClient(gqlClient)), translations.NullTranslationHelper)
+
+	// The layout is queried once and then reused from the project field cache.
+	for range 2 {
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":          "octo-org",
+			"owner_type":     "org",
+			"project_number": float64(7),
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response ProjectFieldsListResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Len(t, response.Fields, 3)
+		assert.Equal(t, &ProjectView{Number: 1, Name: "Board", Layout: "board"}, response.DefaultView)
+		assert.Equal(t, []ProjectFieldLayout{
+			{FieldID: 101, Position: 3, VerticalGroupBy: true},
+			{FieldID: 102, Position: 2, SortDirection: "desc"},
+			{FieldID: 103, Position: 4},
+		}, response.Layout)
+		assert.Empty(t, response.Warnings)
+	}
+	assert.Equal(t, 1, queries)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 func Test_GetProjectField(t *testing.T) {
 	mockClient := gh.NewClient(nil)
 	tool, _ := GetProjectField(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -614,7 +795,7 @@ func Test_GetProjectField(t *testing.T) {
 
 func Test_ListProjectItems(t *testing.T) {
 	mockClient := gh.NewClient(nil)
-	tool, _ := ListProjectItems(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListProjectItems(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "list_project_items", tool.Name)
@@ -661,6 +842,25 @@ func Test_ListProjectItems(t *testing.T) {
 			},
 			expectedLength: 1,
 		},
+		{
+			name: "archived organization items",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+					expectQueryParams(t, map[string]string{"q": "is:issue is:archived", "per_page": strconv.Itoa(MaxProjectsPerPage)}).andThen(
+						mockResponse(t, http.StatusOK, orgItems),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(123),
+				"query":          "is:issue",
+				"archived_only":  true,
+			},
+			expectedLength: 1,
+		},
 		{
 			name: "success organization items with fields",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -686,6 +886,57 @@ func Test_ListProjectItems(t *testing.T) {
 			},
 			expectedLength: 1,
 		},
+		{
+			name: "success organization items with field names",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 123, "name": "Status", "data_type": "single_select"},
+						{"id": 456, "name": "Priority", "data_type": "single_select"},
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet},
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						q := r.URL.Query()
+						if q.Get("fields") == "123,456,789" {
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write(mock.MustMarshal(orgItems))
+							return
+						}
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"message":"unexpected query params"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "field-names-org",
+				"owner_type":     "org",
+				"project_number": float64(123),
+				"fields":         []interface{}{"status", "Priority", "789"},
+			},
+			expectedLength: 1,
+		},
+		{
+			name: "unknown field name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 123, "name": "Status", "data_type": "single_select"},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "unknown-field-org",
+				"owner_type":     "org",
+				"project_number": float64(123),
+				"fields":         []interface{}{"Estimate"},
+			},
+			expectError:    true,
+			expectedErrMsg: `project field "Estimate" not found`,
+		},
 		{
 			name: "success user items",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -775,7 +1026,7 @@ func Test_ListProjectItems(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := gh.NewClient(tc.mockedClient)
-			_, handler := ListProjectItems(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListProjectItems(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(context.Background(), request)
 
@@ -806,12 +1057,102 @@ func Test_ListProjectItems(t *testing.T) {
 			items, ok := response["items"].([]interface{})
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(items))
+			structured, ok := result.StructuredContent.(ProjectItemsListResult)
+			require.True(t, ok)
+			assert.Len(t, structured.Items, tc.expectedLength)
 			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
 		})
 	}
 }
 
+func Test_ListProjectItems_ArchivedAndRedacted(t *testing.T) {
+	items := []map[string]any{
+		{"id": 1, "content_type": "Issue"},
+		{"id": 2, "content_type": "Issue", "archived_at": "2024-05-01T10:00:00Z"},
+		{"id": 3, "content_type": "REDACTED"},
+	}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet}, items, items),
+	)
+	_, handler := ListProjectItems(stubGetClientFn(gh.NewClient(mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+
+	list := func(args map[string]any) map[int]ProjectItem {
+		args["owner"] = "octo-org"
+		args["owner_type"] = "org"
+		args["project_number"] = float64(1)
+		result, err := handler(context.Background(), createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		var response struct {
+			Items []struct {
+				ID         int  `json:"id"`
+				IsArchived bool `json:"is_archived"`
+				IsRedacted bool `json:"is_redacted"`
+			} `json:"items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		byID := map[int]ProjectItem{}
+		for _, item := range response.Items {
+			byID[item.ID] = ProjectItem{IsArchived: item.IsArchived, IsRedacted: item.IsRedacted}
+		}
+		return byID
+	}
+
+	assert.Equal(t, map[int]ProjectItem{
+		1: {},
+		2: {IsArchived: true},
+		3: {IsRedacted: true},
+	}, list(map[string]any{}))
+	assert.Equal(t, map[int]ProjectItem{
+		1: {},
+		2: {IsArchived: true},
+	}, list(map[string]any{"exclude_redacted": true}))
+}
+
+func Test_ListProjectItems_DateCountdowns(t *testing.T) {
+	today := time.Now().UTC()
+	dateItem := func(id int, date any) map[string]any {
+		return map[string]any{
+			"id": id,
+			"fields": []any{
+				map[string]any{"id": 301, "name": "Due", "data_type": "date", "value": date},
+				map[string]any{"id": 302, "name": "Notes", "data_type": "text", "value": "2024-01-01"},
+			},
+		}
+	}
+	items := []map[string]any{
+		dateItem(1, today.AddDate(0, 0, 3).Format("2006-01-02")),
+		dateItem(2, today.AddDate(0, 0, -2).Format("2006-01-02")),
+		dateItem(3, nil),
+	}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodGet}, items),
+	)
+	_, handler := ListProjectItems(stubGetClientFn(gh.NewClient(mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":           "octo-org",
+		"owner_type":      "org",
+		"project_number":  float64(1),
+		"date_countdowns": true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response struct {
+		Items []struct {
+			ID             int             `json:"id"`
+			DateCountdowns []DateCountdown `json:"date_countdowns"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Items, 3)
+	assert.Equal(t, []DateCountdown{{Field: "Due", Date: today.AddDate(0, 0, 3).Format("2006-01-02"), DaysUntilDue: 3}}, response.Items[0].DateCountdowns)
+	assert.Equal(t, []DateCountdown{{Field: "Due", Date: today.AddDate(0, 0, -2).Format("2006-01-02"), DaysUntilDue: -2, Overdue: true}}, response.Items[1].DateCountdowns)
+	assert.Empty(t, response.Items[2].DateCountdowns)
+}
+
 func Test_GetProjectItem(t *testing.T) {
 	mockClient := gh.NewClient(nil)
 	tool, _ := GetProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -1005,6 +1346,402 @@ func Test_GetProjectItem(t *testing.T) {
 	}
 }
 
+func Test_CreateProject(t *testing.T) {
+	mockClient := gh.NewClient(nil)
+	tool, _ := CreateProject(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_project", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "owner_type", "title"})
+
+	orgQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			Organization struct {
+				ID githubv4.ID
+			} `graphql:"organization(login: $login)"`
+		}{},
+		map[string]any{"login": githubv4.String("octo-org")},
+		githubv4mock.DataResponse(map[string]any{"organization": map[string]any{"id": "O_1"}}),
+	)
+	userQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			User struct {
+				ID githubv4.ID
+			} `graphql:"user(login: $login)"`
+		}{},
+		map[string]any{"login": githubv4.String("octocat")},
+		githubv4mock.DataResponse(map[string]any{"user": map[string]any{"id": "U_1"}}),
+	)
+	createMutation := func(ownerID string) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				CreateProjectV2 struct {
+					ProjectV2 struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+					}
+				} `graphql:"createProjectV2(input: $input)"`
+			}{},
+			githubv4.CreateProjectV2Input{OwnerID: githubv4.ID(ownerID), Title: githubv4.String("Roadmap")},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"createProjectV2": map[string]any{"projectV2": map[string]any{"id": "PVT_7", "number": 7}},
+			}),
+		)
+	}
+	updateMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			UpdateProjectV2 struct {
+				ProjectV2 struct {
+					ID githubv4.ID
+				}
+			} `graphql:"updateProjectV2(input: $input)"`
+		}{},
+		githubv4.UpdateProjectV2Input{
+			ProjectID:        githubv4.ID("PVT_7"),
+			ShortDescription: githubv4.NewString("Plans for the year"),
+			Readme:           githubv4.NewString("# Roadmap"),
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"updateProjectV2": map[string]any{"projectV2": map[string]any{"id": "PVT_7"}},
+		}),
+	)
+	project := map[string]any{"id": 123, "node_id": "PVT_7", "number": 7, "title": "Roadmap", "short_description": "Plans for the year"}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		gqlClient      *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "creates organization project with description and readme",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/7", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, project),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(orgQuery, createMutation("O_1"), updateMutation),
+			requestArgs: map[string]interface{}{
+				"owner":       "octo-org",
+				"owner_type":  "org",
+				"title":       "Roadmap",
+				"description": "Plans for the year",
+				"readme":      "# Roadmap",
+			},
+		},
+		{
+			name: "creates user project",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/users/{username}/projectsV2/7", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, project),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(userQuery, createMutation("U_1")),
+			requestArgs: map[string]interface{}{
+				"owner":      "octocat",
+				"owner_type": "user",
+				"title":      "Roadmap",
+			},
+		},
+		{
+			name:         "owner not found",
+			mockedClient: mock.NewMockedHTTPClient(),
+			gqlClient: githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+				struct {
+					Organization struct {
+						ID githubv4.ID
+					} `graphql:"organization(login: $login)"`
+				}{},
+				map[string]any{"login": githubv4.String("missing")},
+				githubv4mock.ErrorResponse("Could not resolve to an Organization with the login of 'missing'."),
+			)),
+			requestArgs: map[string]interface{}{
+				"owner":      "missing",
+				"owner_type": "org",
+				"title":      "Roadmap",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to find org missing",
+		},
+		{
+			name:         "missing title",
+			mockedClient: mock.NewMockedHTTPClient(),
+			gqlClient:    githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"owner_type": "org",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: title",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := gh.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.gqlClient)
+			_, handler := CreateProject(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var created MinimalProject
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &created))
+			assert.Equal(t, 7, *created.Number)
+			assert.Equal(t, "Roadmap", *created.Title)
+		})
+	}
+}
+
+func Test_UpdateProject(t *testing.T) {
+	mockClient := gh.NewClient(nil)
+	tool, _ := UpdateProject(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_project", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "owner_type", "project_number"})
+
+	updateMutation := func(input githubv4.UpdateProjectV2Input) githubv4mock.Matcher {
+		return githubv4mock.NewMutationMatcher(
+			struct {
+				UpdateProjectV2 struct {
+					ProjectV2 struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2(input: $input)"`
+			}{},
+			input,
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"updateProjectV2": map[string]any{"projectV2": map[string]any{"id": "PVT_7"}},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		gqlClient      *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "renames and closes organization project",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/7", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, map[string]any{"id": 123, "node_id": "PVT_7", "number": 7, "title": "Roadmap 2025"}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(updateMutation(githubv4.UpdateProjectV2Input{
+				ProjectID: githubv4.ID("PVT_7"),
+				Title:     githubv4.NewString("Roadmap 2025"),
+				Closed:    githubv4.NewBoolean(true),
+			})),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"title":          "Roadmap 2025",
+				"closed":         true,
+			},
+		},
+		{
+			name: "makes user project private and clears description",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/users/{username}/projectsV2/7", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, map[string]any{"id": 123, "node_id": "PVT_7", "number": 7, "title": "Roadmap"}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(updateMutation(githubv4.UpdateProjectV2Input{
+				ProjectID:        githubv4.ID("PVT_7"),
+				ShortDescription: githubv4.NewString(""),
+				Public:           githubv4.NewBoolean(false),
+			})),
+			requestArgs: map[string]interface{}{
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(7),
+				"description":    "",
+				"public":         false,
+			},
+		},
+		{
+			name: "project not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/8", Method: http.MethodGet},
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(8),
+				"closed":         false,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get project",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			gqlClient:    githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of title, description, readme, public or closed must be provided",
+		},
+		{
+			name:         "empty title",
+			mockedClient: mock.NewMockedHTTPClient(),
+			gqlClient:    githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+				"title":          "",
+			},
+			expectError:    true,
+			expectedErrMsg: "title must not be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := gh.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.gqlClient)
+			_, handler := UpdateProject(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var updated MinimalProject
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &updated))
+			assert.Equal(t, 7, *updated.Number)
+		})
+	}
+}
+
+func Test_DeleteProject(t *testing.T) {
+	mockClient := gh.NewClient(nil)
+	tool, _ := DeleteProject(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_project", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "owner_type", "project_number"})
+
+	deleteMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			DeleteProjectV2 struct {
+				ProjectV2 struct {
+					ID githubv4.ID
+				}
+			} `graphql:"deleteProjectV2(input: $input)"`
+		}{},
+		githubv4.DeleteProjectV2Input{ProjectID: githubv4.ID("PVT_7")},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"deleteProjectV2": map[string]any{"projectV2": map[string]any{"id": "PVT_7"}},
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		gqlClient      *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "deletes organization project",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/7", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, map[string]any{"id": 123, "node_id": "PVT_7", "number": 7}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(deleteMutation),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(7),
+			},
+		},
+		{
+			name: "project not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/users/{username}/projectsV2/8", Method: http.MethodGet},
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			gqlClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(8),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := gh.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(tc.gqlClient)
+			_, handler := DeleteProject(stubGetClientFn(client), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Equal(t, "project 7 successfully deleted", getTextResult(t, result).Text)
+		})
+	}
+}
+
 func Test_AddProjectItem(t *testing.T) {
 	mockClient := gh.NewClient(nil)
 	tool, _ := AddProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -1017,7 +1754,8 @@ func Test_AddProjectItem(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "project_number")
 	assert.Contains(t, tool.InputSchema.Properties, "item_type")
 	assert.Contains(t, tool.InputSchema.Properties, "item_id")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number", "item_type", "item_id"})
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
 
 	orgItem := map[string]any{
 		"id":           601,
@@ -1131,6 +1869,91 @@ func Test_AddProjectItem(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: ProjectAddFailedError,
 		},
+		{
+			name: "success issue reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, map[string]any{"id": 9876, "number": 12}),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items", Method: http.MethodPost},
+					expectRequestBody(t, map[string]any{"type": "Issue", "id": float64(9876)}).andThen(mockResponse(t, http.StatusCreated, orgItem)),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(321),
+				"ref":            "octo-org/web-app#12",
+			},
+			expectedID:           601,
+			expectedContentType:  "Issue",
+			expectedCreatorLogin: "octocat",
+		},
+		{
+			name: "success pull request reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepoByIssueNumber, map[string]any{
+					"id":           1111,
+					"number":       34,
+					"pull_request": map[string]any{"url": "https://api.github.com/repos/octocat/hello-world/pulls/34"},
+				}),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, map[string]any{"id": 7654, "number": 34}),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/users/{user}/projectsV2/{project}/items", Method: http.MethodPost},
+					expectRequestBody(t, map[string]any{"type": "PullRequest", "id": float64(7654)}).andThen(mockResponse(t, http.StatusCreated, userItem)),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(222),
+				"ref":            "octocat/hello-world#34",
+			},
+			expectedID:           701,
+			expectedContentType:  "PullRequest",
+			expectedCreatorLogin: "hubot",
+		},
+		{
+			name: "reference not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(321),
+				"ref":            "octo-org/web-app#404",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get octo-org/web-app#404",
+		},
+		{
+			name:         "invalid reference",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(321),
+				"ref":            "web-app#12",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid reference \"web-app#12\": expected format 'owner/repo#123'",
+		},
+		{
+			name:         "reference and item_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(321),
+				"ref":            "octo-org/web-app#12",
+				"item_id":        float64(10),
+			},
+			expectError:    true,
+			expectedErrMsg: "pass either ref or item_type and item_id, not both",
+		},
 		{
 			name:         "missing owner",
 			mockedClient: mock.NewMockedHTTPClient(),
@@ -1305,6 +2128,102 @@ func Test_UpdateProjectItem(t *testing.T) {
 			},
 			expectedID: 801,
 		},
+		{
+			name: "success organization update by field name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 101, "name": "Status", "data_type": "single_select", "options": []map[string]any{
+							{"id": "opt-review", "name": map[string]any{"raw": "In Review"}},
+							{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+						}},
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{
+							map[string]any{"id": float64(101), "value": "opt-done"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, orgUpdatedItem),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "field-names-org",
+				"owner_type":     "org",
+				"project_number": float64(1001),
+				"item_id":        float64(5555),
+				"updated_field": map[string]any{
+					"name":  "Status",
+					"value": "done",
+				},
+			},
+			expectedID: 801,
+		},
+		{
+			name: "success organization update by option ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 101, "name": "Status", "data_type": "single_select", "options": []map[string]any{
+							{"id": "opt-review", "name": map[string]any{"raw": "In Review"}},
+							{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+						}},
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
+					expectRequestBody(t, map[string]any{
+						"fields": []any{
+							map[string]any{"id": float64(101), "value": "opt-review"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, orgUpdatedItem),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "option-ids-org",
+				"owner_type":     "org",
+				"project_number": float64(1001),
+				"item_id":        float64(5555),
+				"updated_field": map[string]any{
+					"name":  "status",
+					"value": "opt-review",
+				},
+			},
+			expectedID: 801,
+		},
+		{
+			name: "unknown option name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusOK, []map[string]any{
+						{"id": 101, "name": "Status", "data_type": "single_select", "options": []map[string]any{
+							{"id": "opt-review", "name": map[string]any{"raw": "In Review"}},
+							{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+						}},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":          "unknown-option-org",
+				"owner_type":     "org",
+				"project_number": float64(1001),
+				"item_id":        float64(5555),
+				"updated_field": map[string]any{
+					"name":  "Status",
+					"value": "Shipped",
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: `option "Shipped" not found in project field "Status"`,
+		},
 		{
 			name: "success user update",
 			mockedClient: mock.NewMockedHTTPClient(
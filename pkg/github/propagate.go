@@ -0,0 +1,270 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	FilePropagationStatusPullRequestCreated = "pull_request_created"
+	FilePropagationStatusUnchanged          = "unchanged"
+	FilePropagationStatusError              = "error"
+)
+
+// FilePropagationResult describes the outcome of propagating a file to a single repository.
+type FilePropagationResult struct {
+	Repository     string `json:"repository"`
+	Status         string `json:"status"`
+	BaseBranch     string `json:"base_branch,omitempty"`
+	Branch         string `json:"branch,omitempty"`
+	Action         string `json:"action,omitempty"`
+	PullRequest    int    `json:"pull_request,omitempty"`
+	PullRequestURL string `json:"pull_request_url,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// filePropagation holds the parameters shared by every repository a file is propagated to.
+type filePropagation struct {
+	path       string
+	content    string
+	message    string
+	branch     string
+	baseBranch string
+	title      string
+	body       string
+}
+
+// PropagateFile creates a tool to create or update a file across many repositories through branches and pull requests.
+func PropagateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("propagate_file",
+			mcp.WithDescription(t("TOOL_PROPAGATE_FILE_DESCRIPTION", "Create or update a single file across multiple repositories. For each repository a new branch is created from the base branch, the file is committed to it and a pull request is opened. Repositories where the file already has the desired content are skipped. Returns a per-repository status.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PROPAGATE_FILE_USER_TITLE", "Propagate file to repositories"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Repositories to update, in 'owner/repo' format"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to create or update, e.g. 'SECURITY.md' or '.github/workflows/ci.yml'"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the file"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the branch to create in each repository"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Branch to create the new branch from and to open the pull request against. Defaults to each repository's default branch"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Pull request title. Defaults to the commit message"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Pull request description"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("missing required parameter: repositories"), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := RequiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := RequiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if title == "" {
+				title = message
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			p := filePropagation{
+				path:       path,
+				content:    content,
+				message:    message,
+				branch:     branch,
+				baseBranch: base,
+				title:      title,
+				body:       body,
+			}
+
+			results := make([]FilePropagationResult, 0, len(repositories))
+			for _, fullName := range repositories {
+				results = append(results, propagateFileToRepository(ctx, client, fullName, p))
+			}
+
+			r, err := json.Marshal(map[string]any{"results": results})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// propagateFileToRepository creates a branch in a single repository, commits the file to it and opens a pull request.
+// Failures are reported in the returned result rather than as an error so that a batch can continue.
+func propagateFileToRepository(ctx context.Context, client *github.Client, fullName string, p filePropagation) FilePropagationResult {
+	result := FilePropagationResult{Repository: fullName}
+	owner, repo, err := parseRepoFullName(fullName)
+	fail := func(msg string, resp *github.Response, err error) FilePropagationResult {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, msg, resp, err)
+		result.Status = FilePropagationStatusError
+		result.Error = fmt.Sprintf("%s: %s", msg, err)
+		// Delete the branch created for the change, so that rerunning the propagation does not fail on it.
+		if result.Branch != "" {
+			resp, err := client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+result.Branch)
+			if err != nil {
+				_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to delete branch", resp, err)
+				result.Error += fmt.Sprintf("; failed to delete branch %s: %s", result.Branch, err)
+				return result
+			}
+			_ = resp.Body.Close()
+			result.Branch = ""
+		}
+		return result
+	}
+
+	if err != nil {
+		result.Status = FilePropagationStatusError
+		result.Error = err.Error()
+		return result
+	}
+
+	result.BaseBranch = p.baseBranch
+	if result.BaseBranch == "" {
+		repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return fail("failed to get repository", resp, err)
+		}
+		_ = resp.Body.Close()
+		result.BaseBranch = repository.GetDefaultBranch()
+	}
+
+	// Compare against the file on the base branch so repositories already in the desired state are skipped.
+	var existingSHA string
+	existing, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, p.path, &github.RepositoryContentGetOptions{Ref: result.BaseBranch})
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		if existing == nil {
+			result.Status = FilePropagationStatusError
+			result.Error = fmt.Sprintf("path %q is a directory", p.path)
+			return result
+		}
+		current, err := existing.GetContent()
+		if err != nil {
+			result.Status = FilePropagationStatusError
+			result.Error = fmt.Sprintf("failed to decode existing file: %s", err)
+			return result
+		}
+		if current == p.content {
+			result.Status = FilePropagationStatusUnchanged
+			return result
+		}
+		existingSHA = existing.GetSHA()
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		// The file does not exist yet and will be created.
+	default:
+		return fail("failed to get file contents", resp, err)
+	}
+
+	baseRef, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+result.BaseBranch)
+	if err != nil {
+		return fail("failed to get reference", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	_, resp, err = client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+		Ref: "refs/heads/" + p.branch,
+		SHA: baseRef.GetObject().GetSHA(),
+	})
+	if err != nil {
+		return fail("failed to create branch", resp, err)
+	}
+	_ = resp.Body.Close()
+	result.Branch = p.branch
+
+	opts := &github.RepositoryContentFileOptions{
+		Message: github.Ptr(p.message),
+		Content: []byte(p.content),
+		Branch:  github.Ptr(p.branch),
+	}
+	result.Action = "created"
+	if existingSHA != "" {
+		opts.SHA = github.Ptr(existingSHA)
+		result.Action = "updated"
+	}
+	_, resp, err = client.Repositories.CreateFile(ctx, owner, repo, p.path, opts)
+	if err != nil {
+		return fail("failed to create/update file", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	newPR := &github.NewPullRequest{
+		Title: github.Ptr(p.title),
+		Head:  github.Ptr(p.branch),
+		Base:  github.Ptr(result.BaseBranch),
+	}
+	if p.body != "" {
+		newPR.Body = github.Ptr(p.body)
+	}
+	pr, resp, err := client.PullRequests.Create(ctx, owner, repo, newPR)
+	if err != nil {
+		return fail("failed to create pull request", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	result.Status = FilePropagationStatusPullRequestCreated
+	result.PullRequest = pr.GetNumber()
+	result.PullRequestURL = pr.GetHTMLURL()
+	return result
+}
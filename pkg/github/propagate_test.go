@@ -0,0 +1,348 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PropagateFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PropagateFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "propagate_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "repositories")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"repositories", "path", "content", "message", "branch"})
+
+	const fileContent = "# Security Policy\n"
+
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{SHA: github.Ptr("abc123")},
+	}
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		HTMLURL: github.Ptr("https://github.com/octo-org/api/pull/42"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedResults []FilePropagationResult
+	}{
+		{
+			name: "creates missing file and opens pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{DefaultBranch: github.Ptr("main")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref": "refs/heads/add-security-policy",
+						"sha": "abc123",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/heads/add-security-policy")}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]any{
+						"message": "Add security policy",
+						"content": base64.StdEncoding.EncodeToString([]byte(fileContent)),
+						"branch":  "add-security-policy",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.RepositoryContentResponse{}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title": "Add security policy",
+						"head":  "add-security-policy",
+						"base":  "main",
+						"body":  "Rolls out the org security policy.",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+				"path":         "SECURITY.md",
+				"content":      fileContent,
+				"message":      "Add security policy",
+				"branch":       "add-security-policy",
+				"body":         "Rolls out the org security policy.",
+			},
+			expectedResults: []FilePropagationResult{
+				{
+					Repository:     "octo-org/api",
+					Status:         FilePropagationStatusPullRequestCreated,
+					BaseBranch:     "main",
+					Branch:         "add-security-policy",
+					Action:         "created",
+					PullRequest:    42,
+					PullRequestURL: "https://github.com/octo-org/api/pull/42",
+				},
+			},
+		},
+		{
+			name: "updates outdated file using its sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Type:     github.Ptr("file"),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte("old policy"))),
+						SHA:      github.Ptr("file-sha"),
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitRefsByOwnerByRepo,
+					&github.Reference{Ref: github.Ptr("refs/heads/update-policy")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]any{
+						"message": "Update security policy",
+						"content": base64.StdEncoding.EncodeToString([]byte(fileContent)),
+						"branch":  "update-policy",
+						"sha":     "file-sha",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContentResponse{}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.PostReposPullsByOwnerByRepo,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+				"path":         "SECURITY.md",
+				"content":      fileContent,
+				"message":      "Update security policy",
+				"branch":       "update-policy",
+				"base":         "develop",
+			},
+			expectedResults: []FilePropagationResult{
+				{
+					Repository:     "octo-org/api",
+					Status:         FilePropagationStatusPullRequestCreated,
+					BaseBranch:     "develop",
+					Branch:         "update-policy",
+					Action:         "updated",
+					PullRequest:    42,
+					PullRequestURL: "https://github.com/octo-org/api/pull/42",
+				},
+			},
+		},
+		{
+			name: "skips up to date repositories and reports failures",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/octo-org/web/contents/SECURITY.md" {
+							w.WriteHeader(http.StatusForbidden)
+							_, _ = w.Write([]byte(`{"message": "Resource not accessible"}`))
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(&github.RepositoryContent{
+							Type:     github.Ptr("file"),
+							Encoding: github.Ptr("base64"),
+							Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(fileContent))),
+							SHA:      github.Ptr("file-sha"),
+						}))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api", "octo-org/web", "invalid"},
+				"path":         "SECURITY.md",
+				"content":      fileContent,
+				"message":      "Add security policy",
+				"branch":       "add-security-policy",
+				"base":         "main",
+			},
+			expectedResults: []FilePropagationResult{
+				{
+					Repository: "octo-org/api",
+					Status:     FilePropagationStatusUnchanged,
+					BaseBranch: "main",
+				},
+				{
+					Repository: "octo-org/web",
+					Status:     FilePropagationStatusError,
+					BaseBranch: "main",
+				},
+				{
+					Repository: "invalid",
+					Status:     FilePropagationStatusError,
+					Error:      `invalid repository "invalid": expected format 'owner/repo'`,
+				},
+			},
+		},
+		{
+			name:         "missing repositories",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"path":    "SECURITY.md",
+				"content": fileContent,
+				"message": "Add security policy",
+				"branch":  "add-security-policy",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: repositories",
+		},
+		{
+			name:         "missing branch",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"repositories": []interface{}{"octo-org/api"},
+				"path":         "SECURITY.md",
+				"content":      fileContent,
+				"message":      "Add security policy",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := PropagateFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Results []FilePropagationResult `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			require.Len(t, response.Results, len(tc.expectedResults))
+			for i, expected := range tc.expectedResults {
+				actual := response.Results[i]
+				if expected.Status == FilePropagationStatusError && expected.Error == "" {
+					assert.Contains(t, actual.Error, "failed to get file contents")
+					actual.Error = ""
+				}
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func Test_PropagateFile_DeletesBranchOnFailure(t *testing.T) {
+	deleted := ""
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			}),
+		),
+		mock.WithRequestMatch(
+			mock.GetReposGitRefByOwnerByRepoByRef,
+			&github.Reference{Ref: github.Ptr("refs/heads/main"), Object: &github.GitObject{SHA: github.Ptr("abc123")}},
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposGitRefsByOwnerByRepo,
+			mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/heads/add-security-policy")}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PutReposContentsByOwnerByRepoByPath,
+			mockResponse(t, http.StatusCreated, &github.RepositoryContentResponse{}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposPullsByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposGitRefsByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				deleted = r.URL.Path
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+
+	_, handler := PropagateFile(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"repositories": []interface{}{"octo-org/api"},
+		"path":         "SECURITY.md",
+		"content":      "# Security Policy\n",
+		"message":      "Add security policy",
+		"branch":       "add-security-policy",
+		"base":         "main",
+	}))
+	require.NoError(t, err)
+
+	var response struct {
+		Results []FilePropagationResult `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	require.Len(t, response.Results, 1)
+	assert.Equal(t, FilePropagationStatusError, response.Results[0].Status)
+	assert.Contains(t, response.Results[0].Error, "failed to create pull request")
+	assert.Empty(t, response.Results[0].Branch)
+	// The branch is deleted so that the propagation can be rerun.
+	assert.Equal(t, "/repos/octo-org/api/git/refs/heads/add-security-policy", deleted)
+}
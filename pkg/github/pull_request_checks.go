@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CheckResult is a commit status or a check run of a ref that did not succeed.
+type CheckResult struct {
+	Name string `json:"name"`
+	// State is the state of a commit status, or the conclusion of a completed check run and the status of another.
+	State      string `json:"state"`
+	DetailsURL string `json:"details_url,omitempty"`
+}
+
+// RefChecksSummary summarizes the commit statuses and check runs of a ref.
+type RefChecksSummary struct {
+	// State is success, pending, failure, none when there are no statuses and check runs, or unknown when they could
+	// not all be fetched.
+	State         string        `json:"state"`
+	Total         int           `json:"total"`
+	Succeeded     int           `json:"succeeded"`
+	FailingChecks []CheckResult `json:"failing_checks"`
+	PendingChecks []CheckResult `json:"pending_checks"`
+}
+
+// PullRequestStatus is whether the head commit of a pull request is green.
+type PullRequestStatus struct {
+	PullRequest string `json:"pull_request"`
+	HeadSHA     string `json:"head_sha"`
+	RefChecksSummary
+}
+
+// summarizeRefChecks summarizes the commit statuses and check runs of a ref.
+// A failure takes precedence over results that could not be fetched, which report unknown, and those over pending
+// results. Refs without any statuses or check runs report none.
+func summarizeRefChecks(ctx context.Context, client *github.Client, owner, repo, ref string) RefChecksSummary {
+	summary := RefChecksSummary{FailingChecks: []CheckResult{}, PendingChecks: []CheckResult{}}
+	unknown := false
+
+	combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get combined status", resp, err)
+		unknown = true
+	} else {
+		_ = resp.Body.Close()
+		for _, status := range combined.Statuses {
+			summary.Total++
+			result := CheckResult{Name: status.GetContext(), State: status.GetState(), DetailsURL: status.GetTargetURL()}
+			switch status.GetState() {
+			case "success":
+				summary.Succeeded++
+			case "pending":
+				summary.PendingChecks = append(summary.PendingChecks, result)
+			default:
+				summary.FailingChecks = append(summary.FailingChecks, result)
+			}
+		}
+	}
+
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		checkRuns, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+		if err != nil {
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to list check runs", resp, err)
+			unknown = true
+			break
+		}
+		_ = resp.Body.Close()
+		for _, run := range checkRuns.CheckRuns {
+			summary.Total++
+			result := CheckResult{Name: run.GetName(), State: run.GetStatus(), DetailsURL: run.GetDetailsURL()}
+			if run.GetStatus() != "completed" {
+				summary.PendingChecks = append(summary.PendingChecks, result)
+				continue
+			}
+			result.State = run.GetConclusion()
+			switch run.GetConclusion() {
+			case "success", "neutral", "skipped":
+				summary.Succeeded++
+			default:
+				summary.FailingChecks = append(summary.FailingChecks, result)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	switch {
+	case len(summary.FailingChecks) > 0:
+		summary.State = CheckStatusFailure
+	case unknown:
+		summary.State = CheckStatusUnknown
+	case len(summary.PendingChecks) > 0:
+		summary.State = CheckStatusPending
+	case summary.Succeeded > 0:
+		summary.State = CheckStatusSuccess
+	default:
+		summary.State = CheckStatusNone
+	}
+	return summary
+}
+
+// GetPullRequestStatusSummary creates a tool to summarize the commit statuses and check runs of the head commit of a
+// pull request.
+func GetPullRequestStatusSummary(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_status",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get whether a pull request is green: a single state aggregating the commit statuses and check runs of its head commit (success, pending, failure, none or unknown), with the names and details URLs of the failing and pending checks.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PULL_REQUEST_STATUS_USER_TITLE", "Get pull request status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[PullRequestStatus](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			headSHA := pr.GetHead().GetSHA()
+			return MarshalledStructuredResult(PullRequestStatus{
+				PullRequest:      fmt.Sprintf("%s/%s#%d", owner, repo, pullNumber),
+				HeadSHA:          headSHA,
+				RefChecksSummary: summarizeRefChecks(ctx, client, owner, repo, headSHA),
+			}), nil
+		}
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPullRequestStatusSummary(t *testing.T) {
+	tool, _ := GetPullRequestStatusSummary(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pr := &github.PullRequest{Number: github.Ptr(42), Head: &github.PullRequestBranch{SHA: github.Ptr("abc123")}}
+	checkRunsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/commits/abc123/check-runs?page=2>; rel="next"`)
+			mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+				{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("skipped")},
+			}})(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure"), DetailsURL: github.Ptr("https://ci.example.com/test")},
+			{Name: github.Ptr("e2e"), Status: github.Ptr("in_progress"), DetailsURL: github.Ptr("https://ci.example.com/e2e")},
+		}})(w, r)
+	})
+
+	tests := []struct {
+		name     string
+		client   *http.Client
+		expected PullRequestStatus
+	}{
+		{
+			name: "failing check",
+			client: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, &github.CombinedStatus{
+					State: github.Ptr("success"),
+					Statuses: []*github.RepoStatus{
+						{Context: github.Ptr("ci/legacy"), State: github.Ptr("success"), TargetURL: github.Ptr("https://ci.example.com/legacy")},
+					},
+				}),
+				mock.WithRequestMatchHandler(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, checkRunsHandler),
+			),
+			expected: PullRequestStatus{
+				PullRequest: "owner/repo#42",
+				HeadSHA:     "abc123",
+				RefChecksSummary: RefChecksSummary{
+					State:         CheckStatusFailure,
+					Total:         5,
+					Succeeded:     3,
+					FailingChecks: []CheckResult{{Name: "test", State: "failure", DetailsURL: "https://ci.example.com/test"}},
+					PendingChecks: []CheckResult{{Name: "e2e", State: "in_progress", DetailsURL: "https://ci.example.com/e2e"}},
+				},
+			},
+		},
+		{
+			name: "pending status and no check runs",
+			client: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, &github.CombinedStatus{
+					State: github.Ptr("pending"),
+					Statuses: []*github.RepoStatus{
+						{Context: github.Ptr("ci/deploy"), State: github.Ptr("pending")},
+					},
+				}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, &github.ListCheckRunsResults{}),
+			),
+			expected: PullRequestStatus{
+				PullRequest: "owner/repo#42",
+				HeadSHA:     "abc123",
+				RefChecksSummary: RefChecksSummary{
+					State:         CheckStatusPending,
+					Total:         1,
+					FailingChecks: []CheckResult{},
+					PendingChecks: []CheckResult{{Name: "ci/deploy", State: "pending"}},
+				},
+			},
+		},
+		{
+			name: "check runs not accessible",
+			client: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, &github.CombinedStatus{State: github.Ptr("pending")}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible"}`))
+					}),
+				),
+			),
+			expected: PullRequestStatus{
+				PullRequest: "owner/repo#42",
+				HeadSHA:     "abc123",
+				RefChecksSummary: RefChecksSummary{
+					State:         CheckStatusUnknown,
+					FailingChecks: []CheckResult{},
+					PendingChecks: []CheckResult{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetPullRequestStatusSummary(stubGetClientFn(github.NewClient(tc.client)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response PullRequestStatus
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
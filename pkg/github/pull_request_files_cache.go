@@ -0,0 +1,72 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/muesli/cache2go"
+)
+
+// DefaultPullRequestFilesCacheTTL is how long the changed files of a pull request are kept after they were last used.
+const DefaultPullRequestFilesCacheTTL = 30 * time.Minute
+
+// PullRequestFilesCache caches the paths changed by pull requests so that tools filtering pull requests by path do
+// not list the files of every pull request on every call. Entries are keyed by the head commit of the pull request,
+// so pushing to a pull request never returns stale files.
+type PullRequestFilesCache struct {
+	cache *cache2go.CacheTable
+	ttl   time.Duration
+}
+
+// NewPullRequestFilesCache creates a pull request files cache backed by the named cache table.
+func NewPullRequestFilesCache(name string, ttl time.Duration) *PullRequestFilesCache {
+	return &PullRequestFilesCache{
+		cache: cache2go.Cache(name),
+		ttl:   ttl,
+	}
+}
+
+// pullRequestFilesCache is shared by all pull request tools.
+var pullRequestFilesCache = NewPullRequestFilesCache("pull-request-files-cache", DefaultPullRequestFilesCacheTTL)
+
+func pullRequestFilesCacheKey(owner, repo string, number int, headSHA string) string {
+	return fmt.Sprintf("%s/%s#%d@%s", strings.ToLower(owner), strings.ToLower(repo), number, headSHA)
+}
+
+// Paths returns the paths changed by a pull request, including the previous path of renamed files. The files are
+// listed from the API when they are not cached for the head commit.
+func (c *PullRequestFilesCache) Paths(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) ([]string, *github.Response, error) {
+	key := pullRequestFilesCacheKey(owner, repo, pr.GetNumber(), pr.GetHead().GetSHA())
+	if item, err := c.cache.Value(key); err == nil {
+		return item.Data().([]string), nil, nil
+	}
+
+	var paths []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, file := range files {
+			paths = append(paths, file.GetFilename())
+			if file.GetPreviousFilename() != "" {
+				paths = append(paths, file.GetPreviousFilename())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Without a head commit the files cannot be safely reused.
+	if pr.GetHead().GetSHA() != "" {
+		c.cache.Add(key, c.ttl, paths)
+	}
+	return paths, nil, nil
+}
@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PullRequestFilesCache(t *testing.T) {
+	var calls atomic.Int32
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(mock.MustMarshal([]*github.CommitFile{
+					{Filename: github.Ptr("services/api/main.go")},
+					{Filename: github.Ptr("docs/api.md"), PreviousFilename: github.Ptr("docs/old.md")},
+				}))
+			}),
+		),
+	))
+	ctx := context.Background()
+	pr := func(sha string) *github.PullRequest {
+		return &github.PullRequest{Number: github.Ptr(1), Head: &github.PullRequestBranch{SHA: github.Ptr(sha)}}
+	}
+
+	t.Run("reuses files for the same head commit", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewPullRequestFilesCache(t.Name(), time.Minute)
+
+		paths, _, err := cache.Paths(ctx, client, "octo", "repo", pr("abc"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"services/api/main.go", "docs/api.md", "docs/old.md"}, paths)
+
+		_, _, err = cache.Paths(ctx, client, "Octo", "repo", pr("abc"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("lists files again after a push", func(t *testing.T) {
+		calls.Store(0)
+		cache := NewPullRequestFilesCache(t.Name(), time.Minute)
+
+		_, _, err := cache.Paths(ctx, client, "octo", "repo", pr("abc"))
+		require.NoError(t, err)
+		_, _, err = cache.Paths(ctx, client, "octo", "repo", pr("def"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
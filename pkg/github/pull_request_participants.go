@@ -0,0 +1,171 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PullRequestParticipants are the people and teams a pull request is routed to.
+type PullRequestParticipants struct {
+	PullRequest        string   `json:"pull_request"`
+	RequestedReviewers []string `json:"requested_reviewers"`
+	RequestedTeams     []string `json:"requested_teams"`
+	Assignees          []string `json:"assignees"`
+}
+
+// UpdatePullRequestParticipants creates a tool to add or remove requested reviewers and assignees of a pull request.
+func UpdatePullRequestParticipants(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_pull_request_participants",
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_PARTICIPANTS_DESCRIPTION", "Add or remove requested reviewers, users or teams, and assignees of a pull request, e.g. to route it to its code owners. Returns the reviewers, teams and assignees of the pull request after the change.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PULL_REQUEST_PARTICIPANTS_USER_TITLE", "Update pull request reviewers and assignees"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[PullRequestParticipants](),
+			mcp.WithString("method",
+				mcp.Required(),
+				mcp.Description(`The action to perform
+Options are:
+- 'add' - request reviews from the reviewers and teams, and assign the assignees.
+- 'remove' - remove the review requests of the reviewers and teams, and unassign the assignees.
+				`),
+				mcp.Enum("add", "remove"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("Usernames of the reviewers"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("team_reviewers",
+				mcp.Description("Slugs of the reviewing teams"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("Usernames of the assignees"),
+				mcp.WithStringItems(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			method, err := RequiredParam[string](request, "method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewers, err := OptionalStringArrayParam(request, "reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamReviewers, err := OptionalStringArrayParam(request, "team_reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assignees, err := OptionalStringArrayParam(request, "assignees")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			method = strings.ToLower(method)
+			if method != "add" && method != "remove" {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil
+			}
+			if len(reviewers) == 0 && len(teamReviewers) == 0 && len(assignees) == 0 {
+				return mcp.NewToolResultError("at least one of reviewers, team_reviewers and assignees is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if len(reviewers) > 0 || len(teamReviewers) > 0 {
+				reviewersRequest := github.ReviewersRequest{Reviewers: reviewers, TeamReviewers: teamReviewers}
+				var resp *github.Response
+				if method == "add" {
+					_, resp, err = client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, reviewersRequest)
+				} else {
+					resp, err = client.PullRequests.RemoveReviewers(ctx, owner, repo, pullNumber, reviewersRequest)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to %s reviewers", method),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			if len(assignees) > 0 {
+				var resp *github.Response
+				if method == "add" {
+					_, resp, err = client.Issues.AddAssignees(ctx, owner, repo, pullNumber, assignees)
+				} else {
+					_, resp, err = client.Issues.RemoveAssignees(ctx, owner, repo, pullNumber, assignees)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to %s assignees", method),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := PullRequestParticipants{
+				PullRequest:        fmt.Sprintf("%s/%s#%d", owner, repo, pullNumber),
+				RequestedReviewers: []string{},
+				RequestedTeams:     []string{},
+				Assignees:          []string{},
+			}
+			for _, reviewer := range pr.RequestedReviewers {
+				result.RequestedReviewers = append(result.RequestedReviewers, reviewer.GetLogin())
+			}
+			for _, team := range pr.RequestedTeams {
+				result.RequestedTeams = append(result.RequestedTeams, team.GetSlug())
+			}
+			for _, assignee := range pr.Assignees {
+				result.Assignees = append(result.Assignees, assignee.GetLogin())
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdatePullRequestParticipants(t *testing.T) {
+	tool, _ := UpdatePullRequestParticipants(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+
+	pr := &github.PullRequest{
+		Number:             github.Ptr(42),
+		RequestedReviewers: []*github.User{{Login: github.Ptr("alice")}},
+		RequestedTeams:     []*github.Team{{Slug: github.Ptr("backend")}},
+		Assignees:          []*github.User{{Login: github.Ptr("bob")}},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "add reviewers, teams and assignees",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]any{
+						"reviewers":      []any{"alice"},
+						"team_reviewers": []any{"backend"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, pr),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"assignees": []any{"bob"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Issue{Number: github.Ptr(42)}),
+					),
+				),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+			),
+			requestArgs: map[string]any{
+				"method":         "add",
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []any{"alice"},
+				"team_reviewers": []any{"backend"},
+				"assignees":      []any{"bob"},
+			},
+		},
+		{
+			name: "remove team reviewers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]any{
+						"reviewers":      []any{},
+						"team_reviewers": []any{"frontend"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, nil),
+					),
+				),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, pr),
+			),
+			requestArgs: map[string]any{
+				"method":         "remove",
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []any{"frontend"},
+			},
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"method":     "add",
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of reviewers, team_reviewers and assignees is required",
+		},
+		{
+			name: "reviewer is not a collaborator",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Reviews may only be requested from collaborators"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"method":     "add",
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []any{"stranger"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add reviewers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdatePullRequestParticipants(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response PullRequestParticipants
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, PullRequestParticipants{
+				PullRequest:        "owner/repo#42",
+				RequestedReviewers: []string{"alice"},
+				RequestedTeams:     []string{"backend"},
+				Assignees:          []string{"bob"},
+			}, response)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// pullRequestTemplatePaths are the locations GitHub reads a pull request template from, in order of precedence.
+var pullRequestTemplatePaths = []string{
+	".github/pull_request_template.md",
+	".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+}
+
+// pullRequestTemplateDirs are the directories GitHub reads multiple pull request templates from, in order of
+// precedence.
+var pullRequestTemplateDirs = []string{
+	".github/PULL_REQUEST_TEMPLATE",
+	"PULL_REQUEST_TEMPLATE",
+	"docs/PULL_REQUEST_TEMPLATE",
+}
+
+// defaultPullRequestTemplatePolicyKey is the key of the policy applying to every owner without its own.
+const defaultPullRequestTemplatePolicyKey = "default"
+
+// PullRequestTemplatePolicy configures how create_pull_request fills the pull request templates of an owner.
+type PullRequestTemplatePolicy struct {
+	// Enforce fills the template of repositories that have one even if use_template is not set.
+	Enforce bool `mapstructure:"enforce"`
+	// Template is the template to use when a repository has several templates and the request does not name one.
+	Template string `mapstructure:"template"`
+	// RequiredSections are the headings of sections that must not be left empty, in addition to the sections
+	// marked with <!-- required -->. Headings the template does not have are ignored.
+	RequiredSections []string `mapstructure:"required_sections"`
+}
+
+// PullRequestTemplatePolicies maps owners to their pull request template policy. The "default" policy applies to
+// every owner without a policy of their own. Owners are matched case-insensitively.
+type PullRequestTemplatePolicies map[string]PullRequestTemplatePolicy
+
+// forOwner returns the policy of an owner, falling back to the default policy.
+func (p PullRequestTemplatePolicies) forOwner(owner string) PullRequestTemplatePolicy {
+	var policy PullRequestTemplatePolicy
+	for key, candidate := range p {
+		if strings.EqualFold(key, owner) {
+			return candidate
+		}
+		if strings.EqualFold(key, defaultPullRequestTemplatePolicyKey) {
+			policy = candidate
+		}
+	}
+	return policy
+}
+
+// pullRequestTemplateLookup is the result of looking up the pull request template of a repository. If the
+// repository has several templates and none was chosen, Content is empty and Available lists their names.
+type pullRequestTemplateLookup struct {
+	Content   string
+	Path      string
+	Available []string
+}
+
+var (
+	markdownHeadingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	htmlCommentPattern      = regexp.MustCompile(`(?s)<!--(.*?)-->`)
+	requiredMarkerPattern   = regexp.MustCompile(`(?i)<!--\s*required\b`)
+	uncheckedCheckboxLine   = regexp.MustCompile(`(?m)^\s*[-*]\s+\[ \].*$`)
+	checkedCheckboxPattern  = regexp.MustCompile(`(?m)^\s*[-*]\s+\[[xX]\]`)
+	templateGuidanceCleanup = regexp.MustCompile(`\s+`)
+)
+
+// pullRequestTemplateSection is a section of a pull request template, introduced by a markdown heading.
+// A section is required if it contains a <!-- required --> comment.
+type pullRequestTemplateSection struct {
+	Heading  string
+	Level    int
+	Content  string
+	Required bool
+}
+
+// getPullRequestTemplate returns the pull request template of a repository, falling back to the templates in the
+// .github repository of the owner, as GitHub does for community health files. Without a name, a single template
+// file is preferred, then a PULL_REQUEST_TEMPLATE directory holding exactly one template. A name selects a template
+// of a PULL_REQUEST_TEMPLATE directory. The lookup is empty if neither repository has a matching template.
+func getPullRequestTemplate(ctx context.Context, client *github.Client, owner, repo, name string) (pullRequestTemplateLookup, *github.Response, error) {
+	for _, repository := range []string{repo, ".github"} {
+		if name == "" {
+			for _, path := range pullRequestTemplatePaths {
+				content, found, resp, err := getPullRequestTemplateFile(ctx, client, owner, repository, path)
+				if err != nil || found {
+					return pullRequestTemplateLookup{Content: content, Path: fmt.Sprintf("%s/%s/%s", owner, repository, path)}, resp, err
+				}
+			}
+		}
+		for _, dir := range pullRequestTemplateDirs {
+			_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repository, dir, nil)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					continue
+				}
+				return pullRequestTemplateLookup{}, resp, err
+			}
+			_ = resp.Body.Close()
+
+			var names []string
+			for _, entry := range entries {
+				if entry.GetType() == "file" && strings.EqualFold(path.Ext(entry.GetName()), ".md") {
+					names = append(names, entry.GetName())
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+			sort.Strings(names)
+
+			chosen := ""
+			switch {
+			case name != "":
+				for _, candidate := range names {
+					if strings.EqualFold(candidate, name) || strings.EqualFold(strings.TrimSuffix(candidate, path.Ext(candidate)), name) {
+						chosen = candidate
+						break
+					}
+				}
+			case len(names) == 1:
+				chosen = names[0]
+			}
+			if chosen == "" {
+				return pullRequestTemplateLookup{Available: names}, nil, nil
+			}
+
+			filePath := dir + "/" + chosen
+			content, _, resp, err := getPullRequestTemplateFile(ctx, client, owner, repository, filePath)
+			return pullRequestTemplateLookup{Content: content, Path: fmt.Sprintf("%s/%s/%s", owner, repository, filePath)}, resp, err
+		}
+	}
+	return pullRequestTemplateLookup{}, nil, nil
+}
+
+// getPullRequestTemplateFile returns the content of a template file, and whether the file exists.
+func getPullRequestTemplateFile(ctx context.Context, client *github.Client, owner, repo, path string) (string, bool, *github.Response, error) {
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", false, nil, nil
+		}
+		return "", false, resp, err
+	}
+	_ = resp.Body.Close()
+	if file == nil {
+		return "", false, nil, nil
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return "", false, nil, fmt.Errorf("failed to decode pull request template: %w", err)
+	}
+	return content, true, nil, nil
+}
+
+// parsePullRequestTemplate splits a template into the text before the first heading and its sections.
+func parsePullRequestTemplate(template string) (string, []pullRequestTemplateSection) {
+	var preamble strings.Builder
+	var sections []pullRequestTemplateSection
+	inCodeBlock := false
+	for _, line := range strings.SplitAfter(strings.ReplaceAll(template, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+		}
+		if m := markdownHeadingPattern.FindStringSubmatch(strings.TrimRight(line, "\n")); m != nil && !inCodeBlock {
+			sections = append(sections, pullRequestTemplateSection{Heading: m[2], Level: len(m[1])})
+			continue
+		}
+		if len(sections) == 0 {
+			preamble.WriteString(line)
+			continue
+		}
+		sections[len(sections)-1].Content += line
+	}
+	for i := range sections {
+		sections[i].Required = requiredMarkerPattern.MatchString(sections[i].Content)
+	}
+	return preamble.String(), sections
+}
+
+// isEmptyTemplateContent reports whether a section has no content other than comments and unchecked checklist items.
+func isEmptyTemplateContent(content string) bool {
+	content = htmlCommentPattern.ReplaceAllString(content, "")
+	if checkedCheckboxPattern.MatchString(content) {
+		return false
+	}
+	content = uncheckedCheckboxLine.ReplaceAllString(content, "")
+	return strings.TrimSpace(content) == ""
+}
+
+// templateGuidance returns the instructions left in the HTML comments of a section.
+func templateGuidance(content string) string {
+	var guidance []string
+	for _, m := range htmlCommentPattern.FindAllStringSubmatch(content, -1) {
+		text := strings.TrimSpace(templateGuidanceCleanup.ReplaceAllString(m[1], " "))
+		text = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(text, "required"), ":"))
+		if text != "" {
+			guidance = append(guidance, text)
+		}
+	}
+	for _, line := range uncheckedCheckboxLine.FindAllString(content, -1) {
+		guidance = append(guidance, strings.TrimSpace(line))
+	}
+	return strings.Join(guidance, " ")
+}
+
+// mergePullRequestTemplate fills the sections of a pull request template. Content provided for a section, matched
+// case-insensitively by heading, replaces the placeholder content of the template. The summary is placed in the
+// first section unless content was provided for it. Sections of the template that are marked as required, or whose
+// heading is one of requiredSections, but are left empty cause an error that describes what is expected.
+func mergePullRequestTemplate(template, summary string, provided map[string]string, requiredSections []string) (string, error) {
+	preamble, sections := parsePullRequestTemplate(template)
+	for i := range sections {
+		for _, heading := range requiredSections {
+			if strings.EqualFold(strings.TrimSpace(heading), sections[i].Heading) {
+				sections[i].Required = true
+			}
+		}
+	}
+
+	// Match the provided headings in a fixed order, so that the result and errors do not depend on map order.
+	headings := make([]string, 0, len(provided))
+	for heading := range provided {
+		headings = append(headings, heading)
+	}
+	sort.Strings(headings)
+	filledBy := make(map[int]string, len(sections))
+	for _, heading := range headings {
+		matched := false
+		for i := range sections {
+			if !strings.EqualFold(strings.TrimSpace(heading), sections[i].Heading) {
+				continue
+			}
+			if other, ok := filledBy[i]; ok {
+				return "", fmt.Errorf("template_sections %q and %q both name section %q", other, heading, sections[i].Heading)
+			}
+			sections[i].Content = "\n" + strings.TrimSpace(provided[heading]) + "\n\n"
+			filledBy[i] = heading
+			matched = true
+		}
+		if !matched {
+			return "", fmt.Errorf("pull request template has no section %q", heading)
+		}
+	}
+
+	if summary != "" {
+		switch {
+		case len(sections) == 0:
+			preamble = summary + "\n\n" + preamble
+		case isEmptyTemplateContent(sections[0].Content):
+			sections[0].Content = "\n" + strings.TrimSpace(summary) + "\n\n"
+		default:
+			sections[0].Content = "\n" + strings.TrimSpace(summary) + "\n\n" + strings.TrimLeft(sections[0].Content, "\n")
+		}
+	}
+
+	var missing []string
+	var b strings.Builder
+	b.WriteString(htmlCommentPattern.ReplaceAllString(preamble, ""))
+	for _, section := range sections {
+		if section.Required && isEmptyTemplateContent(section.Content) {
+			description := fmt.Sprintf("%q", section.Heading)
+			if guidance := templateGuidance(section.Content); guidance != "" {
+				description += " (" + guidance + ")"
+			}
+			missing = append(missing, description)
+			continue
+		}
+		fmt.Fprintf(&b, "%s %s\n", strings.Repeat("#", section.Level), section.Heading)
+		b.WriteString(htmlCommentPattern.ReplaceAllString(section.Content, ""))
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("required pull request template sections are empty: %s. Provide their content in template_sections, keyed by heading", strings.Join(missing, ", "))
+	}
+	return strings.TrimSpace(b.String()), nil
+}
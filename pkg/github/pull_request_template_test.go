@@ -0,0 +1,292 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pullRequestTemplate = `<!-- Thanks for contributing! -->
+## Summary
+
+<!-- Describe your changes -->
+
+## Testing
+<!-- required: describe how the change was tested -->
+
+- [ ] Unit tests
+- [ ] Manual testing
+
+## Notes
+`
+
+func Test_ParsePullRequestTemplate(t *testing.T) {
+	preamble, sections := parsePullRequestTemplate(pullRequestTemplate + "```\n# not a heading\n```\n")
+	assert.Equal(t, "<!-- Thanks for contributing! -->\n", preamble)
+	require.Len(t, sections, 3)
+	assert.Equal(t, "Summary", sections[0].Heading)
+	assert.Equal(t, 2, sections[0].Level)
+	assert.False(t, sections[0].Required)
+	assert.Equal(t, "Testing", sections[1].Heading)
+	assert.True(t, sections[1].Required)
+	assert.Contains(t, sections[2].Content, "# not a heading")
+}
+
+func Test_MergePullRequestTemplate(t *testing.T) {
+	tests := []struct {
+		name             string
+		summary          string
+		provided         map[string]string
+		requiredSections []string
+		expected         string
+		expectedErrMsg   string
+	}{
+		{
+			name:     "summary and required section",
+			summary:  "Adds a cache.",
+			provided: map[string]string{"testing": "- [x] Unit tests"},
+			expected: "## Summary\n\nAdds a cache.\n\n## Testing\n\n- [x] Unit tests\n\n## Notes",
+		},
+		{
+			name:           "required section left empty",
+			summary:        "Adds a cache.",
+			expectedErrMsg: `required pull request template sections are empty: "Testing" (describe how the change was tested - [ ] Unit tests - [ ] Manual testing)`,
+		},
+		{
+			name:             "section required by policy",
+			provided:         map[string]string{"Testing": "- [x] Unit tests"},
+			requiredSections: []string{"notes", "Screenshots"},
+			expectedErrMsg:   `required pull request template sections are empty: "Notes"`,
+		},
+		{
+			name:           "section named twice",
+			provided:       map[string]string{"Testing": "- [x] Unit tests", "testing": "- [x] Manual testing"},
+			expectedErrMsg: `template_sections "Testing" and "testing" both name section "Testing"`,
+		},
+		{
+			name:           "unknown section",
+			provided:       map[string]string{"Testing": "- [x] Unit tests", "Screenshots": "none"},
+			expectedErrMsg: `pull request template has no section "Screenshots"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := mergePullRequestTemplate(pullRequestTemplate, tc.summary, tc.provided, tc.requiredSections)
+			if tc.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, body)
+		})
+	}
+}
+
+func Test_CreatePullRequest_UseTemplate(t *testing.T) {
+	// templateHandler serves the pull request template of a repository from the given path. A path ending in a
+	// slash is a PULL_REQUEST_TEMPLATE directory holding the template under each of the given names.
+	templateHandler := func(repository, path string, names ...string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			prefix := "/repos/owner/" + repository + "/contents/"
+			switch {
+			case r.URL.Path == prefix+path && !strings.HasSuffix(path, "/"):
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Type:     github.Ptr("file"),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(pullRequestTemplate))),
+				})(w, r)
+			case r.URL.Path == prefix+strings.TrimSuffix(path, "/") && len(names) > 0:
+				entries := []*github.RepositoryContent{{Type: github.Ptr("dir"), Name: github.Ptr("images")}}
+				for _, name := range names {
+					entries = append(entries, &github.RepositoryContent{Type: github.Ptr("file"), Name: github.Ptr(name)})
+				}
+				mockResponse(t, http.StatusOK, entries)(w, r)
+			case strings.HasPrefix(r.URL.Path, prefix+path) && len(names) > 0:
+				mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Type:     github.Ptr("file"),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(pullRequestTemplate))),
+				})(w, r)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			}
+		}
+	}
+
+	tests := []struct {
+		name           string
+		templateRepo   string
+		templatePath   string
+		templateNames  []string
+		policies       PullRequestTemplatePolicies
+		requestArgs    map[string]interface{}
+		expectedBody   string
+		expectedErrMsg string
+	}{
+		{
+			name:         "repository template",
+			templateRepo: "repo",
+			requestArgs: map[string]interface{}{
+				"template_sections": map[string]interface{}{"Testing": "- [x] Unit tests"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\n- [x] Unit tests\n\n## Notes",
+		},
+		{
+			name:         "owner default template",
+			templateRepo: ".github",
+			requestArgs: map[string]interface{}{
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\nRan it locally\n\n## Notes",
+		},
+		{
+			name:           "required section left empty",
+			templateRepo:   "repo",
+			requestArgs:    map[string]interface{}{},
+			expectedErrMsg: `required pull request template sections are empty: "Testing"`,
+		},
+		{
+			name:           "no template",
+			templateRepo:   "other",
+			requestArgs:    map[string]interface{}{},
+			expectedErrMsg: "no pull request template found in owner/repo or owner/.github",
+		},
+		{
+			name:          "single template in directory",
+			templateRepo:  "repo",
+			templatePath:  ".github/PULL_REQUEST_TEMPLATE/",
+			templateNames: []string{"default.md"},
+			requestArgs: map[string]interface{}{
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\nRan it locally\n\n## Notes",
+		},
+		{
+			name:           "several templates in directory",
+			templateRepo:   "repo",
+			templatePath:   ".github/PULL_REQUEST_TEMPLATE/",
+			templateNames:  []string{"feature.md", "bug_fix.md"},
+			requestArgs:    map[string]interface{}{},
+			expectedErrMsg: "owner/repo has several pull request templates: bug_fix.md, feature.md",
+		},
+		{
+			name:          "named template in directory",
+			templateRepo:  "repo",
+			templatePath:  ".github/PULL_REQUEST_TEMPLATE/",
+			templateNames: []string{"feature.md", "bug_fix.md"},
+			requestArgs: map[string]interface{}{
+				"template":          "bug_fix",
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\nRan it locally\n\n## Notes",
+		},
+		{
+			name:           "unknown named template",
+			templateRepo:   "repo",
+			templatePath:   ".github/PULL_REQUEST_TEMPLATE/",
+			templateNames:  []string{"feature.md", "bug_fix.md"},
+			requestArgs:    map[string]interface{}{"template": "docs.md"},
+			expectedErrMsg: `pull request template "docs.md" not found; available templates: bug_fix.md, feature.md`,
+		},
+		{
+			name:          "template chosen by policy",
+			templateRepo:  ".github",
+			templatePath:  "PULL_REQUEST_TEMPLATE/",
+			templateNames: []string{"feature.md", "bug_fix.md"},
+			policies:      PullRequestTemplatePolicies{"Owner": {Template: "feature.md"}},
+			requestArgs: map[string]interface{}{
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\nRan it locally\n\n## Notes",
+		},
+		{
+			name:         "template enforced by default policy",
+			templateRepo: "repo",
+			policies:     PullRequestTemplatePolicies{"default": {Enforce: true, RequiredSections: []string{"Notes"}}},
+			requestArgs: map[string]interface{}{
+				"use_template":      false,
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedErrMsg: `required pull request template sections are empty: "Notes"`,
+		},
+		{
+			name:         "owner policy overrides default policy",
+			templateRepo: "repo",
+			policies: PullRequestTemplatePolicies{
+				"default": {RequiredSections: []string{"Notes"}},
+				"owner":   {},
+			},
+			requestArgs: map[string]interface{}{
+				"template_sections": map[string]interface{}{"Testing": "Ran it locally"},
+			},
+			expectedBody: "## Summary\n\nAdds a cache.\n\n## Testing\n\nRan it locally\n\n## Notes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			templatePath := tc.templatePath
+			if templatePath == "" {
+				templatePath = ".github/pull_request_template.md"
+			}
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, templateHandler(tc.templateRepo, templatePath, tc.templateNames...)),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":                 "Add cache",
+						"head":                  "feature",
+						"base":                  "main",
+						"body":                  tc.expectedBody,
+						"draft":                 false,
+						"maintainer_can_modify": false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.PullRequest{
+							Number:  github.Ptr(42),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+						}),
+					),
+				),
+			))
+			_, handler := CreatePullRequest(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{PullRequestTemplatePolicies: tc.policies})
+
+			args := map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"title":        "Add cache",
+				"head":         "feature",
+				"base":         "main",
+				"body":         "Adds a cache.",
+				"use_template": true,
+			}
+			for k, v := range tc.requestArgs {
+				args[k] = v
+			}
+
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+			var response MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, "https://github.com/owner/repo/pull/42", response.URL)
+		})
+	}
+}
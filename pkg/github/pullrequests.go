@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v79/github"
@@ -43,17 +44,15 @@ Possible options:
 				mcp.Enum("get", "get_diff", "get_status", "get_files", "get_review_comments", "get_reviews", "get_comments"),
 			),
 			mcp.WithString("owner",
-				mcp.Required(),
-				mcp.Description("Repository owner"),
+				mcp.Description("Repository owner. Required unless ref is given"),
 			),
 			mcp.WithString("repo",
-				mcp.Required(),
-				mcp.Description("Repository name"),
+				mcp.Description("Repository name. Required unless ref is given"),
 			),
 			mcp.WithNumber("pullNumber",
-				mcp.Required(),
-				mcp.Description("Pull request number"),
+				mcp.Description("Pull request number. Required unless ref is given"),
 			),
+			WithIssueRef(),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -62,18 +61,11 @@ Possible options:
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			owner, err := RequiredParam[string](request, "owner")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			repo, err := RequiredParam[string](request, "repo")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			pullNumber, err := RequiredInt(request, "pullNumber")
+			target, err := RequiredIssueTarget(request, "pullNumber")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			owner, repo, pullNumber := target.Owner, target.Repo, target.Number
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -370,7 +362,7 @@ func GetPullRequestReviews(ctx context.Context, client *github.Client, cache *lo
 }
 
 // CreatePullRequest creates a tool to create a new pull request.
-func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("create_pull_request",
 			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_DESCRIPTION", "Create a new pull request in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -406,6 +398,15 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			mcp.WithBoolean("maintainer_can_modify",
 				mcp.Description("Allow maintainer edits"),
 			),
+			mcp.WithBoolean("use_template",
+				mcp.Description("Fill the repository's pull request template (or the owner's default template) instead of using body as is. The body is placed in the first section of the template. Sections marked with <!-- required --> or required by the owner's template policy must not be left empty"),
+			),
+			mcp.WithString("template",
+				mcp.Description("Name of the template to fill when the repository has several templates in a PULL_REQUEST_TEMPLATE directory, e.g. 'bug_fix.md'. Only used with use_template"),
+			),
+			mcp.WithObject("template_sections",
+				mcp.Description("Content of pull request template sections, keyed by section heading, e.g. {\"Testing\": \"- [x] Unit tests\"}. Only used with use_template"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -444,6 +445,62 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			useTemplate, err := OptionalParam[bool](request, "use_template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			templateName, err := OptionalParam[string](request, "template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			templateSections, err := OptionalParam[map[string]any](request, "template_sections")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sections := make(map[string]string, len(templateSections))
+			for heading, content := range templateSections {
+				text, ok := content.(string)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("template_sections: content of section %q must be a string", heading)), nil
+				}
+				sections[heading] = text
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			policy := flags.PullRequestTemplatePolicies.forOwner(owner)
+			if useTemplate || policy.Enforce {
+				template, resp, err := getPullRequestTemplate(ctx, client, owner, repo, templateName)
+				if err == nil && template.Content == "" && templateName == "" && policy.Template != "" && len(template.Available) > 0 {
+					template, resp, err = getPullRequestTemplate(ctx, client, owner, repo, policy.Template)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get pull request template",
+						resp,
+						err,
+					), nil
+				}
+				switch {
+				case template.Content != "":
+					body, err = mergePullRequestTemplate(template.Content, body, sections, policy.RequiredSections)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				case len(template.Available) > 0 && templateName != "":
+					return mcp.NewToolResultError(fmt.Sprintf("pull request template %q not found; available templates: %s", templateName, strings.Join(template.Available, ", "))), nil
+				case len(template.Available) > 0:
+					return mcp.NewToolResultError(fmt.Sprintf("%s/%s has several pull request templates: %s; choose one with the template parameter", owner, repo, strings.Join(template.Available, ", "))), nil
+				case useTemplate:
+					return mcp.NewToolResultError(fmt.Sprintf("no pull request template found in %s/%s or %s/.github; create the pull request without use_template", owner, repo, owner)), nil
+				}
+			}
+
 			newPR := &github.NewPullRequest{
 				Title: github.Ptr(title),
 				Head:  github.Ptr(head),
@@ -457,10 +514,6 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			newPR.Draft = github.Ptr(draft)
 			newPR.MaintainerCanModify = github.Ptr(maintainerCanModify)
 
-			client, err := getClient(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
 			pr, resp, err := client.PullRequests.Create(ctx, owner, repo, newPR)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -779,6 +832,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_LIST_PULL_REQUESTS_USER_TITLE", "List pull requests"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.PullRequest](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -805,6 +859,9 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("Sort direction"),
 				mcp.Enum("asc", "desc"),
 			),
+			mcp.WithString("touching_path",
+				mcp.Description("Only return pull requests that change a file matching this glob, e.g. 'services/api/**'. '**' matches any number of directories. Filtering applies to the requested page, so a page may contain fewer pull requests than perPage"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -816,6 +873,10 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			touchingPath, err := OptionalParam[string](request, "touching_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			state, err := OptionalParam[string](request, "state")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -874,6 +935,27 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
+			if touchingPath != "" {
+				filtered := make([]*github.PullRequest, 0, len(prs))
+				for _, pr := range prs {
+					paths, resp, err := pullRequestFilesCache.Paths(ctx, client, owner, repo, pr)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to list files of pull request #%d", pr.GetNumber()),
+							resp,
+							err,
+						), nil
+					}
+					for _, p := range paths {
+						if matchGlob(touchingPath, p) {
+							filtered = append(filtered, pr)
+							break
+						}
+					}
+				}
+				prs = filtered
+			}
+
 			// sanitize title/body on each PR
 			for _, pr := range prs {
 				if pr == nil {
@@ -887,12 +969,111 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				}
 			}
 
-			r, err := json.Marshal(prs)
+			return MarshalledStructuredListResult(prs), nil
+		}
+}
+
+// CommitPullRequest is a pull request associated with a commit, along with its reviewers and linked issues.
+type CommitPullRequest struct {
+	MinimalPullRequest
+	ReviewedBy   []string `json:"reviewed_by"`
+	LinkedIssues []string `json:"linked_issues"`
+}
+
+// ListPullRequestsForCommit creates a tool to list the pull requests associated with a commit.
+func ListPullRequestsForCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_requests_for_commit",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_FOR_COMMIT_DESCRIPTION", "List the pull requests associated with a commit, including their reviewers and the issues they close. Use this to trace a commit, e.g. one from a bad deploy, back to the pull request that introduced it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PULL_REQUESTS_FOR_COMMIT_USER_TITLE", "List pull requests for commit"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithListOutputSchema[CommitPullRequest](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := RequiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.ListOptions{
+				PerPage: pagination.PerPage,
+				Page:    pagination.Page,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull requests for commit",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := make([]CommitPullRequest, 0, len(prs))
+			for _, pr := range prs {
+				if pr.Title != nil {
+					pr.Title = github.Ptr(sanitize.Sanitize(*pr.Title))
+				}
+				commitPR := CommitPullRequest{
+					MinimalPullRequest: convertToMinimalPullRequest(pr),
+					ReviewedBy:         []string{},
+					LinkedIssues:       parseClosingReferences(pr.GetBody(), owner, repo),
+				}
+
+				reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get pull request reviews",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				seen := map[string]bool{}
+				for _, review := range reviews {
+					login := review.GetUser().GetLogin()
+					if login == "" || seen[login] {
+						continue
+					}
+					seen[login] = true
+					commitPR.ReviewedBy = append(commitPR.ReviewedBy, login)
+				}
+
+				result = append(result, commitPR)
+			}
+
+			return MarshalledStructuredListResult(result), nil
 		}
 }
 
@@ -926,6 +1107,9 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description("Merge method"),
 				mcp.Enum("merge", "squash", "rebase"),
 			),
+			mcp.WithString("sha",
+				mcp.Description("SHA the head of the pull request must match for the merge to happen, to avoid merging commits pushed after a review"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -952,10 +1136,15 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			options := &github.PullRequestOptions{
 				CommitTitle: commitTitle,
 				MergeMethod: mergeMethod,
+				SHA:         sha,
 			}
 
 			client, err := getClient(ctx)
@@ -990,16 +1179,17 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 }
 
 // SearchPullRequests creates a tool to search for pull requests.
-func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_pull_requests",
 			mcp.WithDescription(t("TOOL_SEARCH_PULL_REQUESTS_DESCRIPTION", "Search for pull requests in GitHub repositories using issues search syntax already scoped to is:pr")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_PULL_REQUESTS_USER_TITLE", "Search pull requests"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.IssuesSearchResult](),
 			mcp.WithString("query",
 				mcp.Required(),
-				mcp.Description("Search query using GitHub pull request search syntax"),
+				mcp.Description("Search query using GitHub pull request search syntax. Date qualifiers also accept relative dates such as merged:\"last monday\", updated:>=3-days-ago, closed:last-week or created:2024-W05 (ISO week)"),
 			),
 			mcp.WithString("owner",
 				mcp.Description("Optional repository owner. If provided with repo, only pull requests for this repository are listed."),
@@ -1030,12 +1220,13 @@ func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperF
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchHandler(ctx, getClient, request, "pr", "failed to search pull requests")
+			return searchHandler(ctx, getClient, request, "pr", "failed to search pull requests", flags.now())
 		}
 }
 
 // UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
 func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	pullRequestBranchUpdateInProgressMessage := t("RESULT_PULL_REQUEST_BRANCH_UPDATE_IN_PROGRESS", "Pull request branch update is in progress")
 	return mcp.NewTool("update_pull_request_branch",
 			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -1089,7 +1280,7 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
 				// and it's not a real error.
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Pull request branch update is in progress"), nil
+					return mcp.NewToolResultText(pullRequestBranchUpdateInProgressMessage), nil
 				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update pull request branch",
@@ -1420,6 +1611,7 @@ func DeletePendingPullRequestReview(ctx context.Context, client *githubv4.Client
 
 // AddCommentToPendingReview creates a tool to add a comment to a pull request review.
 func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	pendingReviewCommentAddedMessage := t("RESULT_PENDING_REVIEW_COMMENT_ADDED", "pull request review comment successfully added to pending review")
 	return mcp.NewTool("add_comment_to_pending_review",
 			mcp.WithDescription(t("TOOL_ADD_COMMENT_TO_PENDING_REVIEW_DESCRIPTION", "Add review comment to the requester's latest pending pull request review. A pending review needs to already exist to call this (check with the user if not sure).")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -1588,7 +1780,7 @@ func AddCommentToPendingReview(getGQLClient GetGQLClientFn, t translations.Trans
 			// Return nothing interesting, just indicate success for the time being.
 			// In future, we may want to return the review ID, but for the moment, we're not leaking
 			// API implementation details to the LLM.
-			return mcp.NewToolResultText("pull request review comment successfully added to pending review"), nil
+			return mcp.NewToolResultText(pendingReviewCommentAddedMessage), nil
 		}
 }
 
@@ -1668,6 +1860,92 @@ func RequestCopilotReview(getClient GetClientFn, t translations.TranslationHelpe
 		}
 }
 
+// DismissedPullRequestReview is the result of dismissing a pull request review.
+type DismissedPullRequestReview struct {
+	ID      int64  `json:"id"`
+	State   string `json:"state"`
+	User    string `json:"user,omitempty"`
+	HTMLURL string `json:"html_url"`
+}
+
+// DismissPullRequestReview creates a tool to dismiss a submitted review of a pull request.
+func DismissPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("dismiss_pull_request_review",
+			mcp.WithDescription(t("TOOL_DISMISS_PULL_REQUEST_REVIEW_DESCRIPTION", "Dismiss a submitted review of a pull request, e.g. a stale approval or a change request that was addressed, so it no longer counts towards the required reviews. Get review IDs with the get_reviews method of pull_request_read.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DISMISS_PULL_REQUEST_REVIEW_USER_TITLE", "Dismiss pull request review"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[DismissedPullRequestReview](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("review_id",
+				mcp.Required(),
+				mcp.Description("The ID of the review to dismiss"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Reason for dismissing the review, shown on the pull request"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewID, err := RequiredBigInt(request, "review_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := RequiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			review, resp, err := client.PullRequests.DismissReview(ctx, owner, repo, pullNumber, reviewID, &github.PullRequestReviewDismissalRequest{
+				Message: github.Ptr(message),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to dismiss pull request review",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledStructuredResult(DismissedPullRequestReview{
+				ID:      review.GetID(),
+				State:   review.GetState(),
+				User:    review.GetUser().GetLogin(),
+				HTMLURL: review.GetHTMLURL(),
+			}), nil
+		}
+}
+
 // newGQLString like takes something that approximates a string (of which there are many types in shurcooL/githubv4)
 // and constructs a pointer to it, or nil if the string is empty. This is extremely useful because when we parse
 // params from the MCP request, we need to convert them to types that are pointers of type def strings and it's
@@ -30,7 +30,8 @@ func Test_GetPullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock PR for success case
 	mockPR := &github.PullRequest{
@@ -76,6 +77,33 @@ func Test_GetPullRequest(t *testing.T) {
 			expectError: false,
 			expectedPR:  mockPR,
 		},
+		{
+			name: "PR fetch by reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					expectPath(t, "/repos/owner/repo/pulls/42").andThen(
+						mockResponse(t, http.StatusOK, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"method": "get",
+				"ref":    "owner/repo#42",
+			},
+			expectError: false,
+			expectedPR:  mockPR,
+		},
+		{
+			name:         "invalid reference",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"method": "get",
+				"ref":    "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid reference \"main\"",
+		},
 		{
 			name: "PR fetch fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -590,6 +618,7 @@ func Test_ListPullRequests(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "base")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "touching_path")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
@@ -709,6 +738,185 @@ func Test_ListPullRequests(t *testing.T) {
 	}
 }
 
+func Test_ListPullRequests_TouchingPath(t *testing.T) {
+	mockPRs := []*github.PullRequest{
+		{Number: github.Ptr(42), Head: &github.PullRequestBranch{SHA: github.Ptr("touching-path-42")}},
+		{Number: github.Ptr(43), Head: &github.PullRequestBranch{SHA: github.Ptr("touching-path-43")}},
+		{Number: github.Ptr(44), Head: &github.PullRequestBranch{SHA: github.Ptr("touching-path-44")}},
+	}
+	files := map[string][]*github.CommitFile{
+		"/repos/owner/repo/pulls/42/files": {{Filename: github.Ptr("services/api/handlers/users.go")}},
+		"/repos/owner/repo/pulls/43/files": {{Filename: github.Ptr("services/web/index.ts")}},
+		"/repos/owner/repo/pulls/44/files": {{Filename: github.Ptr("services/worker/main.go"), PreviousFilename: github.Ptr("services/api/worker.go")}},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, mockPRs),
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mockResponse(t, http.StatusOK, files[r.URL.Path])(w, r)
+			}),
+		),
+	))
+	_, handler := ListPullRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":         "owner",
+		"repo":          "repo",
+		"touching_path": "services/api/**",
+	}))
+	require.NoError(t, err)
+
+	var returnedPRs []*github.PullRequest
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedPRs))
+	numbers := []int{}
+	for _, pr := range returnedPRs {
+		numbers = append(numbers, pr.GetNumber())
+	}
+	// #44 moved a file out of services/api, which also affects that area.
+	assert.Equal(t, []int{42, 44}, numbers)
+}
+
+func Test_ListPullRequestsForCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestsForCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_requests_for_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	mergedAt := github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	mockPRs := []*github.PullRequest{
+		{
+			Number:             github.Ptr(42),
+			Title:              github.Ptr("Add caching layer"),
+			State:              github.Ptr("closed"),
+			HTMLURL:            github.Ptr("https://github.com/owner/repo/pull/42"),
+			Body:               github.Ptr("Fixes #7 and resolves other/repo#3"),
+			User:               &github.User{Login: github.Ptr("octocat")},
+			Base:               &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head:               &github.PullRequestBranch{Ref: github.Ptr("caching")},
+			MergedAt:           &mergedAt,
+			MergeCommitSHA:     github.Ptr("abc123"),
+			RequestedReviewers: []*github.User{{Login: github.Ptr("hubot")}},
+		},
+	}
+	mockReviews := []*github.PullRequestReview{
+		{User: &github.User{Login: github.Ptr("monalisa")}, State: github.Ptr("COMMENTED")},
+		{User: &github.User{Login: github.Ptr("monalisa")}, State: github.Ptr("APPROVED")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedPRs    []CommitPullRequest
+	}{
+		{
+			name: "lists pull requests with reviewers and linked issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/commits/abc123/pulls",
+						queryParams: map[string]string{"page": "1", "per_page": "30"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPRs),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			},
+			expectedPRs: []CommitPullRequest{
+				{
+					MinimalPullRequest: MinimalPullRequest{
+						Number:             42,
+						Title:              "Add caching layer",
+						State:              "closed",
+						HTMLURL:            "https://github.com/owner/repo/pull/42",
+						Author:             "octocat",
+						Base:               "main",
+						Head:               "caching",
+						MergedAt:           &mergedAt,
+						MergeCommitSHA:     "abc123",
+						RequestedReviewers: []string{"hubot"},
+					},
+					ReviewedBy:   []string{"monalisa"},
+					LinkedIssues: []string{"#7", "other/repo#3"},
+				},
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "No commit found for SHA: missing"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull requests for commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestsForCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returnedPRs []CommitPullRequest
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedPRs))
+			require.Len(t, returnedPRs, len(tc.expectedPRs))
+			for i, expected := range tc.expectedPRs {
+				actual := returnedPRs[i]
+				require.NotNil(t, actual.MergedAt)
+				assert.True(t, expected.MergedAt.Equal(*actual.MergedAt))
+				expected.MergedAt, actual.MergedAt = nil, nil
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
 func Test_MergePullRequest(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -723,6 +931,7 @@ func Test_MergePullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
 	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
 	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock merge result for success case
@@ -765,6 +974,27 @@ func Test_MergePullRequest(t *testing.T) {
 			expectError:         false,
 			expectedMergeResult: mockMergeResult,
 		},
+		{
+			name: "merge when head matches sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"sha": "abcd1234",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMergeResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"sha":        "abcd1234",
+			},
+			expectError:         false,
+			expectedMergeResult: mockMergeResult,
+		},
 		{
 			name: "merge fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -826,7 +1056,7 @@ func Test_MergePullRequest(t *testing.T) {
 
 func Test_SearchPullRequests(t *testing.T) {
 	mockClient := github.NewClient(nil)
-	tool, _ := SearchPullRequests(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SearchPullRequests(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "search_pull_requests", tool.Name)
@@ -1091,7 +1321,7 @@ func Test_SearchPullRequests(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := SearchPullRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SearchPullRequests(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1144,7 +1374,7 @@ func Test_GetPullRequestFiles(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock PR files for success case
 	mockFiles := []*github.CommitFile{
@@ -1286,7 +1516,7 @@ func Test_GetPullRequestStatus(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock PR for successful PR fetch
 	mockPR := &github.PullRequest{
@@ -1575,7 +1805,7 @@ func Test_GetPullRequestComments(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock PR comments for success case
 	mockComments := []*github.PullRequestComment{
@@ -1757,7 +1987,7 @@ func Test_GetPullRequestReviews(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	// Setup mock PR reviews for success case
 	mockReviews := []*github.PullRequestReview{
@@ -1929,7 +2159,7 @@ func Test_GetPullRequestReviews(t *testing.T) {
 func Test_CreatePullRequest(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreatePullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := CreatePullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "create_pull_request", tool.Name)
@@ -2042,7 +2272,7 @@ func Test_CreatePullRequest(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreatePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := CreatePullRequest(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -2962,7 +3192,7 @@ func TestGetPullRequestDiff(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method", "owner", "repo", "pullNumber"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"method"})
 
 	stubbedDiff := `diff --git a/README.md b/README.md
 index 5d6e7b2..8a4f5c3 100644
@@ -3105,3 +3335,86 @@ func getLatestPendingReviewQuery(p getLatestPendingReviewQueryParams) githubv4mo
 		),
 	)
 }
+
+func Test_DismissPullRequestReview(t *testing.T) {
+	t.Parallel()
+
+	mockClient := github.NewClient(nil)
+	tool, _ := DismissPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "dismiss_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "review_id", "message"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful dismissal",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/pulls/42/reviews/7/dismissals",
+						requestBody: map[string]any{"message": "Addressed in abcd123"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequestReview{
+							ID:      github.Ptr(int64(7)),
+							State:   github.Ptr("DISMISSED"),
+							User:    &github.User{Login: github.Ptr("octocat")},
+							HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-7"),
+						}),
+					),
+				),
+			),
+		},
+		{
+			name: "review cannot be dismissed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Can not dismiss a pending pull request review"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to dismiss pull request review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, handler := DismissPullRequestReview(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"review_id":  float64(7),
+				"message":    "Addressed in abcd123",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			assert.Equal(t, DismissedPullRequestReview{
+				ID:      7,
+				State:   "DISMISSED",
+				User:    "octocat",
+				HTMLURL: "https://github.com/owner/repo/pull/42#pullrequestreview-7",
+			}, result.StructuredContent)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const queryDateLayout = "2006-01-02"
+
+// dateQualifierPattern matches search qualifiers whose value is a date, e.g. created:>=2024-01-01 or
+// updated:"last monday". Natural date expressions containing spaces must be quoted or hyphenated.
+var dateQualifierPattern = regexp.MustCompile(`(?i)(^|\s)(-?(?:created|updated|closed|merged|pushed|author-date|committer-date|last-updated):)(>=|<=|>|<)?("[^"]*"|[^\s"]+)`)
+
+var (
+	relativeDatePattern = regexp.MustCompile(`^(\d+) (day|week|month|year)s? ago$`)
+	lastNDaysPattern    = regexp.MustCompile(`^(?:last|past) (\d+) (day|week|month|year)s?$`)
+	isoWeekPattern      = regexp.MustCompile(`^(\d{4})-w(\d{1,2})$`)
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// normalizeQueryDates rewrites natural date expressions in the date qualifiers of a search query, such as
+// created:"last monday", updated:>=3-days-ago or closed:2024-W05, into the absolute dates understood by GitHub.
+// Dates are resolved relative to now, in its location. Values that are not recognized are left unchanged.
+func normalizeQueryDates(query string, now time.Time) string {
+	return dateQualifierPattern.ReplaceAllStringFunc(query, func(match string) string {
+		parts := dateQualifierPattern.FindStringSubmatch(match)
+		prefix, qualifier, operator, value := parts[1], parts[2], parts[3], parts[4]
+
+		from, to, ok := resolveDateExpression(strings.Trim(value, `"`), now)
+		if !ok {
+			return match
+		}
+
+		var date string
+		switch operator {
+		case ">", "<=":
+			date = to.Format(queryDateLayout)
+		case ">=", "<":
+			date = from.Format(queryDateLayout)
+		default:
+			date = from.Format(queryDateLayout)
+			if !to.Equal(from) {
+				date += ".." + to.Format(queryDateLayout)
+			}
+		}
+		return prefix + qualifier + operator + date
+	})
+}
+
+// resolveDateExpression resolves a natural date expression to the first and last day it covers.
+func resolveDateExpression(expr string, now time.Time) (from, to time.Time, ok bool) {
+	expr = strings.Join(strings.FieldsFunc(strings.ToLower(expr), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), " ")
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch expr {
+	case "today":
+		return today, today, true
+	case "yesterday":
+		yesterday := today.AddDate(0, 0, -1)
+		return yesterday, yesterday, true
+	case "this week":
+		monday := startOfISOWeek(today)
+		return monday, monday.AddDate(0, 0, 6), true
+	case "last week":
+		monday := startOfISOWeek(today).AddDate(0, 0, -7)
+		return monday, monday.AddDate(0, 0, 6), true
+	case "this month":
+		first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+		return first, first.AddDate(0, 1, -1), true
+	case "last month":
+		first := time.Date(today.Year(), today.Month()-1, 1, 0, 0, 0, 0, today.Location())
+		return first, first.AddDate(0, 1, -1), true
+	case "this year":
+		first := time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, today.Location())
+		return first, first.AddDate(1, 0, -1), true
+	case "last year":
+		first := time.Date(today.Year()-1, time.January, 1, 0, 0, 0, 0, today.Location())
+		return first, first.AddDate(1, 0, -1), true
+	}
+
+	if name, found := strings.CutPrefix(expr, "last "); found {
+		if weekday, isWeekday := weekdays[name]; isWeekday {
+			days := (int(today.Weekday()) - int(weekday) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			day := today.AddDate(0, 0, -days)
+			return day, day, true
+		}
+	}
+
+	if m := relativeDatePattern.FindStringSubmatch(expr); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		day := subtractPeriod(today, n, m[2])
+		return day, day, true
+	}
+
+	if m := lastNDaysPattern.FindStringSubmatch(expr); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return subtractPeriod(today, n, m[2]), today, true
+	}
+
+	// ISO week numbers, e.g. 2024-W05, are lowercased and split on the hyphen above.
+	if m := isoWeekPattern.FindStringSubmatch(strings.ReplaceAll(expr, " ", "-")); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		if week < 1 || week > 53 {
+			return time.Time{}, time.Time{}, false
+		}
+		// January 4th is always in the first ISO week of its year.
+		monday := startOfISOWeek(time.Date(year, time.January, 4, 0, 0, 0, 0, today.Location())).AddDate(0, 0, (week-1)*7)
+		if _, w := monday.ISOWeek(); w != week {
+			return time.Time{}, time.Time{}, false
+		}
+		return monday, monday.AddDate(0, 0, 6), true
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+func startOfISOWeek(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func subtractPeriod(day time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "week":
+		return day.AddDate(0, 0, -7*n)
+	case "month":
+		return day.AddDate(0, -n, 0)
+	case "year":
+		return day.AddDate(-n, 0, 0)
+	default:
+		return day.AddDate(0, 0, -n)
+	}
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeQueryDates(t *testing.T) {
+	// Wednesday, ISO week 11 of 2024.
+	now := time.Date(2024, time.March, 13, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "absolute dates are unchanged",
+			query:    "is:open created:>=2024-01-01 updated:2024-01-01..2024-02-01",
+			expected: "is:open created:>=2024-01-01 updated:2024-01-01..2024-02-01",
+		},
+		{
+			name:     "today and yesterday",
+			query:    "created:today closed:yesterday",
+			expected: "created:2024-03-13 closed:2024-03-12",
+		},
+		{
+			name:     "quoted last weekday",
+			query:    `is:pr merged:"last monday" author:octocat`,
+			expected: "is:pr merged:2024-03-11 author:octocat",
+		},
+		{
+			name:     "last weekday on the same weekday is a week ago",
+			query:    "created:last-wednesday",
+			expected: "created:2024-03-06",
+		},
+		{
+			name:     "relative days with operator",
+			query:    "updated:>=3-days-ago",
+			expected: "updated:>=2024-03-10",
+		},
+		{
+			name:     "relative months",
+			query:    `created:<"2 months ago"`,
+			expected: "created:<2024-01-13",
+		},
+		{
+			name:     "last n days is a range",
+			query:    "updated:last-7-days",
+			expected: "updated:2024-03-06..2024-03-13",
+		},
+		{
+			name:     "this week",
+			query:    "created:this-week",
+			expected: "created:2024-03-11..2024-03-17",
+		},
+		{
+			name:     "greater than a range uses its end",
+			query:    "created:>last-week",
+			expected: "created:>2024-03-10",
+		},
+		{
+			name:     "less than a range uses its start",
+			query:    "created:<last-week",
+			expected: "created:<2024-03-04",
+		},
+		{
+			name:     "last month",
+			query:    "closed:last-month",
+			expected: "closed:2024-02-01..2024-02-29",
+		},
+		{
+			name:     "ISO week",
+			query:    "created:2024-W05",
+			expected: "created:2024-01-29..2024-02-04",
+		},
+		{
+			name:     "ISO week starting in previous year",
+			query:    "created:2021-W01",
+			expected: "created:2021-01-04..2021-01-10",
+		},
+		{
+			name:     "invalid ISO week is unchanged",
+			query:    "created:2023-W53",
+			expected: "created:2023-W53",
+		},
+		{
+			name:     "negated qualifier",
+			query:    "-updated:today",
+			expected: "-updated:2024-03-13",
+		},
+		{
+			name:     "case insensitive",
+			query:    `Created:"Last Friday"`,
+			expected: "Created:2024-03-08",
+		},
+		{
+			name:     "unknown expression is unchanged",
+			query:    `created:"next tuesday" updated:@today-7d`,
+			expected: `created:"next tuesday" updated:@today-7d`,
+		},
+		{
+			name:     "non-date qualifiers are unchanged",
+			query:    `label:"last monday" today`,
+			expected: `label:"last monday" today`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeQueryDates(tc.query, now))
+		})
+	}
+}
+
+func Test_NormalizeQueryDates_Timezone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// Late on March 13 in UTC is already March 14 in Tokyo.
+	now := time.Date(2024, time.March, 13, 20, 0, 0, 0, time.UTC)
+	assert.Equal(t, "created:2024-03-13", normalizeQueryDates("created:today", now))
+	assert.Equal(t, "created:2024-03-14", normalizeQueryDates("created:today", now.In(tokyo)))
+}
@@ -0,0 +1,324 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	DefaultReferenceGraphDepth = 2
+	MaxReferenceGraphDepth     = 5
+	DefaultReferenceGraphNodes = 50
+	MaxReferenceGraphNodes     = 200
+)
+
+const (
+	ReferenceEdgeMentions   = "mentions"
+	ReferenceEdgeCloses     = "closes"
+	ReferenceEdgeDuplicates = "duplicates"
+)
+
+// ReferenceNode is an issue or pull request of a reference graph.
+type ReferenceNode struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	State string `json:"state"`
+	URL   string `json:"url"`
+	// Depth is the number of references followed from the root to reach the node.
+	Depth int `json:"depth"`
+}
+
+// ReferenceEdge is a reference from one issue or pull request to another.
+type ReferenceEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// ReferenceGraph is the graph of the issues and pull requests reachable by cross-references from a root.
+type ReferenceGraph struct {
+	Root  string          `json:"root"`
+	Nodes []ReferenceNode `json:"nodes"`
+	Edges []ReferenceEdge `json:"edges"`
+	// Truncated is true when nodes were left out because the graph reached max_nodes.
+	Truncated bool `json:"truncated"`
+}
+
+type referenceFields struct {
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String
+	URL        githubv4.String `graphql:"url"`
+	Repository struct {
+		Owner struct {
+			Login githubv4.String
+		}
+		Name githubv4.String
+	}
+}
+
+// referenceTarget is an issue or a pull request.
+type referenceTarget struct {
+	Typename    githubv4.String `graphql:"__typename"`
+	Issue       referenceFields `graphql:"... on Issue"`
+	PullRequest referenceFields `graphql:"... on PullRequest"`
+}
+
+func (r referenceTarget) node(depth int) (ReferenceNode, bool) {
+	fields, kind := r.Issue, "issue"
+	switch r.Typename {
+	case "Issue":
+	case "PullRequest":
+		fields, kind = r.PullRequest, "pull_request"
+	default:
+		return ReferenceNode{}, false
+	}
+	return fields.node(kind, depth), true
+}
+
+func (f referenceFields) node(kind string, depth int) ReferenceNode {
+	return ReferenceNode{
+		ID:    fmt.Sprintf("%s/%s#%d", f.Repository.Owner.Login, f.Repository.Name, f.Number),
+		Type:  kind,
+		Title: string(f.Title),
+		State: string(f.State),
+		URL:   string(f.URL),
+		Depth: depth,
+	}
+}
+
+type referenceTimelineItems struct {
+	Nodes []struct {
+		Typename             githubv4.String `graphql:"__typename"`
+		CrossReferencedEvent struct {
+			Source          referenceTarget
+			WillCloseTarget githubv4.Boolean
+		} `graphql:"... on CrossReferencedEvent"`
+		MarkedAsDuplicateEvent struct {
+			Canonical referenceTarget
+			Duplicate referenceTarget
+		} `graphql:"... on MarkedAsDuplicateEvent"`
+	}
+}
+
+// referenceGraphQuery selects an issue or pull request with the issues and pull requests referencing it, and for a
+// pull request the issues it closes.
+type referenceGraphQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			Typename githubv4.String `graphql:"__typename"`
+			Issue    struct {
+				referenceFields
+				TimelineItems referenceTimelineItems `graphql:"timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT, MARKED_AS_DUPLICATE_EVENT])"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				referenceFields
+				TimelineItems           referenceTimelineItems `graphql:"timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT, MARKED_AS_DUPLICATE_EVENT])"`
+				ClosingIssuesReferences struct {
+					Nodes []referenceFields
+				} `graphql:"closingIssuesReferences(first: 50)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// referenceGraphBuilder walks cross-references breadth first, loading every issue or pull request at most once.
+type referenceGraphBuilder struct {
+	graph    ReferenceGraph
+	maxNodes int
+	nodes    map[string]bool
+	edges    map[ReferenceEdge]bool
+	queue    []ReferenceNode
+}
+
+// add adds a node to the graph, and reports whether it is in the graph.
+func (b *referenceGraphBuilder) add(node ReferenceNode) bool {
+	if b.nodes[node.ID] {
+		return true
+	}
+	if len(b.graph.Nodes) >= b.maxNodes {
+		b.graph.Truncated = true
+		return false
+	}
+	b.nodes[node.ID] = true
+	b.graph.Nodes = append(b.graph.Nodes, node)
+	b.queue = append(b.queue, node)
+	return true
+}
+
+// link adds an edge between two nodes, adding the other node when it is new.
+func (b *referenceGraphBuilder) link(from, to ReferenceNode, kind string, other ReferenceNode) {
+	if !b.add(other) {
+		return
+	}
+	edge := ReferenceEdge{From: from.ID, To: to.ID, Type: kind}
+	if !b.edges[edge] {
+		b.edges[edge] = true
+		b.graph.Edges = append(b.graph.Edges, edge)
+	}
+}
+
+// expand adds the references of a loaded node to the graph.
+func (b *referenceGraphBuilder) expand(node ReferenceNode, timeline referenceTimelineItems, closes []referenceFields) {
+	for _, item := range timeline.Nodes {
+		switch item.Typename {
+		case "CrossReferencedEvent":
+			source, ok := item.CrossReferencedEvent.Source.node(node.Depth + 1)
+			if !ok {
+				continue
+			}
+			kind := ReferenceEdgeMentions
+			if item.CrossReferencedEvent.WillCloseTarget {
+				kind = ReferenceEdgeCloses
+			}
+			b.link(source, node, kind, source)
+		case "MarkedAsDuplicateEvent":
+			duplicate, ok := item.MarkedAsDuplicateEvent.Duplicate.node(node.Depth + 1)
+			if !ok {
+				continue
+			}
+			canonical, ok := item.MarkedAsDuplicateEvent.Canonical.node(node.Depth + 1)
+			if !ok {
+				continue
+			}
+			// The event is on both issues; the one that is not the current node is the new one.
+			if duplicate.ID == node.ID {
+				b.link(node, canonical, ReferenceEdgeDuplicates, canonical)
+			} else {
+				b.link(duplicate, node, ReferenceEdgeDuplicates, duplicate)
+			}
+		}
+	}
+	for _, issue := range closes {
+		target := issue.node("issue", node.Depth+1)
+		b.link(node, target, ReferenceEdgeCloses, target)
+	}
+}
+
+// GetReferenceGraph creates a tool to map the issues and pull requests linked to an issue or pull request by
+// cross-references.
+func GetReferenceGraph(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_reference_graph",
+			mcp.WithDescription(t("TOOL_GET_REFERENCE_GRAPH_DESCRIPTION", "Map the issues and pull requests linked to an issue or pull request by following cross-references (mentions, closing references and duplicates) up to a depth. Returns the graph as nodes and edges, useful to assess the blast radius of an incident or the scope of an epic.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REFERENCE_GRAPH_USER_TITLE", "Get reference graph"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ReferenceGraph](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("number",
+				mcp.Required(),
+				mcp.Description("The number of the issue or pull request to start from"),
+			),
+			mcp.WithNumber("depth",
+				mcp.Description(fmt.Sprintf("Number of references to follow from the start (max %d)", MaxReferenceGraphDepth)),
+				mcp.Min(1),
+				mcp.Max(MaxReferenceGraphDepth),
+				mcp.DefaultNumber(DefaultReferenceGraphDepth),
+			),
+			mcp.WithNumber("max_nodes",
+				mcp.Description(fmt.Sprintf("Maximum number of nodes of the graph (max %d)", MaxReferenceGraphNodes)),
+				mcp.Min(1),
+				mcp.Max(MaxReferenceGraphNodes),
+				mcp.DefaultNumber(DefaultReferenceGraphNodes),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			depth, err := OptionalIntParamWithDefault(request, "depth", DefaultReferenceGraphDepth)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxNodes, err := OptionalIntParamWithDefault(request, "max_nodes", DefaultReferenceGraphNodes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			depth = max(1, min(depth, MaxReferenceGraphDepth))
+			maxNodes = max(1, min(maxNodes, MaxReferenceGraphNodes))
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GQL client: %w", err)
+			}
+
+			b := &referenceGraphBuilder{
+				graph:    ReferenceGraph{Root: fmt.Sprintf("%s/%s#%d", owner, repo, number), Nodes: []ReferenceNode{}, Edges: []ReferenceEdge{}},
+				maxNodes: maxNodes,
+				nodes:    map[string]bool{},
+				edges:    map[ReferenceEdge]bool{},
+			}
+			next, first := b.graph.Root, true
+			for {
+				m := issueReferenceRe.FindStringSubmatch(next)
+				issueNumber, _ := strconv.Atoi(m[3])
+				var query referenceGraphQuery
+				if err := gqlClient.Query(ctx, &query, map[string]any{
+					"owner":  githubv4.String(m[1]),
+					"repo":   githubv4.String(m[2]),
+					"number": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				}); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+						fmt.Sprintf("failed to get references of %s", next),
+						err,
+					), nil
+				}
+				item := query.Repository.IssueOrPullRequest
+				if first {
+					switch item.Typename {
+					case "Issue":
+						b.add(item.Issue.node("issue", 0))
+					case "PullRequest":
+						b.add(item.PullRequest.node("pull_request", 0))
+					default:
+						return mcp.NewToolResultError(fmt.Sprintf("issue or pull request %s not found", next)), nil
+					}
+					b.graph.Root, first = b.graph.Nodes[0].ID, false
+				}
+
+				var node ReferenceNode
+				node, b.queue = b.queue[0], b.queue[1:]
+				if item.Typename == "PullRequest" {
+					b.expand(node, item.PullRequest.TimelineItems, item.PullRequest.ClosingIssuesReferences.Nodes)
+				} else {
+					b.expand(node, item.Issue.TimelineItems, nil)
+				}
+
+				// Nodes at the maximum depth are in the graph without their own references.
+				for len(b.queue) > 0 && b.queue[0].Depth >= depth {
+					b.queue = b.queue[1:]
+				}
+				if len(b.queue) == 0 {
+					break
+				}
+				next = b.queue[0].ID
+			}
+
+			return MarshalledStructuredResult(b.graph), nil
+		}
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetReferenceGraph(t *testing.T) {
+	tool, _ := GetReferenceGraph(stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "number"})
+
+	fields := func(typename, repo string, number int, title string) map[string]any {
+		return map[string]any{
+			"__typename": typename,
+			"number":     number,
+			"title":      title,
+			"state":      "OPEN",
+			"url":        "https://github.com/octo/" + repo,
+			"repository": map[string]any{"owner": map[string]any{"login": "octo"}, "name": repo},
+		}
+	}
+	node := func(typename, repo string, number int, title string, depth int) ReferenceNode {
+		kind := "issue"
+		if typename == "PullRequest" {
+			kind = "pull_request"
+		}
+		return ReferenceNode{
+			ID:    fmt.Sprintf("octo/%s#%d", repo, number),
+			Type:  kind,
+			Title: title,
+			State: "OPEN",
+			URL:   "https://github.com/octo/" + repo,
+			Depth: depth,
+		}
+	}
+	issue := fields("Issue", "api", 7, "Outage")
+	issue["timelineItems"] = map[string]any{"nodes": []any{
+		map[string]any{"__typename": "CrossReferencedEvent", "source": fields("PullRequest", "api", 8, "Fix outage"), "willCloseTarget": true},
+		map[string]any{"__typename": "CrossReferencedEvent", "source": fields("Issue", "web", 3, "Errors on checkout"), "willCloseTarget": false},
+		map[string]any{"__typename": "MarkedAsDuplicateEvent", "duplicate": fields("Issue", "api", 5, "Site down"), "canonical": fields("Issue", "api", 7, "Outage")},
+	}}
+	matcher := githubv4mock.NewQueryMatcher(
+		referenceGraphQuery{},
+		map[string]any{"owner": githubv4.String("octo"), "repo": githubv4.String("api"), "number": githubv4.Int(7)},
+		githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"issueOrPullRequest": issue}}),
+	)
+
+	tests := []struct {
+		name     string
+		maxNodes float64
+		expected ReferenceGraph
+	}{
+		{
+			name:     "references",
+			maxNodes: 10,
+			expected: ReferenceGraph{
+				Root: "octo/api#7",
+				Nodes: []ReferenceNode{
+					node("Issue", "api", 7, "Outage", 0),
+					node("PullRequest", "api", 8, "Fix outage", 1),
+					node("Issue", "web", 3, "Errors on checkout", 1),
+					node("Issue", "api", 5, "Site down", 1),
+				},
+				Edges: []ReferenceEdge{
+					{From: "octo/api#8", To: "octo/api#7", Type: ReferenceEdgeCloses},
+					{From: "octo/web#3", To: "octo/api#7", Type: ReferenceEdgeMentions},
+					{From: "octo/api#5", To: "octo/api#7", Type: ReferenceEdgeDuplicates},
+				},
+			},
+		},
+		{
+			name:     "truncated",
+			maxNodes: 2,
+			expected: ReferenceGraph{
+				Root: "octo/api#7",
+				Nodes: []ReferenceNode{
+					node("Issue", "api", 7, "Outage", 0),
+					node("PullRequest", "api", 8, "Fix outage", 1),
+				},
+				Edges: []ReferenceEdge{
+					{From: "octo/api#8", To: "octo/api#7", Type: ReferenceEdgeCloses},
+				},
+				Truncated: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(matcher))
+			_, handler := GetReferenceGraph(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(map[string]any{
+				"owner":     "octo",
+				"repo":      "api",
+				"number":    float64(7),
+				"depth":     float64(1),
+				"max_nodes": tc.maxNodes,
+			}))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response ReferenceGraph
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		gqlClient := githubv4.NewClient(githubv4mock.NewMockedHTTPClient(githubv4mock.NewQueryMatcher(
+			referenceGraphQuery{},
+			map[string]any{"owner": githubv4.String("octo"), "repo": githubv4.String("api"), "number": githubv4.Int(7)},
+			githubv4mock.DataResponse(map[string]any{"repository": map[string]any{"issueOrPullRequest": nil}}),
+		)))
+		_, handler := GetReferenceGraph(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "octo",
+			"repo":   "api",
+			"number": float64(7),
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "issue or pull request octo/api#7 not found")
+	})
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultRelatedStargazerSample is the default number of recent stargazers sampled by find_related_repositories.
+	DefaultRelatedStargazerSample = 30
+	// MaxRelatedStargazerSample bounds the number of stargazers sampled, as the stars of each one require a request.
+	MaxRelatedStargazerSample = 100
+	// DefaultRelatedStarsPerUser is the default number of recent stars of each stargazer considered.
+	DefaultRelatedStarsPerUser = 30
+	// MaxRelatedStarsPerUser bounds the number of recent stars of each stargazer considered, to a single page.
+	MaxRelatedStarsPerUser = 100
+	// DefaultRelatedRepositoriesLimit is the default number of related repositories returned.
+	DefaultRelatedRepositoriesLimit = 10
+)
+
+// RelatedRepository is a repository starred by stargazers of another repository.
+type RelatedRepository struct {
+	FullName    string `json:"full_name"`
+	Description string `json:"description,omitempty"`
+	Language    string `json:"language,omitempty"`
+	Stars       int    `json:"stars"`
+	// SharedStargazers is the number of sampled stargazers who also starred the repository.
+	SharedStargazers int `json:"shared_stargazers"`
+}
+
+// RelatedRepositories are the repositories most commonly starred by the recent stargazers of a repository.
+type RelatedRepositories struct {
+	Repository        string              `json:"repository"`
+	StargazersSampled int                 `json:"stargazers_sampled"`
+	Related           []RelatedRepository `json:"related"`
+}
+
+// recentStargazers returns the logins of up to sample of the most recent stargazers of a repository. Stargazers are
+// listed oldest first, so the most recent ones are on the last pages.
+func recentStargazers(ctx context.Context, client *github.Client, owner, repo string, sample int) ([]string, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: sample}
+	stargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+
+	if resp.LastPage > 1 {
+		stargazers = nil
+		for page := resp.LastPage - 1; page <= resp.LastPage; page++ {
+			opts.Page = page
+			pageStargazers, resp, err := client.Activity.ListStargazers(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, resp, err
+			}
+			_ = resp.Body.Close()
+			stargazers = append(stargazers, pageStargazers...)
+		}
+		if len(stargazers) > sample {
+			stargazers = stargazers[len(stargazers)-sample:]
+		}
+	}
+
+	logins := make([]string, 0, len(stargazers))
+	for _, stargazer := range stargazers {
+		logins = append(logins, stargazer.GetUser().GetLogin())
+	}
+	return logins, resp, nil
+}
+
+// FindRelatedRepositories creates a tool to find the repositories commonly starred by the recent stargazers of a
+// repository.
+func FindRelatedRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_related_repositories",
+			mcp.WithDescription(t("TOOL_FIND_RELATED_REPOSITORIES_DESCRIPTION", "Find repositories related to a repository: samples its most recent stargazers and returns the other repositories they most commonly starred recently, e.g. to research the ecosystem of a project or find alternatives to it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_RELATED_REPOSITORIES_USER_TITLE", "Find related repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[RelatedRepositories](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("stargazers",
+				mcp.Description(fmt.Sprintf("Number of recent stargazers to sample (max %d)", MaxRelatedStargazerSample)),
+				mcp.Min(1),
+				mcp.Max(MaxRelatedStargazerSample),
+				mcp.DefaultNumber(DefaultRelatedStargazerSample),
+			),
+			mcp.WithNumber("stars_per_user",
+				mcp.Description(fmt.Sprintf("Number of recent stars of each stargazer to consider (max %d)", MaxRelatedStarsPerUser)),
+				mcp.Min(1),
+				mcp.Max(MaxRelatedStarsPerUser),
+				mcp.DefaultNumber(DefaultRelatedStarsPerUser),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of related repositories to return"),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultRelatedRepositoriesLimit),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sample, err := OptionalIntParamWithDefault(request, "stargazers", DefaultRelatedStargazerSample)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			starsPerUser, err := OptionalIntParamWithDefault(request, "stars_per_user", DefaultRelatedStarsPerUser)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParamWithDefault(request, "limit", DefaultRelatedRepositoriesLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sample < 1 || sample > MaxRelatedStargazerSample {
+				sample = MaxRelatedStargazerSample
+			}
+			if starsPerUser < 1 || starsPerUser > MaxRelatedStarsPerUser {
+				starsPerUser = MaxRelatedStarsPerUser
+			}
+			if limit < 1 {
+				limit = DefaultRelatedRepositoriesLimit
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			stargazers, resp, err := recentStargazers(ctx, client, owner, repo, sample)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list stargazers of '%s/%s'", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+
+			fullName := owner + "/" + repo
+			result := RelatedRepositories{Repository: fullName, Related: []RelatedRepository{}}
+			related := map[string]*RelatedRepository{}
+			for _, login := range stargazers {
+				starred, resp, err := client.Activity.ListStarred(ctx, login, &github.ActivityListStarredOptions{
+					Sort:        "created",
+					Direction:   "desc",
+					ListOptions: github.ListOptions{PerPage: starsPerUser},
+				})
+				if err != nil {
+					// The stargazer may have deleted their account since starring the repository.
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list repositories starred by '%s'", login),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				result.StargazersSampled++
+
+				for _, s := range starred {
+					r := s.GetRepository()
+					name := r.GetFullName()
+					if strings.EqualFold(name, fullName) {
+						continue
+					}
+					if related[name] == nil {
+						related[name] = &RelatedRepository{
+							FullName:    name,
+							Description: r.GetDescription(),
+							Language:    r.GetLanguage(),
+							Stars:       r.GetStargazersCount(),
+						}
+					}
+					related[name].SharedStargazers++
+				}
+			}
+
+			for _, r := range related {
+				result.Related = append(result.Related, *r)
+			}
+			sort.Slice(result.Related, func(i, j int) bool {
+				a, b := result.Related[i], result.Related[j]
+				if a.SharedStargazers != b.SharedStargazers {
+					return a.SharedStargazers > b.SharedStargazers
+				}
+				if a.Stars != b.Stars {
+					return a.Stars > b.Stars
+				}
+				return a.FullName < b.FullName
+			})
+			if len(result.Related) > limit {
+				result.Related = result.Related[:limit]
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
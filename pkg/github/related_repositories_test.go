@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindRelatedRepositories(t *testing.T) {
+	tool, _ := FindRelatedRepositories(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	stargazers := func(logins ...string) []*github.Stargazer {
+		result := []*github.Stargazer{}
+		for _, login := range logins {
+			result = append(result, &github.Stargazer{User: &github.User{Login: github.Ptr(login)}})
+		}
+		return result
+	}
+	starred := func(repos ...*github.Repository) []*github.StarredRepository {
+		result := []*github.StarredRepository{}
+		for _, repo := range repos {
+			result = append(result, &github.StarredRepository{Repository: repo})
+		}
+		return result
+	}
+	repository := func(fullName string, stars int) *github.Repository {
+		return &github.Repository{FullName: github.Ptr(fullName), StargazersCount: github.Ptr(stars)}
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposStargazersByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("page") {
+				case "":
+					w.Header().Set("Link", `<https://api.github.com/repos/octo/api/stargazers?page=2&per_page=2>; rel="next", <https://api.github.com/repos/octo/api/stargazers?page=3&per_page=2>; rel="last"`)
+					mockResponse(t, http.StatusOK, stargazers("early", "earlier"))(w, r)
+				case "2":
+					mockResponse(t, http.StatusOK, stargazers("old", "alice"))(w, r)
+				default:
+					mockResponse(t, http.StatusOK, stargazers("bob"))(w, r)
+				}
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetUsersStarredByUsername,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "created", r.URL.Query().Get("sort"))
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/alice/starred"):
+					mockResponse(t, http.StatusOK, starred(repository("octo/api", 50), repository("acme/web", 10), repository("acme/cli", 900)))(w, r)
+				case strings.HasSuffix(r.URL.Path, "/bob/starred"):
+					mockResponse(t, http.StatusOK, starred(repository("acme/web", 10), repository("octo/API", 50)))(w, r)
+				default:
+					t.Errorf("unexpected request for %s", r.URL.Path)
+				}
+			}),
+		),
+	))
+	_, handler := FindRelatedRepositories(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{
+		"owner":      "octo",
+		"repo":       "api",
+		"stargazers": float64(2),
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response RelatedRepositories
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, RelatedRepositories{
+		Repository:        "octo/api",
+		StargazersSampled: 2,
+		Related: []RelatedRepository{
+			{FullName: "acme/web", Stars: 10, SharedStargazers: 2},
+			{FullName: "acme/cli", Stars: 900, SharedStargazers: 1},
+		},
+	}, response)
+}
@@ -0,0 +1,254 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	ReleaseCheckPass    = "pass"
+	ReleaseCheckFail    = "fail"
+	ReleaseCheckPending = "pending"
+
+	// DefaultReleaseBlockerLabel is the label of the issues that must be closed before a release.
+	DefaultReleaseBlockerLabel = "release-blocker"
+)
+
+// ReleaseCheck is an item of a release checklist.
+type ReleaseCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Items are the issues, pull requests or checks that fail the item.
+	Items []string `json:"items"`
+}
+
+// ReleaseReadiness is the checklist of the changes on the default branch since the last release.
+type ReleaseReadiness struct {
+	Base      string         `json:"base"`
+	Head      string         `json:"head"`
+	Commits   int            `json:"commits"`
+	Ready     bool           `json:"ready"`
+	Checklist []ReleaseCheck `json:"checklist"`
+}
+
+// GetReleaseReadiness creates a tool to check whether the default branch of a repository is ready to be released.
+func GetReleaseReadiness(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_release_readiness",
+			mcp.WithDescription(t("TOOL_GET_RELEASE_READINESS_DESCRIPTION", "Check whether the default branch of a repository is ready to be released, comparing it to the last release tag. Returns a checklist: no open release blocker issues, every pull request merged since the release approved by a reviewer, the changelog updated, and the checks of the default branch passing.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RELEASE_READINESS_USER_TITLE", "Get release readiness"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ReleaseReadiness](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base_tag",
+				mcp.Description("Tag of the last release. Defaults to the tag of the latest release"),
+			),
+			mcp.WithString("blocker_label",
+				mcp.Description("Label of the issues blocking the release"),
+				mcp.DefaultString(DefaultReleaseBlockerLabel),
+			),
+			mcp.WithString("changelog_path",
+				mcp.Description("Path of the changelog"),
+				mcp.DefaultString(DefaultChangelogPath),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			baseTag, err := OptionalParam[string](request, "base_tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			blockerLabel, err := OptionalParam[string](request, "blocker_label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			changelogPath, err := OptionalParam[string](request, "changelog_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if blockerLabel == "" {
+				blockerLabel = DefaultReleaseBlockerLabel
+			}
+			if changelogPath == "" {
+				changelogPath = DefaultChangelogPath
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			head := repository.GetDefaultBranch()
+
+			if baseTag == "" {
+				release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("%s/%s has no release, pass the tag to compare to as base_tag", owner, repo)), nil
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get latest release",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				baseTag = release.GetTagName()
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, baseTag, head, &github.ListOptions{PerPage: 100})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s to %s", baseTag, head),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			releasedAt := comparison.GetBaseCommit().GetCommit().GetCommitter().GetDate()
+
+			// Release blockers
+			blockers := ReleaseCheck{Name: "no open release blockers", Items: []string{}}
+			issueOpts := &github.IssueListByRepoOptions{State: "open", Labels: []string{blockerLabel}, ListOptions: github.ListOptions{PerPage: 100}}
+			for {
+				issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, issueOpts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list release blocker issues",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				for _, issue := range issues {
+					blockers.Items = append(blockers.Items, fmt.Sprintf("#%d %s", issue.GetNumber(), issue.GetTitle()))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				issueOpts.ListOptions.Page = resp.NextPage
+			}
+
+			// Pull requests merged since the release, listed from the most recently updated until those last updated
+			// before the release.
+			unreviewed := ReleaseCheck{Name: "pull requests approved", Items: []string{}}
+			prOpts := &github.PullRequestListOptions{State: "closed", Base: head, Sort: "updated", Direction: "desc", ListOptions: github.ListOptions{PerPage: 100}}
+		pages:
+			for {
+				prs, resp, err := client.PullRequests.List(ctx, owner, repo, prOpts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list merged pull requests",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				for _, pr := range prs {
+					if pr.GetUpdatedAt().Before(releasedAt.Time) {
+						break pages
+					}
+					if pr.MergedAt == nil || !pr.GetMergedAt().After(releasedAt.Time) {
+						continue
+					}
+					states, resp, err := latestReviewStates(ctx, client, owner, repo, pr.GetNumber())
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to list reviews of pull request #%d", pr.GetNumber()),
+							resp,
+							err,
+						), nil
+					}
+					approved := false
+					for _, state := range states {
+						approved = approved || state == "approved"
+					}
+					if !approved {
+						unreviewed.Items = append(unreviewed.Items, fmt.Sprintf("#%d %s", pr.GetNumber(), pr.GetTitle()))
+					}
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				prOpts.Page = resp.NextPage
+			}
+
+			// Changelog
+			changelog := ReleaseCheck{Name: "changelog updated", Items: []string{}}
+			changelogUpdated := false
+			for _, file := range comparison.Files {
+				changelogUpdated = changelogUpdated || strings.EqualFold(file.GetFilename(), changelogPath)
+			}
+			if !changelogUpdated && comparison.GetTotalCommits() > 0 {
+				changelog.Items = append(changelog.Items, changelogPath)
+			}
+
+			// Checks of the default branch
+			checks := ReleaseCheck{Name: "checks passing", Items: []string{}}
+			summary := summarizeRefChecks(ctx, client, owner, repo, head)
+			for _, check := range summary.FailingChecks {
+				checks.Items = append(checks.Items, fmt.Sprintf("%s: %s", check.Name, check.State))
+			}
+			checks.Status = ReleaseCheckPass
+			switch summary.State {
+			case CheckStatusFailure, CheckStatusUnknown:
+				checks.Status = ReleaseCheckFail
+			case CheckStatusPending:
+				checks.Status = ReleaseCheckPending
+				for _, check := range summary.PendingChecks {
+					checks.Items = append(checks.Items, fmt.Sprintf("%s: %s", check.Name, check.State))
+				}
+			}
+
+			result := ReleaseReadiness{
+				Base:    baseTag,
+				Head:    head,
+				Commits: comparison.GetTotalCommits(),
+				Ready:   true,
+			}
+			for _, check := range []ReleaseCheck{blockers, unreviewed, changelog, checks} {
+				if check.Status == "" {
+					check.Status = ReleaseCheckPass
+					if len(check.Items) > 0 {
+						check.Status = ReleaseCheckFail
+					}
+				}
+				result.Ready = result.Ready && check.Status == ReleaseCheckPass
+				result.Checklist = append(result.Checklist, check)
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetReleaseReadiness(t *testing.T) {
+	tool, _ := GetReleaseReadiness(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	released := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int) *github.Timestamp {
+		return &github.Timestamp{Time: released.AddDate(0, 0, days)}
+	}
+	comparison := func(files ...string) *github.CommitsComparison {
+		c := &github.CommitsComparison{
+			TotalCommits: github.Ptr(3),
+			BaseCommit: &github.RepositoryCommit{Commit: &github.Commit{
+				Committer: &github.CommitAuthor{Date: &github.Timestamp{Time: released}},
+			}},
+		}
+		for _, f := range files {
+			c.Files = append(c.Files, &github.CommitFile{Filename: github.Ptr(f)})
+		}
+		return c
+	}
+	pulls := []*github.PullRequest{
+		{Number: github.Ptr(12), Title: github.Ptr("Add export"), UpdatedAt: at(5), MergedAt: at(5)},
+		{Number: github.Ptr(11), Title: github.Ptr("Fix typo"), UpdatedAt: at(4), MergedAt: at(4)},
+		{Number: github.Ptr(10), Title: github.Ptr("Abandoned"), UpdatedAt: at(3)},
+		{Number: github.Ptr(9), Title: github.Ptr("Released"), UpdatedAt: at(-1), MergedAt: at(-2)},
+	}
+	reviewsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/pulls/12/") {
+			mockResponse(t, http.StatusOK, []*github.PullRequestReview{
+				{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("APPROVED")},
+			})(w, r)
+			return
+		}
+		if strings.Contains(r.URL.Path, "/pulls/9/") {
+			t.Errorf("pull request #9 merged before the release should not be reviewed")
+		}
+		mockResponse(t, http.StatusOK, []*github.PullRequestReview{
+			{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("COMMENTED")},
+		})(w, r)
+	})
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]any
+		expected     ReleaseReadiness
+	}{
+		{
+			name: "not ready",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{DefaultBranch: github.Ptr("main")}),
+				mock.WithRequestMatch(mock.GetReposReleasesLatestByOwnerByRepo, &github.RepositoryRelease{TagName: github.Ptr("v1.2.0")}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/octo/app/compare/v1.2.0...main", r.URL.Path)
+						mockResponse(t, http.StatusOK, comparison("main.go"))(w, r)
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"state": "open", "labels": "release-blocker", "per_page": "100"}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Issue{{Number: github.Ptr(7), Title: github.Ptr("Data loss on upgrade")}}),
+					),
+				),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, pulls),
+				mock.WithRequestMatchHandler(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, reviewsHandler),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, &github.CombinedStatus{}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+					{Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+				}}),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "app"},
+			expected: ReleaseReadiness{
+				Base:    "v1.2.0",
+				Head:    "main",
+				Commits: 3,
+				Checklist: []ReleaseCheck{
+					{Name: "no open release blockers", Status: ReleaseCheckFail, Items: []string{"#7 Data loss on upgrade"}},
+					{Name: "pull requests approved", Status: ReleaseCheckFail, Items: []string{"#11 Fix typo"}},
+					{Name: "changelog updated", Status: ReleaseCheckFail, Items: []string{"CHANGELOG.md"}},
+					{Name: "checks passing", Status: ReleaseCheckFail, Items: []string{"test: failure"}},
+				},
+			},
+		},
+		{
+			name: "ready",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{DefaultBranch: github.Ptr("main")}),
+				mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, comparison("main.go", "docs/CHANGES.md")),
+				mock.WithRequestMatch(mock.GetReposIssuesByOwnerByRepo, []*github.Issue{}),
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepo, pulls[:1]),
+				mock.WithRequestMatchHandler(mock.GetReposPullsReviewsByOwnerByRepoByPullNumber, reviewsHandler),
+				mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, &github.CombinedStatus{}),
+				mock.WithRequestMatch(mock.GetReposCommitsCheckRunsByOwnerByRepoByRef, &github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+					{Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+				}}),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "app", "base_tag": "v1.1.0", "changelog_path": "docs/CHANGES.md"},
+			expected: ReleaseReadiness{
+				Base:    "v1.1.0",
+				Head:    "main",
+				Commits: 3,
+				Ready:   true,
+				Checklist: []ReleaseCheck{
+					{Name: "no open release blockers", Status: ReleaseCheckPass, Items: []string{}},
+					{Name: "pull requests approved", Status: ReleaseCheckPass, Items: []string{}},
+					{Name: "changelog updated", Status: ReleaseCheckPass, Items: []string{}},
+					{Name: "checks passing", Status: ReleaseCheckPass, Items: []string{}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetReleaseReadiness(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response ReleaseReadiness
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+
+	t.Run("no release", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(mock.GetReposByOwnerByRepo, &github.Repository{DefaultBranch: github.Ptr("main")}),
+			mock.WithRequestMatchHandler(
+				mock.GetReposReleasesLatestByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			),
+		))
+		_, handler := GetReleaseReadiness(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"owner": "octo", "repo": "app"}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "octo/app has no release, pass the tag to compare to as base_tag")
+	})
+}
@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/raw"
@@ -25,6 +28,7 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				Title:        t("TOOL_GET_COMMITS_USER_TITLE", "Get commit details"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[MinimalCommit](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -95,12 +99,7 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			// Convert to minimal commit
 			minimalCommit := convertToMinimalCommit(commit, includeDiff)
 
-			r, err := json.Marshal(minimalCommit)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(minimalCommit), nil
 		}
 }
 
@@ -112,6 +111,7 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				Title:        t("TOOL_LIST_COMMITS_USER_TITLE", "List commits"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[MinimalCommit](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -191,12 +191,7 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				minimalCommits[i] = convertToMinimalCommit(commit, false)
 			}
 
-			r, err := json.Marshal(minimalCommits)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(minimalCommits), nil
 		}
 }
 
@@ -208,6 +203,7 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				Title:        t("TOOL_LIST_BRANCHES_USER_TITLE", "List branches"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[MinimalBranch](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -216,6 +212,9 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only list protected branches if true, or unprotected branches if false"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -227,6 +226,10 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			protected, hasProtected, err := OptionalParamOK[bool](request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -238,6 +241,9 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 					PerPage: pagination.PerPage,
 				},
 			}
+			if hasProtected {
+				opts.Protected = &protected
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -268,12 +274,7 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				minimalBranches = append(minimalBranches, convertToMinimalBranch(branch))
 			}
 
-			r, err := json.Marshal(minimalBranches)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(minimalBranches), nil
 		}
 }
 
@@ -481,6 +482,23 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
+// DefaultMaxFileContentBytes is the default size above which get_file_contents truncates text files and refuses binary
+// files.
+const DefaultMaxFileContentBytes = 1 << 20
+
+// truncateFileContent truncates text to at most maxBytes without splitting a UTF-8 character, and appends a marker
+// telling how much of the file was kept.
+func truncateFileContent(body []byte, maxBytes int) (string, bool) {
+	if len(body) <= maxBytes {
+		return string(body), false
+	}
+	end := maxBytes
+	for end > 0 && !utf8.RuneStart(body[end]) {
+		end--
+	}
+	return fmt.Sprintf("%s\n\n[truncated: showing the first %d of %d bytes]", body[:end], end, len(body)), true
+}
+
 // GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
 func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_file_contents",
@@ -507,6 +525,11 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 			mcp.WithString("sha",
 				mcp.Description("Accepts optional commit SHA. If specified, it will be used instead of ref"),
 			),
+			mcp.WithNumber("max_bytes",
+				mcp.Description("Maximum size of a file to return. Larger text files are truncated, with a marker at the end, and larger binary files are not returned"),
+				mcp.DefaultNumber(DefaultMaxFileContentBytes),
+				mcp.Min(1),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -529,6 +552,13 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			maxBytes, err := OptionalIntParamWithDefault(request, "max_bytes", DefaultMaxFileContentBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxBytes < 1 {
+				return mcp.NewToolResultError("max_bytes must be at least 1"), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -611,16 +641,25 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 						strings.HasSuffix(contentType, "+xml")
 
 					if isTextContent {
+						text, truncated := truncateFileContent(body, maxBytes)
 						result := mcp.TextResourceContents{
 							URI:      resourceURI,
-							Text:     string(body),
+							Text:     text,
 							MIMEType: contentType,
 						}
+						message := "successfully downloaded text file"
+						if truncated {
+							message = fmt.Sprintf("downloaded text file truncated to %d of %d bytes", maxBytes, len(body))
+						}
 						// Include SHA in the result metadata
 						if fileSHA != "" {
-							return mcp.NewToolResultResource(fmt.Sprintf("successfully downloaded text file (SHA: %s)", fileSHA), result), nil
+							return mcp.NewToolResultResource(fmt.Sprintf("%s (SHA: %s)", message, fileSHA), result), nil
 						}
-						return mcp.NewToolResultResource("successfully downloaded text file", result), nil
+						return mcp.NewToolResultResource(message, result), nil
+					}
+
+					if len(body) > maxBytes {
+						return mcp.NewToolResultError(fmt.Sprintf("binary file of %d bytes is larger than max_bytes %d, use get_file_metadata to get its download URL", len(body), maxBytes)), nil
 					}
 
 					result := mcp.BlobResourceContents{
@@ -686,8 +725,109 @@ func GetFileContents(getClient GetClientFn, getRawClient raw.GetRawClientFn, t t
 		}
 }
 
+// FileMetadata describes a file or directory in a repository without its content.
+type FileMetadata struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Type        string `json:"type"`
+	SHA         string `json:"sha,omitempty"`
+	Size        int    `json:"size"`
+	Encoding    string `json:"encoding,omitempty"`
+	MIMEType    string `json:"mime_type,omitempty"`
+	DownloadURL string `json:"download_url,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+	Entries     *int   `json:"entries,omitempty"`
+}
+
+// GetFileMetadata creates a tool to get the metadata of a file or directory without downloading its content.
+func GetFileMetadata(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_metadata",
+			mcp.WithDescription(t("TOOL_GET_FILE_METADATA_DESCRIPTION", "Get the size, type, encoding and raw download URL of a file or directory in a GitHub repository without fetching its content. Use this to decide whether a large or binary file should be downloaded.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FILE_METADATA_USER_TITLE", "Get file metadata"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[FileMetadata](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file or directory"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or a commit SHA. Defaults to the repository's default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := RequiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, strings.TrimSuffix(path, "/"), opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get file metadata",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var metadata FileMetadata
+			if fileContent != nil {
+				metadata = FileMetadata{
+					Name:        fileContent.GetName(),
+					Path:        fileContent.GetPath(),
+					Type:        fileContent.GetType(),
+					SHA:         fileContent.GetSHA(),
+					Size:        fileContent.GetSize(),
+					Encoding:    fileContent.GetEncoding(),
+					MIMEType:    mime.TypeByExtension(filepath.Ext(fileContent.GetName())),
+					DownloadURL: fileContent.GetDownloadURL(),
+					HTMLURL:     fileContent.GetHTMLURL(),
+				}
+			} else {
+				trimmed := strings.Trim(path, "/")
+				metadata = FileMetadata{
+					Name:    trimmed[strings.LastIndex(trimmed, "/")+1:],
+					Path:    trimmed,
+					Type:    "dir",
+					Entries: github.Ptr(len(dirContent)),
+				}
+			}
+
+			return MarshalledStructuredResult(metadata), nil
+		}
+}
+
 // ForkRepository creates a tool to fork a repository.
 func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	forkInProgressMessage := t("RESULT_FORK_IN_PROGRESS", "Fork is in progress")
 	return mcp.NewTool("fork_repository",
 			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -734,7 +874,7 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
 				// and it's not a real error.
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Fork is in progress"), nil
+					return mcp.NewToolResultText(forkInProgressMessage), nil
 				}
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to fork repository",
@@ -801,6 +941,9 @@ func DeleteFile(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Required(),
 				mcp.Description("Branch to delete the file from"),
 			),
+			mcp.WithString("sha",
+				mcp.Description("Blob SHA of the file to delete. If specified, the file is only deleted if it has not changed on the branch"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -823,6 +966,10 @@ func DeleteFile(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -836,6 +983,21 @@ func DeleteFile(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			if sha != "" {
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: *ref.Object.SHA})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get file to delete",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				if file.GetSHA() != sha {
+					return mcp.NewToolResultError(fmt.Sprintf("file %s has changed on branch %s: its SHA is %s, not %s", path, branch, file.GetSHA(), sha)), nil
+				}
+			}
+
 			// Get the commit object that the branch points to
 			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
 			if err != nil {
@@ -1047,6 +1209,116 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
+// GetBranch creates a tool to get a branch of a GitHub repository.
+func GetBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_DESCRIPTION", "Get a branch of a GitHub repository: the SHA of its head commit and whether it is protected")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_BRANCH_USER_TITLE", "Get branch"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[MinimalBranch](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			b, resp, err := client.Repositories.GetBranch(ctx, owner, repo, branch, 1)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get branch '%s'", branch),
+					resp,
+					err,
+				), nil
+			}
+
+			return MarshalledStructuredResult(convertToMinimalBranch(b)), nil
+		}
+}
+
+// DeleteBranch creates a tool to delete a branch of a GitHub repository.
+func DeleteBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	branchDeletedMessage := t("RESULT_BRANCH_DELETED", "branch '%s' deleted")
+	return mcp.NewTool("delete_branch",
+			mcp.WithDescription(t("TOOL_DELETE_BRANCH_DESCRIPTION", "Delete a branch of a GitHub repository, e.g. the head branch of a merged pull request. The default branch and protected branches cannot be deleted")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_BRANCH_USER_TITLE", "Delete branch"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the branch to delete"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete branch '%s'", branch),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf(branchDeletedMessage, branch)), nil
+		}
+}
+
 // PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
 func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("push_files",
@@ -1091,6 +1363,13 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				mcp.Required(),
 				mcp.Description("Commit message"),
 			),
+			mcp.WithBoolean("create_branch",
+				mcp.Description("Create the branch if it does not exist"),
+				mcp.DefaultBool(false),
+			),
+			mcp.WithString("from_branch",
+				mcp.Description("Branch to create the branch from when it does not exist. Defaults to the default branch of the repository"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -1109,6 +1388,14 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			createBranch, err := OptionalBoolParamWithDefault(request, "create_branch", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fromBranch, err := OptionalParam[string](request, "from_branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			// Parse files parameter - this should be an array of objects with path and content
 			filesObj, ok := request.GetArguments()["files"].([]interface{})
@@ -1123,12 +1410,38 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 
 			// Get the reference for the branch
 			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			branchExists := true
 			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to get branch reference",
-					resp,
-					err,
-				), nil
+				if !createBranch || resp == nil || resp.StatusCode != http.StatusNotFound {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get branch reference",
+						resp,
+						err,
+					), nil
+				}
+				branchExists = false
+
+				// Commit on top of the branch to create the branch from instead
+				if fromBranch == "" {
+					repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							"failed to get repository",
+							resp,
+							err,
+						), nil
+					}
+					_ = resp.Body.Close()
+					fromBranch = repository.GetDefaultBranch()
+				}
+				ref, resp, err = client.Git.GetRef(ctx, owner, repo, "refs/heads/"+fromBranch)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get reference of branch %s to create the branch from", fromBranch),
+						resp,
+						err,
+					), nil
+				}
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -1198,6 +1511,28 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			if !branchExists {
+				createdRef, resp, err := client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+					Ref: "refs/heads/" + branch,
+					SHA: *newCommit.SHA,
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to create branch",
+						resp,
+						err,
+					), nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				r, err := json.Marshal(createdRef)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
 			// Update the reference to point to the new commit
 			ref.Object.SHA = newCommit.SHA
 			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, *ref.Ref, github.UpdateRef{
@@ -1230,6 +1565,7 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				Title:        t("TOOL_LIST_TAGS_USER_TITLE", "List tags"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.RepositoryTag](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1282,12 +1618,7 @@ func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list tags: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(tags)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(tags), nil
 		}
 }
 
@@ -1299,6 +1630,7 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 				Title:        t("TOOL_GET_TAG_USER_TITLE", "Get tag details"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.Tag](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1369,12 +1701,7 @@ func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool m
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get tag object: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(tagObj)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(tagObj), nil
 		}
 }
 
@@ -1386,6 +1713,7 @@ func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				Title:        t("TOOL_LIST_RELEASES_USER_TITLE", "List releases"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.RepositoryRelease](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1434,12 +1762,7 @@ func ListReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list releases: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(releases)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(releases), nil
 		}
 }
 
@@ -1451,6 +1774,7 @@ func GetLatestRelease(getClient GetClientFn, t translations.TranslationHelperFun
 				Title:        t("TOOL_GET_LATEST_RELEASE_USER_TITLE", "Get latest release"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.RepositoryRelease](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1489,12 +1813,7 @@ func GetLatestRelease(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get latest release: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(release)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(release), nil
 		}
 }
 
@@ -1505,6 +1824,7 @@ func GetReleaseByTag(getClient GetClientFn, t translations.TranslationHelperFunc
 				Title:        t("TOOL_GET_RELEASE_BY_TAG_USER_TITLE", "Get a release by tag name"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.RepositoryRelease](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -1555,12 +1875,7 @@ func GetReleaseByTag(getClient GetClientFn, t translations.TranslationHelperFunc
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get release by tag: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(release)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(release), nil
 		}
 }
 
@@ -1707,6 +2022,7 @@ func ListStarredRepositories(getClient GetClientFn, t translations.TranslationHe
 				Title:        t("TOOL_LIST_STARRED_REPOSITORIES_USER_TITLE", "List starred repositories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[MinimalRepository](),
 			mcp.WithString("username",
 				mcp.Description("Username to list starred repositories for. Defaults to the authenticated user."),
 			),
@@ -1810,17 +2126,13 @@ func ListStarredRepositories(getClient GetClientFn, t translations.TranslationHe
 				minimalRepos = append(minimalRepos, minimalRepo)
 			}
 
-			r, err := json.Marshal(minimalRepos)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal starred repositories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(minimalRepos), nil
 		}
 }
 
 // StarRepository creates a tool to star a repository.
 func StarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	repositoryStarredMessage := t("RESULT_REPOSITORY_STARRED", "Successfully starred repository %s/%s")
 	return mcp.NewTool("star_repository",
 			mcp.WithDescription(t("TOOL_STAR_REPOSITORY_DESCRIPTION", "Star a GitHub repository")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -1869,12 +2181,13 @@ func StarRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to star repository: %s", string(body))), nil
 			}
 
-			return mcp.NewToolResultText(fmt.Sprintf("Successfully starred repository %s/%s", owner, repo)), nil
+			return mcp.NewToolResultText(fmt.Sprintf(repositoryStarredMessage, owner, repo)), nil
 		}
 }
 
 // UnstarRepository creates a tool to unstar a repository.
 func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	repositoryUnstarredMessage := t("RESULT_REPOSITORY_UNSTARRED", "Successfully unstarred repository %s/%s")
 	return mcp.NewTool("unstar_repository",
 			mcp.WithDescription(t("TOOL_UNSTAR_REPOSITORY_DESCRIPTION", "Unstar a GitHub repository")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -1923,6 +2236,6 @@ func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to unstar repository: %s", string(body))), nil
 			}
 
-			return mcp.NewToolResultText(fmt.Sprintf("Successfully unstarred repository %s/%s", owner, repo)), nil
+			return mcp.NewToolResultText(fmt.Sprintf(repositoryUnstarredMessage, owner, repo)), nil
 		}
 }
@@ -202,6 +202,84 @@ func Test_GetFileContents(t *testing.T) {
 				MIMEType: "application/pdf",
 			},
 		},
+		{
+			name: "text content truncated to max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": ""}}`))
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusOK, &github.RepositoryContent{
+						Name: github.Ptr("README.md"),
+						Path: github.Ptr("README.md"),
+						SHA:  github.Ptr("abc123"),
+						Type: github.Ptr("file"),
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					raw.GetRawReposContentsByOwnerByRepoByBranchByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Content-Type", "text/markdown")
+						_, _ = w.Write(mockRawContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "README.md",
+				"ref":       "refs/heads/main",
+				"max_bytes": float64(17),
+			},
+			expectError: false,
+			expectedResult: mcp.TextResourceContents{
+				URI:      "repo://owner/repo/refs/heads/main/contents/README.md",
+				Text:     "# Test Repository\n\n[truncated: showing the first 17 of 45 bytes]",
+				MIMEType: "text/markdown",
+			},
+		},
+		{
+			name: "binary content larger than max_bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": ""}}`))
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusOK, &github.RepositoryContent{
+						Name: github.Ptr("test.png"),
+						Path: github.Ptr("test.png"),
+						SHA:  github.Ptr("def456"),
+						Type: github.Ptr("file"),
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					raw.GetRawReposContentsByOwnerByRepoByBranchByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("Content-Type", "image/png")
+						_, _ = w.Write(mockRawContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "test.png",
+				"ref":       "refs/heads/main",
+				"max_bytes": float64(10),
+			},
+			expectError:    false,
+			expectedResult: mcp.NewTextContent("binary file of 45 bytes is larger than max_bytes 10, use get_file_metadata to get its download URL"),
+		},
 		{
 			name: "successful directory content fetch",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -965,6 +1043,8 @@ func Test_ListCommits(t *testing.T) {
 			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
 			require.NoError(t, err)
 			assert.Len(t, returnedCommits, len(tc.expectedCommits))
+			require.IsType(t, ListResult[MinimalCommit]{}, result.StructuredContent)
+			assert.Equal(t, returnedCommits, result.StructuredContent.(ListResult[MinimalCommit]).Items)
 			for i, commit := range returnedCommits {
 				assert.Equal(t, tc.expectedCommits[i].GetSHA(), commit.SHA)
 				assert.Equal(t, tc.expectedCommits[i].GetHTMLURL(), commit.HTMLURL)
@@ -1617,6 +1697,68 @@ func Test_PushFiles(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create tree",
 		},
+		{
+			name: "creates missing branch from the default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if strings.HasSuffix(r.URL.Path, "/heads/feature") {
+							w.WriteHeader(http.StatusNotFound)
+							_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+							return
+						}
+						mockResponse(t, http.StatusOK, mockRef)(w, r)
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{DefaultBranch: github.Ptr("main")},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/heads/feature",
+						"sha": "jkl012",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{
+							Ref:    github.Ptr("refs/heads/feature"),
+							Object: &github.GitObject{SHA: github.Ptr("jkl012")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# Updated README",
+					},
+				},
+				"message":       "Update README",
+				"create_branch": true,
+			},
+			expectError: false,
+			expectedRef: &github.Reference{
+				Ref:    github.Ptr("refs/heads/feature"),
+				Object: &github.GitObject{SHA: github.Ptr("jkl012")},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1714,6 +1856,27 @@ func Test_ListBranches(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "only protected branches",
+			args: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"protected": true,
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"protected": "true",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches),
+					),
+				),
+			},
+			wantErr: false,
+		},
 		{
 			name: "missing owner",
 			args: map[string]interface{}{
@@ -1776,6 +1939,104 @@ func Test_ListBranches(t *testing.T) {
 	}
 }
 
+func Test_GetBranch(t *testing.T) {
+	tool, _ := GetBranch(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	t.Run("protected branch", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposBranchesByOwnerByRepoByBranch,
+				expectPath(t, "/repos/owner/repo/branches/release").andThen(
+					mockResponse(t, http.StatusOK, &github.Branch{
+						Name:      github.Ptr("release"),
+						Commit:    &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+						Protected: github.Ptr(true),
+					}),
+				),
+			),
+		))
+		_, handler := GetBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "release",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var response MinimalBranch
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		assert.Equal(t, MinimalBranch{Name: "release", SHA: "abc123", Protected: true}, response)
+	})
+
+	t.Run("branch not found", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposBranchesByOwnerByRepoByBranch,
+				mockResponse(t, http.StatusNotFound, `{"message": "Branch not found"}`),
+			),
+		))
+		_, handler := GetBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "gone",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to get branch 'gone'")
+	})
+}
+
+func Test_DeleteBranch(t *testing.T) {
+	tool, _ := DeleteBranch(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	t.Run("deleted", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposGitRefsByOwnerByRepoByRef,
+				expectPath(t, "/repos/owner/repo/git/refs/heads/feature/x").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+		))
+		_, handler := DeleteBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "feature/x",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.Equal(t, "branch 'feature/x' deleted", getTextResult(t, result).Text)
+	})
+
+	t.Run("protected branch", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.DeleteReposGitRefsByOwnerByRepoByRef,
+				mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Cannot delete this protected branch"}`),
+			),
+		))
+		_, handler := DeleteBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "main",
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "failed to delete branch 'main'")
+	})
+}
+
 func Test_DeleteFile(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -1822,6 +2083,7 @@ func Test_DeleteFile(t *testing.T) {
 		mockedClient      *http.Client
 		requestArgs       map[string]interface{}
 		expectError       bool
+		expectResultError bool
 		expectedCommitSHA string
 		expectedErrMsg    string
 	}{
@@ -1913,6 +2175,35 @@ func Test_DeleteFile(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to get branch reference",
 		},
+		{
+			name: "file deletion refused - file changed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{"ref": "abc123"}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type: github.Ptr("file"),
+							Path: github.Ptr("docs/example.md"),
+							SHA:  github.Ptr("newer456"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"message": "Delete example file",
+				"branch":  "main",
+				"sha":     "older123",
+			},
+			expectResultError: true,
+			expectedErrMsg:    "file docs/example.md has changed on branch main: its SHA is newer456, not older123",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1936,6 +2227,12 @@ func Test_DeleteFile(t *testing.T) {
 
 			require.NoError(t, err)
 
+			if tc.expectResultError {
+				errorContent := getErrorResult(t, result)
+				assert.Equal(t, tc.expectedErrMsg, errorContent.Text)
+				return
+			}
+
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
@@ -3412,3 +3709,306 @@ func Test_GetRepositoryTree(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRepoTree(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepoTree(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repo_tree", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "include")
+	assert.Contains(t, tool.InputSchema.Properties, "exclude")
+	assert.Contains(t, tool.InputSchema.Properties, "max_entries")
+	assert.Contains(t, tool.InputSchema.Properties, "include_sizes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+	mockTree := &github.Tree{
+		SHA:       github.Ptr("abc123"),
+		Truncated: github.Ptr(false),
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob"), SHA: github.Ptr("sha1"), Size: github.Ptr(123)},
+			{Path: github.Ptr("src"), Type: github.Ptr("tree"), SHA: github.Ptr("sha2")},
+			{Path: github.Ptr("src/main.go"), Type: github.Ptr("blob"), SHA: github.Ptr("sha3"), Size: github.Ptr(456)},
+			{Path: github.Ptr("src/main_test.go"), Type: github.Ptr("blob"), SHA: github.Ptr("sha4"), Size: github.Ptr(789)},
+			{Path: github.Ptr("vendor/lib/lib.go"), Type: github.Ptr("blob"), SHA: github.Ptr("sha5"), Size: github.Ptr(1000)},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedResponse RepoTreeResponse
+	}{
+		{
+			name: "returns all entries of the default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/git/trees/main",
+						queryParams: map[string]string{"recursive": "1"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTree),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResponse: RepoTreeResponse{
+				SHA:          "abc123",
+				Ref:          "main",
+				TotalEntries: 5,
+				Entries: []RepoTreeEntry{
+					{Path: "README.md", Type: "blob"},
+					{Path: "src", Type: "tree"},
+					{Path: "src/main.go", Type: "blob"},
+					{Path: "src/main_test.go", Type: "blob"},
+					{Path: "vendor/lib/lib.go", Type: "blob"},
+				},
+			},
+		},
+		{
+			name: "filters entries with globs and includes sizes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockTree,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"ref":           "v1.0.0",
+				"include":       []interface{}{"*.go"},
+				"exclude":       []interface{}{"vendor/**", "*_test.go"},
+				"include_sizes": true,
+			},
+			expectedResponse: RepoTreeResponse{
+				SHA:          "abc123",
+				Ref:          "v1.0.0",
+				TotalEntries: 1,
+				Entries: []RepoTreeEntry{
+					{Path: "src/main.go", Type: "blob", Size: github.Ptr(456)},
+				},
+			},
+		},
+		{
+			name: "caps the number of entries",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockTree,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"ref":         "main",
+				"max_entries": float64(2),
+			},
+			expectedResponse: RepoTreeResponse{
+				SHA:          "abc123",
+				Ref:          "main",
+				TotalEntries: 5,
+				Truncated:    true,
+				Entries: []RepoTreeEntry{
+					{Path: "README.md", Type: "blob"},
+					{Path: "src", Type: "tree"},
+				},
+			},
+		},
+		{
+			name: "tree not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository tree",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetRepoTree(stubGetClientFromHTTPFn(tc.mockedClient), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response RepoTreeResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedResponse, response)
+		})
+	}
+}
+
+func Test_GetFileMetadata(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetFileMetadata(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_file_metadata", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedMetadata FileMetadata
+	}{
+		{
+			name: "returns metadata of a large binary file without content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expect(t, expectations{
+						path:        "/repos/owner/repo/contents/assets/logo.png",
+						queryParams: map[string]string{"ref": "main"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type:        github.Ptr("file"),
+							Name:        github.Ptr("logo.png"),
+							Path:        github.Ptr("assets/logo.png"),
+							SHA:         github.Ptr("abc123"),
+							Size:        github.Ptr(2097152),
+							Encoding:    github.Ptr("none"),
+							Content:     github.Ptr(""),
+							DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/assets/logo.png"),
+							HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/assets/logo.png"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "assets/logo.png",
+				"ref":   "main",
+			},
+			expectedMetadata: FileMetadata{
+				Name:        "logo.png",
+				Path:        "assets/logo.png",
+				Type:        "file",
+				SHA:         "abc123",
+				Size:        2097152,
+				Encoding:    "none",
+				MIMEType:    "image/png",
+				DownloadURL: "https://raw.githubusercontent.com/owner/repo/main/assets/logo.png",
+				HTMLURL:     "https://github.com/owner/repo/blob/main/assets/logo.png",
+			},
+		},
+		{
+			name: "returns entry count for directories",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					[]*github.RepositoryContent{
+						{Type: github.Ptr("file"), Name: github.Ptr("a.go"), Path: github.Ptr("src/a.go"), Size: github.Ptr(10)},
+						{Type: github.Ptr("dir"), Name: github.Ptr("internal"), Path: github.Ptr("src/internal")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/",
+			},
+			expectedMetadata: FileMetadata{
+				Name:    "src",
+				Path:    "src",
+				Type:    "dir",
+				Entries: github.Ptr(2),
+			},
+		},
+		{
+			name: "file not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "missing.txt",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get file metadata",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetFileMetadata(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var metadata FileMetadata
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &metadata))
+			assert.Equal(t, tc.expectedMetadata, metadata)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RepositoryRole is a custom repository role of an organization.
+type RepositoryRole struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	BaseRole    string   `json:"base_role"`
+	Permissions []string `json:"permissions"`
+}
+
+// RepositoryRolesResult lists the custom repository roles of an organization.
+type RepositoryRolesResult struct {
+	Org   string           `json:"org"`
+	Roles []RepositoryRole `json:"roles"`
+}
+
+// RepositoryRoleAssignment is a role given to a team or user on a repository.
+type RepositoryRoleAssignment struct {
+	Repository string `json:"repository"`
+	Role       string `json:"role"`
+	Team       string `json:"team,omitempty"`
+	User       string `json:"user,omitempty"`
+	// Invited is true when the user was not a collaborator and was invited with the role.
+	Invited bool `json:"invited,omitempty"`
+}
+
+// ListRepositoryRoles creates a tool to list the custom repository roles of an organization.
+func ListRepositoryRoles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_roles",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_ROLES_DESCRIPTION", "List the custom repository roles of an organization, with the base role they extend and the permissions they add. Besides these, the built-in roles read, triage, write, maintain and admin can always be assigned.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_ROLES_USER_TITLE", "List custom repository roles"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[RepositoryRolesResult](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			roles, resp, err := client.Organizations.ListCustomRepoRoles(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repository roles of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := RepositoryRolesResult{Org: org, Roles: []RepositoryRole{}}
+			for _, role := range roles.CustomRepoRoles {
+				result.Roles = append(result.Roles, RepositoryRole{
+					ID:          role.GetID(),
+					Name:        role.GetName(),
+					Description: role.GetDescription(),
+					BaseRole:    role.GetBaseRole(),
+					Permissions: role.Permissions,
+				})
+			}
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
+
+// AssignRepositoryRole creates a tool to give a team or user a role on a repository.
+func AssignRepositoryRole(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("assign_repository_role",
+			mcp.WithDescription(t("TOOL_ASSIGN_REPOSITORY_ROLE_DESCRIPTION", "Give a team or a user a built-in or custom role on a repository of an organization, replacing the role they had. A user who is not a collaborator of the repository is invited with the role.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ASSIGN_REPOSITORY_ROLE_USER_TITLE", "Assign repository role"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			WithOutputSchema[RepositoryRoleAssignment](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization owning the repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("role",
+				mcp.Required(),
+				mcp.Description("Role to give: 'pull', 'triage', 'push', 'maintain', 'admin' or the name of a custom repository role"),
+			),
+			mcp.WithString("team",
+				mcp.Description("Slug of the team to give the role to. Exactly one of team and username is required"),
+			),
+			mcp.WithString("username",
+				mcp.Description("Login of the user to give the role to. Exactly one of team and username is required"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := RequiredParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			team, err := OptionalParam[string](request, "team")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (team == "") == (username == "") {
+				return mcp.NewToolResultError("exactly one of team and username is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := RepositoryRoleAssignment{Repository: owner + "/" + repo, Role: role, Team: team, User: username}
+			if team != "" {
+				resp, err := client.Teams.AddTeamRepoBySlug(ctx, owner, team, owner, repo, &github.TeamAddTeamRepoOptions{Permission: role})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to give team '%s' the role '%s'", team, role),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				return MarshalledStructuredResult(result), nil
+			}
+
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{Permission: role})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to give user '%s' the role '%s'", username, role),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			result.Invited = invitation != nil
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryRoles(t *testing.T) {
+	tool, _ := ListRepositoryRoles(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(mock.GetOrgsCustomRepositoryRolesByOrg, &github.OrganizationCustomRepoRoles{
+			TotalCount: github.Ptr(1),
+			CustomRepoRoles: []*github.CustomRepoRoles{{
+				ID:          github.Ptr(int64(8)),
+				Name:        github.Ptr("security-reviewer"),
+				Description: github.Ptr("Triage and dismiss alerts"),
+				BaseRole:    github.Ptr("read"),
+				Permissions: []string{"view_secret_scanning_alerts", "resolve_secret_scanning_alerts"},
+			}},
+		}),
+	))
+	_, handler := ListRepositoryRoles(stubGetClientFn(client), translations.NullTranslationHelper)
+	result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo"}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+
+	var response RepositoryRolesResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, RepositoryRolesResult{
+		Org: "octo",
+		Roles: []RepositoryRole{{
+			ID:          8,
+			Name:        "security-reviewer",
+			Description: "Triage and dismiss alerts",
+			BaseRole:    "read",
+			Permissions: []string{"view_secret_scanning_alerts", "resolve_secret_scanning_alerts"},
+		}},
+	}, response)
+}
+
+func Test_AssignRepositoryRole(t *testing.T) {
+	tool, _ := AssignRepositoryRole(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "role"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       RepositoryRoleAssignment
+	}{
+		{
+			name: "team",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					expectPath(t, "/orgs/octo/teams/security/repos/octo/api").andThen(
+						expectRequestBody(t, map[string]any{"permission": "security-reviewer"}).andThen(
+							mockResponse(t, http.StatusNoContent, nil),
+						),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "api", "role": "security-reviewer", "team": "security"},
+			expected:    RepositoryRoleAssignment{Repository: "octo/api", Role: "security-reviewer", Team: "security"},
+		},
+		{
+			name: "user invited",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectPath(t, "/repos/octo/api/collaborators/alice").andThen(
+						expectRequestBody(t, map[string]any{"permission": "maintain"}).andThen(
+							mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{ID: github.Ptr(int64(1))}),
+						),
+					),
+				),
+			),
+			requestArgs: map[string]any{"owner": "octo", "repo": "api", "role": "maintain", "username": "alice"},
+			expected:    RepositoryRoleAssignment{Repository: "octo/api", Role: "maintain", User: "alice", Invited: true},
+		},
+		{
+			name: "unknown role",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+					}),
+				),
+			),
+			requestArgs:    map[string]any{"owner": "octo", "repo": "api", "role": "missing", "username": "alice"},
+			expectError:    true,
+			expectedErrMsg: "failed to give user 'alice' the role 'missing'",
+		},
+		{
+			name:           "team and username",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			requestArgs:    map[string]any{"owner": "octo", "repo": "api", "role": "push", "team": "security", "username": "alice"},
+			expectError:    true,
+			expectedErrMsg: "exactly one of team and username is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := AssignRepositoryRole(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response RepositoryRoleAssignment
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -2,9 +2,10 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -22,6 +23,7 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 				Title:        t("TOOL_SEARCH_REPOSITORIES_USER_TITLE", "Search repositories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[MinimalSearchRepositoriesResult](),
 			mcp.WithString("query",
 				mcp.Required(),
 				mcp.Description("Repository search query. Examples: 'machine learning in:name stars:>1000 language:python', 'topic:react', 'user:facebook'. Supports advanced search syntax for precise filtering."),
@@ -92,8 +94,8 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search repositories: %s", string(body))), nil
 			}
 
-			// Return either minimal or full response based on parameter
-			var r []byte
+			// Return either minimal or full response based on parameter. Full repositories have the fields of
+			// minimal ones, so both match the output schema.
 			if minimalOutput {
 				minimalRepos := make([]MinimalRepository, 0, len(result.Repositories))
 				for _, repo := range result.Repositories {
@@ -132,18 +134,10 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 					Items:             minimalRepos,
 				}
 
-				r, err = json.Marshal(minimalResult)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal minimal response: %w", err)
-				}
-			} else {
-				r, err = json.Marshal(result)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal full response: %w", err)
-				}
+				return MarshalledStructuredResult(minimalResult), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(result), nil
 		}
 }
 
@@ -155,6 +149,7 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				Title:        t("TOOL_SEARCH_CODE_USER_TITLE", "Search code"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.CodeSearchResult](),
 			mcp.WithString("query",
 				mcp.Required(),
 				mcp.Description("Search query using GitHub's powerful code search syntax. Examples: 'content:Skill language:Java org:github', 'NOT is:archived language:Python OR language:go', 'repo:github/github-mcp-server'. Supports exact matching, language filters, path filters, and more."),
@@ -218,12 +213,149 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			return MarshalledStructuredResult(result), nil
+		}
+}
+
+// SymbolMatch is a file containing a candidate definition of a symbol.
+type SymbolMatch struct {
+	Repository string   `json:"repository"`
+	Path       string   `json:"path"`
+	URL        string   `json:"url"`
+	Fragments  []string `json:"fragments,omitempty"`
+}
+
+// SymbolDefinitions are the candidate definitions of a symbol.
+type SymbolDefinitions struct {
+	Query string `json:"query"`
+	// Strategy is "symbol" for matches of the symbol: qualifier, or "content" for the plain content search fallback.
+	Strategy   string        `json:"strategy"`
+	TotalCount int           `json:"total_count"`
+	Matches    []SymbolMatch `json:"matches"`
+}
+
+// FindSymbolDefinitions creates a tool to locate the definitions of a symbol using code search.
+func FindSymbolDefinitions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_symbol_definitions",
+			mcp.WithDescription(t("TOOL_FIND_SYMBOL_DEFINITIONS_DESCRIPTION", "Locate function, type or other symbol definitions in a repository, or across all repositories of a user or organization. Uses code search with the 'symbol:' qualifier and falls back to a plain content search when no symbol matches are found. Returns the path and matching line fragments of each candidate.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_SYMBOL_DEFINITIONS_USER_TITLE", "Find symbol definitions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[SymbolDefinitions](),
+			mcp.WithString("symbol",
+				mcp.Required(),
+				mcp.Description("Name of the symbol to find, e.g. 'NewServer' or 'ToolsetGroup'"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner. Without repo, all repositories of this user or organization are searched"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. Omit to search across all repositories of the owner"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only search files in this language, e.g. 'go' or 'typescript'"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			symbol, err := RequiredParam[string](request, "symbol")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			language, err := OptionalParam[string](request, "language")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			scope := "user:" + owner
+			if repo != "" {
+				scope = fmt.Sprintf("repo:%s/%s", owner, repo)
+			}
+			qualifiers := []string{scope}
+			if language != "" {
+				qualifiers = append(qualifiers, "language:"+language)
+			}
+
+			opts := &github.SearchOptions{
+				TextMatch: true,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.PerPage,
+					Page:    pagination.Page,
+				},
+			}
+
+			client, err := getClient(ctx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			// Not every code search backend supports the symbol qualifier, so a validation
+			// failure or an empty result falls back to a plain content search.
+			strategy := "symbol"
+			query := strings.Join(append([]string{"symbol:" + symbol}, qualifiers...), " ")
+			result, resp, err := client.Search.Code(ctx, query, opts)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusUnprocessableEntity) {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to search code with query '%s'", query),
+					resp,
+					err,
+				), nil
+			}
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+
+			if err != nil || result.GetTotal() == 0 {
+				strategy = "content"
+				query = strings.Join(append([]string{fmt.Sprintf("%q", symbol), "in:file"}, qualifiers...), " ")
+				result, resp, err = client.Search.Code(ctx, query, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to search code with query '%s'", query),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			matches := make([]SymbolMatch, 0, len(result.CodeResults))
+			for _, code := range result.CodeResults {
+				match := SymbolMatch{
+					Repository: code.GetRepository().GetFullName(),
+					Path:       code.GetPath(),
+					URL:        code.GetHTMLURL(),
+				}
+				for _, tm := range code.TextMatches {
+					if tm.GetFragment() != "" {
+						match.Fragments = append(match.Fragments, tm.GetFragment())
+					}
+				}
+				matches = append(matches, match)
+			}
+
+			response := SymbolDefinitions{
+				Query:      query,
+				Strategy:   strategy,
+				TotalCount: result.GetTotal(),
+				Matches:    matches,
+			}
+
+			return MarshalledStructuredResult(response), nil
 		}
 }
 
@@ -307,11 +439,7 @@ func userOrOrgHandler(accountType string, getClient GetClientFn) server.ToolHand
 			minimalResp.IncompleteResults = *result.IncompleteResults
 		}
 
-		r, err := json.Marshal(minimalResp)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal response: %w", err)
-		}
-		return mcp.NewToolResultText(string(r)), nil
+		return MarshalledStructuredResult(minimalResp), nil
 	}
 }
 
@@ -323,6 +451,7 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			Title:        t("TOOL_SEARCH_USERS_USER_TITLE", "Search users"),
 			ReadOnlyHint: ToBoolPtr(true),
 		}),
+		WithOutputSchema[MinimalSearchUsersResult](),
 		mcp.WithString("query",
 			mcp.Required(),
 			mcp.Description("User search query. Examples: 'john smith', 'location:seattle', 'followers:>100'. Search is automatically scoped to type:user."),
@@ -348,6 +477,7 @@ func SearchOrgs(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			Title:        t("TOOL_SEARCH_ORGS_USER_TITLE", "Search organizations"),
 			ReadOnlyHint: ToBoolPtr(true),
 		}),
+		WithOutputSchema[MinimalSearchUsersResult](),
 		mcp.WithString("query",
 			mcp.Required(),
 			mcp.Description("Organization search query. Examples: 'microsoft', 'location:california', 'created:>=2025-01-01'. Search is automatically scoped to type:org."),
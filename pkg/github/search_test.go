@@ -383,6 +383,195 @@ func Test_SearchCode(t *testing.T) {
 	}
 }
 
+func Test_FindSymbolDefinitions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := FindSymbolDefinitions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "find_symbol_definitions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "symbol")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"symbol", "owner"})
+
+	mockSearchResult := &github.CodeSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		CodeResults: []*github.CodeResult{
+			{
+				Name:       github.Ptr("server.go"),
+				Path:       github.Ptr("pkg/server.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/pkg/server.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("func NewServer(cfg Config) *Server {")},
+				},
+			},
+		},
+	}
+	emptySearchResult := &github.CodeSearchResult{
+		Total:             github.Ptr(0),
+		IncompleteResults: github.Ptr(false),
+		CodeResults:       []*github.CodeResult{},
+	}
+	expectedMatches := []SymbolMatch{
+		{
+			Repository: "owner/repo",
+			Path:       "pkg/server.go",
+			URL:        "https://github.com/owner/repo/blob/main/pkg/server.go",
+			Fragments:  []string{"func NewServer(cfg Config) *Server {"},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedQuery    string
+		expectedStrategy string
+		expectedMatches  []SymbolMatch
+	}{
+		{
+			name: "finds symbol in repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					expectQueryParams(t, map[string]string{
+						"q":        "symbol:NewServer repo:owner/repo language:go",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"symbol":   "NewServer",
+				"owner":    "owner",
+				"repo":     "repo",
+				"language": "go",
+			},
+			expectedQuery:    "symbol:NewServer repo:owner/repo language:go",
+			expectedStrategy: "symbol",
+			expectedMatches:  expectedMatches,
+		},
+		{
+			name: "falls back to content search when symbol search has no results",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusOK)
+						if r.URL.Query().Get("q") == "symbol:NewServer user:owner" {
+							_, _ = w.Write(mock.MustMarshal(emptySearchResult))
+							return
+						}
+						_, _ = w.Write(mock.MustMarshal(mockSearchResult))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"symbol": "NewServer",
+				"owner":  "owner",
+			},
+			expectedQuery:    `"NewServer" in:file user:owner`,
+			expectedStrategy: "content",
+			expectedMatches:  expectedMatches,
+		},
+		{
+			name: "falls back to content search when symbol qualifier is rejected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("q") == "symbol:NewServer user:owner" {
+							w.WriteHeader(http.StatusUnprocessableEntity)
+							_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(mockSearchResult))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"symbol": "NewServer",
+				"owner":  "owner",
+			},
+			expectedQuery:    `"NewServer" in:file user:owner`,
+			expectedStrategy: "content",
+			expectedMatches:  expectedMatches,
+		},
+		{
+			name: "search fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"symbol": "NewServer",
+				"owner":  "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search code",
+		},
+		{
+			name:         "missing symbol",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: symbol",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FindSymbolDefinitions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Query      string        `json:"query"`
+				Strategy   string        `json:"strategy"`
+				TotalCount int           `json:"total_count"`
+				Matches    []SymbolMatch `json:"matches"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedQuery, response.Query)
+			assert.Equal(t, tc.expectedStrategy, response.Strategy)
+			assert.Equal(t, len(tc.expectedMatches), response.TotalCount)
+			assert.Equal(t, tc.expectedMatches, response.Matches)
+		})
+	}
+}
+
 func Test_SearchUsers(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
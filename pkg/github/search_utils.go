@@ -2,11 +2,11 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -41,11 +41,30 @@ func searchHandler(
 	request mcp.CallToolRequest,
 	searchType string,
 	errorPrefix string,
+	now time.Time,
 ) (*mcp.CallToolResult, error) {
+	result, errResult, err := searchIssuesAndPullRequests(ctx, getClient, request, searchType, errorPrefix, now)
+	if result == nil {
+		return errResult, err
+	}
+	return MarshalledStructuredResult(result), nil
+}
+
+// searchIssuesAndPullRequests runs the search of a search tool, scoped to a search type. When the search fails, it
+// returns a nil result with the tool result or error to return.
+func searchIssuesAndPullRequests(
+	ctx context.Context,
+	getClient GetClientFn,
+	request mcp.CallToolRequest,
+	searchType string,
+	errorPrefix string,
+	now time.Time,
+) (*github.IssuesSearchResult, *mcp.CallToolResult, error) {
 	query, err := RequiredParam[string](request, "query")
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
+	query = normalizeQueryDates(query, now)
 
 	if !hasSpecificFilter(query, "is", searchType) {
 		query = fmt.Sprintf("is:%s %s", searchType, query)
@@ -53,12 +72,12 @@ func searchHandler(
 
 	owner, err := OptionalParam[string](request, "owner")
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
 
 	repo, err := OptionalParam[string](request, "repo")
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
 
 	if owner != "" && repo != "" && !hasRepoFilter(query) {
@@ -67,15 +86,15 @@ func searchHandler(
 
 	sort, err := OptionalParam[string](request, "sort")
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
 	order, err := OptionalParam[string](request, "order")
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
 	pagination, err := OptionalPaginationParams(request)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, mcp.NewToolResultError(err.Error()), nil
 	}
 
 	opts := &github.SearchOptions{
@@ -90,26 +109,21 @@ func searchHandler(
 
 	client, err := getClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to get GitHub client: %w", errorPrefix, err)
+		return nil, nil, fmt.Errorf("%s: failed to get GitHub client: %w", errorPrefix, err)
 	}
 	result, resp, err := client.Search.Issues(ctx, query, opts)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", errorPrefix, err)
+		return nil, nil, fmt.Errorf("%s: %w", errorPrefix, err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("%s: failed to read response body: %w", errorPrefix, err)
+			return nil, nil, fmt.Errorf("%s: failed to read response body: %w", errorPrefix, err)
 		}
-		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", errorPrefix, string(body))), nil
-	}
-
-	r, err := json.Marshal(result)
-	if err != nil {
-		return nil, fmt.Errorf("%s: failed to marshal response: %w", errorPrefix, err)
+		return nil, mcp.NewToolResultError(fmt.Sprintf("%s: %s", errorPrefix, string(body))), nil
 	}
 
-	return mcp.NewToolResultText(string(r)), nil
+	return result, nil, nil
 }
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MaxSecretExposureAlerts bounds the number of open alerts aggregated by get_org_secret_exposure_report.
+const MaxSecretExposureAlerts = 5000
+
+// SecretExposureAge counts open alerts by how long ago they were created.
+type SecretExposureAge struct {
+	UnderWeek    int `json:"under_week"`
+	UnderMonth   int `json:"under_month"`
+	UnderQuarter int `json:"under_quarter"`
+	OverQuarter  int `json:"over_quarter"`
+}
+
+func (a *SecretExposureAge) add(age time.Duration) {
+	switch days := age.Hours() / 24; {
+	case days < 7:
+		a.UnderWeek++
+	case days < 30:
+		a.UnderMonth++
+	case days < 90:
+		a.UnderQuarter++
+	default:
+		a.OverQuarter++
+	}
+}
+
+// SecretExposureRow aggregates the open alerts of a secret type or of a repository.
+type SecretExposureRow struct {
+	Name                   string `json:"name"`
+	Alerts                 int    `json:"alerts"`
+	PushProtectionBypasses int    `json:"push_protection_bypasses"`
+	Repositories           int    `json:"repositories,omitempty"`
+	OldestDays             int    `json:"oldest_days"`
+}
+
+// SecretExposureReport summarizes the open secret scanning alerts of an organization.
+type SecretExposureReport struct {
+	Org                    string              `json:"org"`
+	OpenAlerts             int                 `json:"open_alerts"`
+	PushProtectionBypasses int                 `json:"push_protection_bypasses"`
+	Age                    SecretExposureAge   `json:"age"`
+	BySecretType           []SecretExposureRow `json:"by_secret_type"`
+	ByRepository           []SecretExposureRow `json:"by_repository"`
+	// Truncated is set when the organization has more open alerts than MaxSecretExposureAlerts.
+	Truncated bool `json:"truncated,omitempty"`
+	// Table renders BySecretType as a Markdown table.
+	Table string `json:"table"`
+}
+
+// listAllOrgSecretScanningAlerts returns up to limit open secret scanning alerts of an organization, and whether there
+// were more.
+func listAllOrgSecretScanningAlerts(ctx context.Context, client *github.Client, org, secretType string, limit int) ([]*github.SecretScanningAlert, bool, *github.Response, error) {
+	var alerts []*github.SecretScanningAlert
+	opts := &github.SecretScanningAlertListOptions{
+		State:             "open",
+		SecretType:        secretType,
+		ListCursorOptions: github.ListCursorOptions{PerPage: 100},
+	}
+	for {
+		page, resp, err := client.SecretScanning.ListAlertsForOrg(ctx, org, opts)
+		if err != nil {
+			return nil, false, resp, err
+		}
+		_ = resp.Body.Close()
+		alerts = append(alerts, page...)
+		if len(alerts) >= limit {
+			return alerts[:limit], len(alerts) > limit || resp.After != "", resp, nil
+		}
+		if resp.After == "" {
+			return alerts, false, resp, nil
+		}
+		opts.After = resp.After
+	}
+}
+
+// summarizeSecretExposure aggregates open secret scanning alerts by age, secret type and repository. Rows are sorted by
+// number of alerts, then name.
+func summarizeSecretExposure(org string, alerts []*github.SecretScanningAlert, now time.Time) SecretExposureReport {
+	report := SecretExposureReport{Org: org, OpenAlerts: len(alerts)}
+	type group struct {
+		row          SecretExposureRow
+		repositories map[string]bool
+	}
+	byType := map[string]*group{}
+	byRepository := map[string]*group{}
+	addTo := func(groups map[string]*group, name, repository string, bypassed bool, days int) {
+		g, ok := groups[name]
+		if !ok {
+			g = &group{row: SecretExposureRow{Name: name}, repositories: map[string]bool{}}
+			groups[name] = g
+		}
+		g.row.Alerts++
+		if bypassed {
+			g.row.PushProtectionBypasses++
+		}
+		g.row.OldestDays = max(g.row.OldestDays, days)
+		g.repositories[repository] = true
+	}
+	for _, alert := range alerts {
+		age := now.Sub(alert.GetCreatedAt().Time)
+		report.Age.add(age)
+		if alert.GetPushProtectionBypassed() {
+			report.PushProtectionBypasses++
+		}
+		secretType := alert.GetSecretTypeDisplayName()
+		if secretType == "" {
+			secretType = alert.GetSecretType()
+		}
+		repository := alert.GetRepository().GetFullName()
+		days := int(age.Hours() / 24)
+		addTo(byType, secretType, repository, alert.GetPushProtectionBypassed(), days)
+		addTo(byRepository, repository, repository, alert.GetPushProtectionBypassed(), days)
+	}
+
+	rows := func(groups map[string]*group, countRepositories bool) []SecretExposureRow {
+		result := []SecretExposureRow{}
+		for _, g := range groups {
+			if countRepositories {
+				g.row.Repositories = len(g.repositories)
+			}
+			result = append(result, g.row)
+		}
+		sort.Slice(result, func(i, j int) bool {
+			if result[i].Alerts != result[j].Alerts {
+				return result[i].Alerts > result[j].Alerts
+			}
+			return result[i].Name < result[j].Name
+		})
+		return result
+	}
+	report.BySecretType = rows(byType, true)
+	report.ByRepository = rows(byRepository, false)
+
+	var b strings.Builder
+	b.WriteString("| Secret type | Alerts | Repositories | Bypasses | Oldest (days) |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, row := range report.BySecretType {
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %d |\n", row.Name, row.Alerts, row.Repositories, row.PushProtectionBypasses, row.OldestDays)
+	}
+	report.Table = b.String()
+	return report
+}
+
+// GetOrgSecretExposureReport creates a tool to summarize the open secret scanning alerts of an organization.
+func GetOrgSecretExposureReport(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_org_secret_exposure_report",
+			mcp.WithDescription(t("TOOL_GET_ORG_SECRET_EXPOSURE_REPORT_DESCRIPTION", fmt.Sprintf("Summarize the open secret scanning alerts across all repositories of an organization: how many there are and how old, how many bypassed push protection, by secret type and by repository, with a Markdown table by secret type for security leadership. At most %d alerts are aggregated.", MaxSecretExposureAlerts))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORG_SECRET_EXPOSURE_REPORT_USER_TITLE", "Get organization secret exposure report"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[SecretExposureReport](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("The organization name."),
+			),
+			mcp.WithString("secret_type",
+				mcp.Description("A comma-separated list of secret types to report on. All default secret patterns are reported by default."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			secretType, err := OptionalParam[string](request, "secret_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			alerts, truncated, resp, err := listAllOrgSecretScanningAlerts(ctx, client, org, secretType, MaxSecretExposureAlerts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list alerts for organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+
+			report := summarizeSecretExposure(org, alerts, flags.now())
+			report.Truncated = truncated
+			return MarshalledStructuredResult(report), nil
+		}
+}
@@ -0,0 +1,86 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetOrgSecretExposureReport(t *testing.T) {
+	tool, _ := GetOrgSecretExposureReport(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_org_secret_exposure_report", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	now := time.Now()
+	alert := func(repository, secretType string, age time.Duration, bypassed bool) *github.SecretScanningAlert {
+		return &github.SecretScanningAlert{
+			State:                  github.Ptr("open"),
+			SecretTypeDisplayName:  github.Ptr(secretType),
+			CreatedAt:              &github.Timestamp{Time: now.Add(-age)},
+			PushProtectionBypassed: github.Ptr(bypassed),
+			Repository:             &github.Repository{FullName: github.Ptr(repository)},
+		}
+	}
+	day := 24 * time.Hour
+
+	t.Run("report", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetOrgsSecretScanningAlertsByOrg,
+				expectQueryParams(t, map[string]string{"state": "open", "per_page": "100"}).andThen(
+					mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{
+						alert("octo-org/api", "GitHub Personal Access Token", 2*day, true),
+						alert("octo-org/api", "AWS Access Key ID", 40*day, false),
+						alert("octo-org/web", "GitHub Personal Access Token", 100*day, false),
+					}),
+				),
+			),
+		)
+		_, handler := GetOrgSecretExposureReport(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo-org"}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+
+		var report SecretExposureReport
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &report))
+		assert.Equal(t, 3, report.OpenAlerts)
+		assert.Equal(t, 1, report.PushProtectionBypasses)
+		assert.Equal(t, SecretExposureAge{UnderWeek: 1, UnderQuarter: 1, OverQuarter: 1}, report.Age)
+		assert.Equal(t, []SecretExposureRow{
+			{Name: "GitHub Personal Access Token", Alerts: 2, PushProtectionBypasses: 1, Repositories: 2, OldestDays: 100},
+			{Name: "AWS Access Key ID", Alerts: 1, Repositories: 1, OldestDays: 40},
+		}, report.BySecretType)
+		assert.Equal(t, []SecretExposureRow{
+			{Name: "octo-org/api", Alerts: 2, PushProtectionBypasses: 1, OldestDays: 40},
+			{Name: "octo-org/web", Alerts: 1, OldestDays: 100},
+		}, report.ByRepository)
+		assert.False(t, report.Truncated)
+		assert.Contains(t, report.Table, "| GitHub Personal Access Token | 2 | 2 | 1 | 100 |")
+	})
+
+	t.Run("alerts not available", func(t *testing.T) {
+		mockedClient := mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetOrgsSecretScanningAlertsByOrg,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		)
+		_, handler := GetOrgSecretExposureReport(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper, FeatureFlags{})
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{"org": "octo-org"}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to list alerts for organization 'octo-org'")
+	})
+}
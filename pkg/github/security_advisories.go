@@ -2,7 +2,6 @@ package github
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,6 +19,7 @@ func ListGlobalSecurityAdvisories(getClient GetClientFn, t translations.Translat
 				Title:        t("TOOL_LIST_GLOBAL_SECURITY_ADVISORIES_USER_TITLE", "List global security advisories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.GlobalSecurityAdvisory](),
 			mcp.WithString("ghsaId",
 				mcp.Description("Filter by GitHub Security Advisory ID (format: GHSA-xxxx-xxxx-xxxx)."),
 			),
@@ -173,12 +173,7 @@ func ListGlobalSecurityAdvisories(getClient GetClientFn, t translations.Translat
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(advisories), nil
 		}
 }
 
@@ -189,6 +184,7 @@ func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.Tran
 				Title:        t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_USER_TITLE", "List repository security advisories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.SecurityAdvisory](),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("The owner of the repository."),
@@ -262,12 +258,7 @@ func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.Tran
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(advisories), nil
 		}
 }
 
@@ -278,6 +269,7 @@ func GetGlobalSecurityAdvisory(getClient GetClientFn, t translations.Translation
 				Title:        t("TOOL_GET_GLOBAL_SECURITY_ADVISORY_USER_TITLE", "Get a global security advisory"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithOutputSchema[github.GlobalSecurityAdvisory](),
 			mcp.WithString("ghsaId",
 				mcp.Description("GitHub Security Advisory ID (format: GHSA-xxxx-xxxx-xxxx)."),
 				mcp.Required(),
@@ -307,12 +299,7 @@ func GetGlobalSecurityAdvisory(getClient GetClientFn, t translations.Translation
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get advisory: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisory)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisory: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredResult(advisory), nil
 		}
 }
 
@@ -323,6 +310,7 @@ func ListOrgRepositorySecurityAdvisories(getClient GetClientFn, t translations.T
 				Title:        t("TOOL_LIST_ORG_REPOSITORY_SECURITY_ADVISORIES_USER_TITLE", "List org repository security advisories"),
 				ReadOnlyHint: ToBoolPtr(true),
 			}),
+			WithListOutputSchema[*github.SecurityAdvisory](),
 			mcp.WithString("org",
 				mcp.Required(),
 				mcp.Description("The organization login."),
@@ -387,11 +375,6 @@ func ListOrgRepositorySecurityAdvisories(getClient GetClientFn, t translations.T
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
-			}
-
-			return mcp.NewToolResultText(string(r)), nil
+			return MarshalledStructuredListResult(advisories), nil
 		}
 }
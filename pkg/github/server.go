@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -120,6 +121,82 @@ func RequiredBigInt(r mcp.CallToolRequest, p string) (int64, error) {
 	return result, nil
 }
 
+// IssueRef is an issue or pull request named by a shorthand reference such as "octo-org/web-app#123".
+type IssueRef struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r IssueRef) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// ParseIssueRef parses an issue or pull request reference in 'owner/repo#123' format.
+func ParseIssueRef(ref string) (IssueRef, error) {
+	m := issueReferenceRe.FindStringSubmatch(strings.TrimSpace(ref))
+	if m == nil || m[1] == "" || !strings.Contains(ref, "#") {
+		return IssueRef{}, fmt.Errorf("invalid reference %q: expected format 'owner/repo#123'", ref)
+	}
+	number, err := strconv.Atoi(m[3])
+	if err != nil || number <= 0 {
+		return IssueRef{}, fmt.Errorf("invalid reference %q: the number must be a positive integer", ref)
+	}
+	return IssueRef{Owner: m[1], Repo: m[2], Number: number}, nil
+}
+
+// OptionalIssueRef is a helper function that can be used to fetch an issue or pull request reference in
+// 'owner/repo#123' format from the request. It returns false if the parameter is absent or empty.
+func OptionalIssueRef(r mcp.CallToolRequest, p string) (IssueRef, bool, error) {
+	ref, err := OptionalParam[string](r, p)
+	if err != nil || ref == "" {
+		return IssueRef{}, false, err
+	}
+	parsed, err := ParseIssueRef(ref)
+	if err != nil {
+		return IssueRef{}, false, err
+	}
+	return parsed, true, nil
+}
+
+// WithIssueRef adds the ref parameter, naming the issue or pull request a tool acts on in 'owner/repo#123' format as
+// an alternative to its owner, repo and number parameters.
+func WithIssueRef() mcp.ToolOption {
+	return mcp.WithString("ref",
+		mcp.Description("The issue or pull request, as a reference in 'owner/repo#123' format. Use instead of owner, repo and the number."),
+	)
+}
+
+// RequiredIssueTarget is a helper function that can be used to fetch the issue or pull request a tool acts on, given
+// either by the ref parameter or by the owner, repo and numberParam parameters.
+func RequiredIssueTarget(r mcp.CallToolRequest, numberParam string) (IssueRef, error) {
+	ref, hasRef, err := OptionalIssueRef(r, "ref")
+	if err != nil {
+		return IssueRef{}, err
+	}
+	if hasRef {
+		for _, p := range []string{"owner", "repo", numberParam} {
+			if value, ok := r.GetArguments()[p]; ok && value != nil && value != "" {
+				return IssueRef{}, fmt.Errorf("pass either ref or owner, repo and %s, not both", numberParam)
+			}
+		}
+		return ref, nil
+	}
+	owner, err := RequiredParam[string](r, "owner")
+	if err != nil {
+		return IssueRef{}, err
+	}
+	repo, err := RequiredParam[string](r, "repo")
+	if err != nil {
+		return IssueRef{}, err
+	}
+	number, err := RequiredInt(r, numberParam)
+	if err != nil {
+		return IssueRef{}, err
+	}
+	return IssueRef{Owner: owner, Repo: repo, Number: number}, nil
+}
+
 // OptionalParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
@@ -264,6 +341,15 @@ func OptionalBigIntArrayParam(r mcp.CallToolRequest, p string) ([]int64, error)
 	}
 }
 
+// parseRepoFullName splits a repository reference in 'owner/repo' format into its owner and name.
+func parseRepoFullName(fullName string) (owner, repo string, err error) {
+	parts := strings.Split(strings.TrimSpace(fullName), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid repository %q: expected format 'owner/repo'", fullName)
+	}
+	return parts[0], parts[1], nil
+}
+
 // WithPagination adds REST API pagination parameters to a tool.
 // https://docs.github.com/en/rest/using-the-rest-api/using-pagination-in-the-rest-api
 func WithPagination() mcp.ToolOption {
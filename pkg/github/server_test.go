@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-github/v79/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func stubGetClientFn(client *github.Client) GetClientFn {
@@ -312,6 +313,63 @@ func Test_OptionalIntParam(t *testing.T) {
 	}
 }
 
+func Test_OptionalIssueRef(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		expected    IssueRef
+		expectedOK  bool
+		expectError bool
+	}{
+		{
+			name:       "valid reference",
+			params:     map[string]interface{}{"ref": "acme/api.v2#123"},
+			expected:   IssueRef{Owner: "acme", Repo: "api.v2", Number: 123},
+			expectedOK: true,
+		},
+		{
+			name:       "missing parameter",
+			params:     map[string]interface{}{},
+			expectedOK: false,
+		},
+		{
+			name:        "number only",
+			params:      map[string]interface{}{"ref": "#123"},
+			expectError: true,
+		},
+		{
+			name:        "missing hash",
+			params:      map[string]interface{}{"ref": "acme/api123"},
+			expectError: true,
+		},
+		{
+			name:        "zero number",
+			params:      map[string]interface{}{"ref": "acme/api#0"},
+			expectError: true,
+		},
+		{
+			name:        "wrong type parameter",
+			params:      map[string]interface{}{"ref": float64(123)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, ok, err := OptionalIssueRef(request, "ref")
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedOK, ok)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func Test_OptionalNumberParamWithDefault(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -573,3 +631,44 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalledStructuredResult(t *testing.T) {
+	user := MinimalUser{Login: "octocat", ID: 1}
+	result := MarshalledStructuredResult(user)
+
+	require.False(t, result.IsError)
+	assert.Equal(t, user, result.StructuredContent)
+	assert.JSONEq(t, `{"login":"octocat","id":1}`, getTextResult(t, result).Text)
+}
+
+func TestMarshalledStructuredListResult(t *testing.T) {
+	tests := []struct {
+		name         string
+		items        []string
+		expectedText string
+		expected     ListResult[string]
+	}{
+		{
+			name:         "list of items",
+			items:        []string{"a", "b"},
+			expectedText: `["a","b"]`,
+			expected:     ListResult[string]{Items: []string{"a", "b"}},
+		},
+		{
+			name:         "nil list",
+			items:        nil,
+			expectedText: `[]`,
+			expected:     ListResult[string]{Items: []string{}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := MarshalledStructuredListResult(tc.items)
+
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expected, result.StructuredContent)
+			assert.JSONEq(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	SLAKindFirstResponse = "first_response"
+	SLAKindResolution    = "resolution"
+
+	SLAStatusBreached = "breached"
+	SLAStatusAtRisk   = "at_risk"
+)
+
+// slaDurationPattern matches SLA durations such as '24h', '90m', '3d' or '2w'.
+var slaDurationPattern = regexp.MustCompile(`^(\d+)\s*([mhdw])$`)
+
+// parseSLADuration parses a duration in minutes, hours, days or weeks, e.g. '24h' or '3d'.
+func parseSLADuration(s string) (time.Duration, error) {
+	m := slaDurationPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a number followed by m, h, d or w, e.g. '24h' or '3d'", s)
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	unit := map[string]time.Duration{"m": time.Minute, "h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[m[2]]
+	return time.Duration(n) * unit, nil
+}
+
+// formatSLADuration formats a duration rounded to minutes, e.g. '1d3h20m'. Negative durations are prefixed with '-'.
+func formatSLADuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	minutes := (d - hours*time.Hour) / time.Minute
+
+	var b strings.Builder
+	if days > 0 {
+		fmt.Fprintf(&b, "%dd", days)
+	}
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+	if minutes > 0 || b.Len() == 0 {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+	return sign + b.String()
+}
+
+// IssueSLA is the first response and resolution SLA of issues with a label.
+type IssueSLA struct {
+	Label         string
+	FirstResponse time.Duration
+	Resolution    time.Duration
+}
+
+// SLABreach is an open issue that breached, or is about to breach, an SLA.
+type SLABreach struct {
+	Number       int       `json:"number"`
+	Title        string    `json:"title"`
+	URL          string    `json:"url"`
+	Label        string    `json:"label"`
+	Kind         string    `json:"kind"`
+	Status       string    `json:"status"`
+	DueAt        time.Time `json:"due_at"`
+	TimeToBreach string    `json:"time_to_breach"`
+}
+
+// SLABreachReport is the result of scanning the open issues of a repository against SLAs.
+type SLABreachReport struct {
+	CheckedAt     time.Time   `json:"checked_at"`
+	IssuesChecked int         `json:"issues_checked"`
+	Breaches      []SLABreach `json:"breaches"`
+}
+
+// parseIssueSLAs converts the slas parameter of a request to issue SLAs.
+func parseIssueSLAs(request mcp.CallToolRequest) ([]IssueSLA, error) {
+	items, ok := request.GetArguments()["slas"].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("missing required parameter: slas")
+	}
+	slas := make([]IssueSLA, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each SLA must be an object")
+		}
+		sla := IssueSLA{}
+		sla.Label, _ = obj["label"].(string)
+		if sla.Label == "" {
+			return nil, fmt.Errorf("each SLA must have a label")
+		}
+		for key, target := range map[string]*time.Duration{"first_response": &sla.FirstResponse, "resolution": &sla.Resolution} {
+			value, _ := obj[key].(string)
+			if value == "" {
+				continue
+			}
+			d, err := parseSLADuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("SLA for label %q: %s: %w", sla.Label, key, err)
+			}
+			*target = d
+		}
+		if sla.FirstResponse == 0 && sla.Resolution == 0 {
+			return nil, fmt.Errorf("SLA for label %q must have a first_response or resolution duration", sla.Label)
+		}
+		slas = append(slas, sla)
+	}
+	return slas, nil
+}
+
+// matchIssueSLA returns the first SLA whose label is on the issue. SLAs are listed in order of precedence.
+func matchIssueSLA(issue *github.Issue, slas []IssueSLA) (IssueSLA, bool) {
+	for _, sla := range slas {
+		for _, label := range issue.Labels {
+			if strings.EqualFold(label.GetName(), sla.Label) {
+				return sla, true
+			}
+		}
+	}
+	return IssueSLA{}, false
+}
+
+// firstResponseAt returns when someone other than the author, and not a bot, first commented on an issue. It returns
+// the zero time if nobody responded yet.
+func firstResponseAt(ctx context.Context, client *github.Client, owner, repo string, issue *github.Issue) (time.Time, *github.Response, error) {
+	if issue.GetComments() == 0 {
+		return time.Time{}, nil, nil
+	}
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.Ptr("created"),
+		Direction:   github.Ptr("asc"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issue.GetNumber(), opts)
+		if err != nil {
+			return time.Time{}, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, comment := range comments {
+			if comment.GetUser().GetType() == "Bot" || strings.EqualFold(comment.GetUser().GetLogin(), issue.GetUser().GetLogin()) {
+				continue
+			}
+			return comment.GetCreatedAt().Time, resp, nil
+		}
+		if resp.NextPage == 0 {
+			return time.Time{}, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// checkSLA reports a breach if an SLA that is due at dueAt is breached at now, or at risk of being breached within
+// warnWithin.
+func checkSLA(issue *github.Issue, label, kind string, dueAt, now time.Time, warnWithin time.Duration) (SLABreach, bool) {
+	remaining := dueAt.Sub(now)
+	breach := SLABreach{
+		Number:       issue.GetNumber(),
+		Title:        issue.GetTitle(),
+		URL:          issue.GetHTMLURL(),
+		Label:        label,
+		Kind:         kind,
+		DueAt:        dueAt,
+		TimeToBreach: formatSLADuration(remaining),
+	}
+	switch {
+	case remaining <= 0:
+		breach.Status = SLAStatusBreached
+	case remaining <= warnWithin:
+		breach.Status = SLAStatusAtRisk
+	default:
+		return breach, false
+	}
+	return breach, true
+}
+
+// ListSLABreaches creates a tool to find open issues that breached their first response or resolution SLA.
+func ListSLABreaches(getClient GetClientFn, t translations.TranslationHelperFunc, flags FeatureFlags) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_sla_breaches",
+			mcp.WithDescription(t("TOOL_LIST_SLA_BREACHES_DESCRIPTION", "Scan all open issues of a repository against first response and resolution SLAs configured per label, e.g. 'priority: p1' must get a first response within 24h. Reports issues that breached an SLA and, with warn_within, issues that will breach soon, with their due date and time to breach. A first response is the first comment by someone other than the author that is not a bot.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_SLA_BREACHES_USER_TITLE", "List SLA breaches"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[SLABreachReport](),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("slas",
+				mcp.Required(),
+				mcp.Description("SLAs per label, in order of precedence. An issue is checked against the first SLA whose label it has"),
+				mcp.Items(map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"label"},
+					"properties": map[string]interface{}{
+						"label": map[string]interface{}{
+							"type":        "string",
+							"description": "Label the SLA applies to",
+						},
+						"first_response": map[string]interface{}{
+							"type":        "string",
+							"description": "Time to first response, e.g. '24h' or '2d'",
+						},
+						"resolution": map[string]interface{}{
+							"type":        "string",
+							"description": "Time to close the issue, e.g. '7d' or '2w'",
+						},
+					},
+				}),
+			),
+			mcp.WithString("warn_within",
+				mcp.Description("Also report issues that will breach an SLA within this duration, e.g. '4h'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			slas, err := parseIssueSLAs(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			warn, err := OptionalParam[string](request, "warn_within")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var warnWithin time.Duration
+			if warn != "" {
+				warnWithin, err = parseSLADuration(warn)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("warn_within: %s", err)), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			now := flags.now()
+			breaches := []SLABreach{}
+			scanned := 0
+			opts := &github.IssueListByRepoOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+			for {
+				issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list issues",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, issue := range issues {
+					if issue.IsPullRequest() {
+						continue
+					}
+					sla, ok := matchIssueSLA(issue, slas)
+					if !ok {
+						continue
+					}
+					scanned++
+					createdAt := issue.GetCreatedAt().Time
+
+					if sla.FirstResponse > 0 {
+						respondedAt, resp, err := firstResponseAt(ctx, client, owner, repo, issue)
+						if err != nil {
+							return ghErrors.NewGitHubAPIErrorResponse(ctx,
+								fmt.Sprintf("failed to list comments of issue #%d", issue.GetNumber()),
+								resp,
+								err,
+							), nil
+						}
+						if respondedAt.IsZero() {
+							if breach, ok := checkSLA(issue, sla.Label, SLAKindFirstResponse, createdAt.Add(sla.FirstResponse), now, warnWithin); ok {
+								breaches = append(breaches, breach)
+							}
+						}
+					}
+					if sla.Resolution > 0 {
+						if breach, ok := checkSLA(issue, sla.Label, SLAKindResolution, createdAt.Add(sla.Resolution), now, warnWithin); ok {
+							breaches = append(breaches, breach)
+						}
+					}
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.ListOptions.Page = resp.NextPage
+			}
+
+			sort.SliceStable(breaches, func(i, j int) bool {
+				return breaches[i].DueAt.Before(breaches[j].DueAt)
+			})
+
+			return MarshalledStructuredResult(SLABreachReport{
+				CheckedAt:     now,
+				IssuesChecked: scanned,
+				Breaches:      breaches,
+			}), nil
+		}
+}
@@ -0,0 +1,167 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseSLADuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+	}{
+		{input: "90m", expected: 90 * time.Minute},
+		{input: "24h", expected: 24 * time.Hour},
+		{input: "3d", expected: 72 * time.Hour},
+		{input: "2W", expected: 14 * 24 * time.Hour},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := parseSLADuration(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+
+	_, err := parseSLADuration("1 day")
+	require.ErrorContains(t, err, `invalid duration "1 day"`)
+}
+
+func Test_FormatSLADuration(t *testing.T) {
+	assert.Equal(t, "1d3h20m", formatSLADuration(27*time.Hour+20*time.Minute))
+	assert.Equal(t, "-2h", formatSLADuration(-2*time.Hour))
+	assert.Equal(t, "0m", formatSLADuration(10*time.Second))
+}
+
+func Test_ListSLABreaches(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSLABreaches(stubGetClientFn(mockClient), translations.NullTranslationHelper, FeatureFlags{})
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_sla_breaches", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "slas"})
+
+	now := time.Now()
+	ago := func(d time.Duration) *github.Timestamp { return &github.Timestamp{Time: now.Add(-d)} }
+	labels := func(names ...string) []*github.Label {
+		result := make([]*github.Label, 0, len(names))
+		for _, name := range names {
+			result = append(result, &github.Label{Name: github.Ptr(name)})
+		}
+		return result
+	}
+	author := &github.User{Login: github.Ptr("reporter")}
+	page1 := []*github.Issue{
+		// No response after two days breaches the p1 first response SLA.
+		{Number: github.Ptr(1), Title: github.Ptr("Outage"), User: author, CreatedAt: ago(48 * time.Hour), Labels: labels("priority: p1")},
+		// Only the author and a bot commented, so there is no first response yet; due in 2h.
+		{Number: github.Ptr(2), Title: github.Ptr("Slow page"), User: author, CreatedAt: ago(22 * time.Hour), Comments: github.Ptr(2), Labels: labels("priority: p1", "bug")},
+		// Answered, and not due for resolution for another day.
+		{Number: github.Ptr(3), Title: github.Ptr("Typo"), User: author, CreatedAt: ago(6 * 24 * time.Hour), Comments: github.Ptr(1), Labels: labels("priority: p2")},
+	}
+	page2 := []*github.Issue{
+		// Open longer than the p2 resolution SLA.
+		{Number: github.Ptr(4), Title: github.Ptr("Old bug"), User: author, CreatedAt: ago(10 * 24 * time.Hour), Comments: github.Ptr(1), Labels: labels("priority: p2")},
+		// No SLA label.
+		{Number: github.Ptr(5), Title: github.Ptr("Question"), User: author, CreatedAt: ago(30 * 24 * time.Hour), Labels: labels("question")},
+		// Pull requests are ignored.
+		{Number: github.Ptr(6), User: author, CreatedAt: ago(30 * 24 * time.Hour), Labels: labels("priority: p1"), PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/octo/repo/pulls/6")}},
+	}
+	comments := map[string][]*github.IssueComment{
+		"/repos/octo/repo/issues/2/comments": {
+			{User: author, CreatedAt: ago(21 * time.Hour)},
+			{User: &github.User{Login: github.Ptr("ci"), Type: github.Ptr("Bot")}, CreatedAt: ago(20 * time.Hour)},
+		},
+		"/repos/octo/repo/issues/3/comments": {
+			{User: &github.User{Login: github.Ptr("maintainer")}, CreatedAt: ago(5 * 24 * time.Hour)},
+		},
+		"/repos/octo/repo/issues/4/comments": {
+			{User: &github.User{Login: github.Ptr("maintainer")}, CreatedAt: ago(9 * 24 * time.Hour)},
+		},
+	}
+	slas := []interface{}{
+		map[string]interface{}{"label": "priority: p1", "first_response": "24h", "resolution": "7d"},
+		map[string]interface{}{"label": "priority: p2", "first_response": "3d", "resolution": "1w"},
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expected       []string
+	}{
+		{
+			name:        "breaches only",
+			requestArgs: map[string]interface{}{"owner": "octo", "repo": "repo", "slas": slas},
+			expected:    []string{"4:resolution:breached", "1:first_response:breached"},
+		},
+		{
+			name:        "with issues at risk",
+			requestArgs: map[string]interface{}{"owner": "octo", "repo": "repo", "slas": slas, "warn_within": "1d"},
+			expected:    []string{"4:resolution:breached", "1:first_response:breached", "2:first_response:at_risk", "3:resolution:at_risk"},
+		},
+		{
+			name: "invalid SLA duration",
+			requestArgs: map[string]interface{}{"owner": "octo", "repo": "repo", "slas": []interface{}{
+				map[string]interface{}{"label": "priority: p1", "first_response": "one day"},
+			}},
+			expectError:    true,
+			expectedErrMsg: `SLA for label "priority: p1": first_response: invalid duration "one day"`,
+		},
+		{
+			name: "SLA without durations",
+			requestArgs: map[string]interface{}{"owner": "octo", "repo": "repo", "slas": []interface{}{
+				map[string]interface{}{"label": "priority: p1"},
+			}},
+			expectError:    true,
+			expectedErrMsg: "must have a first_response or resolution duration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(mock.GetReposIssuesByOwnerByRepo, page1, page2),
+				mock.WithRequestMatchHandler(mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					mockResponse(t, http.StatusOK, comments[r.URL.Path])(w, r)
+				})),
+			))
+			_, handler := ListSLABreaches(stubGetClientFn(client), translations.NullTranslationHelper, FeatureFlags{})
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			var response struct {
+				IssuesChecked int         `json:"issues_checked"`
+				Breaches      []SLABreach `json:"breaches"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, 4, response.IssuesChecked)
+			found := []string{}
+			for _, b := range response.Breaches {
+				found = append(found, fmt.Sprintf("%d:%s:%s", b.Number, b.Kind, b.Status))
+			}
+			assert.Equal(t, tc.expected, found)
+		})
+	}
+}
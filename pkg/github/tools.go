@@ -112,6 +112,14 @@ var (
 		ID:          "labels",
 		Description: "GitHub Labels related tools",
 	}
+	ToolsetMetadataWorkspace = ToolsetMetadata{
+		ID:          "workspace",
+		Description: "Session workspace for storing intermediate results",
+	}
+	ToolsetMetadataModels = ToolsetMetadata{
+		ID:          "models",
+		Description: "GitHub Models inference for summarization and rewriting steps. Only available when the server runs with --enable-models",
+	}
 )
 
 func AvailableTools() []ToolsetMetadata {
@@ -135,6 +143,8 @@ func AvailableTools() []ToolsetMetadata {
 		ToolsetMetadataStargazers,
 		ToolsetMetadataDynamic,
 		ToolsetLabels,
+		ToolsetMetadataWorkspace,
+		ToolsetMetadataModels,
 	}
 }
 
@@ -169,23 +179,41 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, getRawClient, t)),
+			toolsets.NewServerTool(GetFileMetadata(getClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
+			toolsets.NewServerTool(CompareRefs(getClient, t)),
+			toolsets.NewServerTool(GetPathChurn(getClient, t)),
+			toolsets.NewServerTool(GenerateWeeklyDigest(getClient, getGQLClient, t, flags)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
+			toolsets.NewServerTool(FindSymbolDefinitions(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
+			toolsets.NewServerTool(GetBranch(getClient, t)),
+			toolsets.NewServerTool(ListRepoEvents(getClient, t)),
 			toolsets.NewServerTool(ListTags(getClient, t)),
 			toolsets.NewServerTool(GetTag(getClient, t)),
 			toolsets.NewServerTool(ListReleases(getClient, t)),
 			toolsets.NewServerTool(GetLatestRelease(getClient, t)),
 			toolsets.NewServerTool(GetReleaseByTag(getClient, t)),
+			toolsets.NewServerTool(GetReleaseReadiness(getClient, t)),
+			toolsets.NewServerTool(CompareEnvironmentDeployments(getClient, t)),
+			toolsets.NewServerTool(GetPagesStatus(getClient, t)),
+			toolsets.NewServerTool(GetPagesDomainHealth(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
 			toolsets.NewServerTool(CreateRepository(getClient, t)),
 			toolsets.NewServerTool(ForkRepository(getClient, t)),
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
+			toolsets.NewServerTool(DeleteBranch(getClient, t)),
 			toolsets.NewServerTool(PushFiles(getClient, t)),
 			toolsets.NewServerTool(DeleteFile(getClient, t)),
+			toolsets.NewServerTool(SyncRequiredChecks(getClient, t)),
+			toolsets.NewServerTool(PropagateFile(getClient, t)),
+			toolsets.NewServerTool(BootstrapRepository(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(TagRepositoriesByRule(getClient, t)),
+			toolsets.NewServerTool(UpdateChangelog(getClient, t, flags)),
+			toolsets.NewServerTool(CreatePagesDeployment(getClient, t)),
 		).
 		AddResourceTemplates(
 			toolsets.NewServerResourceTemplate(GetRepositoryResourceContent(getClient, getRawClient, t)),
@@ -197,20 +225,33 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	git := toolsets.NewToolset(ToolsetMetadataGit.ID, ToolsetMetadataGit.Description).
 		AddReadTools(
 			toolsets.NewServerTool(GetRepositoryTree(getClient, t)),
+			toolsets.NewServerTool(GetRepoTree(getClient, t)),
 		)
 	issues := toolsets.NewToolset(ToolsetMetadataIssues.ID, ToolsetMetadataIssues.Description).
 		AddReadTools(
 			toolsets.NewServerTool(IssueRead(getClient, getGQLClient, cache, t, flags)),
-			toolsets.NewServerTool(SearchIssues(getClient, t)),
+			toolsets.NewServerTool(SearchIssues(getClient, t, flags)),
 			toolsets.NewServerTool(ListIssues(getGQLClient, t)),
+			toolsets.NewServerTool(ListIssueDependencies(getGQLClient, t)),
+			toolsets.NewServerTool(GetReferenceGraph(getGQLClient, t)),
+			toolsets.NewServerTool(GetEpicRollup(getGQLClient, t, flags)),
 			toolsets.NewServerTool(ListIssueTypes(getClient, t)),
 			toolsets.NewServerTool(GetLabel(getGQLClient, t)),
+			toolsets.NewServerTool(ListIssueTemplates(getClient, t)),
+			toolsets.NewServerTool(SuggestAssignee(getClient, t)),
+			toolsets.NewServerTool(ListSLABreaches(getClient, t, flags)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(IssueWrite(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CreateIssueFromTemplate(getClient, t)),
+			toolsets.NewServerTool(ClassifyAndLabelIssue(getClient, t)),
+			toolsets.NewServerTool(NotifyOnItems(getClient, t, flags)),
 			toolsets.NewServerTool(AddIssueComment(getClient, t)),
+			toolsets.NewServerTool(UpdateIssueComment(getClient, t)),
+			toolsets.NewServerTool(DeleteIssueComment(getClient, t)),
 			toolsets.NewServerTool(AssignCopilotToIssue(getGQLClient, t)),
 			toolsets.NewServerTool(SubIssueWrite(getClient, t)),
+			toolsets.NewServerTool(IssueDependencyWrite(getGQLClient, t)),
 		).AddPrompts(
 		toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)),
 		toolsets.NewServerPrompt(IssueToFixWorkflowPrompt(t)),
@@ -218,27 +259,61 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	users := toolsets.NewToolset(ToolsetMetadataUsers.ID, ToolsetMetadataUsers.Description).
 		AddReadTools(
 			toolsets.NewServerTool(SearchUsers(getClient, t)),
+			toolsets.NewServerTool(ListBlockedUsers(getClient, t)),
+			toolsets.NewServerTool(GetUserContributionReport(getGQLClient, t, flags)),
+			toolsets.NewServerTool(ListUserEvents(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(BlockUser(getClient, t)),
+			toolsets.NewServerTool(UnblockUser(getClient, t)),
 		)
 	orgs := toolsets.NewToolset(ToolsetMetadataOrgs.ID, ToolsetMetadataOrgs.Description).
 		AddReadTools(
 			toolsets.NewServerTool(SearchOrgs(getClient, t)),
+			toolsets.NewServerTool(GetInteractionLimits(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryRoles(getClient, t)),
+			toolsets.NewServerTool(GetOrgSecurityPosture(getClient, t)),
+			toolsets.NewServerTool(GetOrgMigration(getClient, t)),
+			toolsets.NewServerTool(ListOrgAppInstallations(getClient, t)),
+			toolsets.NewServerTool(ListRepoInstallations(getClient, t)),
+			toolsets.NewServerTool(ListOrgPATGrants(getClient, t)),
+			toolsets.NewServerTool(ListOrgPATRequests(getClient, t)),
+			toolsets.NewServerTool(ListOrgEvents(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(SetInteractionLimits(getClient, t)),
+			toolsets.NewServerTool(BlockOrgUser(getClient, t)),
+			toolsets.NewServerTool(UnblockOrgUser(getClient, t)),
+			toolsets.NewServerTool(AssignRepositoryRole(getClient, t)),
+			toolsets.NewServerTool(StartOrgMigration(getClient, t)),
+			toolsets.NewServerTool(ReviewOrgPATRequest(getClient, t)),
 		)
 	pullRequests := toolsets.NewToolset(ToolsetMetadataPullRequests.ID, ToolsetMetadataPullRequests.Description).
 		AddReadTools(
 			toolsets.NewServerTool(PullRequestRead(getClient, cache, t, flags)),
 			toolsets.NewServerTool(ListPullRequests(getClient, t)),
-			toolsets.NewServerTool(SearchPullRequests(getClient, t)),
+			toolsets.NewServerTool(GetPullRequestStatusSummary(getClient, t)),
+			toolsets.NewServerTool(SearchPullRequests(getClient, t, flags)),
+			toolsets.NewServerTool(ListDependencyUpdatePRs(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestLinkedIssues(getGQLClient, t)),
+			toolsets.NewServerTool(ValidateClosingReferences(getClient, t)),
+			toolsets.NewServerTool(CodeownersCoverageReport(getClient, t)),
+			toolsets.NewServerTool(SuggestReviewersFromBlame(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ListPullRequestsForCommit(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(MergePullRequest(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
-			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
+			toolsets.NewServerTool(CreatePullRequest(getClient, t, flags)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(RequestCopilotReview(getClient, t)),
+			toolsets.NewServerTool(UpdatePullRequestParticipants(getClient, t)),
+			toolsets.NewServerTool(LinkPullRequestToIssues(getClient, t)),
 
 			// Reviews
 			toolsets.NewServerTool(PullRequestReviewWrite(getGQLClient, t)),
 			toolsets.NewServerTool(AddCommentToPendingReview(getGQLClient, t)),
+			toolsets.NewServerTool(DismissPullRequestReview(getClient, t)),
 		)
 	codeSecurity := toolsets.NewToolset(ToolsetMetadataCodeSecurity.ID, ToolsetMetadataCodeSecurity.Description).
 		AddReadTools(
@@ -249,11 +324,16 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(GetSecretScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListSecretScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(GetOrgSecretExposureReport(getClient, t, flags)),
 		)
 	dependabot := toolsets.NewToolset(ToolsetMetadataDependabot.ID, ToolsetMetadataDependabot.Description).
 		AddReadTools(
 			toolsets.NewServerTool(GetDependabotAlert(getClient, t)),
 			toolsets.NewServerTool(ListDependabotAlerts(getClient, t)),
+			toolsets.NewServerTool(CheckDependencyLicenses(getClient, getGQLClient, t, flags)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateDependencyUpdatePR(getClient, t)),
 		)
 
 	notifications := toolsets.NewToolset(ToolsetMetadataNotifications.ID, ToolsetMetadataNotifications.Description).
@@ -287,6 +367,7 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(ListWorkflowRunArtifacts(getClient, t)),
 			toolsets.NewServerTool(DownloadWorkflowRunArtifact(getClient, t)),
 			toolsets.NewServerTool(GetWorkflowRunUsage(getClient, t)),
+			toolsets.NewServerTool(GetReusableWorkflowUsage(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(RunWorkflow(getClient, t)),
@@ -318,29 +399,62 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(ListGists(getClient, t)),
 			toolsets.NewServerTool(GetGist(getClient, t)),
+			toolsets.NewServerTool(LoadAgentState(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateGist(getClient, t)),
 			toolsets.NewServerTool(UpdateGist(getClient, t)),
+			toolsets.NewServerTool(SaveAgentState(getClient, t)),
 		)
 
 	projects := toolsets.NewToolset(ToolsetMetadataProjects.ID, ToolsetMetadataProjects.Description).
 		AddReadTools(
-			toolsets.NewServerTool(ListProjects(getClient, t)),
+			toolsets.NewServerTool(ListProjects(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(GetProject(getClient, t)),
-			toolsets.NewServerTool(ListProjectFields(getClient, t)),
+			toolsets.NewServerTool(ListProjectFields(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(GetProjectField(getClient, t)),
-			toolsets.NewServerTool(ListProjectItems(getClient, t)),
+			toolsets.NewServerTool(ListProjectViews(getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectView(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectWorkflows(getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectWorkflow(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectLinks(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectStatusUpdates(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectItems(getClient, t, flags)),
 			toolsets.NewServerTool(GetProjectItem(getClient, t)),
+			toolsets.NewServerTool(CheckWIPLimits(getClient, t, flags)),
+			toolsets.NewServerTool(GetExecutionOrder(getClient, getGQLClient, t, flags)),
+			toolsets.NewServerTool(GetIterationCapacityReport(getClient, t, flags)),
+			toolsets.NewServerTool(GetIterationBurndown(getClient, getGQLClient, t, flags)),
 		).
 		AddWriteTools(
+			toolsets.NewServerTool(CreateProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(DeleteProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(LinkProjectToRepository(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(LinkProjectToTeam(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CreateProjectStatusUpdate(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectStatusUpdate(getGQLClient, t)),
+			toolsets.NewServerTool(CreateProjectField(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectField(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(DeleteProjectField(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(AddProjectItem(getClient, t)),
+			toolsets.NewServerTool(AddDraftIssueToProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ConvertDraftToIssue(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(DeleteProjectItem(getClient, t)),
+			toolsets.NewServerTool(ArchiveProjectItem(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UnarchiveProjectItem(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectItemPosition(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(UpdateProjectItem(getClient, t)),
+			toolsets.NewServerTool(SetProjectItemExternalLink(getClient, t)),
+			toolsets.NewServerTool(TransitionProjectItem(getClient, t, flags)),
+			toolsets.NewServerTool(ReconcileBoardWithRepo(getClient, getGQLClient, t, flags)),
+			toolsets.NewServerTool(ComputeStatusRollup(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(MirrorProject(getClient, getGQLClient, t)),
 		)
 	stargazers := toolsets.NewToolset(ToolsetMetadataStargazers.ID, ToolsetMetadataStargazers.Description).
 		AddReadTools(
 			toolsets.NewServerTool(ListStarredRepositories(getClient, t)),
+			toolsets.NewServerTool(FindRelatedRepositories(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(StarRepository(getClient, t)),
@@ -356,6 +470,15 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddWriteTools(
 			// create or update
 			toolsets.NewServerTool(LabelWrite(getGQLClient, t)),
+			toolsets.NewServerTool(SyncLabels(getClient, t)),
+		)
+	resultStore := NewResultStore("workspace-results", DefaultWorkspaceSessionTTL)
+	workspace := toolsets.NewToolset(ToolsetMetadataWorkspace.ID, ToolsetMetadataWorkspace.Description).
+		AddReadTools(
+			toolsets.NewServerTool(GetStoredResult(resultStore, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(StoreResult(resultStore, t)),
 		)
 	// Add toolsets to the group
 	tsg.AddToolset(contextTools)
@@ -377,6 +500,14 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	tsg.AddToolset(projects)
 	tsg.AddToolset(stargazers)
 	tsg.AddToolset(labels)
+	tsg.AddToolset(workspace)
+	if flags.Models {
+		models := toolsets.NewToolset(ToolsetMetadataModels.ID, ToolsetMetadataModels.Description).
+			AddReadTools(
+				toolsets.NewServerTool(GenerateWithModel(getClient, t)),
+			)
+		tsg.AddToolset(models)
+	}
 
 	return tsg
 }
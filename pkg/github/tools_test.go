@@ -1,8 +1,14 @@
 package github
 
 import (
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/github/github-mcp-server/pkg/raw"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -280,3 +286,28 @@ func TestContainsToolset(t *testing.T) {
 		})
 	}
 }
+
+// toolsWithoutOutputSchema are the read-only tools whose result has no single shape: file contents are returned as
+// embedded resources, and the result of issue_read and pull_request_read depends on the method, including raw diffs.
+var toolsWithoutOutputSchema = map[string]bool{
+	"get_file_contents": true,
+	"issue_read":        true,
+	"pull_request_read": true,
+}
+
+func TestReadToolsHaveOutputSchema(t *testing.T) {
+	client := github.NewClient(nil)
+	gqlClient := githubv4.NewClient(nil)
+	rawClient := raw.NewClient(client, &url.URL{Scheme: "https", Host: "raw.githubusercontent.com", Path: "/"})
+	tsg := DefaultToolsetGroup(false, stubGetClientFn(client), stubGetGQLClientFn(gqlClient), stubGetRawClientFn(rawClient), translations.NullTranslationHelper, 5000, FeatureFlags{Models: true}, stubRepoAccessCache(gqlClient, time.Minute))
+
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetAvailableTools() {
+			annotations := tool.Tool.Annotations
+			if annotations.ReadOnlyHint == nil || !*annotations.ReadOnlyHint || toolsWithoutOutputSchema[tool.Tool.Name] {
+				continue
+			}
+			assert.NotEmpty(t, tool.Tool.RawOutputSchema, "read-only tool %s has no output schema", tool.Tool.Name)
+		}
+	}
+}
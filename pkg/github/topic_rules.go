@@ -0,0 +1,315 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	TopicTaggingStatusUnchanged   = "unchanged"
+	TopicTaggingStatusWouldUpdate = "would_update"
+	TopicTaggingStatusUpdated     = "updated"
+	TopicTaggingStatusError       = "error"
+)
+
+// TopicTaggingResult describes the topics added to and removed from a single repository.
+type TopicTaggingResult struct {
+	Repository string   `json:"repository"`
+	Status     string   `json:"status"`
+	Added      []string `json:"added,omitempty"`
+	Removed    []string `json:"removed,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// topicRule applies a topic to the repositories meeting all of its conditions.
+type topicRule struct {
+	Topic          string `json:"topic"`
+	Language       string `json:"language,omitempty"`
+	Path           string `json:"path,omitempty"`
+	CodeownersTeam string `json:"codeowners_team,omitempty"`
+}
+
+// topicPattern matches valid repository topics.
+var topicPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// topicRuleSchema is the JSON schema of a topic rule in tool parameters.
+var topicRuleSchema = map[string]interface{}{
+	"type":                 "object",
+	"additionalProperties": false,
+	"required":             []string{"topic"},
+	"properties": map[string]interface{}{
+		"topic": map[string]interface{}{
+			"type":        "string",
+			"description": "Topic to apply, in lowercase, e.g. 'containerized'",
+		},
+		"language": map[string]interface{}{
+			"type":        "string",
+			"description": "Primary language the repository must have, e.g. 'Go'",
+		},
+		"path": map[string]interface{}{
+			"type":        "string",
+			"description": "File or directory that must exist on the default branch, e.g. 'Dockerfile'",
+		},
+		"codeowners_team": map[string]interface{}{
+			"type":        "string",
+			"description": "Team that must be a code owner in the CODEOWNERS file, as a slug or '@org/slug'",
+		},
+	},
+}
+
+// parseTopicRules converts the rules parameter of a request to topic rules.
+func parseTopicRules(request mcp.CallToolRequest, p string) ([]topicRule, error) {
+	raw, ok := request.GetArguments()[p]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an array of rule objects", p)
+	}
+	var rules []topicRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("%s must be an array of rule objects", p)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+	for _, rule := range rules {
+		if !topicPattern.MatchString(rule.Topic) {
+			return nil, fmt.Errorf("invalid topic %q: topics are lowercase letters, numbers and hyphens, at most 50 characters", rule.Topic)
+		}
+		if rule.Language == "" && rule.Path == "" && rule.CodeownersTeam == "" {
+			return nil, fmt.Errorf("the rule for topic %q must have a language, path or codeowners_team condition", rule.Topic)
+		}
+	}
+	return rules, nil
+}
+
+// listAllOrgRepositories returns all repositories of an organization.
+func listAllOrgRepositories(ctx context.Context, client *github.Client, org string) ([]*github.Repository, *github.Response, error) {
+	var repositories []*github.Repository
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		repositories = append(repositories, page...)
+		if resp.NextPage == 0 {
+			return repositories, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// topicRuleEvaluator evaluates topic rules against a repository, fetching each file at most once.
+type topicRuleEvaluator struct {
+	client     *github.Client
+	org        string
+	repository *github.Repository
+	paths      map[string]bool
+	codeowners []CodeownersRule
+	fetched    bool
+}
+
+func (e *topicRuleEvaluator) pathExists(ctx context.Context, p string) (bool, error) {
+	if exists, ok := e.paths[p]; ok {
+		return exists, nil
+	}
+	_, _, resp, err := e.client.Repositories.GetContents(ctx, e.org, e.repository.GetName(), p, nil)
+	switch {
+	case err == nil:
+		_ = resp.Body.Close()
+		e.paths[p] = true
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		e.paths[p] = false
+	default:
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get file contents", resp, err)
+		return false, fmt.Errorf("failed to get %s: %w", p, err)
+	}
+	return e.paths[p], nil
+}
+
+func (e *topicRuleEvaluator) codeownedBy(ctx context.Context, team string) (bool, error) {
+	if !e.fetched {
+		content, _, resp, err := getCodeowners(ctx, e.client, e.org, e.repository.GetName(), "")
+		if err != nil {
+			_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get CODEOWNERS", resp, err)
+			return false, fmt.Errorf("failed to get CODEOWNERS: %w", err)
+		}
+		e.codeowners = parseCodeowners(content)
+		e.fetched = true
+	}
+	mention := "@" + strings.ToLower(strings.TrimPrefix(team, "@"))
+	if !strings.Contains(mention, "/") {
+		mention = "@" + strings.ToLower(e.org) + "/" + strings.TrimPrefix(mention, "@")
+	}
+	for _, rule := range e.codeowners {
+		for _, owner := range rule.Owners {
+			if strings.ToLower(owner) == mention {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// matches reports whether a repository meets all the conditions of a rule. Conditions are checked from the cheapest.
+func (e *topicRuleEvaluator) matches(ctx context.Context, rule topicRule) (bool, error) {
+	if rule.Language != "" && !strings.EqualFold(e.repository.GetLanguage(), rule.Language) {
+		return false, nil
+	}
+	if rule.Path != "" {
+		exists, err := e.pathExists(ctx, strings.Trim(rule.Path, "/"))
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+	if rule.CodeownersTeam != "" {
+		return e.codeownedBy(ctx, rule.CodeownersTeam)
+	}
+	return true, nil
+}
+
+// tagRepository applies the topics of the rules a repository meets and, if strip is set, removes the topics of the
+// rules it does not meet. Other topics are kept.
+func tagRepository(ctx context.Context, client *github.Client, org string, repository *github.Repository, rules []topicRule, strip, dryRun bool) TopicTaggingResult {
+	result := TopicTaggingResult{Repository: repository.GetFullName(), Status: TopicTaggingStatusUnchanged}
+	e := &topicRuleEvaluator{client: client, org: org, repository: repository, paths: map[string]bool{}}
+
+	// A topic applied by any rule is kept even when another rule for the same topic does not match.
+	matched := map[string]bool{}
+	for _, rule := range rules {
+		ok, err := e.matches(ctx, rule)
+		if err != nil {
+			result.Status = TopicTaggingStatusError
+			result.Error = err.Error()
+			return result
+		}
+		matched[rule.Topic] = matched[rule.Topic] || ok
+	}
+
+	topics := slices.Clone(repository.Topics)
+	for _, rule := range rules {
+		has := slices.Contains(topics, rule.Topic)
+		switch {
+		case matched[rule.Topic] && !has:
+			topics = append(topics, rule.Topic)
+			result.Added = append(result.Added, rule.Topic)
+		case !matched[rule.Topic] && has && strip:
+			topics = slices.DeleteFunc(topics, func(topic string) bool { return topic == rule.Topic })
+			result.Removed = append(result.Removed, rule.Topic)
+		}
+	}
+	if len(result.Added) == 0 && len(result.Removed) == 0 {
+		return result
+	}
+	if dryRun {
+		result.Status = TopicTaggingStatusWouldUpdate
+		return result
+	}
+
+	_, resp, err := client.Repositories.ReplaceAllTopics(ctx, org, repository.GetName(), topics)
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to replace topics", resp, err)
+		result.Status = TopicTaggingStatusError
+		result.Error = fmt.Sprintf("failed to replace topics: %s", err)
+		return result
+	}
+	_ = resp.Body.Close()
+	result.Status = TopicTaggingStatusUpdated
+	return result
+}
+
+// TagRepositoriesByRule creates a tool to apply and strip topics across the repositories of an organization by rule.
+func TagRepositoriesByRule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("tag_repositories_by_rule",
+			mcp.WithDescription(t("TOOL_TAG_REPOSITORIES_BY_RULE_DESCRIPTION", "Segment the repositories of an organization with topics: each rule applies a topic to the repositories meeting all its conditions, on primary language, a file or directory existing such as 'Dockerfile', or a team owning code in CODEOWNERS, and strips it from the other repositories. Archived repositories are skipped. Use dry_run to only report the changes. Returns the changes per repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_TAG_REPOSITORIES_BY_RULE_USER_TITLE", "Tag repositories by rule"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("The organization name"),
+			),
+			mcp.WithArray("rules",
+				mcp.Required(),
+				mcp.Description("Rules applying topics to repositories"),
+				mcp.Items(topicRuleSchema),
+			),
+			mcp.WithBoolean("strip_unmatched",
+				mcp.Description("Remove the topic of a rule from the repositories not meeting it"),
+				mcp.DefaultBool(true),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("When true, only report the changes without making them"),
+				mcp.DefaultBool(false),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rules, err := parseTopicRules(request, "rules")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			strip, err := OptionalBoolParamWithDefault(request, "strip_unmatched", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalBoolParamWithDefault(request, "dry_run", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repositories, resp, err := listAllOrgRepositories(ctx, client, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repositories of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+
+			results := []TopicTaggingResult{}
+			for _, repository := range repositories {
+				if repository.GetArchived() {
+					continue
+				}
+				results = append(results, tagRepository(ctx, client, org, repository, rules, strip, dryRun))
+			}
+
+			response := map[string]any{
+				"dry_run": dryRun,
+				"results": results,
+			}
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TagRepositoriesByRule(t *testing.T) {
+	tool, _ := TagRepositoriesByRule(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "tag_repositories_by_rule", tool.Name)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "rules"})
+
+	repositories := []*github.Repository{
+		{Name: github.Ptr("api"), FullName: github.Ptr("octo/api"), Language: github.Ptr("Go"), Topics: []string{"legacy"}},
+		{Name: github.Ptr("web"), FullName: github.Ptr("octo/web"), Language: github.Ptr("TypeScript"), Topics: []string{"containerized"}},
+		{Name: github.Ptr("old"), FullName: github.Ptr("octo/old"), Language: github.Ptr("Go"), Archived: github.Ptr(true)},
+	}
+	contentsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/octo/api/contents/Dockerfile":
+			mockResponse(t, http.StatusOK, &github.RepositoryContent{Type: github.Ptr("file"), Name: github.Ptr("Dockerfile")})(w, r)
+		case "/repos/octo/api/contents/.github/CODEOWNERS":
+			mockResponse(t, http.StatusOK, &github.RepositoryContent{
+				Type:     github.Ptr("file"),
+				Encoding: github.Ptr("base64"),
+				Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte("* @octo/Platform\n"))),
+			})(w, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	})
+	requestArgs := map[string]any{
+		"org": "octo",
+		"rules": []any{
+			map[string]any{"topic": "containerized", "path": "Dockerfile"},
+			map[string]any{"topic": "go", "language": "go"},
+			map[string]any{"topic": "platform", "codeowners_team": "platform"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		dryRun          bool
+		strip           bool
+		mockedClient    *http.Client
+		expectedResults []TopicTaggingResult
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			strip:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsReposByOrg, repositories),
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsHandler),
+			),
+			expectedResults: []TopicTaggingResult{
+				{Repository: "octo/api", Status: TopicTaggingStatusWouldUpdate, Added: []string{"containerized", "go", "platform"}},
+				{Repository: "octo/web", Status: TopicTaggingStatusWouldUpdate, Removed: []string{"containerized"}},
+			},
+		},
+		{
+			name:  "apply without stripping",
+			strip: false,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsReposByOrg, repositories),
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsHandler),
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					expectPath(t, "/repos/octo/api/topics").andThen(
+						expectRequestBody(t, map[string]any{"names": []any{"legacy", "containerized", "go", "platform"}}).andThen(
+							mockResponse(t, http.StatusOK, map[string]any{"names": []string{"legacy", "containerized", "go", "platform"}}),
+						),
+					),
+				),
+			),
+			expectedResults: []TopicTaggingResult{
+				{Repository: "octo/api", Status: TopicTaggingStatusUpdated, Added: []string{"containerized", "go", "platform"}},
+				{Repository: "octo/web", Status: TopicTaggingStatusUnchanged},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := TagRepositoriesByRule(stubGetClientFn(github.NewClient(tc.mockedClient)), translations.NullTranslationHelper)
+
+			args := map[string]any{"dry_run": tc.dryRun, "strip_unmatched": tc.strip}
+			for k, v := range requestArgs {
+				args[k] = v
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response struct {
+				DryRun  bool                 `json:"dry_run"`
+				Results []TopicTaggingResult `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.dryRun, response.DryRun)
+			assert.Equal(t, tc.expectedResults, response.Results)
+		})
+	}
+
+	t.Run("rule without condition", func(t *testing.T) {
+		_, handler := TagRepositoriesByRule(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+		result, err := handler(context.Background(), createMCPRequest(map[string]any{
+			"org":   "octo",
+			"rules": []any{map[string]any{"topic": "containerized"}},
+		}))
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "must have a language, path or codeowners_team condition")
+	})
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// workflowsDir is the directory of the workflows of a repository.
+const workflowsDir = ".github/workflows"
+
+// workflowUsesRe matches the reusable workflow or action and the ref of a `uses:` step or job, e.g.
+// `uses: octo/shared/.github/workflows/build.yml@v2` or `- uses: "actions/checkout@v4"`.
+var workflowUsesRe = regexp.MustCompile(`(?m)^\s*(?:-\s*)?uses:\s*["']?([^\s"'@#]+)@([^\s"'#]+)`)
+
+// WorkflowUsage is a reference of a workflow to a reusable workflow or action.
+type WorkflowUsage struct {
+	Repository string `json:"repository"`
+	Workflow   string `json:"workflow"`
+	Ref        string `json:"ref"`
+	Outdated   bool   `json:"outdated"`
+}
+
+// ReusableWorkflowUsage reports the repositories of an organization using a reusable workflow or action.
+type ReusableWorkflowUsage struct {
+	Uses string `json:"uses"`
+	// Latest is the ref the usages are compared to, empty when it is unknown.
+	Latest string          `json:"latest,omitempty"`
+	Usages []WorkflowUsage `json:"usages"`
+	// OutdatedRepositories are the repositories with at least one usage of another ref than latest.
+	OutdatedRepositories []string `json:"outdated_repositories"`
+	RepositoriesScanned  int      `json:"repositories_scanned"`
+}
+
+// findWorkflowUsages returns the refs of the references to uses in a workflow.
+func findWorkflowUsages(content, uses string) []string {
+	refs := []string{}
+	for _, match := range workflowUsesRe.FindAllStringSubmatch(content, -1) {
+		if strings.EqualFold(match[1], uses) {
+			refs = append(refs, match[2])
+		}
+	}
+	return refs
+}
+
+// listWorkflowFiles returns the paths of the workflows of a repository, or none when it has no workflows directory.
+func listWorkflowFiles(ctx context.Context, client *github.Client, owner, repo string) ([]string, *github.Response, error) {
+	_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflowsDir, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, resp, nil
+		}
+		return nil, resp, err
+	}
+	_ = resp.Body.Close()
+	var paths []string
+	for _, entry := range entries {
+		if ext := path.Ext(entry.GetName()); entry.GetType() == "file" && (ext == ".yml" || ext == ".yaml") {
+			paths = append(paths, entry.GetPath())
+		}
+	}
+	return paths, resp, nil
+}
+
+// GetReusableWorkflowUsage creates a tool to report which repositories of an organization use a reusable workflow or
+// action, and which of them are not on its latest version.
+func GetReusableWorkflowUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_reusable_workflow_usage",
+			mcp.WithDescription(t("TOOL_GET_REUSABLE_WORKFLOW_USAGE_DESCRIPTION", "Scan the workflows of the repositories of an organization for references to a reusable workflow or action, and report the ref each one uses and which repositories are not on the latest ref, e.g. to drive an upgrade campaign. Archived repositories are skipped.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REUSABLE_WORKFLOW_USAGE_USER_TITLE", "Get reusable workflow usage"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[ReusableWorkflowUsage](),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization whose repositories to scan"),
+			),
+			mcp.WithString("uses",
+				mcp.Required(),
+				mcp.Description("The reusable workflow or action without ref, as referenced in 'uses:', e.g. 'octo/shared/.github/workflows/build.yml' or 'actions/checkout'"),
+			),
+			mcp.WithString("latest",
+				mcp.Description("The up-to-date ref, e.g. 'v3'. Defaults to the tag of the latest release of the repository of the workflow or action"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			uses, err := RequiredParam[string](request, "uses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			latest, err := OptionalParam[string](request, "latest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			usesParts := strings.Split(uses, "/")
+			if len(usesParts) < 2 || strings.Contains(uses, "@") {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid uses: %s, expected owner/repo[/path] without ref", uses)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if latest == "" {
+				release, resp, err := client.Repositories.GetLatestRelease(ctx, usesParts[0], usesParts[1])
+				switch {
+				case err == nil:
+					_ = resp.Body.Close()
+					latest = release.GetTagName()
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					// Without releases, the usages are reported without comparing them.
+				default:
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get latest release of %s/%s", usesParts[0], usesParts[1]),
+						resp,
+						err,
+					), nil
+				}
+			}
+
+			repositories, resp, err := listAllOrgRepositories(ctx, client, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repositories of organization '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+
+			result := ReusableWorkflowUsage{Uses: uses, Latest: latest, Usages: []WorkflowUsage{}, OutdatedRepositories: []string{}}
+			outdated := map[string]bool{}
+			for _, repository := range repositories {
+				if repository.GetArchived() {
+					continue
+				}
+				result.RepositoriesScanned++
+				owner, repo := repository.GetOwner().GetLogin(), repository.GetName()
+				workflows, resp, err := listWorkflowFiles(ctx, client, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list workflows of %s", repository.GetFullName()),
+						resp,
+						err,
+					), nil
+				}
+				for _, workflow := range workflows {
+					file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflow, nil)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to get %s of %s", workflow, repository.GetFullName()),
+							resp,
+							err,
+						), nil
+					}
+					_ = resp.Body.Close()
+					content, err := file.GetContent()
+					if err != nil {
+						return nil, fmt.Errorf("failed to decode %s of %s: %w", workflow, repository.GetFullName(), err)
+					}
+					for _, ref := range findWorkflowUsages(content, uses) {
+						usage := WorkflowUsage{
+							Repository: repository.GetFullName(),
+							Workflow:   workflow,
+							Ref:        ref,
+							Outdated:   latest != "" && ref != latest,
+						}
+						if usage.Outdated {
+							outdated[usage.Repository] = true
+						}
+						result.Usages = append(result.Usages, usage)
+					}
+				}
+			}
+			for repository := range outdated {
+				result.OutdatedRepositories = append(result.OutdatedRepositories, repository)
+			}
+			sort.Strings(result.OutdatedRepositories)
+
+			return MarshalledStructuredResult(result), nil
+		}
+}
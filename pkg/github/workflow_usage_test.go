@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findWorkflowUsages(t *testing.T) {
+	content := `jobs:
+  build:
+    uses: octo/shared/.github/workflows/build.yml@v2
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: "actions/checkout@v4" # pinned
+      - uses: Actions/Checkout@8f4b7f84864484a7bf31766abe9204da3cbe65b3
+      - uses: actions/checkout-extra@v1
+      - run: echo "uses: actions/checkout@v1"
+`
+	assert.Equal(t, []string{"v4", "8f4b7f84864484a7bf31766abe9204da3cbe65b3"}, findWorkflowUsages(content, "actions/checkout"))
+	assert.Equal(t, []string{"v2"}, findWorkflowUsages(content, "octo/shared/.github/workflows/build.yml"))
+	assert.Equal(t, []string{}, findWorkflowUsages(content, "actions/setup-go"))
+}
+
+func Test_GetReusableWorkflowUsage(t *testing.T) {
+	tool, _ := GetReusableWorkflowUsage(stubGetClientFn(github.NewClient(nil)), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "uses"})
+
+	owner := &github.User{Login: github.Ptr("octo")}
+	repositories := []*github.Repository{
+		{Name: github.Ptr("api"), FullName: github.Ptr("octo/api"), Owner: owner},
+		{Name: github.Ptr("web"), FullName: github.Ptr("octo/web"), Owner: owner},
+		{Name: github.Ptr("docs"), FullName: github.Ptr("octo/docs"), Owner: owner},
+		{Name: github.Ptr("old"), FullName: github.Ptr("octo/old"), Owner: owner, Archived: github.Ptr(true)},
+	}
+	file := func(content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		}
+	}
+	entry := func(p string) *github.RepositoryContent {
+		return &github.RepositoryContent{Type: github.Ptr("file"), Name: github.Ptr(p[len(workflowsDir)+1:]), Path: github.Ptr(p)}
+	}
+	contentsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/octo/api/contents/.github/workflows":
+			mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+				entry(".github/workflows/ci.yml"),
+				entry(".github/workflows/README.md"),
+			})(w, r)
+		case "/repos/octo/api/contents/.github/workflows/ci.yml":
+			mockResponse(t, http.StatusOK, file("jobs:\n  build:\n    uses: octo/shared/.github/workflows/build.yml@v1\n"))(w, r)
+		case "/repos/octo/web/contents/.github/workflows":
+			mockResponse(t, http.StatusOK, []*github.RepositoryContent{entry(".github/workflows/build.yaml")})(w, r)
+		case "/repos/octo/web/contents/.github/workflows/build.yaml":
+			mockResponse(t, http.StatusOK, file("jobs:\n  build:\n    uses: octo/shared/.github/workflows/build.yml@v2\n"))(w, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	})
+
+	tests := []struct {
+		name        string
+		latest      string
+		releaseMock mock.MockBackendOption
+		expected    ReusableWorkflowUsage
+	}{
+		{
+			name:        "latest release",
+			releaseMock: mock.WithRequestMatch(mock.GetReposReleasesLatestByOwnerByRepo, &github.RepositoryRelease{TagName: github.Ptr("v2")}),
+			expected: ReusableWorkflowUsage{
+				Uses:   "octo/shared/.github/workflows/build.yml",
+				Latest: "v2",
+				Usages: []WorkflowUsage{
+					{Repository: "octo/api", Workflow: ".github/workflows/ci.yml", Ref: "v1", Outdated: true},
+					{Repository: "octo/web", Workflow: ".github/workflows/build.yaml", Ref: "v2"},
+				},
+				OutdatedRepositories: []string{"octo/api"},
+				RepositoriesScanned:  3,
+			},
+		},
+		{
+			name:   "explicit latest ref",
+			latest: "v3",
+			expected: ReusableWorkflowUsage{
+				Uses:   "octo/shared/.github/workflows/build.yml",
+				Latest: "v3",
+				Usages: []WorkflowUsage{
+					{Repository: "octo/api", Workflow: ".github/workflows/ci.yml", Ref: "v1", Outdated: true},
+					{Repository: "octo/web", Workflow: ".github/workflows/build.yaml", Ref: "v2", Outdated: true},
+				},
+				OutdatedRepositories: []string{"octo/api", "octo/web"},
+				RepositoriesScanned:  3,
+			},
+		},
+		{
+			name: "no releases",
+			releaseMock: mock.WithRequestMatchHandler(
+				mock.GetReposReleasesLatestByOwnerByRepo,
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			),
+			expected: ReusableWorkflowUsage{
+				Uses: "octo/shared/.github/workflows/build.yml",
+				Usages: []WorkflowUsage{
+					{Repository: "octo/api", Workflow: ".github/workflows/ci.yml", Ref: "v1"},
+					{Repository: "octo/web", Workflow: ".github/workflows/build.yaml", Ref: "v2"},
+				},
+				OutdatedRepositories: []string{},
+				RepositoriesScanned:  3,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			options := []mock.MockBackendOption{
+				mock.WithRequestMatch(mock.GetOrgsReposByOrg, repositories),
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsHandler),
+			}
+			if tc.releaseMock != nil {
+				options = append(options, tc.releaseMock)
+			}
+			client := github.NewClient(mock.NewMockedHTTPClient(options...))
+			_, handler := GetReusableWorkflowUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]any{"org": "octo", "uses": "octo/shared/.github/workflows/build.yml"}
+			if tc.latest != "" {
+				args["latest"] = tc.latest
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+			require.False(t, result.IsError, getTextResult(t, result).Text)
+
+			var response ReusableWorkflowUsage
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+			assert.Equal(t, tc.expected, response)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/muesli/cache2go"
+)
+
+const (
+	// DefaultWorkspaceSessionTTL is how long the stored results of an idle session are kept.
+	DefaultWorkspaceSessionTTL = time.Hour
+	// MaxStoredResultsPerSession bounds the number of results a session can store at once.
+	MaxStoredResultsPerSession = 20
+	// MaxStoredResultSize bounds the size in bytes of a single stored result.
+	MaxStoredResultSize = 1 << 20
+
+	// defaultWorkspaceSession is used when the request is not associated with a client session.
+	defaultWorkspaceSession = "default"
+)
+
+// StoredResult is a result stashed by an agent for later analysis.
+type StoredResult struct {
+	Key         string    `json:"key"`
+	Description string    `json:"description,omitempty"`
+	Size        int       `json:"size"`
+	StoredAt    time.Time `json:"stored_at"`
+	Data        string    `json:"-"`
+}
+
+// StoredResultContent is the list of stored results when no key is given, the data stored under a key, or a slice
+// of it when the data is a JSON array.
+type StoredResultContent struct {
+	Results []*StoredResult `json:"results,omitempty"`
+	Key     string          `json:"key,omitempty"`
+	Data    string          `json:"data,omitempty"`
+	Total   *int            `json:"total,omitempty"`
+	Offset  *int            `json:"offset,omitempty"`
+	// Items is the requested slice of the JSON array.
+	Items json.RawMessage `json:"items,omitempty"`
+}
+
+type workspaceSession struct {
+	mu      sync.Mutex
+	results map[string]*StoredResult
+}
+
+// ResultStore keeps stored results in memory, scoped to the MCP session that stored them.
+type ResultStore struct {
+	cache *cache2go.CacheTable
+	ttl   time.Duration
+}
+
+// NewResultStore creates a result store backed by the named cache table. Sessions are evicted after being idle for ttl.
+func NewResultStore(name string, ttl time.Duration) *ResultStore {
+	return &ResultStore{
+		cache: cache2go.Cache(name),
+		ttl:   ttl,
+	}
+}
+
+func (s *ResultStore) session(ctx context.Context) *workspaceSession {
+	id := defaultWorkspaceSession
+	if session := server.ClientSessionFromContext(ctx); session != nil && session.SessionID() != "" {
+		id = session.SessionID()
+	}
+	// Accessing an item extends its lifespan, so sessions only expire once idle.
+	for {
+		if item, err := s.cache.Value(id); err == nil {
+			return item.Data().(*workspaceSession)
+		}
+		s.cache.NotFoundAdd(id, s.ttl, &workspaceSession{results: map[string]*StoredResult{}})
+	}
+}
+
+// Store saves a result for the session of ctx, replacing any result stored under the same key.
+func (s *ResultStore) Store(ctx context.Context, key, description, data string) (*StoredResult, int, error) {
+	if len(data) > MaxStoredResultSize {
+		return nil, 0, fmt.Errorf("result is %d bytes, which exceeds the limit of %d bytes", len(data), MaxStoredResultSize)
+	}
+
+	session := s.session(ctx)
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if _, exists := session.results[key]; !exists && len(session.results) >= MaxStoredResultsPerSession {
+		return nil, 0, fmt.Errorf("cannot store more than %d results per session, overwrite an existing key instead", MaxStoredResultsPerSession)
+	}
+	result := &StoredResult{
+		Key:         key,
+		Description: description,
+		Size:        len(data),
+		StoredAt:    time.Now(),
+		Data:        data,
+	}
+	session.results[key] = result
+	return result, len(session.results), nil
+}
+
+// Get returns the result stored under key for the session of ctx.
+func (s *ResultStore) Get(ctx context.Context, key string) (*StoredResult, bool) {
+	session := s.session(ctx)
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	result, ok := session.results[key]
+	return result, ok
+}
+
+// List returns the results stored for the session of ctx, ordered by key.
+func (s *ResultStore) List(ctx context.Context) []*StoredResult {
+	session := s.session(ctx)
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	results := make([]*StoredResult, 0, len(session.results))
+	for _, result := range session.results {
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Key < results[j].Key })
+	return results
+}
+
+// StoreResult creates a tool to stash a result in the session workspace.
+func StoreResult(store *ResultStore, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("store_result",
+			mcp.WithDescription(t("TOOL_STORE_RESULT_DESCRIPTION", fmt.Sprintf("Store a result, such as a fully paginated list of items, in the in-memory workspace of the current session so it can be analyzed several times without fetching it from GitHub again. Results are kept until the session is idle for an hour; at most %d results of up to %d bytes each can be stored.", MaxStoredResultsPerSession, MaxStoredResultSize))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_STORE_RESULT_USER_TITLE", "Store result in workspace"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("Key to store the result under. An existing result with the same key is replaced"),
+			),
+			mcp.WithString("data",
+				mcp.Required(),
+				mcp.Description("Result to store, typically the JSON output of another tool"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Short description of the result, e.g. the query used to produce it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := RequiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			data, err := RequiredParam[string](request, "data")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result, count, err := store.Store(ctx, key, description, data)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"key":            result.Key,
+				"size":           result.Size,
+				"stored_results": count,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetStoredResult creates a tool to read a result from the session workspace.
+func GetStoredResult(store *ResultStore, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_stored_result",
+			mcp.WithDescription(t("TOOL_GET_STORED_RESULT_DESCRIPTION", "Get a result previously stored in the workspace of the current session with store_result. Omit the key to list the stored results. When the stored result is a JSON array, offset and limit can be used to read a slice of it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_STORED_RESULT_USER_TITLE", "Get stored result from workspace"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			WithOutputSchema[StoredResultContent](),
+			mcp.WithString("key",
+				mcp.Description("Key of the stored result. If omitted, the keys, descriptions and sizes of all stored results are listed"),
+			),
+			mcp.WithNumber("offset",
+				mcp.Description("Index of the first element to return when the stored result is a JSON array"),
+				mcp.Min(0),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of elements to return when the stored result is a JSON array"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := OptionalParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			offset, err := OptionalIntParam(request, "offset")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParam(request, "limit")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if offset < 0 || limit < 0 {
+				return mcp.NewToolResultError("offset and limit must not be negative"), nil
+			}
+
+			// The text content keeps the list of stored results and the stored data as they are, for clients that
+			// do not support structured content.
+			if key == "" {
+				results := store.List(ctx)
+				r, err := json.Marshal(results)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultStructured(StoredResultContent{Results: results}, string(r)), nil
+			}
+
+			result, ok := store.Get(ctx, key)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("no result stored under key %q", key)), nil
+			}
+			if offset == 0 && limit == 0 {
+				return mcp.NewToolResultStructured(StoredResultContent{Key: key, Data: result.Data}, result.Data), nil
+			}
+
+			var items []json.RawMessage
+			if err := json.Unmarshal([]byte(result.Data), &items); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("result stored under key %q is not a JSON array, offset and limit cannot be used", key)), nil
+			}
+			total := len(items)
+			start := min(offset, total)
+			end := total
+			if limit > 0 {
+				end = min(start+limit, total)
+			}
+
+			slice, err := json.Marshal(items[start:end])
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+			content := StoredResultContent{Key: key, Total: &total, Offset: &start, Items: slice}
+
+			return MarshalledStructuredResult(content), nil
+		}
+}
@@ -0,0 +1,223 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClientSession struct {
+	id string
+}
+
+func (s fakeClientSession) Initialize()       {}
+func (s fakeClientSession) Initialized() bool { return true }
+func (s fakeClientSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification)
+}
+func (s fakeClientSession) SessionID() string { return s.id }
+
+func withSession(id string) context.Context {
+	return server.NewMCPServer("test", "0.0.1").WithContext(context.Background(), fakeClientSession{id: id})
+}
+
+func Test_StoreResult(t *testing.T) {
+	store := NewResultStore(t.Name(), time.Minute)
+	tool, _ := StoreResult(store, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "store_result", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "key")
+	assert.Contains(t, tool.InputSchema.Properties, "data")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"key", "data"})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedCount  float64
+	}{
+		{
+			name:          "store result",
+			requestArgs:   map[string]interface{}{"key": "issues", "data": `[{"number":1},{"number":2}]`, "description": "open issues"},
+			expectedCount: 1,
+		},
+		{
+			name:          "overwrite existing key",
+			requestArgs:   map[string]interface{}{"key": "issues", "data": `[{"number":3}]`},
+			expectedCount: 1,
+		},
+		{
+			name:          "store second result",
+			requestArgs:   map[string]interface{}{"key": "pulls", "data": `[]`},
+			expectedCount: 2,
+		},
+		{
+			name:           "result too large",
+			requestArgs:    map[string]interface{}{"key": "big", "data": strings.Repeat("x", MaxStoredResultSize+1)},
+			expectError:    true,
+			expectedErrMsg: "exceeds the limit",
+		},
+		{
+			name:           "missing data",
+			requestArgs:    map[string]interface{}{"key": "issues"},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: data",
+		},
+	}
+
+	ctx := withSession("store-session")
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := StoreResult(store, translations.NullTranslationHelper)
+			result, err := handler(ctx, createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.requestArgs["key"], response["key"])
+			assert.Equal(t, float64(len(tc.requestArgs["data"].(string))), response["size"])
+			assert.Equal(t, tc.expectedCount, response["stored_results"])
+		})
+	}
+}
+
+func Test_StoreResult_SessionLimit(t *testing.T) {
+	store := NewResultStore(t.Name(), time.Minute)
+	ctx := withSession("limit-session")
+	for i := 0; i < MaxStoredResultsPerSession; i++ {
+		_, _, err := store.Store(ctx, strings.Repeat("k", i+1), "", "data")
+		require.NoError(t, err)
+	}
+
+	_, _, err := store.Store(ctx, "one-too-many", "", "data")
+	require.ErrorContains(t, err, "cannot store more than")
+
+	// Overwriting an existing key is still allowed.
+	_, count, err := store.Store(ctx, "k", "", "new data")
+	require.NoError(t, err)
+	assert.Equal(t, MaxStoredResultsPerSession, count)
+}
+
+func Test_GetStoredResult(t *testing.T) {
+	store := NewResultStore(t.Name(), time.Minute)
+	tool, _ := GetStoredResult(store, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_stored_result", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "key")
+	assert.Contains(t, tool.InputSchema.Properties, "offset")
+	assert.Contains(t, tool.InputSchema.Properties, "limit")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	ctx := withSession("get-session")
+	_, _, err := store.Store(ctx, "issues", "open issues", `[{"number":1},{"number":2},{"number":3}]`)
+	require.NoError(t, err)
+	_, _, err = store.Store(ctx, "summary", "", "3 open issues")
+	require.NoError(t, err)
+	_, _, err = store.Store(withSession("other-session"), "secret", "", "not visible")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedText   string
+	}{
+		{
+			name:         "get full result",
+			requestArgs:  map[string]interface{}{"key": "summary"},
+			expectedText: "3 open issues",
+		},
+		{
+			name:         "get slice of array",
+			requestArgs:  map[string]interface{}{"key": "issues", "offset": float64(1), "limit": float64(1)},
+			expectedText: `{"items":[{"number":2}],"key":"issues","offset":1,"total":3}`,
+		},
+		{
+			name:         "offset beyond end",
+			requestArgs:  map[string]interface{}{"key": "issues", "offset": float64(10)},
+			expectedText: `{"items":[],"key":"issues","offset":3,"total":3}`,
+		},
+		{
+			name:           "negative offset",
+			requestArgs:    map[string]interface{}{"key": "issues", "offset": float64(-1)},
+			expectError:    true,
+			expectedErrMsg: "must not be negative",
+		},
+		{
+			name:           "negative limit",
+			requestArgs:    map[string]interface{}{"key": "issues", "limit": float64(-2)},
+			expectError:    true,
+			expectedErrMsg: "must not be negative",
+		},
+		{
+			name:           "slice of non-array result",
+			requestArgs:    map[string]interface{}{"key": "summary", "limit": float64(1)},
+			expectError:    true,
+			expectedErrMsg: "is not a JSON array",
+		},
+		{
+			name:           "result of another session",
+			requestArgs:    map[string]interface{}{"key": "secret"},
+			expectError:    true,
+			expectedErrMsg: `no result stored under key "secret"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetStoredResult(store, translations.NullTranslationHelper)
+			result, err := handler(ctx, createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if strings.HasPrefix(tc.expectedText, "{") {
+				assert.JSONEq(t, tc.expectedText, textContent.Text)
+			} else {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+			}
+		})
+	}
+
+	t.Run("list stored results", func(t *testing.T) {
+		_, handler := GetStoredResult(store, translations.NullTranslationHelper)
+		result, err := handler(ctx, createMCPRequest(map[string]interface{}{}))
+		require.NoError(t, err)
+
+		var stored []StoredResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &stored))
+		require.Len(t, stored, 2)
+		assert.Equal(t, "issues", stored[0].Key)
+		assert.Equal(t, "open issues", stored[0].Description)
+		assert.Equal(t, "summary", stored[1].Key)
+		assert.Equal(t, len("3 open issues"), stored[1].Size)
+	})
+}
@@ -0,0 +1,168 @@
+package gqlbudget
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultWindow is how long the points of a session's budget last before they are replenished, matching the
+	// hourly GraphQL rate limit of GitHub.
+	DefaultWindow = time.Hour
+
+	// defaultSession is used when a request is not associated with a client session.
+	defaultSession = "default"
+)
+
+// ExceededError is returned for a query that would exceed the budget of its session.
+type ExceededError struct {
+	Cost      int
+	Remaining int
+	Budget    int
+	Reset     time.Time
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("GraphQL budget exceeded: the query would cost an estimated %d points, but only %d of this session's %d points remain until %s. Narrow the query, e.g. by requesting fewer items per page, or wait for the budget to reset",
+		e.Cost, e.Remaining, e.Budget, e.Reset.UTC().Format(time.RFC3339))
+}
+
+type usage struct {
+	points int
+	reset  time.Time
+}
+
+// Budget limits the estimated GraphQL points each MCP session can spend per window. It is safe for concurrent use.
+type Budget struct {
+	points int
+	window time.Duration
+	now    func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*usage
+}
+
+// NewBudget creates a budget of the given number of points per session per window.
+func NewBudget(points int, window time.Duration) *Budget {
+	return &Budget{
+		points:   points,
+		window:   window,
+		now:      time.Now,
+		sessions: map[string]*usage{},
+	}
+}
+
+// spend charges cost points to the budget of a session, or returns an ExceededError without charging them if the
+// session does not have enough points left. It returns the usage the points were charged to, for refund.
+func (b *Budget) spend(session string, cost int) (*usage, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	u, ok := b.sessions[session]
+	if !ok || !now.Before(u.reset) {
+		u = &usage{reset: now.Add(b.window)}
+		b.sessions[session] = u
+	}
+	if u.points+cost > b.points {
+		return nil, &ExceededError{Cost: cost, Remaining: b.points - u.points, Budget: b.points, Reset: u.reset}
+	}
+	u.points += cost
+	return u, nil
+}
+
+// refund gives back points charged by spend, unless the window they were charged to has since been replenished.
+func (b *Budget) refund(session string, u *usage, cost int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.sessions[session] == u {
+		u.points -= cost
+	}
+}
+
+// Transport wraps the http.RoundTripper of a GraphQL client to estimate the cost of every query and reject the ones
+// exceeding the budget of the session they are made for.
+func (b *Budget) Transport(transport http.RoundTripper) http.RoundTripper {
+	return &transportWithBudget{budget: b, transport: transport}
+}
+
+type transportWithBudget struct {
+	budget    *Budget
+	transport http.RoundTripper
+}
+
+func (t *transportWithBudget) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		return t.transport.RoundTrip(req)
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var payload struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables"`
+	}
+	session := defaultSession
+	var charged *usage
+	cost := 0
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Query != "" {
+		if s := server.ClientSessionFromContext(req.Context()); s != nil && s.SessionID() != "" {
+			session = s.SessionID()
+		}
+		cost = Estimate(payload.Query, payload.Variables)
+		if charged, err = t.budget.spend(session, cost); err != nil {
+			return nil, err
+		}
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	resp, err := t.transport.RoundTrip(req)
+	if charged != nil {
+		var rejected bool
+		if resp, rejected = isRejected(resp, err); rejected {
+			t.budget.refund(session, charged, cost)
+		}
+	}
+	return resp, err
+}
+
+// isRejected reports whether a query was not executed: the round trip failed, GitHub responded with an error
+// status, or it rejected the query with errors and no data, e.g. because the query is invalid. As the response body
+// is read to tell, the returned response must be used in place of the given one.
+func isRejected(resp *http.Response, err error) (*http.Response, bool) {
+	if err != nil || resp == nil {
+		return resp, true
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp, true
+	}
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return resp, false
+	}
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return resp, false
+	}
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []any           `json:"errors"`
+	}
+	if json.Unmarshal(body, &result) != nil {
+		return resp, false
+	}
+	return resp, len(result.Errors) > 0 && (len(result.Data) == 0 || string(result.Data) == "null")
+}
@@ -0,0 +1,159 @@
+package gqlbudget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type fakeClientSession struct {
+	id string
+}
+
+func (s fakeClientSession) Initialize()       {}
+func (s fakeClientSession) Initialized() bool { return true }
+func (s fakeClientSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification, 1)
+}
+func (s fakeClientSession) SessionID() string { return s.id }
+
+func sessionContext(id string) context.Context {
+	return server.NewMCPServer("test", "0.0.1").WithContext(context.Background(), fakeClientSession{id: id})
+}
+
+func TestTransport(t *testing.T) {
+	// Costs 6 points: 1 + 50 + 500 requests.
+	query := `query{viewer{repositories(first: 50){nodes{issues(first: 10){nodes{comments(first: 20){nodes{body}}}}}}}}`
+	payload, err := json.Marshal(map[string]any{"query": query})
+	require.NoError(t, err)
+
+	var received []string
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		received = append(received, string(body))
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	budget := NewBudget(15, time.Hour)
+	budget.now = func() time.Time { return now }
+	client := &http.Client{Transport: budget.Transport(transport)}
+
+	post := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.github.com/graphql", strings.NewReader(string(payload)))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	alice, bob := sessionContext("alice"), sessionContext("bob")
+	require.NoError(t, post(alice))
+	require.NoError(t, post(alice))
+	err = post(alice)
+	var exceeded *ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, &ExceededError{Cost: 6, Remaining: 3, Budget: 15, Reset: now.Add(time.Hour)}, exceeded)
+	assert.Contains(t, err.Error(), "GraphQL budget exceeded: the query would cost an estimated 6 points, but only 3 of this session's 15 points remain until 2024-05-01T13:00:00Z")
+
+	// Other sessions have budgets of their own.
+	require.NoError(t, post(bob))
+
+	// The budget is replenished after the window.
+	now = now.Add(time.Hour)
+	require.NoError(t, post(alice))
+
+	assert.Len(t, received, 4)
+	for _, body := range received {
+		assert.JSONEq(t, string(payload), body)
+	}
+}
+
+func TestTransport_RefundsRejectedQueries(t *testing.T) {
+	// Costs 6 points, like the query of TestTransport.
+	query := `query{viewer{repositories(first: 50){nodes{issues(first: 10){nodes{comments(first: 20){nodes{body}}}}}}}}`
+	payload, err := json.Marshal(map[string]any{"query": query})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		response func() (*http.Response, error)
+		refunded bool
+	}{
+		{
+			name:     "transport error",
+			response: func() (*http.Response, error) { return nil, errors.New("connection reset") },
+			refunded: true,
+		},
+		{
+			name: "error status",
+			response: func() (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, nil
+			},
+			refunded: true,
+		},
+		{
+			name: "invalid query",
+			response: func() (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"errors":[{"message":"Field 'foo' doesn't exist"}]}`))}, nil
+			},
+			refunded: true,
+		},
+		{
+			name: "partial data",
+			response: func() (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"data":{"viewer":null},"errors":[{"message":"Not Found"}]}`))}, nil
+			},
+			refunded: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			budget := NewBudget(10, time.Hour)
+			client := &http.Client{Transport: budget.Transport(roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+				return tc.response()
+			}))}
+			post := func() {
+				req, err := http.NewRequestWithContext(sessionContext("alice"), http.MethodPost, "https://api.github.com/graphql", strings.NewReader(string(payload)))
+				require.NoError(t, err)
+				resp, err := client.Do(req)
+				if err == nil {
+					// The body must still be readable after the transport inspected it.
+					_, readErr := io.ReadAll(resp.Body)
+					assert.NoError(t, readErr)
+					_ = resp.Body.Close()
+				}
+			}
+
+			post()
+			budget.mu.Lock()
+			spent := budget.sessions["alice"].points
+			budget.mu.Unlock()
+			if tc.refunded {
+				assert.Equal(t, 0, spent)
+			} else {
+				assert.Equal(t, 6, spent)
+			}
+		})
+	}
+}
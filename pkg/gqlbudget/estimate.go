@@ -0,0 +1,115 @@
+// Package gqlbudget estimates the rate limit cost of GraphQL queries and enforces a point budget per MCP session, so
+// that a runaway agent cannot exhaust the hourly GraphQL quota of the token.
+package gqlbudget
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+)
+
+// maxConnectionSize is the page size assumed for connections whose size cannot be determined.
+const maxConnectionSize = 100
+
+// connectionSizePattern matches first and last arguments, but not variables named $first or $last in the definition
+// of an operation.
+var connectionSizePattern = regexp.MustCompile(`(?:^|[^$\w])(?:first|last)\s*:\s*(\d+|\$\w+)`)
+
+var stringPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// Estimate calculates the rate limit cost of a GraphQL query the way GitHub does: every connection costs as many
+// requests as it is fetched for, which is the product of the sizes of the connections it is nested in. The cost is
+// the total number of requests divided by 100 and rounded, and at least 1.
+// See https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api.
+func Estimate(query string, variables map[string]any) int {
+	requests := 0.0
+	// multipliers holds, for every enclosing selection set, the number of times it is fetched.
+	multipliers := []float64{1}
+	// next is the multiplier of the selection set that follows the arguments of a connection.
+	next := 0.0
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; c {
+		case '"':
+			i = skipString(query, i)
+		case '(':
+			end := skipArguments(query, i)
+			top := multipliers[len(multipliers)-1]
+			if size, ok := connectionSize(query[i:end], variables); ok {
+				requests += top
+				next = top * size
+			}
+			i = end - 1
+		case '{':
+			multiplier := multipliers[len(multipliers)-1]
+			if next > 0 {
+				multiplier = next
+			}
+			multipliers = append(multipliers, multiplier)
+			next = 0
+		case '}':
+			if len(multipliers) > 1 {
+				multipliers = multipliers[:len(multipliers)-1]
+			}
+			next = 0
+		case ' ', '\t', '\n', '\r', ',':
+		default:
+			next = 0
+		}
+	}
+	return max(1, int(math.Round(requests/100)))
+}
+
+// connectionSize returns the number of nodes requested by the first or last argument in args, if there is one.
+func connectionSize(args string, variables map[string]any) (float64, bool) {
+	match := connectionSizePattern.FindStringSubmatch(stringPattern.ReplaceAllString(args, `""`))
+	if match == nil {
+		return 0, false
+	}
+	value := match[1]
+	if value[0] != '$' {
+		var size float64
+		if err := json.Unmarshal([]byte(value), &size); err == nil {
+			return size, true
+		}
+		return maxConnectionSize, true
+	}
+	switch size := variables[value[1:]].(type) {
+	case float64:
+		return size, true
+	case int:
+		return float64(size), true
+	}
+	return maxConnectionSize, true
+}
+
+// skipString returns the index of the quote closing the string starting at query[start].
+func skipString(query string, start int) int {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(query)
+}
+
+// skipArguments returns the index after the parenthesis closing the arguments starting at query[start].
+func skipArguments(query string, start int) int {
+	depth := 0
+	for i := start; i < len(query); i++ {
+		switch query[i] {
+		case '"':
+			i = skipString(query, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(query)
+}
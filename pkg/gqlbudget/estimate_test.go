@@ -0,0 +1,59 @@
+package gqlbudget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		expected  int
+	}{
+		{
+			name:     "query without connections costs one point",
+			query:    `query($login:String!){organization(login: $login){id}}`,
+			expected: 1,
+		},
+		{
+			name:     "single connection",
+			query:    `query{repository(owner: "octo-org", name: "repo"){issues(first: 100){nodes{id}}}}`,
+			expected: 1,
+		},
+		{
+			name:     "nested connections multiply",
+			query:    `query{repository(owner: "octo-org", name: "repo"){issues(first: 100){nodes{labels(first: 50){nodes{name}}}}}}`,
+			expected: 1, // 1 + 100 requests
+		},
+		{
+			name:     "deeply nested connections",
+			query:    `query{viewer{repositories(first: 50){nodes{issues(first: 10){nodes{comments(first: 20){nodes{body}}}}}}}}`,
+			expected: 6, // 1 + 50 + 500 requests
+		},
+		{
+			name:      "connection sizes from variables",
+			query:     `query($first:Int!$last:Int){viewer{repositories(first: $first){nodes{pullRequests(last: $last){nodes{id}}}}}}`,
+			variables: map[string]any{"first": float64(100), "last": float64(100)},
+			expected:  1, // 1 + 100 requests
+		},
+		{
+			name:     "unknown connection sizes count as 100",
+			query:    `query($first:Int){viewer{repositories(first: $first){nodes{issues(first: $first){nodes{labels(first: 100){nodes{id}}}}}}}}`,
+			expected: 101, // 1 + 100 + 10000 requests
+		},
+		{
+			name:     "sibling connections add up",
+			query:    `query{a:search(type: ISSUE, query: "is:open (label:bug)", first: 100){nodes{...on Issue{labels(first: 100){nodes{id}}}}} b:search(type: ISSUE, query: "{first: 5}", first: 100){nodes{...on Issue{labels(first: 100){nodes{id}}}}}}`,
+			expected: 2, // 2 * (1 + 100) requests
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Estimate(tc.query, tc.variables))
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Package metrics collects operational metrics of the MCP server and exposes them in the Prometheus text format.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const namespace = "github_mcp"
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the GitHub API latency histogram.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type toolStats struct {
+	calls   uint64
+	errors  uint64
+	seconds float64
+}
+
+type histogram struct {
+	counts []uint64 // cumulative count per bucket
+	count  uint64
+	sum    float64
+}
+
+type apiRequestKey struct {
+	api    string
+	status string
+}
+
+type rateLimit struct {
+	limit     int
+	remaining int
+	reset     int64
+}
+
+// Registry holds the metrics of a server. It is safe for concurrent use.
+type Registry struct {
+	mu          sync.Mutex
+	buckets     []float64
+	tools       map[string]*toolStats
+	apiRequests map[apiRequestKey]uint64
+	apiLatency  map[string]*histogram
+	rateLimits  map[string]*rateLimit
+}
+
+// NewRegistry creates an empty metrics registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		buckets:     DefaultLatencyBuckets,
+		tools:       map[string]*toolStats{},
+		apiRequests: map[apiRequestKey]uint64{},
+		apiLatency:  map[string]*histogram{},
+		rateLimits:  map[string]*rateLimit{},
+	}
+}
+
+// ObserveToolCall records a tool invocation and whether it failed.
+func (r *Registry) ObserveToolCall(tool string, duration time.Duration, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats, ok := r.tools[tool]
+	if !ok {
+		stats = &toolStats{}
+		r.tools[tool] = stats
+	}
+	stats.calls++
+	stats.seconds += duration.Seconds()
+	if failed {
+		stats.errors++
+	}
+}
+
+// ObserveAPIRequest records a request to the GitHub REST or GraphQL API. A status of 0 denotes a transport error.
+func (r *Registry) ObserveAPIRequest(api string, status int, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	statusLabel := strconv.Itoa(status)
+	if status == 0 {
+		statusLabel = "error"
+	}
+	r.apiRequests[apiRequestKey{api: api, status: statusLabel}]++
+
+	h, ok := r.apiLatency[api]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.buckets))}
+		r.apiLatency[api] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range r.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// SetRateLimit records the latest rate limit reported by GitHub for a resource, e.g. "core" or "graphql".
+func (r *Registry) SetRateLimit(resource string, limit, remaining int, reset time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rateLimits[resource] = &rateLimit{limit: limit, remaining: remaining, reset: reset.Unix()}
+}
+
+// ToolHandlerMiddleware returns a middleware that records the invocation count, errors and duration of every tool call.
+// A call is counted as failed if the handler returns an error or an error result.
+func (r *Registry) ToolHandlerMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, request)
+			r.ObserveToolCall(request.Params.Name, time.Since(start), err != nil || (result != nil && result.IsError))
+			return result, err
+		}
+	}
+}
+
+// Transport wraps an http.RoundTripper to record the latency, status and rate limit headers of GitHub API responses.
+func (r *Registry) Transport(api string, transport http.RoundTripper) http.RoundTripper {
+	return &transportWithMetrics{registry: r, api: api, transport: transport}
+}
+
+type transportWithMetrics struct {
+	registry  *Registry
+	api       string
+	transport http.RoundTripper
+}
+
+func (t *transportWithMetrics) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		t.registry.ObserveAPIRequest(t.api, 0, time.Since(start))
+		return resp, err
+	}
+	t.registry.ObserveAPIRequest(t.api, resp.StatusCode, time.Since(start))
+
+	limit, limitErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	remaining, remainingErr := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, resetErr := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if limitErr == nil && remainingErr == nil && resetErr == nil {
+		resource := resp.Header.Get("X-RateLimit-Resource")
+		if resource == "" {
+			resource = "core"
+		}
+		t.registry.SetRateLimit(resource, limit, remaining, time.Unix(reset, 0))
+	}
+	return resp, nil
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = r.Write(w)
+}
+
+// Write writes all metrics in the Prometheus text exposition format to w.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+
+	tools := sortedKeys(r.tools)
+	writeHeader(&b, "tool_calls_total", "counter", "Total number of tool invocations.")
+	for _, tool := range tools {
+		fmt.Fprintf(&b, "%s_tool_calls_total{tool=%q} %d\n", namespace, tool, r.tools[tool].calls)
+	}
+	writeHeader(&b, "tool_errors_total", "counter", "Total number of tool invocations that returned an error.")
+	for _, tool := range tools {
+		fmt.Fprintf(&b, "%s_tool_errors_total{tool=%q} %d\n", namespace, tool, r.tools[tool].errors)
+	}
+	writeHeader(&b, "tool_duration_seconds_total", "counter", "Total time spent handling tool invocations.")
+	for _, tool := range tools {
+		fmt.Fprintf(&b, "%s_tool_duration_seconds_total{tool=%q} %s\n", namespace, tool, formatFloat(r.tools[tool].seconds))
+	}
+
+	requestKeys := make([]apiRequestKey, 0, len(r.apiRequests))
+	for key := range r.apiRequests {
+		requestKeys = append(requestKeys, key)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		if requestKeys[i].api != requestKeys[j].api {
+			return requestKeys[i].api < requestKeys[j].api
+		}
+		return requestKeys[i].status < requestKeys[j].status
+	})
+	writeHeader(&b, "github_api_requests_total", "counter", "Total number of GitHub API requests by API and response status.")
+	for _, key := range requestKeys {
+		fmt.Fprintf(&b, "%s_github_api_requests_total{api=%q,status=%q} %d\n", namespace, key.api, key.status, r.apiRequests[key])
+	}
+
+	writeHeader(&b, "github_api_request_duration_seconds", "histogram", "Latency of GitHub API requests.")
+	for _, api := range sortedKeys(r.apiLatency) {
+		h := r.apiLatency[api]
+		for i, bound := range r.buckets {
+			fmt.Fprintf(&b, "%s_github_api_request_duration_seconds_bucket{api=%q,le=%q} %d\n", namespace, api, formatFloat(bound), h.counts[i])
+		}
+		fmt.Fprintf(&b, "%s_github_api_request_duration_seconds_bucket{api=%q,le=\"+Inf\"} %d\n", namespace, api, h.count)
+		fmt.Fprintf(&b, "%s_github_api_request_duration_seconds_sum{api=%q} %s\n", namespace, api, formatFloat(h.sum))
+		fmt.Fprintf(&b, "%s_github_api_request_duration_seconds_count{api=%q} %d\n", namespace, api, h.count)
+	}
+
+	resources := sortedKeys(r.rateLimits)
+	writeHeader(&b, "github_rate_limit_limit", "gauge", "Maximum number of requests allowed in the current rate limit window.")
+	for _, resource := range resources {
+		fmt.Fprintf(&b, "%s_github_rate_limit_limit{resource=%q} %d\n", namespace, resource, r.rateLimits[resource].limit)
+	}
+	writeHeader(&b, "github_rate_limit_remaining", "gauge", "Number of requests remaining in the current rate limit window.")
+	for _, resource := range resources {
+		fmt.Fprintf(&b, "%s_github_rate_limit_remaining{resource=%q} %d\n", namespace, resource, r.rateLimits[resource].remaining)
+	}
+	writeHeader(&b, "github_rate_limit_reset_timestamp_seconds", "gauge", "Time at which the current rate limit window resets, in seconds since the epoch.")
+	for _, resource := range resources {
+		fmt.Fprintf(&b, "%s_github_rate_limit_reset_timestamp_seconds{resource=%q} %d\n", namespace, resource, r.rateLimits[resource].reset)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeHeader(b *strings.Builder, name, metricType, help string) {
+	fmt.Fprintf(b, "# HELP %s_%s %s\n", namespace, name, help)
+	fmt.Fprintf(b, "# TYPE %s_%s %s\n", namespace, name, metricType)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
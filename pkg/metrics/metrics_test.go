@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestToolHandlerMiddleware(t *testing.T) {
+	registry := NewRegistry()
+	handler := registry.ToolHandlerMiddleware()(func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		switch request.GetString("mode", "") {
+		case "error_result":
+			return mcp.NewToolResultError("bad input"), nil
+		case "error":
+			return nil, errors.New("boom")
+		default:
+			return mcp.NewToolResultText("ok"), nil
+		}
+	})
+
+	for _, mode := range []string{"", "error_result", "error"} {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "get_me"
+		request.Params.Arguments = map[string]any{"mode": mode}
+		_, _ = handler(context.Background(), request)
+	}
+
+	var out strings.Builder
+	require.NoError(t, registry.Write(&out))
+	assert.Contains(t, out.String(), `github_mcp_tool_calls_total{tool="get_me"} 3`)
+	assert.Contains(t, out.String(), `github_mcp_tool_errors_total{tool="get_me"} 2`)
+}
+
+func TestTransport(t *testing.T) {
+	registry := NewRegistry()
+	transport := registry.Transport("rest", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/fail" {
+			return nil, errors.New("connection refused")
+		}
+		header := http.Header{}
+		header.Set("X-RateLimit-Limit", "5000")
+		header.Set("X-RateLimit-Remaining", "4999")
+		header.Set("X-RateLimit-Reset", "1700000000")
+		header.Set("X-RateLimit-Resource", "core")
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: http.NoBody}, nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	req = httptest.NewRequest(http.MethodGet, "https://api.github.com/fail", nil)
+	_, err = transport.RoundTrip(req) //nolint:bodyclose // no response on error
+	require.Error(t, err)
+
+	var out strings.Builder
+	require.NoError(t, registry.Write(&out))
+	assert.Contains(t, out.String(), `github_mcp_github_api_requests_total{api="rest",status="200"} 1`)
+	assert.Contains(t, out.String(), `github_mcp_github_api_requests_total{api="rest",status="error"} 1`)
+	assert.Contains(t, out.String(), `github_mcp_github_api_request_duration_seconds_bucket{api="rest",le="+Inf"} 2`)
+	assert.Contains(t, out.String(), `github_mcp_github_api_request_duration_seconds_count{api="rest"} 2`)
+	assert.Contains(t, out.String(), `github_mcp_github_rate_limit_limit{resource="core"} 5000`)
+	assert.Contains(t, out.String(), `github_mcp_github_rate_limit_remaining{resource="core"} 4999`)
+	assert.Contains(t, out.String(), `github_mcp_github_rate_limit_reset_timestamp_seconds{resource="core"} 1700000000`)
+}
+
+func TestObserveAPIRequestBuckets(t *testing.T) {
+	registry := NewRegistry()
+	registry.ObserveAPIRequest("graphql", http.StatusOK, 300*time.Millisecond)
+
+	var out strings.Builder
+	require.NoError(t, registry.Write(&out))
+	assert.Contains(t, out.String(), `github_mcp_github_api_request_duration_seconds_bucket{api="graphql",le="0.25"} 0`)
+	assert.Contains(t, out.String(), `github_mcp_github_api_request_duration_seconds_bucket{api="graphql",le="0.5"} 1`)
+	assert.Contains(t, out.String(), `github_mcp_github_api_request_duration_seconds_bucket{api="graphql",le="10"} 1`)
+}
+
+func TestServeHTTP(t *testing.T) {
+	registry := NewRegistry()
+	registry.ObserveToolCall("search_code", time.Second, false)
+
+	rec := httptest.NewRecorder()
+	registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "# TYPE github_mcp_tool_calls_total counter")
+	assert.Contains(t, rec.Body.String(), `github_mcp_tool_duration_seconds_total{tool="search_code"} 1`)
+}
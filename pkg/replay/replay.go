@@ -0,0 +1,183 @@
+// Package replay records the GitHub API responses the server receives as fixtures on disk, and serves them back
+// instead of calling GitHub, so that agent flows can be tested end to end deterministically and offline.
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Fixture is a recorded request and its response, stored as one JSON file.
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest identifies a recorded request. Request headers, and so credentials, are never recorded.
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// FixtureResponse is a recorded response.
+type FixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// fixtureKey identifies the fixtures of a request by a hash of its method, URL and body.
+func fixtureKey(method, url, body string) string {
+	sum := sha256.Sum256([]byte(method + " " + url + "\n" + body))
+	return hex.EncodeToString(sum[:8])
+}
+
+// fixtureFile returns the name of the fixture of the nth occurrence of a request, counting from 1. Identical requests
+// are recorded in separate fixtures, so that a flow reading the same resource before and after changing it replays
+// faithfully.
+func fixtureFile(dir, key string, n int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s-%d.json", key, n))
+}
+
+// readBody reads and restores the body of a request, which may be nil.
+func readBody(req *http.Request) (string, error) {
+	if req.Body == nil {
+		return "", nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
+
+// occurrences counts how often each request has been made.
+type occurrences struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (o *occurrences) next(key string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.counts[key]++
+	return o.counts[key]
+}
+
+// NewRecorder wraps transport to save every request and response to a fixture in dir, creating dir if needed.
+func NewRecorder(dir string, transport http.RoundTripper) (http.RoundTripper, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	return &recorder{dir: dir, transport: transport, seen: occurrences{counts: map[string]int{}}}, nil
+}
+
+type recorder struct {
+	dir       string
+	transport http.RoundTripper
+	seen      occurrences
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	reqBody, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// The body is stored decoded, so headers describing its encoding no longer apply.
+	header := resp.Header.Clone()
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+	fixture := Fixture{
+		Request:  FixtureRequest{Method: req.Method, URL: req.URL.String(), Body: reqBody},
+		Response: FixtureResponse{StatusCode: resp.StatusCode, Header: header, Body: string(respBody)},
+	}
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fixture: %w", err)
+	}
+	key := fixtureKey(req.Method, req.URL.String(), reqBody)
+	if err := os.WriteFile(fixtureFile(r.dir, key, r.seen.next(key)), data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return resp, nil
+}
+
+// NewReplayer creates a transport that serves responses from the fixtures in dir instead of sending requests. The nth
+// occurrence of a request is served the nth recorded response, or the last one if it was recorded fewer times.
+// Requests without fixtures fail.
+func NewReplayer(dir string) (http.RoundTripper, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fixture directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fixture path %s is not a directory", dir)
+	}
+	return &replayer{dir: dir, seen: occurrences{counts: map[string]int{}}}, nil
+}
+
+type replayer struct {
+	dir  string
+	seen occurrences
+}
+
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := fixtureKey(req.Method, req.URL.String(), body)
+	var data []byte
+	for n := r.seen.next(key); n > 0 && data == nil; n-- {
+		data, err = os.ReadFile(fixtureFile(r.dir, key, n))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture for %s %s: %w", req.Method, req.URL, err)
+	}
+	if fixture.Response.Header == nil {
+		fixture.Response.Header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.Response.StatusCode, http.StatusText(fixture.Response.StatusCode)),
+		StatusCode:    fixture.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        fixture.Response.Header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(fixture.Response.Body))),
+		ContentLength: int64(len(fixture.Response.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package replay
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func send(t *testing.T, transport http.RoundTripper, method, url, body string) (*http.Response, string) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	return resp, string(data)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	// A live API whose issue title changes after an update.
+	title := "Old title"
+	live := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"title":"` + title + `"}`
+		if req.Method == http.MethodPatch {
+			data, _ := io.ReadAll(req.Body)
+			title = strings.TrimSuffix(strings.TrimPrefix(string(data), `{"title":"`), `"}`)
+			body = string(data)
+		}
+		header := http.Header{}
+		header.Set("Content-Type", "application/json")
+		header.Set("Content-Length", "100")
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+
+	const url = "https://api.github.com/repos/owner/repo/issues/1"
+	recorder, err := NewRecorder(dir, live)
+	require.NoError(t, err)
+	flow := func(transport http.RoundTripper) []string {
+		_, before := send(t, transport, http.MethodGet, url, "")
+		_, updated := send(t, transport, http.MethodPatch, url, `{"title":"New title"}`)
+		_, after := send(t, transport, http.MethodGet, url, "")
+		return []string{before, updated, after}
+	}
+	recorded := flow(recorder)
+	assert.Equal(t, []string{`{"title":"Old title"}`, `{"title":"New title"}`, `{"title":"New title"}`}, recorded)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+	for _, file := range files {
+		data, err := os.ReadFile(dir + "/" + file.Name())
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret-token")
+		assert.NotContains(t, string(data), "Content-Length")
+	}
+
+	replayer, err := NewReplayer(dir)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, flow(replayer))
+
+	// Further occurrences of a request are served its last recorded response.
+	resp, body := send(t, replayer, http.MethodGet, url, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `{"title":"New title"}`, body)
+
+	// Requests differing in their body are told apart.
+	req, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(`{"title":"Other title"}`))
+	require.NoError(t, err)
+	_, err = replayer.RoundTrip(req)
+	assert.EqualError(t, err, "no recorded response for PATCH "+url)
+}
+
+func TestNewReplayer_MissingDirectory(t *testing.T) {
+	_, err := NewReplayer(t.TempDir() + "/missing")
+	assert.ErrorContains(t, err, "failed to open fixture directory")
+}
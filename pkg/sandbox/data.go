@@ -0,0 +1,378 @@
+package sandbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// seedTime is when the synthetic organization starts its history, so that the seeded data is the same on every run.
+var seedTime = time.Date(2025, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+type labelSeed struct {
+	name, color, description string
+}
+
+type issueSeed struct {
+	title, body, author string
+	labels, assignees   []string
+	closed              bool
+	// head and base make the issue a pull request.
+	head, base string
+	merged     bool
+	comments   []string
+}
+
+type repoSeed struct {
+	name, description, language string
+	issues                      []issueSeed
+}
+
+var defaultLabels = []labelSeed{
+	{"bug", "d73a4a", "Something isn't working"},
+	{"enhancement", "a2eeef", "New feature or request"},
+	{"documentation", "0075ca", "Improvements or additions to documentation"},
+	{"good first issue", "7057ff", "Good for newcomers"},
+}
+
+var repoSeeds = []repoSeed{
+	{
+		name:        "web-app",
+		description: "Customer-facing web application",
+		language:    "TypeScript",
+		issues: []issueSeed{
+			{
+				title:     "Login button unresponsive on Safari",
+				body:      "Clicking the login button on Safari 17 does nothing. No errors are logged in the console.",
+				author:    "hubot",
+				labels:    []string{"bug"},
+				assignees: []string{"mona"},
+				comments:  []string{"Reproduced on Safari 17.2, the click handler is never attached."},
+			},
+			{
+				title:  "Add dark mode",
+				body:   "Support the prefers-color-scheme media query and add a toggle in the settings page.",
+				author: "sandbox-user",
+				labels: []string{"enhancement"},
+			},
+			{
+				title:  "Document local development setup",
+				body:   "The README does not explain how to run the app against a local API.",
+				author: "mona",
+				labels: []string{"documentation"},
+				closed: true,
+			},
+			{
+				title:  "Fix Safari login handler",
+				body:   "Attach the click handler after hydration.\n\nFixes #1",
+				author: "mona",
+				head:   "fix-safari-login",
+				base:   "main",
+			},
+			{
+				title:  "Improve error messages on the signup form",
+				body:   "Validation errors only say \"Invalid input\". Tell users which field is wrong and why.",
+				author: "hubot",
+				labels: []string{"enhancement", "good first issue"},
+			},
+		},
+	},
+	{
+		name:        "api-service",
+		description: "Backend REST API",
+		language:    "Go",
+		issues: []issueSeed{
+			{
+				title:     "Rate limiter returns 500 instead of 429",
+				body:      "When a client exceeds its quota, the API responds with 500 Internal Server Error.",
+				author:    "sandbox-user",
+				labels:    []string{"bug"},
+				assignees: []string{"hubot"},
+			},
+			{
+				title:  "Add pagination to /v1/orders",
+				body:   "Large accounts time out listing their orders. Add cursor based pagination.",
+				author: "mona",
+				labels: []string{"enhancement"},
+			},
+			{
+				title:  "Return 429 from the rate limiter",
+				body:   "Fixes #1",
+				author: "hubot",
+				head:   "rate-limit-status",
+				base:   "main",
+			},
+			{
+				title:  "Bump Go to 1.24",
+				author: "sandbox-user",
+				head:   "go-1.24",
+				base:   "main",
+				closed: true,
+				merged: true,
+			},
+		},
+	},
+}
+
+// seed populates the synthetic organization: its members, repositories with issues and pull requests, and a
+// project tracking some of the issues.
+func (b *Backend) seed() {
+	for _, login := range []string{User, "mona", "hubot"} {
+		b.user(login)
+	}
+	org := &github.User{
+		Login:   github.Ptr(Org),
+		ID:      github.Ptr(int64(1)),
+		NodeID:  github.Ptr("O_sandbox1"),
+		Type:    github.Ptr("Organization"),
+		HTMLURL: github.Ptr(webURL + "/" + Org),
+		URL:     github.Ptr(apiURL + "/orgs/" + Org),
+	}
+
+	for i, seed := range repoSeeds {
+		id := b.newID()
+		fullName := Org + "/" + seed.name
+		at := &github.Timestamp{Time: seedTime.Add(time.Duration(i) * time.Hour)}
+		rs := &repoState{
+			repo: &github.Repository{
+				ID:            github.Ptr(id),
+				NodeID:        github.Ptr(fmt.Sprintf("R_sandbox%d", id)),
+				Owner:         org,
+				Name:          github.Ptr(seed.name),
+				FullName:      github.Ptr(fullName),
+				Description:   github.Ptr(seed.description),
+				Language:      github.Ptr(seed.language),
+				DefaultBranch: github.Ptr("main"),
+				Private:       github.Ptr(false),
+				Visibility:    github.Ptr("public"),
+				HTMLURL:       github.Ptr(webURL + "/" + fullName),
+				URL:           github.Ptr(apiURL + "/repos/" + fullName),
+				CreatedAt:     at,
+				UpdatedAt:     at,
+				PushedAt:      at,
+			},
+			pulls:    map[int]*github.PullRequest{},
+			comments: map[int][]*github.IssueComment{},
+		}
+		for _, seed := range defaultLabels {
+			label := b.label(rs, seed.name)
+			label.Color = github.Ptr(seed.color)
+			label.Description = github.Ptr(seed.description)
+		}
+		b.repos = append(b.repos, rs)
+
+		for j, issueSeed := range seed.issues {
+			createdAt := seedTime.Add(time.Duration(24*(j+1)) * time.Hour)
+			if issueSeed.head != "" {
+				b.newPull(rs, issueSeed.title, issueSeed.body, issueSeed.author, issueSeed.head, issueSeed.base, createdAt)
+			} else {
+				b.newIssue(rs, issueSeed.title, issueSeed.body, issueSeed.author, createdAt)
+			}
+			issue := rs.issues[len(rs.issues)-1]
+			labels, assignees := issueSeed.labels, issueSeed.assignees
+			b.applyIssueRequest(rs, issue, &github.IssueRequest{Labels: &labels, Assignees: &assignees})
+			issue.UpdatedAt = issue.CreatedAt
+			if issueSeed.closed {
+				issue.State = github.Ptr("closed")
+				issue.StateReason = github.Ptr("completed")
+				issue.ClosedAt = &github.Timestamp{Time: createdAt.Add(2 * time.Hour)}
+				issue.UpdatedAt = issue.ClosedAt
+			}
+			for k, body := range issueSeed.comments {
+				b.addComment(rs, issue, "mona", body, createdAt.Add(time.Duration(k+1)*time.Hour))
+			}
+			if pull, ok := rs.pulls[issue.GetNumber()]; ok {
+				syncPull(pull, issue)
+				if issueSeed.merged {
+					pull.Merged = github.Ptr(true)
+					pull.MergedAt = issue.ClosedAt
+					issue.PullRequestLinks.MergedAt = issue.ClosedAt
+				}
+			}
+		}
+	}
+
+	b.seedProject(org)
+}
+
+// seedProject creates the Roadmap project of the organization, tracking some of the issues of its repositories.
+func (b *Backend) seedProject(org *github.User) {
+	id := b.newID()
+	at := &github.Timestamp{Time: seedTime}
+	ps := &projectState{
+		owner: Org,
+		project: &github.ProjectV2{
+			ID:               github.Ptr(id),
+			NodeID:           github.Ptr(fmt.Sprintf("PVT_sandbox%d", id)),
+			Owner:            org,
+			Creator:          b.user(User),
+			Number:           github.Ptr(1),
+			Title:            github.Ptr("Roadmap"),
+			ShortDescription: github.Ptr("What the sandbox org is working on"),
+			Public:           github.Ptr(true),
+			HTMLURL:          github.Ptr(fmt.Sprintf("%s/orgs/%s/projects/1", webURL, Org)),
+			URL:              github.Ptr(fmt.Sprintf("%s/orgs/%s/projectsV2/1", apiURL, Org)),
+			CreatedAt:        at,
+			UpdatedAt:        at,
+		},
+	}
+	field := func(name, dataType string, options ...string) *github.ProjectV2Field {
+		id := b.newID()
+		f := &github.ProjectV2Field{
+			ID:         github.Ptr(id),
+			NodeID:     github.Ptr(fmt.Sprintf("PVTF_sandbox%d", id)),
+			Name:       github.Ptr(name),
+			DataType:   github.Ptr(dataType),
+			ProjectURL: ps.project.URL,
+			CreatedAt:  at,
+			UpdatedAt:  at,
+		}
+		colors := []string{"GRAY", "YELLOW", "GREEN"}
+		for i, option := range options {
+			f.Options = append(f.Options, &github.ProjectV2FieldOption{
+				ID:    github.Ptr(fmt.Sprintf("%08x", b.newID())),
+				Name:  &github.ProjectV2TextContent{Raw: github.Ptr(option), HTML: github.Ptr(option)},
+				Color: github.Ptr(colors[i%len(colors)]),
+			})
+		}
+		ps.fields = append(ps.fields, f)
+		return f
+	}
+	field("Title", "title")
+	status := field("Status", "single_select", "Todo", "In Progress", "Done")
+	estimate := field("Estimate", "number")
+	b.projects = append(b.projects, ps)
+
+	statuses := map[string]int{"In Progress": 1, "Done": 2}
+	for _, seed := range []struct {
+		repo, status string
+		number       int
+		estimate     float64
+	}{
+		{"web-app", "In Progress", 1, 3},
+		{"web-app", "Todo", 2, 8},
+		{"web-app", "Done", 3, 1},
+		{"api-service", "Todo", 1, 2},
+	} {
+		for _, rs := range b.repos {
+			if rs.repo.GetName() != seed.repo {
+				continue
+			}
+			issue := rs.issues[seed.number-1]
+			item := b.newProjectItem(ps, issue, seedTime.Add(time.Duration(len(ps.items)+1)*time.Minute))
+			option := status.Options[statuses[seed.status]]
+			setFieldValue(item, status, map[string]any{"id": option.GetID(), "name": option.GetName().GetRaw(), "color": option.GetColor()})
+			setFieldValue(item, estimate, seed.estimate)
+		}
+	}
+}
+
+// newIssue adds an issue to a repository, numbered after its last issue or pull request.
+func (b *Backend) newIssue(rs *repoState, title, body, author string, createdAt time.Time) *github.Issue {
+	id := b.newID()
+	number := len(rs.issues) + 1
+	at := &github.Timestamp{Time: createdAt.UTC().Truncate(time.Second)}
+	issue := &github.Issue{
+		ID:            github.Ptr(id),
+		NodeID:        github.Ptr(fmt.Sprintf("I_sandbox%d", id)),
+		Number:        github.Ptr(number),
+		Title:         github.Ptr(title),
+		Body:          github.Ptr(body),
+		State:         github.Ptr("open"),
+		User:          b.user(author),
+		Labels:        []*github.Label{},
+		Assignees:     []*github.User{},
+		Comments:      github.Ptr(0),
+		Locked:        github.Ptr(false),
+		HTMLURL:       github.Ptr(fmt.Sprintf("%s/issues/%d", rs.repo.GetHTMLURL(), number)),
+		URL:           github.Ptr(fmt.Sprintf("%s/issues/%d", rs.repo.GetURL(), number)),
+		RepositoryURL: rs.repo.URL,
+		CreatedAt:     at,
+		UpdatedAt:     at,
+	}
+	rs.issues = append(rs.issues, issue)
+	return issue
+}
+
+// newPull adds a pull request to a repository, along with the issue representing it in the issues API.
+func (b *Backend) newPull(rs *repoState, title, body, author, head, base string, createdAt time.Time) *github.PullRequest {
+	issue := b.newIssue(rs, title, body, author, createdAt)
+	issue.HTMLURL = github.Ptr(fmt.Sprintf("%s/pull/%d", rs.repo.GetHTMLURL(), issue.GetNumber()))
+	issue.PullRequestLinks = &github.PullRequestLinks{
+		URL:     github.Ptr(fmt.Sprintf("%s/pulls/%d", rs.repo.GetURL(), issue.GetNumber())),
+		HTMLURL: issue.HTMLURL,
+	}
+	id := b.newID()
+	pull := &github.PullRequest{
+		ID:        github.Ptr(id),
+		NodeID:    github.Ptr(fmt.Sprintf("PR_sandbox%d", id)),
+		Number:    issue.Number,
+		Draft:     github.Ptr(false),
+		Merged:    github.Ptr(false),
+		Mergeable: github.Ptr(true),
+		Head: &github.PullRequestBranch{
+			Ref:   github.Ptr(head),
+			Label: github.Ptr(Org + ":" + head),
+			SHA:   github.Ptr(fmt.Sprintf("%040x", id)),
+			Repo:  rs.repo,
+		},
+		Base: &github.PullRequestBranch{
+			Ref:   github.Ptr(base),
+			Label: github.Ptr(Org + ":" + base),
+			Repo:  rs.repo,
+		},
+		User:      issue.User,
+		HTMLURL:   issue.HTMLURL,
+		URL:       issue.PullRequestLinks.URL,
+		IssueURL:  issue.URL,
+		CreatedAt: issue.CreatedAt,
+	}
+	syncPull(pull, issue)
+	rs.pulls[issue.GetNumber()] = pull
+	return pull
+}
+
+func (b *Backend) addComment(rs *repoState, issue *github.Issue, author, body string, createdAt time.Time) *github.IssueComment {
+	id := b.newID()
+	at := &github.Timestamp{Time: createdAt.UTC().Truncate(time.Second)}
+	comment := &github.IssueComment{
+		ID:        github.Ptr(id),
+		NodeID:    github.Ptr(fmt.Sprintf("IC_sandbox%d", id)),
+		Body:      github.Ptr(body),
+		User:      b.user(author),
+		HTMLURL:   github.Ptr(fmt.Sprintf("%s#issuecomment-%d", issue.GetHTMLURL(), id)),
+		URL:       github.Ptr(fmt.Sprintf("%s/issues/comments/%d", rs.repo.GetURL(), id)),
+		IssueURL:  issue.URL,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+	rs.comments[issue.GetNumber()] = append(rs.comments[issue.GetNumber()], comment)
+	issue.Comments = github.Ptr(len(rs.comments[issue.GetNumber()]))
+	return comment
+}
+
+func (b *Backend) newProjectItem(ps *projectState, issue *github.Issue, createdAt time.Time) *github.ProjectV2Item {
+	id := b.newID()
+	at := &github.Timestamp{Time: createdAt.UTC().Truncate(time.Second)}
+	contentType := "Issue"
+	if issue.PullRequestLinks != nil {
+		contentType = "PullRequest"
+	}
+	item := &github.ProjectV2Item{
+		ID:            github.Ptr(id),
+		NodeID:        github.Ptr(fmt.Sprintf("PVTI_sandbox%d", id)),
+		ProjectNodeID: ps.project.NodeID,
+		ContentNodeID: issue.NodeID,
+		ContentType:   github.Ptr(contentType),
+		ProjectURL:    ps.project.URL,
+		ItemURL:       github.Ptr(fmt.Sprintf("%s/items/%d", ps.project.GetURL(), id)),
+		Creator:       b.user(User),
+		CreatedAt:     at,
+		UpdatedAt:     at,
+	}
+	title := ps.fields[0]
+	setFieldValue(item, title, map[string]any{"raw": issue.GetTitle()})
+	ps.items = append(ps.items, item)
+	return item
+}
@@ -0,0 +1,895 @@
+// Package sandbox serves a synthetic GitHub organization from memory instead of calling GitHub, so that the server
+// can be demoed and clients developed without a token and without touching real repositories. Writes are applied
+// to the in-memory state and are lost when the server exits.
+package sandbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v79/github"
+)
+
+const (
+	// Org is the login of the synthetic organization.
+	Org = "sandbox-org"
+	// User is the login of the authenticated user, a member of Org.
+	User = "sandbox-user"
+
+	webURL = "https://github.com"
+	apiURL = "https://api.github.com"
+)
+
+// Backend is an http.RoundTripper serving the REST API of the synthetic organization. GraphQL requests are
+// answered with an error, as the sandbox does not implement the GraphQL API. It is safe for concurrent use.
+type Backend struct {
+	mux *http.ServeMux
+	now func() time.Time
+
+	mu       sync.Mutex
+	nextID   int64
+	users    map[string]*github.User
+	repos    []*repoState
+	projects []*projectState
+}
+
+type repoState struct {
+	repo   *github.Repository
+	labels []*github.Label
+	// issues holds the issues and pull requests of the repository, which share their numbers, the way the issues
+	// API returns them.
+	issues   []*github.Issue
+	pulls    map[int]*github.PullRequest
+	comments map[int][]*github.IssueComment
+}
+
+type projectState struct {
+	owner   string
+	project *github.ProjectV2
+	fields  []*github.ProjectV2Field
+	items   []*github.ProjectV2Item
+}
+
+// New creates a backend serving a freshly seeded synthetic organization.
+func New() *Backend {
+	b := &Backend{
+		mux:    http.NewServeMux(),
+		now:    time.Now,
+		nextID: 1000,
+		users:  map[string]*github.User{},
+	}
+	b.seed()
+	b.routes()
+	return b
+}
+
+// RoundTrip serves req from the in-memory state. Paths are accepted with or without the /api/v3 prefix of GitHub
+// Enterprise Server.
+func (b *Backend) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, "/api/v3")
+	if r.URL.Path == "/api/graphql" {
+		r.URL.Path = "/graphql"
+	}
+	rec := httptest.NewRecorder()
+	b.mux.ServeHTTP(rec, r)
+	resp := rec.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+func (b *Backend) routes() {
+	handle := func(pattern string, handler func(w http.ResponseWriter, r *http.Request)) {
+		b.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			handler(w, r)
+		})
+	}
+
+	handle("GET /user", b.getAuthenticatedUser)
+	handle("GET /users/{username}", b.getUser)
+	handle("GET /orgs/{org}", b.getOrg)
+	handle("GET /orgs/{org}/repos", b.listRepos)
+	handle("GET /user/repos", b.listRepos)
+	handle("GET /repos/{owner}/{repo}", b.getRepo)
+	handle("GET /repos/{owner}/{repo}/labels", b.listLabels)
+	handle("GET /repos/{owner}/{repo}/labels/{name}", b.getLabel)
+	handle("GET /repos/{owner}/{repo}/issues", b.listIssues)
+	handle("POST /repos/{owner}/{repo}/issues", b.createIssue)
+	handle("GET /repos/{owner}/{repo}/issues/{number}", b.getIssue)
+	handle("PATCH /repos/{owner}/{repo}/issues/{number}", b.updateIssue)
+	handle("GET /repos/{owner}/{repo}/issues/{number}/comments", b.listComments)
+	handle("POST /repos/{owner}/{repo}/issues/{number}/comments", b.createComment)
+	handle("GET /repos/{owner}/{repo}/pulls", b.listPulls)
+	handle("POST /repos/{owner}/{repo}/pulls", b.createPull)
+	handle("GET /repos/{owner}/{repo}/pulls/{number}", b.getPull)
+	handle("PATCH /repos/{owner}/{repo}/pulls/{number}", b.updatePull)
+	handle("GET /search/issues", b.searchIssues)
+	for _, prefix := range []string{"/orgs/{owner}", "/users/{owner}"} {
+		handle("GET "+prefix+"/projectsV2", b.listProjects)
+		handle("GET "+prefix+"/projectsV2/{project}", b.getProject)
+		handle("GET "+prefix+"/projectsV2/{project}/fields", b.listProjectFields)
+		handle("GET "+prefix+"/projectsV2/{project}/fields/{id}", b.getProjectField)
+		handle("GET "+prefix+"/projectsV2/{project}/items", b.listProjectItems)
+		handle("POST "+prefix+"/projectsV2/{project}/items", b.addProjectItem)
+		handle("GET "+prefix+"/projectsV2/{project}/items/{id}", b.getProjectItem)
+		handle("PATCH "+prefix+"/projectsV2/{project}/items/{id}", b.updateProjectItem)
+		handle("DELETE "+prefix+"/projectsV2/{project}/items/{id}", b.deleteProjectItem)
+	}
+	handle("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"data": nil,
+			"errors": []map[string]any{{
+				"message": "the GraphQL API is not available in sandbox mode; use tools backed by the REST API instead",
+			}},
+		})
+	})
+	b.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not available in sandbox mode", r.Method, r.URL.Path))
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Problems parsing JSON")
+		return false
+	}
+	return true
+}
+
+func pathInt(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	n, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return 0, false
+	}
+	return n, true
+}
+
+func (b *Backend) newID() int64 {
+	b.nextID++
+	return b.nextID
+}
+
+func (b *Backend) timestamp() *github.Timestamp {
+	return &github.Timestamp{Time: b.now().UTC().Truncate(time.Second)}
+}
+
+// user returns the user with the given login, creating it on first use so that any login can be assigned.
+func (b *Backend) user(login string) *github.User {
+	if u, ok := b.users[strings.ToLower(login)]; ok {
+		return u
+	}
+	id := b.newID()
+	u := &github.User{
+		Login:   github.Ptr(login),
+		ID:      github.Ptr(id),
+		NodeID:  github.Ptr(fmt.Sprintf("U_sandbox%d", id)),
+		Type:    github.Ptr("User"),
+		HTMLURL: github.Ptr(webURL + "/" + login),
+		URL:     github.Ptr(apiURL + "/users/" + login),
+	}
+	b.users[strings.ToLower(login)] = u
+	return u
+}
+
+func (b *Backend) getAuthenticatedUser(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, b.user(User))
+}
+
+func (b *Backend) getUser(w http.ResponseWriter, r *http.Request) {
+	u, ok := b.users[strings.ToLower(r.PathValue("username"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (b *Backend) getOrg(w http.ResponseWriter, r *http.Request) {
+	if !strings.EqualFold(r.PathValue("org"), Org) {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	writeJSON(w, http.StatusOK, &github.Organization{
+		Login:       github.Ptr(Org),
+		ID:          github.Ptr(int64(1)),
+		NodeID:      github.Ptr("O_sandbox1"),
+		Name:        github.Ptr("Sandbox Org"),
+		Description: github.Ptr("A synthetic organization served by the sandbox mode of github-mcp-server"),
+		HTMLURL:     github.Ptr(webURL + "/" + Org),
+		Type:        github.Ptr("Organization"),
+	})
+}
+
+func (b *Backend) findRepo(w http.ResponseWriter, r *http.Request) *repoState {
+	for _, rs := range b.repos {
+		if strings.EqualFold(rs.repo.GetOwner().GetLogin(), r.PathValue("owner")) && strings.EqualFold(rs.repo.GetName(), r.PathValue("repo")) {
+			return rs
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+	return nil
+}
+
+func (b *Backend) listRepos(w http.ResponseWriter, r *http.Request) {
+	repos := []*github.Repository{}
+	if org := r.PathValue("org"); org == "" || strings.EqualFold(org, Org) {
+		for _, rs := range b.repos {
+			repos = append(repos, rs.repo)
+		}
+	}
+	writeJSON(w, http.StatusOK, repos)
+}
+
+func (b *Backend) getRepo(w http.ResponseWriter, r *http.Request) {
+	if rs := b.findRepo(w, r); rs != nil {
+		writeJSON(w, http.StatusOK, rs.repo)
+	}
+}
+
+func (b *Backend) listLabels(w http.ResponseWriter, r *http.Request) {
+	if rs := b.findRepo(w, r); rs != nil {
+		writeJSON(w, http.StatusOK, rs.labels)
+	}
+}
+
+func (b *Backend) getLabel(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	for _, label := range rs.labels {
+		if strings.EqualFold(label.GetName(), r.PathValue("name")) {
+			writeJSON(w, http.StatusOK, label)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// label returns the label of the repository with the given name, creating it if it does not exist yet, as GitHub
+// does when an issue is labeled.
+func (b *Backend) label(rs *repoState, name string) *github.Label {
+	for _, label := range rs.labels {
+		if strings.EqualFold(label.GetName(), name) {
+			return label
+		}
+	}
+	id := b.newID()
+	label := &github.Label{
+		ID:     github.Ptr(id),
+		NodeID: github.Ptr(fmt.Sprintf("LA_sandbox%d", id)),
+		Name:   github.Ptr(name),
+		Color:  github.Ptr("ededed"),
+		URL:    github.Ptr(fmt.Sprintf("%s/labels/%s", rs.repo.GetURL(), name)),
+	}
+	rs.labels = append(rs.labels, label)
+	return label
+}
+
+func (b *Backend) findIssue(w http.ResponseWriter, r *http.Request, rs *repoState) *github.Issue {
+	number, ok := pathInt(w, r, "number")
+	if !ok {
+		return nil
+	}
+	for _, issue := range rs.issues {
+		if int64(issue.GetNumber()) == number {
+			return issue
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+	return nil
+}
+
+// matchesState reports whether an issue or pull request in the given state is selected by the state filter of a
+// list request, which defaults to open.
+func matchesState(filter, state string) bool {
+	return filter == "all" || state == filter || (filter == "" && state == "open")
+}
+
+func (b *Backend) listIssues(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	q := r.URL.Query()
+	issues := []*github.Issue{}
+	for _, issue := range rs.issues {
+		if !matchesState(q.Get("state"), issue.GetState()) || !hasLabels(issue, q.Get("labels")) {
+			continue
+		}
+		if assignee := q.Get("assignee"); assignee != "" && !isAssigned(issue, assignee) {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if q.Get("direction") == "asc" {
+			return issues[i].GetNumber() < issues[j].GetNumber()
+		}
+		return issues[i].GetNumber() > issues[j].GetNumber()
+	})
+	writeJSON(w, http.StatusOK, issues)
+}
+
+func hasLabels(issue *github.Issue, labels string) bool {
+	for _, name := range strings.Split(labels, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		found := false
+		for _, label := range issue.Labels {
+			found = found || strings.EqualFold(label.GetName(), name)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func isAssigned(issue *github.Issue, login string) bool {
+	for _, assignee := range issue.Assignees {
+		if strings.EqualFold(assignee.GetLogin(), login) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyIssueRequest applies the fields set in req to an issue.
+func (b *Backend) applyIssueRequest(rs *repoState, issue *github.Issue, req *github.IssueRequest) {
+	if req.Title != nil {
+		issue.Title = req.Title
+	}
+	if req.Body != nil {
+		issue.Body = req.Body
+	}
+	if req.Labels != nil {
+		issue.Labels = []*github.Label{}
+		for _, name := range *req.Labels {
+			issue.Labels = append(issue.Labels, b.label(rs, name))
+		}
+	}
+	assignees := req.Assignees
+	if req.Assignee != nil {
+		assignees = &[]string{*req.Assignee}
+	}
+	if assignees != nil {
+		issue.Assignees = []*github.User{}
+		for _, login := range *assignees {
+			issue.Assignees = append(issue.Assignees, b.user(login))
+		}
+		issue.Assignee = nil
+		if len(issue.Assignees) > 0 {
+			issue.Assignee = issue.Assignees[0]
+		}
+	}
+	if req.State != nil && *req.State != issue.GetState() {
+		issue.State = req.State
+		issue.ClosedAt = nil
+		issue.StateReason = nil
+		if *req.State == "closed" {
+			issue.ClosedAt = b.timestamp()
+			issue.StateReason = github.Ptr("completed")
+		}
+	}
+	if req.StateReason != nil && issue.GetState() == "closed" {
+		issue.StateReason = req.StateReason
+	}
+	issue.UpdatedAt = b.timestamp()
+}
+
+func (b *Backend) createIssue(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	var req github.IssueRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.GetTitle()) == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed: title is missing")
+		return
+	}
+	req.State = nil
+	issue := b.newIssue(rs, "", "", User, b.now())
+	b.applyIssueRequest(rs, issue, &req)
+	writeJSON(w, http.StatusCreated, issue)
+}
+
+func (b *Backend) getIssue(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	if issue := b.findIssue(w, r, rs); issue != nil {
+		writeJSON(w, http.StatusOK, issue)
+	}
+}
+
+func (b *Backend) updateIssue(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	issue := b.findIssue(w, r, rs)
+	if issue == nil {
+		return
+	}
+	var req github.IssueRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	b.applyIssueRequest(rs, issue, &req)
+	if pull, ok := rs.pulls[issue.GetNumber()]; ok {
+		syncPull(pull, issue)
+	}
+	writeJSON(w, http.StatusOK, issue)
+}
+
+func (b *Backend) listComments(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	issue := b.findIssue(w, r, rs)
+	if issue == nil {
+		return
+	}
+	comments := rs.comments[issue.GetNumber()]
+	if comments == nil {
+		comments = []*github.IssueComment{}
+	}
+	writeJSON(w, http.StatusOK, comments)
+}
+
+func (b *Backend) createComment(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	issue := b.findIssue(w, r, rs)
+	if issue == nil {
+		return
+	}
+	var req github.IssueComment
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if strings.TrimSpace(req.GetBody()) == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed: body is missing")
+		return
+	}
+	comment := b.addComment(rs, issue, User, req.GetBody(), b.now())
+	writeJSON(w, http.StatusCreated, comment)
+}
+
+func (b *Backend) findPull(w http.ResponseWriter, r *http.Request, rs *repoState) *github.PullRequest {
+	number, ok := pathInt(w, r, "number")
+	if !ok {
+		return nil
+	}
+	pull, ok := rs.pulls[int(number)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return nil
+	}
+	return pull
+}
+
+func (b *Backend) listPulls(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	q := r.URL.Query()
+	pulls := []*github.PullRequest{}
+	for _, pull := range rs.pulls {
+		if !matchesState(q.Get("state"), pull.GetState()) {
+			continue
+		}
+		if base := q.Get("base"); base != "" && pull.GetBase().GetRef() != base {
+			continue
+		}
+		if head := q.Get("head"); head != "" && pull.GetHead().GetLabel() != head && pull.GetHead().GetRef() != head {
+			continue
+		}
+		pulls = append(pulls, pull)
+	}
+	sort.Slice(pulls, func(i, j int) bool {
+		if q.Get("direction") == "asc" {
+			return pulls[i].GetNumber() < pulls[j].GetNumber()
+		}
+		return pulls[i].GetNumber() > pulls[j].GetNumber()
+	})
+	writeJSON(w, http.StatusOK, pulls)
+}
+
+func (b *Backend) getPull(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	if pull := b.findPull(w, r, rs); pull != nil {
+		writeJSON(w, http.StatusOK, pull)
+	}
+}
+
+func (b *Backend) createPull(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	var req github.NewPullRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	switch {
+	case strings.TrimSpace(req.GetTitle()) == "":
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed: title is missing")
+		return
+	case req.GetHead() == "" || req.GetBase() == "":
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed: head and base are required")
+		return
+	case req.GetHead() == req.GetBase():
+		writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("Validation Failed: No commits between %s and %s", req.GetBase(), req.GetHead()))
+		return
+	}
+	pull := b.newPull(rs, req.GetTitle(), req.GetBody(), User, req.GetHead(), req.GetBase(), b.now())
+	pull.Draft = github.Ptr(req.GetDraft())
+	writeJSON(w, http.StatusCreated, pull)
+}
+
+func (b *Backend) updatePull(w http.ResponseWriter, r *http.Request) {
+	rs := b.findRepo(w, r)
+	if rs == nil {
+		return
+	}
+	pull := b.findPull(w, r, rs)
+	if pull == nil {
+		return
+	}
+	var req struct {
+		Title *string `json:"title"`
+		Body  *string `json:"body"`
+		State *string `json:"state"`
+		Base  *string `json:"base"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if pull.GetMerged() && req.State != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Validation Failed: cannot change the state of a merged pull request")
+		return
+	}
+	for _, issue := range rs.issues {
+		if issue.GetNumber() == pull.GetNumber() {
+			b.applyIssueRequest(rs, issue, &github.IssueRequest{Title: req.Title, Body: req.Body, State: req.State})
+			syncPull(pull, issue)
+		}
+	}
+	if req.Base != nil {
+		pull.Base.Ref = req.Base
+		pull.Base.Label = github.Ptr(Org + ":" + *req.Base)
+	}
+	writeJSON(w, http.StatusOK, pull)
+}
+
+// syncPull copies the fields a pull request shares with its issue.
+func syncPull(pull *github.PullRequest, issue *github.Issue) {
+	pull.Title = issue.Title
+	pull.Body = issue.Body
+	pull.State = issue.State
+	pull.Labels = issue.Labels
+	pull.Assignees = issue.Assignees
+	pull.Assignee = issue.Assignee
+	pull.UpdatedAt = issue.UpdatedAt
+	pull.ClosedAt = issue.ClosedAt
+}
+
+// searchIssues supports the common qualifiers of the issue search syntax: repo, org, user, is, type, state, label,
+// author and assignee. Other terms must all appear in the title or body.
+func (b *Backend) searchIssues(w http.ResponseWriter, r *http.Request) {
+	var terms []string
+	filters := map[string][]string{}
+	for _, token := range strings.Fields(r.URL.Query().Get("q")) {
+		if key, value, ok := strings.Cut(token, ":"); ok && value != "" {
+			filters[strings.ToLower(key)] = append(filters[strings.ToLower(key)], strings.Trim(value, `"`))
+			continue
+		}
+		terms = append(terms, strings.ToLower(strings.Trim(token, `"`)))
+	}
+
+	matches := func(rs *repoState, issue *github.Issue) bool {
+		isPull := issue.PullRequestLinks != nil
+		for key, values := range filters {
+			for _, value := range values {
+				value = strings.ToLower(value)
+				var ok bool
+				switch key {
+				case "repo":
+					ok = strings.ToLower(rs.repo.GetFullName()) == value
+				case "org", "user":
+					ok = strings.ToLower(rs.repo.GetOwner().GetLogin()) == value
+				case "is", "type", "state":
+					switch value {
+					case "issue":
+						ok = !isPull
+					case "pr", "pull-request":
+						ok = isPull
+					case "open", "closed":
+						ok = issue.GetState() == value
+					default:
+						ok = true
+					}
+				case "label":
+					ok = hasLabels(issue, value)
+				case "author":
+					ok = strings.ToLower(issue.GetUser().GetLogin()) == value
+				case "assignee":
+					ok = isAssigned(issue, value)
+				default:
+					ok = true
+				}
+				if !ok {
+					return false
+				}
+			}
+		}
+		text := strings.ToLower(issue.GetTitle() + "\n" + issue.GetBody())
+		for _, term := range terms {
+			if !strings.Contains(text, term) {
+				return false
+			}
+		}
+		return true
+	}
+
+	items := []*github.Issue{}
+	for _, rs := range b.repos {
+		for _, issue := range rs.issues {
+			if matches(rs, issue) {
+				items = append(items, issue)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, &github.IssuesSearchResult{
+		Total:             github.Ptr(len(items)),
+		IncompleteResults: github.Ptr(false),
+		Issues:            items,
+	})
+}
+
+func (b *Backend) findProject(w http.ResponseWriter, r *http.Request) *projectState {
+	number, ok := pathInt(w, r, "project")
+	if !ok {
+		return nil
+	}
+	for _, ps := range b.projects {
+		if strings.EqualFold(ps.owner, r.PathValue("owner")) && int64(ps.project.GetNumber()) == number {
+			return ps
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+	return nil
+}
+
+func (b *Backend) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := []*github.ProjectV2{}
+	for _, ps := range b.projects {
+		if strings.EqualFold(ps.owner, r.PathValue("owner")) {
+			projects = append(projects, ps.project)
+		}
+	}
+	writeJSON(w, http.StatusOK, projects)
+}
+
+func (b *Backend) getProject(w http.ResponseWriter, r *http.Request) {
+	if ps := b.findProject(w, r); ps != nil {
+		writeJSON(w, http.StatusOK, ps.project)
+	}
+}
+
+func (b *Backend) listProjectFields(w http.ResponseWriter, r *http.Request) {
+	if ps := b.findProject(w, r); ps != nil {
+		writeJSON(w, http.StatusOK, ps.fields)
+	}
+}
+
+func (b *Backend) getProjectField(w http.ResponseWriter, r *http.Request) {
+	ps := b.findProject(w, r)
+	if ps == nil {
+		return
+	}
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return
+	}
+	for _, field := range ps.fields {
+		if field.GetID() == id {
+			writeJSON(w, http.StatusOK, field)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+func (b *Backend) findProjectItem(w http.ResponseWriter, r *http.Request, ps *projectState) (int, *github.ProjectV2Item) {
+	id, ok := pathInt(w, r, "id")
+	if !ok {
+		return 0, nil
+	}
+	for i, item := range ps.items {
+		if item.GetID() == id {
+			return i, item
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+	return 0, nil
+}
+
+func (b *Backend) listProjectItems(w http.ResponseWriter, r *http.Request) {
+	if ps := b.findProject(w, r); ps != nil {
+		writeJSON(w, http.StatusOK, ps.items)
+	}
+}
+
+func (b *Backend) getProjectItem(w http.ResponseWriter, r *http.Request) {
+	ps := b.findProject(w, r)
+	if ps == nil {
+		return
+	}
+	if _, item := b.findProjectItem(w, r, ps); item != nil {
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+func (b *Backend) addProjectItem(w http.ResponseWriter, r *http.Request) {
+	ps := b.findProject(w, r)
+	if ps == nil {
+		return
+	}
+	var req github.AddProjectItemOptions
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	for _, rs := range b.repos {
+		for _, issue := range rs.issues {
+			isPull := issue.PullRequestLinks != nil
+			if issue.GetID() != req.ID && !(isPull && rs.pulls[issue.GetNumber()].GetID() == req.ID) {
+				continue
+			}
+			if (req.Type == "Issue") == isPull {
+				writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("Validation Failed: content %d is not of type %s", req.ID, req.Type))
+				return
+			}
+			for _, item := range ps.items {
+				if item.GetContentNodeID() == issue.GetNodeID() {
+					writeJSON(w, http.StatusOK, item)
+					return
+				}
+			}
+			writeJSON(w, http.StatusCreated, b.newProjectItem(ps, issue, b.now()))
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s %d not found", req.Type, req.ID))
+}
+
+func (b *Backend) updateProjectItem(w http.ResponseWriter, r *http.Request) {
+	ps := b.findProject(w, r)
+	if ps == nil {
+		return
+	}
+	_, item := b.findProjectItem(w, r, ps)
+	if item == nil {
+		return
+	}
+	var req github.UpdateProjectItemOptions
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Archived != nil {
+		item.ArchivedAt = nil
+		if *req.Archived {
+			item.ArchivedAt = b.timestamp()
+		}
+	}
+	for _, update := range req.Fields {
+		var field *github.ProjectV2Field
+		for _, f := range ps.fields {
+			if f.GetID() == update.ID {
+				field = f
+			}
+		}
+		if field == nil {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("Validation Failed: field %d not found", update.ID))
+			return
+		}
+		value, err := fieldValue(field, update.Value)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "Validation Failed: "+err.Error())
+			return
+		}
+		setFieldValue(item, field, value)
+	}
+	item.UpdatedAt = b.timestamp()
+	writeJSON(w, http.StatusOK, item)
+}
+
+// fieldValue converts the value of a field update to the representation of the value in an item.
+func fieldValue(field *github.ProjectV2Field, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch field.GetDataType() {
+	case "single_select":
+		id, _ := value.(string)
+		for _, option := range field.Options {
+			if option.GetID() == id {
+				return map[string]any{"id": option.GetID(), "name": option.GetName().GetRaw(), "color": option.GetColor()}, nil
+			}
+		}
+		return nil, fmt.Errorf("option %v not found in field %s", value, field.GetName())
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return nil, fmt.Errorf("field %s expects a number", field.GetName())
+		}
+	case "text", "date":
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("field %s expects a string", field.GetName())
+		}
+	default:
+		return nil, fmt.Errorf("field %s cannot be updated", field.GetName())
+	}
+	return value, nil
+}
+
+func setFieldValue(item *github.ProjectV2Item, field *github.ProjectV2Field, value any) {
+	for _, fv := range item.Fields {
+		if fv.GetID() == field.GetID() {
+			fv.Value = value
+			return
+		}
+	}
+	item.Fields = append(item.Fields, &github.ProjectV2ItemFieldValue{
+		ID:       field.ID,
+		Name:     field.GetName(),
+		DataType: field.GetDataType(),
+		Value:    value,
+	})
+}
+
+func (b *Backend) deleteProjectItem(w http.ResponseWriter, r *http.Request) {
+	ps := b.findProject(w, r)
+	if ps == nil {
+		return
+	}
+	i, item := b.findProjectItem(w, r, ps)
+	if item == nil {
+		return
+	}
+	ps.items = append(ps.items[:i], ps.items[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
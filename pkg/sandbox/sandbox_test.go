@@ -0,0 +1,167 @@
+package sandbox
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient() *github.Client {
+	return github.NewClient(&http.Client{Transport: New()})
+}
+
+func TestReadSeededData(t *testing.T) {
+	ctx := context.Background()
+	client := newClient()
+
+	user, _, err := client.Users.Get(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, User, user.GetLogin())
+
+	repos, _, err := client.Repositories.ListByOrg(ctx, Org, nil)
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "sandbox-org/web-app", repos[0].GetFullName())
+
+	issues, _, err := client.Issues.ListByRepo(ctx, Org, "web-app", &github.IssueListByRepoOptions{Labels: []string{"bug"}})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "Login button unresponsive on Safari", issues[0].GetTitle())
+	assert.Equal(t, "mona", issues[0].GetAssignee().GetLogin())
+	assert.Equal(t, 1, issues[0].GetComments())
+
+	pulls, _, err := client.PullRequests.List(ctx, Org, "api-service", &github.PullRequestListOptions{State: "closed"})
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	assert.True(t, pulls[0].GetMerged())
+
+	result, _, err := client.Search.Issues(ctx, "org:sandbox-org is:issue is:open safari", nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.GetTotal())
+	assert.Equal(t, 1, result.Issues[0].GetNumber())
+
+	_, resp, err := client.Issues.Get(ctx, Org, "web-app", 99)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestWrites(t *testing.T) {
+	ctx := context.Background()
+	backend := New()
+	client := github.NewClient(&http.Client{Transport: backend})
+
+	issue, resp, err := client.Issues.Create(ctx, Org, "api-service", &github.IssueRequest{
+		Title:  github.Ptr("Document the rate limits"),
+		Labels: &[]string{"documentation", "triage"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 5, issue.GetNumber())
+	assert.Equal(t, User, issue.GetUser().GetLogin())
+	require.Len(t, issue.Labels, 2)
+
+	// Missing labels are created, as GitHub does.
+	label, _, err := client.Issues.GetLabel(ctx, Org, "api-service", "triage")
+	require.NoError(t, err)
+	assert.Equal(t, "triage", label.GetName())
+
+	_, _, err = client.Issues.CreateComment(ctx, Org, "api-service", 5, &github.IssueComment{Body: github.Ptr("On it")})
+	require.NoError(t, err)
+	issue, _, err = client.Issues.Edit(ctx, Org, "api-service", 5, &github.IssueRequest{State: github.Ptr("closed"), StateReason: github.Ptr("not_planned")})
+	require.NoError(t, err)
+	assert.Equal(t, "closed", issue.GetState())
+	assert.Equal(t, "not_planned", issue.GetStateReason())
+	assert.Equal(t, 1, issue.GetComments())
+
+	pull, _, err := client.PullRequests.Create(ctx, Org, "web-app", &github.NewPullRequest{
+		Title: github.Ptr("Add dark mode"),
+		Head:  github.Ptr("dark-mode"),
+		Base:  github.Ptr("main"),
+		Body:  github.Ptr("Closes #2"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 6, pull.GetNumber())
+	pull, _, err = client.PullRequests.Edit(ctx, Org, "web-app", 6, &github.PullRequest{Title: github.Ptr("Support dark mode")})
+	require.NoError(t, err)
+	assert.Equal(t, "Support dark mode", pull.GetTitle())
+	asIssue, _, err := client.Issues.Get(ctx, Org, "web-app", 6)
+	require.NoError(t, err)
+	assert.Equal(t, "Support dark mode", asIssue.GetTitle())
+	assert.NotNil(t, asIssue.PullRequestLinks)
+
+	_, _, err = client.PullRequests.Create(ctx, Org, "web-app", &github.NewPullRequest{Title: github.Ptr("Nothing"), Head: github.Ptr("main"), Base: github.Ptr("main")})
+	require.ErrorContains(t, err, "No commits between main and main")
+
+	// A fresh backend does not see the writes of another.
+	_, resp, err = newClient().Issues.Get(ctx, Org, "api-service", 5)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+	client := newClient()
+
+	project, _, err := client.Projects.GetOrganizationProject(ctx, Org, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Roadmap", project.GetTitle())
+
+	fields, _, err := client.Projects.ListOrganizationProjectFields(ctx, Org, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, fields, 3)
+	status := fields[1]
+	require.Equal(t, "Status", status.GetName())
+
+	items, _, err := client.Projects.ListOrganizationProjectItems(ctx, Org, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	issue, _, err := client.Issues.Get(ctx, Org, "web-app", 5)
+	require.NoError(t, err)
+	item, _, err := client.Projects.AddOrganizationProjectItem(ctx, Org, 1, &github.AddProjectItemOptions{Type: "Issue", ID: issue.GetID()})
+	require.NoError(t, err)
+	assert.Equal(t, issue.GetNodeID(), item.GetContentNodeID())
+
+	done := status.Options[2]
+	item, _, err = client.Projects.UpdateOrganizationProjectItem(ctx, Org, 1, item.GetID(), &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{{ID: status.GetID(), Value: done.GetID()}},
+	})
+	require.NoError(t, err)
+	require.Len(t, item.Fields, 2)
+	assert.Equal(t, "Done", item.Fields[1].Value.(map[string]any)["name"])
+
+	_, _, err = client.Projects.UpdateOrganizationProjectItem(ctx, Org, 1, item.GetID(), &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{{ID: status.GetID(), Value: "missing"}},
+	})
+	require.ErrorContains(t, err, "option missing not found")
+
+	_, err = client.Projects.DeleteOrganizationProjectItem(ctx, Org, 1, item.GetID())
+	require.NoError(t, err)
+	items, _, err = client.Projects.ListOrganizationProjectItems(ctx, Org, 1, nil)
+	require.NoError(t, err)
+	assert.Len(t, items, 4)
+}
+
+func TestUnsupportedRequests(t *testing.T) {
+	backend := New()
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", strings.NewReader(`{"query":"{viewer{login}}"}`))
+	require.NoError(t, err)
+	resp, err := backend.RoundTrip(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "GraphQL API is not available in sandbox mode")
+
+	_, ghResp, err := github.NewClient(&http.Client{Transport: backend}).Actions.ListWorkflows(context.Background(), Org, "web-app", nil)
+	require.ErrorContains(t, err, "is not available in sandbox mode")
+	assert.Equal(t, http.StatusNotFound, ghResp.StatusCode)
+}
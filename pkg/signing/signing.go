@@ -0,0 +1,178 @@
+// Package signing signs tool results with an HMAC, so that systems consuming the output of an agent can verify that
+// the data came from this server for the request it claims to answer, rather than having been made up by the model.
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MetaKey is the key of the signature in the _meta of signed tool results.
+const MetaKey = "github-mcp-server/signature"
+
+// Algorithm is the signing algorithm recorded in signatures.
+const Algorithm = "HMAC-SHA256"
+
+// Signature is attached to signed tool results. The HMAC is computed over the payload returned by Payload, which
+// binds the request hash, the content hash and the signing time together.
+type Signature struct {
+	Algorithm   string `json:"algorithm"`
+	RequestHash string `json:"request_hash"`
+	ContentHash string `json:"content_hash"`
+	SignedAt    string `json:"signed_at"`
+	Signature   string `json:"signature"`
+}
+
+// Payload returns the bytes the HMAC of s is computed over.
+func (s Signature) Payload() []byte {
+	return []byte(fmt.Sprintf("%s\n%s\n%s\n%s", s.Algorithm, s.RequestHash, s.ContentHash, s.SignedAt))
+}
+
+// Signer signs tool results with a secret key. It is safe for concurrent use.
+type Signer struct {
+	key []byte
+	now func() time.Time
+}
+
+// NewSigner creates a signer using key, which should be at least 32 random bytes.
+func NewSigner(key []byte) (*Signer, error) {
+	if len(key) == 0 {
+		return nil, errors.New("signing key must not be empty")
+	}
+	return &Signer{key: key, now: time.Now}, nil
+}
+
+// canonicalJSON encodes v as JSON with sorted object keys and no insignificant whitespace, so that a consumer
+// decoding and re-encoding a value gets the same bytes. Numbers are kept as written.
+func canonicalJSON(v any) ([]byte, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+func hashJSON(v any) (string, error) {
+	canonical, err := canonicalJSON(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// RequestHash returns the SHA-256 of the canonical JSON of a tool call: {"arguments":...,"name":...}.
+func RequestHash(tool string, arguments any) (string, error) {
+	return hashJSON(map[string]any{"name": tool, "arguments": arguments})
+}
+
+// ContentHash returns the SHA-256 of the canonical JSON of the content and isError fields of a tool result:
+// {"content":[...],"isError":false}. Structured content duplicates the text content and is not covered.
+func ContentHash(result *mcp.CallToolResult) (string, error) {
+	return hashJSON(map[string]any{
+		"content": result.Content,
+		"isError": result.IsError,
+	})
+}
+
+func (s *Signer) mac(sig Signature) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(sig.Payload())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign attaches a signature of result, as the answer to a call of tool with arguments, to the _meta of result.
+func (s *Signer) Sign(tool string, arguments any, result *mcp.CallToolResult) error {
+	requestHash, err := RequestHash(tool, arguments)
+	if err != nil {
+		return fmt.Errorf("failed to hash request: %w", err)
+	}
+	contentHash, err := ContentHash(result)
+	if err != nil {
+		return fmt.Errorf("failed to hash result: %w", err)
+	}
+	sig := Signature{
+		Algorithm:   Algorithm,
+		RequestHash: requestHash,
+		ContentHash: contentHash,
+		SignedAt:    s.now().UTC().Format(time.RFC3339),
+	}
+	sig.Signature = s.mac(sig)
+
+	if result.Meta == nil {
+		result.Meta = map[string]any{}
+	}
+	result.Meta[MetaKey] = sig
+	return nil
+}
+
+// Verify checks that result carries a valid signature for a call of tool with arguments.
+func (s *Signer) Verify(tool string, arguments any, result *mcp.CallToolResult) error {
+	raw, ok := result.Meta[MetaKey]
+	if !ok {
+		return errors.New("result is not signed")
+	}
+	// The signature is a Signature when verifying in process, and a map when the result was decoded from JSON.
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	var sig Signature
+	if err := json.Unmarshal(encoded, &sig); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if sig.Algorithm != Algorithm {
+		return fmt.Errorf("unsupported signature algorithm %q", sig.Algorithm)
+	}
+
+	requestHash, err := RequestHash(tool, arguments)
+	if err != nil {
+		return fmt.Errorf("failed to hash request: %w", err)
+	}
+	if requestHash != sig.RequestHash {
+		return errors.New("result was signed for a different request")
+	}
+	contentHash, err := ContentHash(result)
+	if err != nil {
+		return fmt.Errorf("failed to hash result: %w", err)
+	}
+	if contentHash != sig.ContentHash {
+		return errors.New("result content does not match its signature")
+	}
+	if !hmac.Equal([]byte(s.mac(sig)), []byte(sig.Signature)) {
+		return errors.New("signature is invalid")
+	}
+	return nil
+}
+
+// ToolHandlerMiddleware returns a middleware that signs the result of every tool call, including tool errors.
+func (s *Signer) ToolHandlerMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+			if err := s.Sign(request.Params.Name, request.GetArguments(), result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		}
+	}
+}
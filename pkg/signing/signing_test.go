@@ -0,0 +1,81 @@
+package signing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type issue struct {
+	Title  string `json:"title"`
+	Number int    `json:"number"`
+}
+
+func newTestSigner(t *testing.T) *Signer {
+	t.Helper()
+	signer, err := NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	signer.now = func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return signer
+}
+
+// roundTrip encodes and decodes result as a client consuming it would.
+func roundTrip(t *testing.T, result *mcp.CallToolResult) *mcp.CallToolResult {
+	t.Helper()
+	encoded, err := json.Marshal(result)
+	require.NoError(t, err)
+	var decoded mcp.CallToolResult
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	return &decoded
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signer := newTestSigner(t)
+	args := map[string]any{"owner": "octocat", "repo": "hello-world", "issue_number": float64(42)}
+
+	handler := signer.ToolHandlerMiddleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultStructured(issue{Title: "Fix the build", Number: 42}, `{"title":"Fix the build","number":42}`), nil
+	})
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_issue"
+	request.Params.Arguments = args
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+
+	sig, ok := result.Meta[MetaKey].(Signature)
+	require.True(t, ok)
+	assert.Equal(t, Algorithm, sig.Algorithm)
+	assert.Equal(t, "2025-03-01T12:00:00Z", sig.SignedAt)
+	require.NoError(t, signer.Verify("get_issue", args, result))
+
+	// The signature survives the result being sent to a client.
+	decoded := roundTrip(t, result)
+	require.NoError(t, signer.Verify("get_issue", map[string]any{"issue_number": 42, "repo": "hello-world", "owner": "octocat"}, decoded))
+
+	t.Run("other request", func(t *testing.T) {
+		assert.ErrorContains(t, signer.Verify("get_issue", map[string]any{"owner": "octocat", "repo": "hello-world", "issue_number": float64(43)}, decoded), "different request")
+	})
+	t.Run("tampered content", func(t *testing.T) {
+		tampered := roundTrip(t, result)
+		tampered.Content = []mcp.Content{mcp.NewTextContent(`{"title":"Delete the repository","number":42}`)}
+		assert.ErrorContains(t, signer.Verify("get_issue", args, tampered), "does not match")
+	})
+	t.Run("other key", func(t *testing.T) {
+		other, err := NewSigner([]byte("another key"))
+		require.NoError(t, err)
+		assert.ErrorContains(t, other.Verify("get_issue", args, decoded), "signature is invalid")
+	})
+	t.Run("unsigned", func(t *testing.T) {
+		assert.ErrorContains(t, signer.Verify("get_issue", args, mcp.NewToolResultText("ok")), "not signed")
+	})
+}
+
+func TestNewSigner_EmptyKey(t *testing.T) {
+	_, err := NewSigner(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+// Package throttle limits the number of concurrent outbound GitHub API requests, overall and per toolset, so that
+// tools fanning out many requests do not trip GitHub's secondary rate limits.
+package throttle
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type toolsetKey struct{}
+
+// ContextWithToolset returns a copy of ctx in which requests count towards the limit of the given toolset.
+func ContextWithToolset(ctx context.Context, toolset string) context.Context {
+	return context.WithValue(ctx, toolsetKey{}, toolset)
+}
+
+// ToolsetFromContext returns the toolset requests made with ctx count towards, if any.
+func ToolsetFromContext(ctx context.Context) string {
+	toolset, _ := ctx.Value(toolsetKey{}).(string)
+	return toolset
+}
+
+// Limiter bounds the number of requests in flight. Requests over a limit are queued until a slot frees up or their
+// context is done. It is safe for concurrent use.
+type Limiter struct {
+	global   chan struct{}
+	toolsets map[string]chan struct{}
+}
+
+// NewLimiter creates a limiter allowing at most maxRequests requests in flight overall, and at most
+// toolsetLimits[toolset] requests made by the tools of a toolset. A limit of zero or less means no limit.
+func NewLimiter(maxRequests int, toolsetLimits map[string]int) *Limiter {
+	l := &Limiter{toolsets: map[string]chan struct{}{}}
+	if maxRequests > 0 {
+		l.global = make(chan struct{}, maxRequests)
+	}
+	for toolset, limit := range toolsetLimits {
+		if limit > 0 {
+			l.toolsets[toolset] = make(chan struct{}, limit)
+		}
+	}
+	return l
+}
+
+// acquire waits for a slot of the toolset of ctx and a global slot. The toolset slot is taken first, so that requests
+// queued behind their toolset's limit do not hold up requests of other toolsets.
+func (l *Limiter) acquire(ctx context.Context) (release func(), err error) {
+	var held []chan struct{}
+	release = func() {
+		for _, slots := range held {
+			<-slots
+		}
+	}
+	for _, slots := range []chan struct{}{l.toolsets[ToolsetFromContext(ctx)], l.global} {
+		if slots == nil {
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+			held = append(held, slots)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
+
+// ToolHandlerMiddleware returns a middleware that attributes the requests made by a tool call to the toolset of the
+// tool, as returned by toolsetOf.
+func (l *Limiter) ToolHandlerMiddleware(toolsetOf func(tool string) string) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if toolset := toolsetOf(request.Params.Name); toolset != "" {
+				ctx = ContextWithToolset(ctx, toolset)
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// Transport wraps an http.RoundTripper to hold every request until it is within the limits. A request is in flight
+// until its response headers have been received.
+func (l *Limiter) Transport(transport http.RoundTripper) http.RoundTripper {
+	return &transportWithLimits{limiter: l, transport: transport}
+}
+
+type transportWithLimits struct {
+	limiter   *Limiter
+	transport http.RoundTripper
+}
+
+func (t *transportWithLimits) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return t.transport.RoundTrip(req)
+}
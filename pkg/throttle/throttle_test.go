@@ -0,0 +1,122 @@
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// concurrencyProbe is a transport that records the highest number of requests it served at once.
+type concurrencyProbe struct {
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (p *concurrencyProbe) RoundTrip(_ *http.Request) (*http.Response, error) {
+	n := p.inFlight.Add(1)
+	for {
+		peak := p.peak.Load()
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	p.inFlight.Add(-1)
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func sendRequests(t *testing.T, ctx context.Context, transport http.RoundTripper, n int) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/", nil)
+			require.NoError(t, err)
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTransport_GlobalLimit(t *testing.T) {
+	probe := &concurrencyProbe{}
+	transport := NewLimiter(2, nil).Transport(probe)
+
+	sendRequests(t, context.Background(), transport, 8)
+	assert.Equal(t, int32(2), probe.peak.Load())
+}
+
+func TestTransport_ToolsetLimit(t *testing.T) {
+	probe := &concurrencyProbe{}
+	transport := NewLimiter(0, map[string]int{"projects": 1}).Transport(probe)
+
+	sendRequests(t, ContextWithToolset(context.Background(), "projects"), transport, 4)
+	assert.Equal(t, int32(1), probe.peak.Load())
+
+	// Requests of other toolsets are not limited.
+	sendRequests(t, ContextWithToolset(context.Background(), "issues"), transport, 4)
+	assert.Greater(t, probe.peak.Load(), int32(1))
+}
+
+func TestTransport_QueuedRequestCanceled(t *testing.T) {
+	blocked := make(chan struct{})
+	transport := NewLimiter(1, nil).Transport(roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		<-blocked
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/", nil)
+		_, _ = transport.RoundTrip(req)
+	}()
+	// Wait for the first request to take the only slot.
+	require.Eventually(t, func() bool {
+		return len(transport.(*transportWithLimits).limiter.global) == 1
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(blocked)
+}
+
+func TestToolHandlerMiddleware(t *testing.T) {
+	limiter := NewLimiter(1, nil)
+	handler := limiter.ToolHandlerMiddleware(func(tool string) string {
+		if tool == "list_project_items" {
+			return "projects"
+		}
+		return ""
+	})(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(ToolsetFromContext(ctx)), nil
+	})
+
+	for tool, expected := range map[string]string{"list_project_items": "projects", "unknown_tool": ""} {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = tool
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result.Content[0].(mcp.TextContent).Text)
+	}
+}
@@ -250,6 +250,29 @@ func (tg *ToolsetGroup) EnableToolset(name string) error {
 	return nil
 }
 
+// WrapResourceTemplateHandlers wraps the handlers of the resource templates of every toolset with middleware. It must
+// be called before the toolsets are registered.
+func (tg *ToolsetGroup) WrapResourceTemplateHandlers(middleware func(server.ResourceTemplateHandlerFunc) server.ResourceTemplateHandlerFunc) {
+	for _, toolset := range tg.Toolsets {
+		for i := range toolset.resourceTemplates {
+			toolset.resourceTemplates[i].Handler = middleware(toolset.resourceTemplates[i].Handler)
+		}
+	}
+}
+
+// WrapTools replaces every tool of every toolset with the result of wrap, e.g. to adjust its input schema. It must be
+// called before the toolsets are registered.
+func (tg *ToolsetGroup) WrapTools(wrap func(server.ServerTool) server.ServerTool) {
+	for _, toolset := range tg.Toolsets {
+		for i := range toolset.readTools {
+			toolset.readTools[i] = wrap(toolset.readTools[i])
+		}
+		for i := range toolset.writeTools {
+			toolset.writeTools[i] = wrap(toolset.writeTools[i])
+		}
+	}
+}
+
 func (tg *ToolsetGroup) RegisterAll(s *server.MCPServer) {
 	for _, toolset := range tg.Toolsets {
 		toolset.RegisterTools(s)
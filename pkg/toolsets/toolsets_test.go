@@ -1,8 +1,12 @@
 package toolsets
 
 import (
+	"context"
 	"errors"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestNewToolsetGroupIsEmptyWithoutEverythingOn(t *testing.T) {
@@ -262,3 +266,52 @@ func TestToolsetGroup_GetToolset(t *testing.T) {
 		t.Errorf("expected error to be ToolsetDoesNotExistError, got %v", err)
 	}
 }
+
+func TestWrapResourceTemplateHandlers(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+	toolset := NewToolset("repos", "Repositories")
+	toolset.AddResourceTemplates(NewServerResourceTemplate(
+		mcp.NewResourceTemplate("repo://{owner}/{repo}", "Repository"),
+		func(_ context.Context, _ mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			return nil, nil
+		},
+	))
+	tsg.AddToolset(toolset)
+
+	tsg.WrapResourceTemplateHandlers(func(_ server.ResourceTemplateHandlerFunc) server.ResourceTemplateHandlerFunc {
+		return func(_ context.Context, _ mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			return nil, errors.New("denied")
+		}
+	})
+
+	_, err := toolset.GetAvailableResourceTemplates()[0].Handler(context.Background(), mcp.ReadResourceRequest{})
+	if err == nil || err.Error() != "denied" {
+		t.Errorf("Expected the wrapped handler to be called, got error %v", err)
+	}
+}
+
+func TestWrapTools(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+	toolset := NewToolset("repos", "Repositories")
+	newTool := func(name string, readOnly bool) server.ServerTool {
+		return NewServerTool(mcp.NewTool(name, mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: &readOnly})), nil)
+	}
+	toolset.AddReadTools(newTool("get_repo", true))
+	toolset.AddWriteTools(newTool("create_repo", false))
+	tsg.AddToolset(toolset)
+
+	tsg.WrapTools(func(tool server.ServerTool) server.ServerTool {
+		tool.Tool.Description = "wrapped"
+		return tool
+	})
+
+	for _, name := range []string{"get_repo", "create_repo"} {
+		tool, _, err := tsg.FindToolByName(name)
+		if err != nil {
+			t.Fatalf("Expected to find tool %s, got error %v", name, err)
+		}
+		if tool.Tool.Description != "wrapped" {
+			t.Errorf("Expected tool %s to be wrapped", name)
+		}
+	}
+}
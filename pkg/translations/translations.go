@@ -1,11 +1,15 @@
 package translations
 
 import (
+	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/spf13/viper"
 )
@@ -16,8 +20,55 @@ func NullTranslationHelper(_ string, defaultValue string) string {
 	return defaultValue
 }
 
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// Locales returns the names of the bundled locales, which can be selected with the --locale flag.
+func Locales() []string {
+	entries, _ := fs.ReadDir(localeFS, "locales")
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		locales = append(locales, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	return locales
+}
+
+// loadLocale returns the bundled translations of the given locale. The empty locale has no translations, so the
+// default values are used.
+func loadLocale(locale string) (map[string]string, error) {
+	translations := map[string]string{}
+	if locale == "" {
+		return translations, nil
+	}
+	data, err := localeFS.ReadFile("locales/" + locale + ".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("unknown locale %q, bundled locales are: %s", locale, strings.Join(Locales(), ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading locale %q: %w", locale, err)
+	}
+	if err := json.Unmarshal(data, &translations); err != nil {
+		return nil, fmt.Errorf("error parsing locale %q: %w", locale, err)
+	}
+	return translations, nil
+}
+
 func TranslationHelper() (TranslationHelperFunc, func()) {
+	t, dump, _ := TranslationHelperForLocale("")
+	return t, dump
+}
+
+// TranslationHelperForLocale is like TranslationHelper, but falls back to the translations of the given bundled
+// locale before the default values. Overrides from the environment or the JSON config file take precedence over
+// the locale.
+func TranslationHelperForLocale(locale string) (TranslationHelperFunc, func(), error) {
+	localeKeyMap, err := loadLocale(locale)
+	if err != nil {
+		return nil, nil, err
+	}
 	var translationKeyMap = map[string]string{}
+	// mu guards translationKeyMap and v, as tools can be built and called concurrently.
+	var mu sync.Mutex
 	v := viper.New()
 
 	// Load from JSON file
@@ -35,6 +86,8 @@ func TranslationHelper() (TranslationHelperFunc, func()) {
 	// create a function that takes both a key, and a default value and returns either the default value or an override value
 	return func(key string, defaultValue string) string {
 			key = strings.ToUpper(key)
+			mu.Lock()
+			defer mu.Unlock()
 			if value, exists := translationKeyMap[key]; exists {
 				return value
 			}
@@ -45,15 +98,20 @@ func TranslationHelper() (TranslationHelperFunc, func()) {
 				return value
 			}
 
+			if value, exists := localeKeyMap[key]; exists {
+				defaultValue = value
+			}
 			v.SetDefault(key, defaultValue)
 			translationKeyMap[key] = v.GetString(key)
 			return translationKeyMap[key]
 		}, func() {
 			// dump the translationKeyMap to a json file
+			mu.Lock()
+			defer mu.Unlock()
 			if err := DumpTranslationKeyMap(translationKeyMap); err != nil {
 				log.Fatalf("Could not dump translation key map: %v", err)
 			}
-		}
+		}, nil
 }
 
 // DumpTranslationKeyMap writes the translation map to a json file called github-mcp-server-config.json
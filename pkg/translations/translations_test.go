@@ -0,0 +1,57 @@
+package translations
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslationHelperForLocale(t *testing.T) {
+	t.Setenv("GITHUB_MCP_RESULT_LABEL_DELETED", "Label '%s' is gone")
+
+	translate, _, err := TranslationHelperForLocale("de")
+	require.NoError(t, err)
+	assert.Equal(t, "Projektelement gelöscht", translate("RESULT_PROJECT_ITEM_DELETED", "project item successfully deleted"))
+	assert.Equal(t, "Label '%s' is gone", translate("RESULT_LABEL_DELETED", "label '%s' deleted successfully"))
+	assert.Equal(t, "not translated", translate("TOOL_NOT_TRANSLATED", "not translated"))
+
+	translate, _, err = TranslationHelperForLocale("")
+	require.NoError(t, err)
+	assert.Equal(t, "project item successfully deleted", translate("RESULT_PROJECT_ITEM_DELETED", "project item successfully deleted"))
+
+	_, _, err = TranslationHelperForLocale("xx")
+	assert.ErrorContains(t, err, `unknown locale "xx", bundled locales are: de, es`)
+}
+
+func TestLocalesTranslateTheSameKeys(t *testing.T) {
+	reference, err := loadLocale("de")
+	require.NoError(t, err)
+	for _, locale := range Locales() {
+		translations, err := loadLocale(locale)
+		require.NoError(t, err)
+		for key := range reference {
+			assert.Contains(t, translations, key, "locale %s", locale)
+		}
+		assert.Len(t, translations, len(reference), "locale %s", locale)
+	}
+}
+
+func TestTranslationHelperForLocaleConcurrently(t *testing.T) {
+	translate, _, err := TranslationHelperForLocale("de")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("TOOL_CONCURRENT_%d", i%3)
+			assert.Equal(t, "default", translate(key, "default"))
+			assert.Equal(t, "Projektelement gelöscht", translate("RESULT_PROJECT_ITEM_DELETED", "project item successfully deleted"))
+		}(i)
+	}
+	wg.Wait()
+}